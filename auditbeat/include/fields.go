@@ -31,5 +31,5 @@ func init() {
 
 // Asset returns asset data
 func Asset() string {
-	return "eJzsfVuT2zb257s/BSovdqq6lbgdu6b88K/t2DOTrkkmrnG8O1tbW2qIPKSQJgEaAKVWPv3WOQBI8CKJUHdmX/556FgS8Tu4HJw7wBfX7AEO79kGuH3BmBW2gvfsR/cpB5Np0Vih5Hv2Xy8YY+yDkpYLaVim6lpJascKAVVuGN9xUfFNBUxIxquKwQ6kZfbQgFm9YP6x9y8I6JpJXoMjvMJ/0rezNPG/37ZADZgqmN0C9ZAZkLmQJX1RqZLVYAwvwazYXfQUNROmgzJgsYP4e6ZkIcpWcyTHClHBFX6PP3LLdrxqsSVrDeSEKSx+lMrGYNSEbZWxnpJ//jdFpAb9uMLf6Kt7/Hjf4Sga8fF+raaTFiien7iub9wwDbbVEnK2ORAp1QCSkSUzB2OhZkqy/VZk277j0dzpVkohy5neWFHDH0ou6E148s/szQ60EUqe74x/MLAVsTMtfgkSuwI5s1thHCuvhqz7zf/AoRjL6+YbD4q8/p7l3IZ50PC1FRry98zqNnxZKF1zO3gOHnnd4Na7bcvWWHbzzm7Zzfev312x1zfv37x9//bN6s2bm2WzS11ie8fI4LchbhANmdI523PTj280KMtLc5rKrd4Iq7k+0LNutjKOooD4vQHtForLnD5YzaXhme3Xw83TiLCTDoN5VJvfIQt7zX1Yu18e4LBXOj/d0U5WtQZ0v6dQQDliox6A1koPOlBq1TanifwVGwUJmDmKyL88zwU+yysmZKFwZ2fckPwiOmYVmMFLxQAYeuOFWfd96JOFRxt9eaRbfdc8zmpCIFP5FL1SskxBR5ApNGJNoIdrtgjdsYlXUVml2rzXUR/wI2u02okccJiW59zyebX1i/+VFVrVDqlranCtehHE83xND6wDJD6ZgTFKH9Vi+OiKWq0C7HhjQ3Zm9/4zUm/DHq7YJ2WMQMYlnWQY14CAV6zM4IopzXJRCssrlQGXq6N9E9JYLjNYizNb584/yO4+hi6hEmE1z7ZCjrfuHIXzmqmjEev1ZVT8A+uIz7p5tjerGnLR1qep/+IgiMXSiHszR1TCHtaRyut60Jpr4MZev87OCNIIiJFGFL22E8Z1R5hezZ1gOZKN3ap2XfG/XD8uZz3fBPvyd6XKCtxOO05dQ3lW1f6Lnjk3Pr/Rc5U90P7xO/1j+DwD7n5jxnKL4reqIEOdTdvc/YZ71myVtmunAd6zglcGF43LbKt0oHfd7fIXQ6Echtx1i83qh2Ny3OsE0CuRP00mfpHiaws9IBP5nFTvyNVz6iOJYswXBBesU98BNCQ2ragsU/JUVyJhcGFPPnQ0EesUrYpvoDITagNbgp22J8705Y5mwtHpmBaZuWfZn9ynGZA7NAYiRkUtNxE9PW/i92c509NO48unr8lP3q2YrsYzcboTEDNMznW2FRYy2+pnGMMAjr2CVblij395t373wxXjur5iTZNdsVo05ttpV5RZNRW3aNI/rSe/fmYByPchA2mVuWLtppW2vWJ7IXO1P9KJocdzeR88ziyNgteiOjyZhIPxg9SQb7m9YjlsBJdXrNAAG5OfGq1oJl0YfHWC+s/CWBRod5+ueZ5rMAbMlEDNs6cNMpDZcp3vuYae2BVrTcur6sB+uf0Q9yHIkYd2A1qCBdNLk3/E382Q7X/vzOChTduDsliWnFaLfaOzAmjQaZYkhhqVP4N6iGagUbmTbbOk2qeKpojSJ5WzL3cfp4Twr2l49nyD6hGnxNADe9YZRMQjU7hUuS4j5NBYzZspJS6lshT/ejZyEeQ8zec0WCK62cB2OUX2GUy2WboON/jRFLmNHOnweUG4NzHSG8VWKH5Wq7yt3ABnhzCO8rrnpwE5iOJxQwpRnGk6eZ1bVKmyhPxayONduSUg/9sGjLN8t1yWvj9Wi7IEPekP/vc3b9UVgqbIQqnRx+uGA6yZOvRBNnJrtdi0Fsy6VrkoBORXLNOAY0c9WQH9o21y902tdvg/LnMf41q7bg5mB3sSzUovmQeD/xv2t6fvNOOcwG+43Q5EfRSVGiDiiuLDzKpuRoLCrdvKivVQMQQCmu9fnN4FR/fALEn2G3qcwjDOpJLXXPLq8Afa30jdLagL3BdtFWGhec7LUkPp5MYkWKpLsOvJbJwIT1KMlpq5TiIFc6grIR8m6H10YxEscRvFM165VEYuNAWHPP63I/wcdiJLouBajGCE7AOJi1CoAdPQaDAgbcjg+M3S/dtlAEbEev2dEAhGohQDvvvIXn25+/gtTQpkLe1KkWMnCgGavfpMPxZ9f9Qe5fKwD/Rd8sJQq5cuGO28pgFouXxgxOBa1Fwf3Eamcf191PUxfmSLXUAhlsoz6PVpFugE7/fvfvj+GEHEGMy9kExlllc9q9AmHJE2YMdMsVGqAi7HxKMMzID4Z7BMRHS33En7ewd9zzbCIpkV+7UWFjWQslvQe2HG02DAjtfxGftSpvVF/DFckii0P8+h2AInfXNA6+GVE43CMCWrg/cnKKd0j3j3+Ms9trsfy5XainpIOkp0TUhX3FgWFB2lrwZM8Iq+Ibpoz6CZseemazCmnaXTDkr9BOXOq0LS7vkJZaVFKeQipRhrq1vJuNb8gKSN1UKWJhgdlEbqLQV4tCg5Kk8JNUdsKqJ+c6oOrQ/PaC43/eVfPzPh5i1Xe1kpnvsY6Yr9KgcevWmbRmnkKyHRD/718xXbCe68yF8+3lmo/9cWNPxNq9r0psIqggiMKYrQUyFdxpNCwJQ/7y3IP90YWKz2J8qeKVJGEZofD7LCZOdXQraPR93fCet9/uvP2MBrH3sYClfXaH42UIGkTAdSI+UTSDj3aTWeZFVBMiwhvTTdaBBkDJyrmgv5ZGgHw7qs4JBIBTuoztDohK/5PoEuIXdhXt7mwkZZyNvweQxkgJJ0bknJaeodmJBppLbeKzjhNs3a7XNWecYtlEoflmhiIn6t22pBxQb14qXp8N2GcuUxOWixCxkX0lSuyIAC6/erJxm01EdftPDS9EUDk52wZHJ4gulYKdzm3mS81P6caw4J7aEoILNiB7NIhUmAcurdVdTMgaVgoR02C5JgwQardTg3CQD93MxCmQQoA3YepEhBiWd4Fg3/rmveLNMQUZa8wB1lVLWDtcgNmV1UVqbIhpyvGKMatvtAsq8y24uqCmEhxlnNmwaNDFUgX3ulSKHG4Dt7yvkwW+Qi97ih2PV/Ma2U/fa0yuJx2HWBAoj2X0R4ugcXgR2DgUSc0X6cQYz35CLIyb6cAU3F7PfnDFiZhtX7fuO5SwQa79e5cSZC9vt2diUS0ab7t0N9dhOPZ9aVEh3fMKk2Hs8y1UrLTLvx1jcZsy0KAisyHhXfXWrvYZdGlt6zGHo0G/95O29M1pt5Q2ojg+riMXWGE1XM1Q3XtgZpzcCa8Um2WYNmQOGTe3BZoHa5Cguwdx+HVluTgsE1ip/mCFS0Uxd3hyJPrzJV19+OrEm0wFPRqJFfhprLnFVCAmu45jVY0Ia9wr7TUyNy8LiY2O3GqKq1Pgztdz08Qtba3uXteGyfFPjLWk1TvFf6AXV3LjQgtx2GqFyXJgU2rBjXZUusyTja+JUwwyyLUa3OFmQSPtNzJzIlxxhWDC0kcVS6aqiVpXJNDcaMWFZpmzR6pS2Tbb0BPQQa1difBQslMWHR3XSNOpeULXDhCxtyGtwYlQnyIvcCP7NWikdmVPYAw5XKwVgh+SgddmS5PvYPhxn977X7/7d2Eizu75Xb3McTmsM1DA+HAXgUZjUvCpGxV/dCZqoWsrxHCXivWlsq/PTtgHgX4ljmVxv42oLMlsWah3GF0NQvXycpKZmM8scYUUanQx5AS6hW7POQJPPtXVm0sQrZlcRXK6R9cxN8JNfcJUq5RAumUrsx1xgwUR1VYpLJN2Z3H/u+W4VyFB2aFbsNqXLDNFQumd393CEFFPLWtnznokgGWZNiLsMOazBtdWrH9OZQm5GMV5oVXFTHNycCdsYqcgPk4WSOkuyVx/kOQcZRcNPWNR9FoI6XAXUshxpsZGTFrY6ZWGF7kbszI69cACmSWtMuxPaXiLp+bDZPcEDggpA5m7f7B3IhGIousMyrESfgf3cfV+zOOmaQqktX4KBCpsidb6HvyXPnkpJCwSuYFrgZyJTMLxisY3Lf+OwAD43IeDXIL7Cel31my8/WFYPHDBpLjkt3dIlGtuXuSB27N+392EQf1QKd5Z255ABSsluKhGgPyDZA6Rcq0m2b/mjeQmZ6+gmY2/jHMMN74vEtsG+ov99g7100xmX8nS65GgDhHF57mTJTTXkh23/zzfNxVYfVq+6nJXz6qp2t2gfWJJHKDWtAF0rXkK/YF1+LaSNG6O18RhmfzlMg+UIV78Qazp6H/Fw1UGw6HC+PHIwnlI9Sk4nZMOHCeZkal2UsmkVXluFU6dizh10KkisTcZ4bN6xQraR84nc9TrR916nBjgm7IEhqOGMWJD2CMQuzJEhxFmUgQS7CuCQY6RKFcXg/sjQS2cB2FUODGhvTQCZ45Wp8qLjg2xEh/Js6/Achc9wzbhSdseL2qoYCNFqM+XiOLgkTujkaRPmjbWehTkF056GwFQqg0N1M6XzS2TpxQ1NBTVHxkjLZvK8BPHIIZOn4aV8LyfguM6OKMncAky2Lj/YHMntbrTvCf85eHJ8mPtNxCbYQlQXNGo4qkuXCNMqImcBoLeTEGF0i7qjdvPzk2cREOR3ODdHWENedCXGj85y6G4d+t9dcwcwSVDEs0YeUJa3LiGImmm2apA7udKYPjVUegBlwh5/GOydpJ+atdgaamyBv/Y4gQVotwCR1uXNFfeNg+PUcREG8ESUUPylkDOgdktEsqwS6u0KGWeqE15hEVNG1TCTCwdV0oUl9Btw0F2SZ+jDvmDd1tk3lTaiK4UEsklwT4DJLBe5XtA9ukpNEJtyO0j7opozFAP/9MjGA7ebFQCtFkhhw+ag8btdXHm/S7J0R+85JFAu6Ric0aYJ9G7fVY1niT/Uik5Sa16iDRvRKzaVVOml7Nrz2BTOG8YYOyPvy4YFO6BTSIIC2dCHjQNqZfaOaVHYc2yadO9KVo/mQy3h1bFIaytoDaz1Lpi3LcDssZVRsEraakD7hO76DRGWUvhgPLTIblqXYfvvfcW1ll/QdHk9Mswq0sipTUyGQJG48s/xy+4HxqlRa2G19TN01RRrjg6YZLZTec52js60hO7Aa7FZNVKmFOgl9KCcpWu2MUDOIe4yl8fdPclD466c1v3la8zdPaj7KLiye5e4upkTjq4ryIgtraDIUyz4HELXu3LlURG89RgmXsbn1mKzfSGxgu/g6mm5DJwlXv/nQ6imELGlTiwnPVql283Aevek8sZ4uWpzjM5nxxl8MkiQjlBGPfVsxsYdllBZ+sv3kg41sxnaSsL8GiXZGklEpYU+y3YftHQAzYHFTjLcvPrPe8OyhUuW6EnUa6zkSzsB6aZjHYV9baCE+OhEZEukmhNKHOTsr4806LbgRDO2+jKpnENYXFcaBgjT9Hc5CUUtvmFDIHV2HnZjIDgn7dZO0O3FtwzAaNBqpSunkMFSVX8JF/qzQYk5SqdWJXYn4tEx40PkkzCp3yb0RzIZLCWkmsm/i1k9JJwUhZ01/trgTsMAHV2gsciopiepbelbxR2JG6Lv6OrOPqeJmh/uRjvo8Wn8iZmJzX7heR/1VA8iSSbMcSruIlym3YqJ9M8EfZGmTCNz+zw8sh0xQSphcJsi/y0GKCRWUujq6vi0xUq8KpmUZEl95OCLEONuNjRHcmRKShhN2JdnGPv4U58gnNIwoYbfGB1SSKBMluaNzWlWkQZ1IiKgqX4MslM5E2oTjPscpcI0BtSldXtWOVTVO8S5r2kvmuNfYHz59YZnSE0NA435Nge6qP5kv/4wAokqENHPyZJ3BsMxgrP3zPNG06KYEFZFLPeRg5xyzgicF8L0VFHh64IR2O7OSD9eJUqsRueuurYR8CGFrAzKfcKNpN78nWZ+maaiRE4snhS3/P99fv/m/qUJ8bCnORtiyYZp+UfTHHet3LdE1NQdTjJnbqah0pfbSdNfBzm/6TNVJrKH6GY6KTWcsQpTcifI0lqNeZJ+SpkFN7ZJ2fVfBTmGAHiNORXWrWXGTuv9dkSi1PN19KppOmh9h1q7R2nLzMKyS6na6SEGk3FfXSyG3oMVZG3ZoGSWKKt/YiauxjW+S9I4rQjxUiucDievDPGNv59lDA8jhBlIz/SirAw9GbXt9vuOVyNdegF3C2cOm3fgT435++NGeHPe0eUzd4Hef/t1FHeatmUS3RTRZL5HmnZaaZxQITYEFu6ULh4KGwibs7qPL3I4l6AV6ytdjnVRSIlG33n2imHOpec0KzUsyw/oahRneTQvWxqeJYnt6TrTt6lTLgDyko74MRReSBFuAWijS0DJNdZkbDTuhWuMODc45usqkWY8dJ/c1t2Ppnl7ZEb45lsATieUigy13pGAEuSsX5iHJbRPm4SxjNXQWJ1VJxE5PdyWwO9UzUz9SQVJmrQJZdgX03coXFU/zoRqQXex4LkvcJnInZ1++TPdRYrWNgQwNjlBpOBPOJi+g1IlhM2f++zOJR2LF67RIOfH6wiAcsmedVtKEWpvXVJahClZDTVcGSPaPH8d+C4VdLlHbfdTFb4McMpFTvGtE4yIvHUewyEvH2cm2PLUyDvGxGc8s6BCEWWDFo+h9rggP2iy7UzGe1ooiaVu2lu660wXPjoRNsjppXwa3aVhwO8LcKpUkO/ucL7Z0aThvumQ4STO5N1zl5JpOXGPdysGJ1Y7xU/Wn8W/wOBpuNiKJK05FyYaZlT0Xdh1dqvSU7ApisQir01QUoU7yDHyTOWcaN0mixAqBs0VSK1VmdRdKTYTWTKRYVXmi9aaqPNWCQ1F2wXrmHGrSbPFVE86xpjf2RKidDUb3PqWTqlT50gxbx6mnRDMMt2J3Ud5QzI7A7QWupO1udKSDPHHU54TLUvMkV+h8cdme27Siu2Etr2t/JBwQXxSZqnVGWIn2z9EbHjZKpYljrwCDBTG8l68z8LO6id8qsowB/CtEsPHotT0x01YqS1RUe3/Hkiq6fFsEMhAZvdJaqgRdWYhWtTBZi3IiMsP7uuWkOebufGG4yLEL8J20aUSqPRzXYVBVwkh/DHLUSaI0zlEvFKU5FLyt7PUFcsM3JUNzPgxFvliqsOsEXXhvB4KgZIpuLR0YNqpcN9yYfbJIdcxZKE2pdsJQOqfLlafyjlYjsWJ1n1r4EErtLlmOYPMHqR0z7fBQ8MDx2qXZLXFkKC7UOZ15iDzvNNE+WYTEKXGb7XhcMyoNWZZo8ad0sF3LK/HHolM6FNJKSxSlV5okp0SD0djdiDOfEtWJkWQvM0+aKZTpT+1vHEyf66va/P4UWTM4iNtb9onR5EgxzdXj4kI9IVg28Hknmsg5PgUXVWJNzMjd8QhzSTIh03xqujz1pEu9S9L7ft+FNw3NyYg4oLYIs655czIcR3GvxKx6l/b2fHC0DOPJkfnTPIEUEiPTXazboFifCXUb+JpY0zK8J2O8YP8RFfSVboVO4t0u+G3Y15Z3NwXEQP2UpKYGxwUpFBaJztkNlHS68uy0/0LLAyVyarkOCuTzpTrIgcnBJ1XlR4NP5C8kadM4xLBQo+apZdY+hXr36YilQSo68Yz3RENP76wTKrNVYljSXZLytQV6dZgrdAkXZhLekXoXk5hVdkXix40v3jRc12mH1EIbfyAnupl7LFX+/D2DrLSueZo2H0fSsP34CoYQzJg7s5IozF3M9WiOkaRLehXJ0kzr5vf1JZednjDINHCTdhouCmGxHKSy7lp6B9S9v3D29F0lTHIkbWxLuVA9Ih2vxEyNrAS5sCS6smnTjg/7MJNpN8HKaOmy7Do27SslS8M6y3ggmZP2XSyZEzRVqiAduA5TIfonRZ9anbSzvvzrjjVK+BfKqKNxIWfmX3CwIGZN89L4YwXf5cLQidqjZbyXRViGTLowyuLM1VRbaqwnZ0qtmjTEKmRpjZI8OsbUSeEL4+wLi1ZtcjFiH8D35UYIIIr5q3MTDe44e3KuxlRC0s1HjjmcI2OAbhEYnunoupx2rJveVSAyjz93A9GxA9GiLi8+9UGeunvP8OkDIFV+SdY9sPkk834u575OnL1wR2unX04nHXyhewqF/ZbK7tye8K73npsAVbQVU5pJNR9RvtymOBdQ9s50kjLoPCD/arvZcldXZT/CHdaPH3/DqI7fFTnfpRkTf3Kx6KA/A/8mAVXz/fjIgLG6pSsvZl50fCmVmQtWY9zFb/LtX2ovxeMpin5M4d2++PgVE83uB/r77ipEdOZuoOsvVU0YZNrlqjG9cMfQi5je+Xc13UqmdE4ORuUvaLN+QQMi05DB8HUo/rwceigd0h40uON5VqGkcwzgbqHLVUYOpb9GsXsJVxBeoqCbS7SO/L5wrUIwBcOVGHTBktLY5l7IrGpzWGu+X/vuhndJdDiDd0kM52zPtRRy+Z3Sw3tRQ+vpO3B+BG7DXT6etpuN6NLD0ZWL/ct1vOVFYCGbxmVOv3WnV3PYQaUactLxxxw2bV8r07S6UcbfQza4BrcE5VOTY2EzO1AcJjXp3pGKHYRCyHAZbabkDqSgQJ6QLOMG2EG1vnSt9wZAapFt+wVsjfO4Ajo5REKyn1VpLDdbXOE7WYKx7J8qh+n1zHFNI1rHIO1aJl5dMH7BpowuSu5QJ9fnC3t4XkrCHibvrYJSKPmsZBzkZDSqlVYf1sKodWpxaEztg8Nhd59/pSrRySsO1MDo7PgP1Jrcm2XjQRdT2DYHYvqKW/rQvccKdey6ezNt/z4regPsXfT9WNAveK/VEPvk+6223GyX77GfuNmCGbwpjcb6AAe33/pLVyS9xcZd2xm/ZDfIry2wLTwykLgCOcsF7R/3nL+4c+6y603FH+Bms755+26pJPzx59t//PVmc33z9h0N98RbNAP6m7/8kIr+5i8/LEV/+/omFf3t65tz6HX+dinqLx/fnkMz2+5ymLNwn3+6fb0A7+Zm8aR+/un25ubsfCLmcjZAzPMcYLY8YfE//3S7YN0Rc502enp+GW7SDNDzi3ATZ2G9dB4SmJ9wF3C+2fI01MWYiav29vXNd8vWjbCTVo6wz6/d4+P23eIu//vf7+Y6+/8CAAD//8G+yCQ="
+	return "eJzsfe2P27bS7/f9K4h+SQJ43WbzgiIfDu426TldnPY0aJJ7z8XFhU1LI5ldiVRJyl73r38wfJGoV4veTZ/nAZ44aGNL/M2QHA6HM0Py6prcw+kd2QHVV4Ropgt4R36w31JQiWSVZoK/I3+7IoSQ94JryrgiiShLwU05kjEoUkXogbKC7gogjBNaFAQOwDXRpwrU+oq4195dGaBrwmkJlvAa/2l+HaWJfz/vwRQgIiN6D4ZDooCnjOfmh0LkpASlaA5qTe6Ct0wxphooBRoZxOeJ4BnLa0mxiiRjBaywHD6kmhxoUQNhitQKUoPJNH7lQodgpgjZC6UdJff+Z2FIdfhY4TPz/hZf3jY4wtR4mq/1sNE8xfMN1/BGFZGga8khJbuT4UNUgNXnOVEnpaEkgpPjniX7lvGg7WTNOeP5CDealfCn4Au48W9+TW4OIBUT/Dwz7kUvVljYdn4OHBsGUqL3TFlRXndF95v/hVVRmpbVNw4UZf0dSan27SDhj5pJSN8RLWv/YyZkSXXnPXigZYVD77bOa6XJzVu9JzffvXy7Ii9v3r168+7Nq/WrVzfnK9SwRI5WkMENQxwgEhIhU3Kkqq1fr1Ka5mqeyq3cMS2pPJl3bWslFFWBkfcKpO0oylPzRUvKFU102x/E6IQeYasd3Bv4/B0Ru98h8WPNftnYJ/dwOgqZzjPa6KpagWzHFCooS6zHAUgppCttyeRS1NU8kR+xkMNDGqgdUSfRNGX4Li0I45nAkZ1QBShoho7RiIS0WtEDem6cMmt+9zxpeGjVzyRbLWsOZz0gkIh0iF4InsegI8gQGrGCl8f6bBE6Flz7KSopRJ22c9R7/EoqKQ4sBaympinVdHza+sU9JZkUJUk6RRWhadqqIJqmG/PCxkMikQSUEnJyFsNX16bU2sP2BzYkZ0bvv4LprcvhmnwUSjEUXDMnKUIlEEhuViRPYEWEJCnLmaaFSIDy9SRvjCtNeQIbdmbo3LkXyd0HzxJOIqSkyZ5xWEDh/MzU0Ajn9WVU3AubQM6adtY36xJSVpfz1H+xEGZQxRF3Zg4rmD5tgimv4aBW10CVvn6ZzLNwGwARBCKsne2YMiYFmhPNNDfFUSWF0Y0s7bPinlw/zHMSip4rgrz8Q4i8ADvSpqlLyM9Otb+Zd87Vzw30VCT3INuR/sF/HwG3z4jSVKNNWhSQaEjtMLfPcMyqvZB6Y2eAdySjhUKxoTzZC+npXTejPBjkYZUbtsbnh7BIWMzNCSDXLH2cTvzC2R81tICEpes5ciXNH6mFQ7kwcN46dQygIbGrWaGJ4HOsBMrgQk7cXA7SyN8crYLuoFADah1b4ow9cYaXO9MSlk4jtDhYW5H9yX4bAblDYyAQVCFHVE8rmwh7VjId7Ti5fHyf/OSWFcPeeCJJx3qNCjmVyZ5pSHQtn6AOHTjyHNb5mjx8/3bz9vWKUFmuSFUlK1KySr0YsiLUuiqoRpP+cZz8+ol4IMdDAlwLtSL1rua6XpEj46k4TjDRXfFczoPDGaWR0ZIVp0eTsDCukhLSPdUrksKOUb4imQTYqXSutqwasMCqZdR/ZkqjQrv7eE3TVIJSoIYESpoMKERV0pPZU5keqYSWGDoAaloUJ/LL7fuQB69H7usdSA4aVKtN/hn+NkK2fd6YwV2btgVtbdmz02Jb6KwCal+NVkOVSJ9geghaoBKpgb4aJVWz9MkofRQp+XL3YUgI/6sqmjxdpVrEITFcgT1pC3KRwkQTLp1clxGyaKSk1ZAS5Vxo4/96MnIB5DjNpzRYAroN7ESjtmSfwGQbpWtxnYaxnttWu7z330dQ++7eSE9vW0fzwroUaV3A1WQV+l5e+/7QIed8WuurAYXAzzRsvGZZVIg8h/Sa8WlWbg2Qe7YDZay0ZE957vjRkuU5yAE/WPrvzqpDzy1hXEMucY3XVAdINVzQe91ItZZsV2tQm1KkLGOQrkgiAZ11OE8WYP5RV+g+TFekFAf8ju42u8LZWDYH3FgH77cVTe7RgEXXhndQz7ADD0yhR3bjyq2I+8fGrPWLAtL2p4Ypyr28+kcSDKNTXClcXelopmyxFbH/34gKuG8M91NSCAXpiKjcM56eExRcV56RV4Tx8mqBydYU21qhQCd3JaStUlJLiSPFvIClqLfYgGumTytijKIKJBMpS4jitFJ7oZWn0LS5b1e1Isc9SKBeTW4N1T5xSgKh8O1Qq1DVzTSDFEJPt0K4SkRIIo7ch2V8/1DPSWuUdDreQbnu73AZOkJ7LtCGwY9OpndU7cnz1+vX65ffX9+8aJtrmvuf6pJyIoGmRqEF7/g6DXUNjuurMTunQ+HvOPrb0by+6ttBHq6ien814ePtIKJ+xJeJFo1+8eZrWReabUL4loCkR/fLWDdPNo0X8QFJ8hljIkwRSrjg15TT4vQnul2QulWPNmyW1UWAlQlJaJ5LyO3Evr7qNIOmMge9GbRGl9dBg9hiBIsZCupUFozfD9BbX+EiWKyoYYA8x3+uSMrkirT4L3r4KRxYEkXBlujBMC7SKBRTAAeXBIVKhOfh1NP82462HrHWGj5LqpWJz36Y330gz7/cfXhhGgWS2sxxLEUmMgaSPP9kHmYtP+KIVk6XB/PbUi6ajjGlnhkZk4jTA82XVwwhK8lKDGgZvwn6vJ//o8d6Hz9Y2VxAIbRxRtDLeRFotN53b19/N0UQMUISaKmJRNOiFRUzCHukFei+UOyEKIDyPvEgntkh/gnD6wHdPbW209ZCb8mOaQwUrsmvJdNozwm9B3lkqt8MCnT+9XjJ43hhf8LVRKBsXEIV+9MMwN0JlzrPjWJGb77gxcmtzk2Edot4W3yyRR63fb1SYkR3Krw8IF1QDGE4s5Fo1pUz8tz8YujiqgNNEfTh+gJ92kk8bW8iz1BufBRI2r4/oCwkyxnvkJ6aFMPZ6pYTKiU9YaWVloznyr28Q4uEtjYlPGiQnBaOEsma0C/+1Ti/YYutjZ3qBM1menz57WfCbLul4sgLQVMXcViTX3nHP6bqytk8jGNg6ddPK3Jg1MDc//LhTkP5f9B4+7sUpWpNhdYjRRrBZJnnFMPJsjSD12WjtOuxr24MLJ72B5M9JpbovRce/Lj6oCj0el9BwXjtg1Z9I6vHIGq7Tz/+jAXc7KNPoej5NhlvDZxAYpoDqZnJx5Owzoh1D1aKAqJhDdIz1dRGipZ7D5yKkjL+aGgLQ5oYe5dIAQcoztBolK/6LoKuQW6CJrROmQ5i+rf+ex9IAa7+XJdiNwbuALecsFhujT3jhBi120PZ8G8nVEMu5OlquhWaNjDEr6V3bkwOoc9+OfFMNfh2QJk1LklBsoPTJqZWW5uyY8JU2/XVRJLFGG/9JrQ8uhSgZ6pNwRmMhCWNQyNMx0Kg2nImYwclAmSsOESUhyyDRLMDjCJlKgIK5wW3eh0Fi8FCO2wUJMKC9VZrpzzkl7TNKJSKgEKH0ihIpvLLWngUDXttgz5cQsYkdnL83WVkK0GJ4oA5MMqYXcgyrqdlDeP5lyYjdOtJtjmbR1YU3slKKLqUKzQyREZq5idFLKX82tlRdvEJD2PjYDigyPXfjKuliXmFI2907I235fT4Cwh7tEiwKRiIxOmNxxHETEVCDsblCGgsZjs+R8DyOKx27dfDgUig/ngdgVSRkO24HQHLYtGG47dBfXITjyZayPkBE2vj0SQRNXpp652zvo0xW6Mi0CyhQSrrpfYeshSYRUHxxxl6pjX+ejuvT9aZeYTMGFQX16kxnEz+aVlRqUv0dXesGReyHjVoOhQ+2heXOWqbYTDG+ijs3Yeu1VbFYFATLHBVGUAFI3UxO1iGPE9EWb7oghkLPBbNFMJFBAJizKVgHN3FkpagMbP2OfJu3uqRg4fFrN/ulChq7dzQbtTDAyS1bpe8Hjc5pktxPwfxmKOQ9zh3p0wCStupi0plrmJgfY9RmddGNHGvAyUFU904ghK1TOC8jH4y783EHacEtsmL6aXJDDjG6JwG4hJSurVHF0ZU7THgxOtyB7IL1NuxchbMJ5h5VW+baz0fO5llzrovtI9pUKVEwswq8sjwO6k5e3CRw05PpYBB0Fbzz3bXh/Zl36L/03f/eX3HQeP4XtvB3e3BkGKH3gf/sq+AQyFa0ixjCXm+ZTwRJeP5FjXgVtQ6F/jtRYd44+IYysxYpyv4owaeLPM1d/0KvqjrPs+3kTKjf5RiebDX6h4kh2JNPnVJElcetRcQpQWKKxYnNeP61Y1fI9niBLV+ggFUURTi0JcaBSrISjzbyf0KmcIYj2l414JQu6BZk1ufeIKx5YLqzuMGyaOY1dqeHjB9A4hC0TQ+ly7DElRd6CUuH1UnZkbF3T+UFWPVwUpYQN8ZRhowzGD3uQlOnjucbzPKir4XXNUlRo0cdl9+uhLUljLGmPttrNQIl6aIH15om47pK8N8qLWGLLRMuIBX8/t4a85IgJcCHzkbt/s7esEbitaxTIueJODfuw9rcqetMHDRhCuwUj5SZKpvfzcrd8pNgMqvCtaDqipIBE8vqCxWryl8toKniiWYORqgkVaWXWTLtdaKwEMClTZe+GYjoKkZxuNwgyrZqtp5FNuq9DLrzspO2F1hT+g9WlNCOkCyA/xOzd6Mumo3ui4UpsDVeaEk3YYPfQsfjYzvgXxj+P0GubfeGFzA+qSiVQcI2/Da6ZQX6wGnrv1jmf3mmwHSxVLVYHmkvXhcwKfNgduLoxdNo1Kpwh2eGI7CDdJfXGazDgShtfPxE6wUjH7BGJoVDWvPQzqmd5yQdDQjmm/qakpKO/XxydimyMBsGEhhVwbH0jIWtaIp4KbSHlYKhxgkmyZC/AbuTNQ8xfHzbYvjkcXu902ss2MgLggS684YBYn3YIzCLHFSnEXpaJCLMC5xRtpAYejebwFlpBjoJmPIzRQ2x0ZVkDBaGHo2ueBFjxCSi62+T2pE1MBYsWNVQgYSLc20R0lc4ia0bdTx8reITEMZg2h3F2IpVECeXYygDZgNkmwWQeP7JCtojq1MaJsD2EIG66NFkAhhuMHhTA+J6lp/bjvzAhWHhkS7vbm11VzqpJrXbSN7888wzkFnrNAgTRYqaJIyVQnFAhwPXjIuZAy2k3FTblx/0iTRMYjUe1u9XzfopwYzTaO41IN1t5u5vJnFcDsq57iG5Lnplx7FhFX7OE3tl9OJPFVaOACiwO557cHHjcS0xlyZpoGc9duDBK4lAxWD2y5FXWFv+LUSZJx4PUpNavZCMgrkAclIkhQMl7t42oJtpUZ59UkEGV2LaNzDyWSBGZP6DLiqWCT/HTdvDw23J8agYftCkXW3NRrNNQDOk1jgtkdb56ZZJBkT7mDSunGZ0iNV0t8vUwP09yk1UHMWpQZsxD4Ny3moUAYjFaApOqZRNMgSF6ExsL6MwevoErdHHoUkl7TEOahHL5eUayGjhmdFS5cwowitzHETLn24Myd4El0H2kIaTaFAdU2NG1HFIOv90DZpliNNOppzufQoaX2KIqVPpHYiGdct3eGwiJgr4pUn4y7g2z/RRyQmfJH2KAZmwyJynz//33DDgYfpbfaNGhSVFFokouhBhvtqF+A5Yfnl9j2hRS4k0/tyarqrsjjBB4khdNTlRypToiCRkJxw++xe9LHRnFSX60lcdzojVHX8Hj0y9LsYGoMFCn35uOI3jyv+6lHFe9GFRSD+2ASnK6OMryKIiywiVoiEFk0MICjtEWUsorMeg4BLDxEeouc3nHhNufBwJ4+XVRcMPrR6MsZzM6jZQGaLWLu5247OdO6BqtimtKDTLZnQyh2zE4OKC5qHtiyDPp88CAtfoBe69pNzNpIR24nD8Ro42hlpDDUOR6PbndveAuDOC1yR9EiYdzY7mtwXIt8UrIwTPVPcJfw8U8ThkD9qqCHcOuHJpSxKaJqo/JidldBqE+fc8IZ2m0bVCghpkwo9AVz4FzH4zV4oU9IZJsbljkuHA5O6R4HDcVNFjU7sW1+NCo1Gk6U0Ww1RpJdIkdsrtFiSRGx2YpMiPkwT7jAfhVmkdidzD2ZHOYc4E9kVwXklRcdPJXE/d0oqmvfRM6CdA2kWoLsgqivpRMVtiemhH8rrRD/EgOMEeSgx0xy3+jxotyOmhyuqC/trcr2qAEUyqpV9ahcWZCbq67132O4D/E6UNorA7f9+T1JIGJKwSyZIv02BswEV1LqS5zFE3ALB+2kkz10l7HYvtEMIJYe+MYIjk4OOoeRHpbGNnf8pjJEPaCiWw2GDL4gYOliAFuOzKuPiiQIiokg3gLuaklY8F2GKIsVzdIgtDKXfWl+rIYXrQ1LVMeC+jdsZ+/3HLyQRcmAISByvMdBN9iexYh8CNKCgYiDP5Bl00wx6pIx4xhBrmwQnIht6SEGPLcwyWsYgOyvI+1Q7i1APyQt+fx2ptSqWWnZ1wfi9d1vjAdIDaVT17vcY6EJVFaYKO7U4q2zp//vu+tX/j0E3vdmzFEc9bEkYHVgE7bf125K4NFUnlakerp2iYnBtiWeqOVx5fNAnoiwvmng6yaYjFiFq7kh9GupRp7LntKmfpg4QQ8TPQRgd5wFGGIryFJKCKhUDjntLMDvSlpxn3yRNR7UPUxtbaKOpunfKpoeasRhEVBotl4zvQbKzNmzXMopUVa6wCdz1hbxUUfMODsqKnnDrb0fjOjdPDzx9ctcASriC2Eg/6movg7UagDJ+oAVLN06BxSB71G5RD5xG+v1c9YMx2QNk1UPsAL/7+O/G6zDapCxy2cKqpNVI44uWkibGERoDC5hqxs1xJmYiRE4wAdJkpfTwxQXzlMvHmp2kWOTcevcRjwWgGJcgmaS5McPaHIUeOspupi7dTRTa02Oq7VDGWgaHcm4tY7wLUYrNQy1UaWiZxi6ZKwkHJmpFsODoQlcoUBfNrT5bdtAQWRqDZ7rM/zIVwGP5I4bcRMIISlfK1H0MLr5/VrAqsxcnBhZnhXDR42cKizSWP1IAjyFQAM/1vochMNAbg4JHpjW+47EocR0pnZR8+TLol85lAwtAFCRocPhMwxF3tlkF5LKKQfXmv9uTOCaUOODjPOXYkUudcCieZVxKE87atDR5KyIjJZToB2Wc/POHHrZ1u8RA+2nbm6JNFBU9JKhhB9HMi1bpWINFq3RsnWRPYzPjEB+LYXqx9E4YN57nzGBUvU/l4UGb5TDn46k1y2IoiRqzWDTIjDZbOnqYSZnGIPplUzfhtoe5F+L+spgvlrRhOGe6JOgGG4m9YS9H53RiH8uad3asekAVO38qdx/OpLtZsfypvGTdyMqRMr0JDlV6THQFsUiA5SlaD7WMoeCKjIVXcJBEaizvOFuktWJ1VnOg1EBpjXiK0bEYZ72hRzHSgmvPCF1Iw4gESSngibrdoybswhrHZ4jqKWGiPY1a9lhShcifqW5pD4mhp0gzDIeit75c0/t1fA9cd9eDi9B1c6LjM9VuIDnnVyvp7xeo8ZnksiPVyf7yXF5bfsIdEB4UGcPuyOiMtH8mT3jAc/VigPwE6C2I7rl8HpUlZRXe0bMI2l/Ig4V9AnMPF4W2EEnkRHV0ZyyJzEfqSADisY3KaCethdguLUSKkqmkRtsvMMM9NE2i2piafYHNQY6Ng2/WpmFVDAnPuHd9YlZCb/7wwNjmFbs0Rr1QlaaQ0brQ1xfoDVfUhIO6xT24WYvFKrtG0bnFmwF5pjqnlnoCxrAR+aaiSh2jqLTCmQlpQu0GA+/qw6PKh/rO9AZc2hsLEx98qt0l3eFtfle0Y4g7H0OPmll4HUDGUAk9Q01VGJyJPAQr7+VURpRmpAvSD7Zpv2aQGhK1SwfL1bRgfy7apYMNHZdt4uVm4SIXTcbokKg3Gj/9OBsSlclFQZFZMwXHbTS/oTN9jFfcRfcIXdPZiOsxo73JXuQm8nGxox7hLOuseQczkTE9N7irvZaPWe44hLEgGeNxa2rGzy2pD2UMnht3/t6uMR0ROtQWYZYlrWbdccbvFRlVb8LeTg7GK4/i8FjP/LxMIIVIz3Tj61agRl3dCv6IzGnpnpPRg/trpqA/zKnQMXRa57cif9S0OSkgBPLo8aHBfkIKfg/32XlknDsyiEHuxnkXWh6okWPTdVAhn0/VQQmMdj6hU2DK+WTWC1UsWuSMmsamWbsQ6t3HCUsDmyF2j/dghm7Le1gmEl1EuiVx7yrekg1KN4ku/sBMg+e1YY+Wiowq2yTxaeOLVhWVZdwmNV/GbcgJTubugf8FYwZFaVNSdX9Z7MKY5ATL949g8M6MsT0rkcrcpvhOxhiNdolaY7qbDRYtLtEii3QUO+/wpEGG1+4IHoMYuLBIClxgBjWheP2MEry5DXR09x2eHhdFag8DW8puuUWk8XkJtVmsZ8XrhSXelV2tYqC9m0nVO29lYNSbHMrQtMerGHATRY8W1qWCSzVzxEwVq0g7S4ehEv1K3qdaRo2sL7/dkUowd6GMmPQLWTP/go0FoWiqZ8ptK/g2ZQqhptN4L/OwdIV0oZcFycXbUv15Mijvgas4xMJHaZXgNNjG5OHUBQ4anF4XJq3q6GTE1oHv0o0QgGXjR+dGGtxh9ORcjimHqJOPrHDYhQweiMy412U93EPctm5zVwFLnPCNnUDU5Gn3CLEyv3jXh1mpm5sf57sXpfyCqLsX80HkfdY/jUo5svX8Ga3N/DIfdHCJ7jEUjnuTdudvpUNj0xxN5aDwNhPMYRK6R8o4gx9hU5xzKLvFdAx6uwJyF0WOprt27tzzuN388e5RMW3RTi7rFEtLDhbt8NNZ30SgSnr0GyedVY8yXpsjL4ZkLqYycsDqzFb5aeTm8Eg8qnSOoquTvykbX18RVh1em/++XfmNDGMn0LWHqkZUMu5w1ZCeP2PIYS+9q+kWDyVPzf2phTugTbsO9YgYPoXudShuvxyuUBqkI0hzIIOJLSSCWwGwp9ClIjELSneMYnMJl1deDG/gJCBlsO7zxyp4U9DfqIc5rhiRZhnBo1+LOoWNpMeNY9ffJdHguK3+NsDfbbMjlXhDprqa7qOJZkPh8KWHd+D8AFT7s3wcbdsawaGHncPuSHC5jrO8zPmwPpqG+ULUNy9aTSn6TkSFbWqOfk1hV/sJhZCqlhWmnppzyDrH4OYgXGiyr2xGK4rVNEWcHjJn0aaQMe4Po00EP+C2PXTk4Wk/VAE5idps7kuhXQ0Al3gkWNOBtcLGoQ26WRAxTn4WudJ4e6iQ5I7n6I34F96sPTipt60TzqqMA9ebwPE73puT/Tm887hBXffJMX16WkpMn/pEJORM8CclYyEHtcHcIHnaMCU2scmhIbX3FofcffrVHHrQp1OIjtHp8XMQG7O8OU8BOwiXmEzXKRihL6g2X5p7rHCO3TT3PLf3WZkbYO+C3ztElt1r1cWevd9qT9V++Rj7iao9NBcL+3tdUcee7NnP7aEr2JTKVF3vw+uYHRL+uIcHAhx7ICUpM+PHvre+Ggwhz++uoPdws9vcvHl7Nd35HeZ/+Pn2nz/e7K5v3rzF2xyb+wBGbtH06K++fx2L/ur710vR37y8iUV/8/LmHHqZvlmK+suHN+fQ1J6+XAr36afblwvwbm4WN+qnn25vbs62J2IuFwPEPC8Bak8jOv/TT7cL+h0xN3G1f7VZVv9XMQMBud0sbIOYIWBwl7ZDhPAb3AWSr/Y0DnUxZmSvvXl58+2yfjPYUT1nsM/33cPD/u1ilv/977djzLoZyvrZ2pnpk//ewfjbVdcknJuXnEO2nY8kFEAVKl7QdHR+cndQGw/O8nnqLrjvlO4wLz4gn4jCJil5tvzBp1qyRIFW68HsE/7xnLnL9AfPhyx2/4wy3P1sHfbWXxWnOje42hpRHtzsr0Smj9j8ruT6agjbb+z+n6kKt1XumHjhZ0zSomtNmiv5kc56hg/nwPsrWHGk5rhx7fpXcONIrfBuDgnoV7FSLKtyjsPOaaBfkb3w9NA5fgqWAP9rWsyRmuPGjaLOFovwM7jF+hHsOFpmD8YcT52TZsPP4HaX7scqiXekmyvyWHbx5Cb252yXdq8d6f4Z+HcewZGjM8dKLYur3qOvIVlffvt5jotODvtXZKOknObOEe2Uv3FEt12H3rgVEdysrtPqPl8RWZUkcF91P3tRwk7Cca52PsVqFGJ+/rtMAZMdZELiWprUFY5FrFFxaiJnjDez5sa+kG6vRoHd/T9jtZubA9u6O45G31nSvQsbgJCPPo+t6rbFepa9ueno67LnKK+vpljrxRCWSswCfrYWet5gIp/ff0Rv7JcPH0lWiOPaXRZkovcr9AfL1dUIuLuIzDkv23vavNFciCN6NtoZ2blxtw56uyJbBN+Oo28t/NbgbwMCWyeOY/39eGNuEIGIEZJFInLrwisuJOGay/bUimwxNmHvQcP4RHOv0BivvWTkr8LtZ0m5OUa0TXntc6yTyjJcp9Usv92rXyLm70Wc3nG3v6dlbo4ZdGfOtdzUsFvIzc8IT4Cnxjd6nq1lSjbYlNT99G42vIhlz3Sb67ieZaYXwIzoz0iGkND6apoRjO5q+Hp9+ZvB/+/Wmb/1M1fXs9z8Bb3pODrXncZK36jwkJAIbp7I1v9XE/43QPZiCZyKaHLPxbGANG9dJRWAXJNfeXEi9EBZYfKusklTEidcqwnw9gGXKP56fYNKlMMxjOtPtY4P6P4XaiHP0lhDTFZ5AntJQ6B9BfpyQYmspAJzHJOVhKg6Tq8pFtfxMd19aT2/Tn/+xwA0bvd0"
 }
@@ -22,4 +22,6 @@ import (
 	// factories with the global registry.
 	_ "github.com/elastic/beats/auditbeat/module/auditd"
 	_ "github.com/elastic/beats/auditbeat/module/file_integrity"
+	_ "github.com/elastic/beats/auditbeat/module/system/package"
+	_ "github.com/elastic/beats/auditbeat/module/system/socket"
 )
@@ -0,0 +1,126 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pkg
+
+import (
+	"bufio"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// dpkgLister lists the packages of Debian based systems from the dpkg
+// status file.
+type dpkgLister struct {
+	statusFile string
+	infoDir    string
+}
+
+func (l *dpkgLister) name() string { return "dpkg" }
+
+func (l *dpkgLister) list() ([]*Package, error) {
+	f, err := os.Open(l.statusFile)
+	if err != nil {
+		return nil, errors.Wrap(err, "error opening dpkg status file")
+	}
+	defer f.Close()
+
+	packages, err := parseDpkgStatus(f)
+	if err != nil {
+		return nil, err
+	}
+
+	// The install time is the modification time of the file list of the
+	// package.
+	for _, p := range packages {
+		for _, name := range []string{p.Name + ":" + p.Arch + ".list", p.Name + ".list"} {
+			if info, err := os.Stat(filepath.Join(l.infoDir, name)); err == nil {
+				p.InstallTime = info.ModTime().UTC()
+				break
+			}
+		}
+	}
+	return packages, nil
+}
+
+// parseDpkgStatus parses the paragraphs of a dpkg status file and returns
+// the installed packages.
+func parseDpkgStatus(r io.Reader) ([]*Package, error) {
+	var (
+		packages []*Package
+		fields   = map[string]string{}
+	)
+
+	flush := func() {
+		if p := dpkgPackage(fields); p != nil {
+			packages = append(packages, p)
+		}
+		fields = map[string]string{}
+	}
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := scanner.Text()
+		switch {
+		case strings.TrimSpace(line) == "":
+			flush()
+		case line[0] == ' ' || line[0] == '\t':
+			// Continuation of a multi-line field, only the first line is
+			// kept.
+			continue
+		default:
+			idx := strings.IndexByte(line, ':')
+			if idx < 0 {
+				continue
+			}
+			fields[line[:idx]] = strings.TrimSpace(line[idx+1:])
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, errors.Wrap(err, "error reading dpkg status file")
+	}
+	flush()
+
+	return packages, nil
+}
+
+func dpkgPackage(fields map[string]string) *Package {
+	if fields["Package"] == "" || !strings.HasSuffix(fields["Status"], " installed") {
+		return nil
+	}
+
+	p := &Package{
+		Manager: "dpkg",
+		Name:    fields["Package"],
+		Version: fields["Version"],
+		Arch:    fields["Architecture"],
+		Summary: fields["Description"],
+		URL:     fields["Homepage"],
+	}
+
+	// Installed-Size is in KiB.
+	if size, err := strconv.ParseUint(fields["Installed-Size"], 10, 64); err == nil {
+		p.Size = size * 1024
+	}
+	return p
+}
@@ -0,0 +1,98 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pkg
+
+import (
+	"bufio"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+
+	"github.com/pkg/errors"
+)
+
+// homebrewLister lists the packages (formulae) installed by Homebrew on
+// macOS. Each installed version is a directory in the Cellar.
+type homebrewLister struct {
+	cellar string
+}
+
+func (l *homebrewLister) name() string { return "homebrew" }
+
+var (
+	homebrewDescRegexp     = regexp.MustCompile(`^\s*desc\s+"(.+)"`)
+	homebrewHomepageRegexp = regexp.MustCompile(`^\s*homepage\s+"(.+)"`)
+)
+
+func (l *homebrewLister) list() ([]*Package, error) {
+	formulae, err := ioutil.ReadDir(l.cellar)
+	if err != nil {
+		return nil, errors.Wrap(err, "error reading Homebrew Cellar")
+	}
+
+	var packages []*Package
+	for _, formula := range formulae {
+		if !formula.IsDir() {
+			continue
+		}
+
+		versions, err := ioutil.ReadDir(filepath.Join(l.cellar, formula.Name()))
+		if err != nil {
+			return nil, errors.Wrapf(err, "error reading Homebrew formula %v", formula.Name())
+		}
+
+		for _, version := range versions {
+			if !version.IsDir() {
+				continue
+			}
+
+			p := &Package{
+				Manager:     "homebrew",
+				Name:        formula.Name(),
+				Version:     version.Name(),
+				InstallTime: version.ModTime().UTC(),
+			}
+
+			rb := filepath.Join(l.cellar, formula.Name(), version.Name(), ".brew", formula.Name()+".rb")
+			p.Summary, p.URL = readHomebrewFormula(rb)
+			packages = append(packages, p)
+		}
+	}
+	return packages, nil
+}
+
+// readHomebrewFormula returns the description and homepage of a formula.
+func readHomebrewFormula(path string) (desc, homepage string) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", ""
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() && (desc == "" || homepage == "") {
+		line := scanner.Text()
+		if m := homebrewDescRegexp.FindStringSubmatch(line); m != nil && desc == "" {
+			desc = m[1]
+		} else if m := homebrewHomepageRegexp.FindStringSubmatch(line); m != nil && homepage == "" {
+			homepage = m[1]
+		}
+	}
+	return desc, homepage
+}
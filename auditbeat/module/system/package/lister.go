@@ -0,0 +1,61 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pkg
+
+import (
+	"os"
+	"os/exec"
+)
+
+// lister lists the packages installed by a package manager.
+type lister interface {
+	name() string
+	list() ([]*Package, error)
+}
+
+// Default locations of the package manager databases.
+var (
+	dpkgStatusFile   = "/var/lib/dpkg/status"
+	dpkgInfoDir      = "/var/lib/dpkg/info"
+	rpmDatabaseDir   = "/var/lib/rpm"
+	homebrewCellar   = "/usr/local/Cellar"
+	rpmQueryExecName = "rpm"
+)
+
+// defaultListers returns the listers of the package managers found on the
+// host.
+func defaultListers() []lister {
+	var listers []lister
+	if exists(dpkgStatusFile) {
+		listers = append(listers, &dpkgLister{statusFile: dpkgStatusFile, infoDir: dpkgInfoDir})
+	}
+	if exists(rpmDatabaseDir) {
+		if path, err := exec.LookPath(rpmQueryExecName); err == nil {
+			listers = append(listers, &rpmLister{command: path})
+		}
+	}
+	if exists(homebrewCellar) {
+		listers = append(listers, &homebrewLister{cellar: homebrewCellar})
+	}
+	return listers
+}
+
+func exists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
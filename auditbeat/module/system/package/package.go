@@ -0,0 +1,316 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pkg
+
+import (
+	"bytes"
+	"encoding/gob"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/auditbeat/datastore"
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/common/cfgwarn"
+	"github.com/elastic/beats/libbeat/logp"
+	"github.com/elastic/beats/metricbeat/mb"
+	"github.com/elastic/beats/metricbeat/mb/parse"
+)
+
+const (
+	moduleName    = "system"
+	metricsetName = "package"
+	namespace     = "system.audit.package"
+
+	bucketName        = "package.v1"
+	bucketKeyPackages = "packages"
+)
+
+// Values of the event.kind field.
+const (
+	eventKindState = "state"
+	eventKindEvent = "event"
+)
+
+// Values of the event.action field.
+const (
+	eventActionExistingPackage  = "existing_package"
+	eventActionPackageInstalled = "package_installed"
+	eventActionPackageUpdated   = "package_updated"
+	eventActionPackageRemoved   = "package_removed"
+)
+
+func init() {
+	mb.Registry.MustAddMetricSet(moduleName, metricsetName, New,
+		mb.DefaultMetricSet(),
+		mb.WithHostParser(parse.EmptyHostParser),
+		mb.WithNamespace(namespace),
+	)
+}
+
+// MetricSet collects data about the installed software packages.
+type MetricSet struct {
+	mb.BaseMetricSet
+	config    config
+	log       *logp.Logger
+	listers   []lister
+	bucket    datastore.Bucket
+	packages  map[string]*Package
+	lastState time.Time
+}
+
+// Package is an installed software package.
+type Package struct {
+	Manager     string
+	Name        string
+	Version     string
+	Release     string
+	Arch        string
+	License     string
+	InstallTime time.Time
+	Size        uint64
+	Summary     string
+	URL         string
+}
+
+// key identifies a package across versions.
+func (p *Package) key() string {
+	return p.Manager + "/" + p.Name + "/" + p.Arch
+}
+
+// changed returns true if the installed version of the package differs.
+func (p *Package) changed(other *Package) bool {
+	return p.Version != other.Version || p.Release != other.Release ||
+		!p.InstallTime.Equal(other.InstallTime)
+}
+
+func (p *Package) toMapStr() common.MapStr {
+	mapstr := common.MapStr{
+		"name":    p.Name,
+		"version": p.Version,
+		"type":    p.Manager,
+	}
+
+	if p.Release != "" {
+		mapstr["release"] = p.Release
+	}
+	if p.Arch != "" {
+		mapstr["arch"] = p.Arch
+	}
+	if p.License != "" {
+		mapstr["license"] = p.License
+	}
+	if !p.InstallTime.IsZero() {
+		mapstr["installtime"] = p.InstallTime
+	}
+	if p.Size != 0 {
+		mapstr["size"] = p.Size
+	}
+	if p.Summary != "" {
+		mapstr["summary"] = p.Summary
+	}
+	if p.URL != "" {
+		mapstr["url"] = p.URL
+	}
+	return mapstr
+}
+
+// New constructs a new MetricSet.
+func New(base mb.BaseMetricSet) (mb.MetricSet, error) {
+	cfgwarn.Beta("The %v/%v dataset is beta", moduleName, metricsetName)
+
+	config := defaultConfig
+	if err := base.Module().UnpackConfig(&config); err != nil {
+		return nil, errors.Wrapf(err, "failed to unpack the %v/%v config", moduleName, metricsetName)
+	}
+
+	bucket, err := datastore.OpenBucket(bucketName)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to open persistent datastore")
+	}
+
+	ms := &MetricSet{
+		BaseMetricSet: base,
+		config:        config,
+		log:           logp.NewLogger(metricsetName),
+		listers:       defaultListers(),
+		bucket:        bucket,
+	}
+
+	if err := ms.restoreState(); err != nil {
+		ms.log.Warnw("Failed to restore the package state", "error", err)
+		ms.packages = nil
+	}
+
+	if len(ms.listers) == 0 {
+		ms.log.Warn("No supported package manager found")
+	}
+	return ms, nil
+}
+
+// Close cleans up the MetricSet when it finishes.
+func (ms *MetricSet) Close() error {
+	if ms.bucket != nil {
+		return ms.bucket.Close()
+	}
+	return nil
+}
+
+// Fetch lists the installed packages and reports the packages that were
+// installed, updated or removed since the last fetch. Every state.period it
+// also reports all installed packages.
+func (ms *MetricSet) Fetch(report mb.ReporterV2) {
+	packages, err := ms.listPackages()
+	if err != nil {
+		ms.log.Error(err)
+		report.Error(err)
+		return
+	}
+
+	current := make(map[string]*Package, len(packages))
+	for _, p := range packages {
+		current[p.key()] = p
+	}
+
+	// Without a previous state all packages are reported as existing.
+	if ms.packages != nil {
+		ms.reportChanges(report, current)
+	}
+	ms.packages = current
+
+	if time.Since(ms.lastState) >= ms.config.effectiveStatePeriod() {
+		for _, p := range packages {
+			report.Event(packageEvent(p, eventKindState, eventActionExistingPackage))
+		}
+		ms.lastState = time.Now()
+	}
+
+	if err := ms.saveState(); err != nil {
+		ms.log.Errorw("Failed to save the package state", "error", err)
+	}
+}
+
+func (ms *MetricSet) reportChanges(report mb.ReporterV2, current map[string]*Package) {
+	for _, key := range sortedKeys(current) {
+		p := current[key]
+		old, found := ms.packages[key]
+		switch {
+		case !found:
+			report.Event(packageEvent(p, eventKindEvent, eventActionPackageInstalled))
+		case p.changed(old):
+			event := packageEvent(p, eventKindEvent, eventActionPackageUpdated)
+			event.MetricSetFields.Put("previous.version", old.Version)
+			if old.Release != "" {
+				event.MetricSetFields.Put("previous.release", old.Release)
+			}
+			report.Event(event)
+		}
+	}
+
+	for _, key := range sortedKeys(ms.packages) {
+		if _, found := current[key]; !found {
+			report.Event(packageEvent(ms.packages[key], eventKindEvent, eventActionPackageRemoved))
+		}
+	}
+}
+
+func packageEvent(p *Package, kind, action string) mb.Event {
+	return mb.Event{
+		RootFields: common.MapStr{
+			"event": common.MapStr{
+				"kind":   kind,
+				"action": action,
+			},
+			"message": packageMessage(p, action),
+		},
+		MetricSetFields: p.toMapStr(),
+	}
+}
+
+func packageMessage(p *Package, action string) string {
+	var verb string
+	switch action {
+	case eventActionExistingPackage:
+		verb = "is already installed"
+	case eventActionPackageInstalled:
+		verb = "installed"
+	case eventActionPackageUpdated:
+		verb = "updated"
+	case eventActionPackageRemoved:
+		verb = "removed"
+	}
+	return fmt.Sprintf("Package %v (%v) %v", p.Name, p.Version, verb)
+}
+
+func (ms *MetricSet) listPackages() ([]*Package, error) {
+	var packages []*Package
+	for _, l := range ms.listers {
+		p, err := l.list()
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to list %v packages", l.name())
+		}
+		packages = append(packages, p...)
+	}
+	return packages, nil
+}
+
+// savedState is the state persisted in the datastore between restarts.
+type savedState struct {
+	Packages  []*Package
+	LastState time.Time
+}
+
+func (ms *MetricSet) saveState() error {
+	state := savedState{LastState: ms.lastState}
+	for _, key := range sortedKeys(ms.packages) {
+		state.Packages = append(state.Packages, ms.packages[key])
+	}
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(state); err != nil {
+		return errors.Wrap(err, "error encoding packages")
+	}
+	return ms.bucket.Store(bucketKeyPackages, buf.Bytes())
+}
+
+func (ms *MetricSet) restoreState() error {
+	return ms.bucket.Load(bucketKeyPackages, func(blob []byte) error {
+		var state savedState
+		if err := gob.NewDecoder(bytes.NewReader(blob)).Decode(&state); err != nil {
+			return errors.Wrap(err, "error decoding packages")
+		}
+
+		ms.packages = make(map[string]*Package, len(state.Packages))
+		for _, p := range state.Packages {
+			ms.packages[p.key()] = p
+		}
+		ms.lastState = state.LastState
+		return nil
+	})
+}
+
+func sortedKeys(m map[string]*Package) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
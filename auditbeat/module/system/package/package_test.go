@@ -0,0 +1,262 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package pkg
+
+import (
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elastic/beats/auditbeat/core"
+	"github.com/elastic/beats/auditbeat/datastore"
+	"github.com/elastic/beats/libbeat/paths"
+	"github.com/elastic/beats/metricbeat/mb"
+	mbtest "github.com/elastic/beats/metricbeat/mb/testing"
+)
+
+type fakeLister struct {
+	packages []*Package
+}
+
+func (l *fakeLister) name() string { return "fake" }
+
+func (l *fakeLister) list() ([]*Package, error) { return l.packages, nil }
+
+func TestData(t *testing.T) {
+	defer setup(t)()
+
+	f := mbtest.NewReportingMetricSetV2(t, getConfig())
+	f.(*MetricSet).listers = []lister{&dpkgLister{statusFile: "testdata/dpkg-status", infoDir: "testdata"}}
+
+	events, errs := mbtest.ReportingFetchV2(f)
+	if len(errs) > 0 {
+		t.Fatalf("received error: %+v", errs[0])
+	}
+	if !assert.Len(t, events, 2) {
+		return
+	}
+
+	fullEvent := mbtest.StandardizeEvent(f, events[0], core.AddDatasetToEvent)
+	mbtest.WriteEventToDataJSON(t, fullEvent, "")
+}
+
+func TestPackageChanges(t *testing.T) {
+	defer setup(t)()
+
+	fake := &fakeLister{packages: []*Package{
+		{Manager: "dpkg", Name: "bash", Version: "4.4.18-2", Arch: "amd64"},
+		{Manager: "dpkg", Name: "nano", Version: "2.9.3-2", Arch: "amd64"},
+	}}
+
+	f := mbtest.NewReportingMetricSetV2(t, getConfig())
+	f.(*MetricSet).listers = []lister{fake}
+
+	// The first fetch reports the state of all packages.
+	events, errs := mbtest.ReportingFetchV2(f)
+	if len(errs) > 0 {
+		t.Fatalf("received error: %+v", errs[0])
+	}
+	if assert.Len(t, events, 2) {
+		for _, e := range events {
+			assertEventKindAction(t, e, eventKindState, eventActionExistingPackage)
+		}
+	}
+
+	fake.packages = []*Package{
+		{Manager: "dpkg", Name: "bash", Version: "4.4.18-3", Arch: "amd64"},
+		{Manager: "dpkg", Name: "vim", Version: "8.0.1453-1", Arch: "amd64"},
+	}
+
+	// The following fetches only report changes until the next state period.
+	events, errs = mbtest.ReportingFetchV2(f)
+	if len(errs) > 0 {
+		t.Fatalf("received error: %+v", errs[0])
+	}
+	if !assert.Len(t, events, 3) {
+		return
+	}
+
+	assertEventKindAction(t, events[0], eventKindEvent, eventActionPackageUpdated)
+	assert.Equal(t, "bash", events[0].MetricSetFields["name"])
+	previous, _ := events[0].MetricSetFields.GetValue("previous.version")
+	assert.Equal(t, "4.4.18-2", previous)
+
+	assertEventKindAction(t, events[1], eventKindEvent, eventActionPackageInstalled)
+	assert.Equal(t, "vim", events[1].MetricSetFields["name"])
+
+	assertEventKindAction(t, events[2], eventKindEvent, eventActionPackageRemoved)
+	assert.Equal(t, "nano", events[2].MetricSetFields["name"])
+	assert.Equal(t, "Package nano (2.9.3-2) removed", events[2].RootFields["message"])
+
+	events, _ = mbtest.ReportingFetchV2(f)
+	assert.Empty(t, events)
+}
+
+func TestRestoreState(t *testing.T) {
+	defer setup(t)()
+
+	fake := &fakeLister{packages: []*Package{
+		{Manager: "rpm", Name: "bash", Version: "4.2.46", Release: "31.el7", Arch: "x86_64"},
+	}}
+
+	f := mbtest.NewReportingMetricSetV2(t, getConfig())
+	f.(*MetricSet).listers = []lister{fake}
+	events, _ := mbtest.ReportingFetchV2(f)
+	assert.Len(t, events, 1)
+	if err := f.(*MetricSet).Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// A new instance continues from the persisted state and only reports
+	// what changed in between.
+	fake.packages[0] = &Package{Manager: "rpm", Name: "bash", Version: "4.2.46", Release: "34.el7", Arch: "x86_64"}
+
+	f = mbtest.NewReportingMetricSetV2(t, getConfig())
+	f.(*MetricSet).listers = []lister{fake}
+	events, _ = mbtest.ReportingFetchV2(f)
+	if assert.Len(t, events, 1) {
+		assertEventKindAction(t, events[0], eventKindEvent, eventActionPackageUpdated)
+		previous, _ := events[0].MetricSetFields.GetValue("previous.release")
+		assert.Equal(t, "31.el7", previous)
+	}
+}
+
+func TestParseDpkgStatus(t *testing.T) {
+	f, err := os.Open("testdata/dpkg-status")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	packages, err := parseDpkgStatus(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// nano is only left with its config files and is not installed.
+	if !assert.Len(t, packages, 2) {
+		return
+	}
+
+	libc := packages[0]
+	assert.Equal(t, "dpkg", libc.Manager)
+	assert.Equal(t, "libc6", libc.Name)
+	assert.Equal(t, "2.27-3ubuntu1", libc.Version)
+	assert.Equal(t, "amd64", libc.Arch)
+	assert.EqualValues(t, 10684*1024, libc.Size)
+	assert.Equal(t, "GNU C Library: Shared libraries", libc.Summary)
+	assert.Equal(t, "https://www.gnu.org/software/libc/libc.html", libc.URL)
+
+	assert.Equal(t, "zlib1g", packages[1].Name)
+	assert.Equal(t, "1:1.2.11.dfsg-0ubuntu2", packages[1].Version)
+}
+
+func TestParseRPMOutput(t *testing.T) {
+	f, err := os.Open("testdata/rpm-query-output")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	packages, err := parseRPMOutput(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// gpg-pubkey entries are not packages.
+	if !assert.Len(t, packages, 2) {
+		return
+	}
+
+	bash := packages[0]
+	assert.Equal(t, "rpm", bash.Manager)
+	assert.Equal(t, "bash", bash.Name)
+	assert.Equal(t, "4.2.46", bash.Version)
+	assert.Equal(t, "31.el7", bash.Release)
+	assert.Equal(t, "x86_64", bash.Arch)
+	assert.Equal(t, "GPLv3+", bash.License)
+	assert.Equal(t, time.Unix(1540935543, 0).UTC(), bash.InstallTime.UTC())
+	assert.EqualValues(t, 3667773, bash.Size)
+	assert.Equal(t, "http://www.gnu.org/software/bash", bash.URL)
+
+	assert.Equal(t, "basesystem", packages[1].Name)
+	assert.Empty(t, packages[1].URL)
+}
+
+func TestHomebrewLister(t *testing.T) {
+	l := &homebrewLister{cellar: "testdata/homebrew/Cellar"}
+	packages, err := l.list()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !assert.Len(t, packages, 2) {
+		return
+	}
+	for i, version := range []string{"1.11.2", "1.11.4"} {
+		p := packages[i]
+		assert.Equal(t, "homebrew", p.Manager)
+		assert.Equal(t, "go", p.Name)
+		assert.Equal(t, version, p.Version)
+		assert.Equal(t, "https://golang.org", p.URL)
+		assert.True(t, strings.HasPrefix(p.Summary, "Open source programming language"))
+		assert.False(t, p.InstallTime.IsZero())
+	}
+}
+
+func assertEventKindAction(t testing.TB, e mb.Event, kind, action string) {
+	t.Helper()
+	v, _ := e.RootFields.GetValue("event.kind")
+	assert.Equal(t, kind, v)
+	v, _ = e.RootFields.GetValue("event.action")
+	assert.Equal(t, action, v)
+}
+
+func setup(t testing.TB) func() {
+	// path.data should be set so that the DB is written to a predictable location.
+	var err error
+	paths.Paths.Data, err = ioutil.TempDir("", "beat-data-dir")
+	if err != nil {
+		t.Fatal()
+	}
+
+	// The datastore is shared by all tests, start each one without state.
+	bucket, err := datastore.OpenBucket(bucketName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = bucket.Delete(bucketKeyPackages); err != nil {
+		t.Fatal(err)
+	}
+	bucket.Close()
+
+	return func() { os.RemoveAll(paths.Paths.Data) }
+}
+
+func getConfig() map[string]interface{} {
+	return map[string]interface{}{
+		"module":     "system",
+		"metricsets": []string{"package"},
+	}
+}
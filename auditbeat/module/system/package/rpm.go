@@ -0,0 +1,103 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pkg
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"os/exec"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// rpmQueryFormat is the rpm query format of the fields of each package,
+// separated by tabs.
+const rpmQueryFormat = "%{NAME}\t%{VERSION}\t%{RELEASE}\t%{ARCH}\t%{LICENSE}\t%{INSTALLTIME}\t%{SIZE}\t%{SUMMARY}\t%{URL}\n"
+
+// rpmLister lists the packages of RPM based systems by querying the rpm
+// database with the rpm command.
+type rpmLister struct {
+	command string
+}
+
+func (l *rpmLister) name() string { return "rpm" }
+
+func (l *rpmLister) list() ([]*Package, error) {
+	var stderr bytes.Buffer
+	cmd := exec.Command(l.command, "--query", "--all", "--queryformat", rpmQueryFormat)
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, errors.Wrapf(err, "error querying rpm database: %v", strings.TrimSpace(stderr.String()))
+	}
+
+	return parseRPMOutput(bytes.NewReader(out))
+}
+
+// parseRPMOutput parses the output of an rpm query using rpmQueryFormat.
+func parseRPMOutput(r io.Reader) ([]*Package, error) {
+	var packages []*Package
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		fields := strings.Split(scanner.Text(), "\t")
+		if len(fields) != 9 {
+			continue
+		}
+		// gpg-pubkey entries are keys imported into the database, not
+		// packages.
+		if fields[0] == "gpg-pubkey" {
+			continue
+		}
+
+		p := &Package{
+			Manager: "rpm",
+			Name:    fields[0],
+			Version: fields[1],
+			Release: rpmValue(fields[2]),
+			Arch:    rpmValue(fields[3]),
+			License: rpmValue(fields[4]),
+			Summary: rpmValue(fields[7]),
+			URL:     rpmValue(fields[8]),
+		}
+		if sec, err := strconv.ParseInt(fields[5], 10, 64); err == nil {
+			p.InstallTime = time.Unix(sec, 0).UTC()
+		}
+		if size, err := strconv.ParseUint(fields[6], 10, 64); err == nil {
+			p.Size = size
+		}
+		packages = append(packages, p)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, errors.Wrap(err, "error reading rpm query output")
+	}
+	return packages, nil
+}
+
+// rpmValue returns the value of an rpm tag, rpm prints "(none)" for unset
+// tags.
+func rpmValue(s string) string {
+	if s == "(none)" {
+		return ""
+	}
+	return s
+}
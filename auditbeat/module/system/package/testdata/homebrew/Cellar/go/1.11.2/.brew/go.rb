@@ -0,0 +1,5 @@
+class Go < Formula
+  desc "Open source programming language to build simple/reliable/efficient software"
+  homepage "https://golang.org"
+  url "https://dl.google.com/go/go1.11.2.src.tar.gz"
+end
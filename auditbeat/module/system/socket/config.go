@@ -0,0 +1,42 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package socket
+
+import (
+	"time"
+)
+
+// config defines the socket metricset's configuration options.
+type config struct {
+	StatePeriod       time.Duration `config:"state.period"`
+	SocketStatePeriod time.Duration `config:"socket.state.period"`
+}
+
+// effectiveStatePeriod returns the period after which a full state snapshot
+// of the open sockets is sent. socket.state.period takes precedence over the
+// module wide state.period.
+func (c *config) effectiveStatePeriod() time.Duration {
+	if c.SocketStatePeriod != 0 {
+		return c.SocketStatePeriod
+	}
+	return c.StatePeriod
+}
+
+var defaultConfig = config{
+	StatePeriod: 12 * time.Hour,
+}
@@ -0,0 +1,138 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package socket
+
+import (
+	"os"
+	"os/user"
+	"strconv"
+	"strings"
+
+	"github.com/joeshaw/multierror"
+	"github.com/prometheus/procfs"
+)
+
+// process contains the static information of a process owning a socket.
+type process struct {
+	PID        int
+	Name       string
+	Executable string
+	Args       []string
+}
+
+// processTable maps socket inodes to the processes holding them open. Only
+// the own process can be inspected when not running as root.
+type processTable struct {
+	fs     procfs.FS
+	euid   int
+	procs  map[int]*process
+	inodes map[uint32]*process
+}
+
+func newProcessTable(mountpoint string) (*processTable, error) {
+	fs, err := procfs.NewFS(mountpoint)
+	if err != nil {
+		return nil, err
+	}
+	return &processTable{fs: fs, euid: os.Geteuid()}, nil
+}
+
+// Refresh rebuilds the inode to process mapping. Information about processes
+// that are still running is reused.
+func (t *processTable) Refresh() error {
+	var procs procfs.Procs
+	if t.euid == 0 {
+		var err error
+		if procs, err = t.fs.AllProcs(); err != nil {
+			return err
+		}
+	} else {
+		self, err := t.fs.Self()
+		if err != nil {
+			return err
+		}
+		procs = procfs.Procs{self}
+	}
+
+	var errs multierror.Errors
+	cached := make(map[int]*process, len(procs))
+	inodes := map[uint32]*process{}
+	for _, p := range procs {
+		proc := t.procs[p.PID]
+		if proc == nil {
+			proc = &process{PID: p.PID}
+			// Processes may exit at any time, keep whatever can be read.
+			proc.Name, _ = p.Comm()
+			proc.Executable, _ = p.Executable()
+			proc.Args, _ = p.CmdLine()
+		}
+		cached[p.PID] = proc
+
+		fds, err := p.FileDescriptorTargets()
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		for _, fd := range fds {
+			if inode, ok := socketInode(fd); ok {
+				inodes[inode] = proc
+			}
+		}
+	}
+
+	t.procs = cached
+	t.inodes = inodes
+	return errs.Err()
+}
+
+// ProcessBySocketInode returns the process holding the socket with the given
+// inode, or nil if it is unknown.
+func (t *processTable) ProcessBySocketInode(inode uint32) *process {
+	return t.inodes[inode]
+}
+
+// socketInode returns the inode of file descriptor targets of the form
+// socket:[<inode>].
+func socketInode(target string) (uint32, bool) {
+	if !strings.HasPrefix(target, "socket:[") || !strings.HasSuffix(target, "]") {
+		return 0, false
+	}
+	inode, err := strconv.ParseUint(target[8:len(target)-1], 10, 32)
+	if err != nil {
+		return 0, false
+	}
+	return uint32(inode), true
+}
+
+// userCache is a cache of UID to username.
+type userCache map[uint32]string
+
+// LookupUID returns the username of the UID, or an empty string if it cannot
+// be resolved. Results are cached forever.
+func (c userCache) LookupUID(uid uint32) string {
+	if username, found := c[uid]; found {
+		return username
+	}
+
+	var name string
+	if u, err := user.LookupId(strconv.FormatUint(uint64(uid), 10)); err == nil {
+		name = u.Username
+	}
+	c[uid] = name
+	return name
+}
@@ -0,0 +1,203 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package socket
+
+import (
+	"bytes"
+	"encoding/binary"
+	"net"
+	"os"
+	"syscall"
+
+	"github.com/pkg/errors"
+
+	"github.com/elastic/gosigar/sys"
+	"github.com/elastic/gosigar/sys/linux"
+)
+
+const (
+	// inetDiagInfo is the attribute type of the tcp_info struct in a
+	// sock_diag response. The kernel only sends it when the bit
+	// 1 << (inetDiagInfo - 1) is set in the extensions of the request.
+	// https://github.com/torvalds/linux/blob/v4.2/include/uapi/linux/inet_diag.h#L111
+	inetDiagInfo = 2
+
+	sizeofInetDiagMsg = 72
+	sizeofRtAttr      = 4
+)
+
+// Offsets of the counters in struct tcp_info. bytes_acked and bytes_received
+// were added in Linux 4.1, segs_out and segs_in in Linux 4.2.
+// https://github.com/torvalds/linux/blob/v4.2/include/uapi/linux/tcp.h#L150
+const (
+	tcpInfoBytesAcked    = 120
+	tcpInfoBytesReceived = 128
+	tcpInfoSegsOut       = 136
+	tcpInfoSegsIn        = 140
+	tcpInfoMinLen        = 144
+)
+
+var byteOrder = sys.GetEndian()
+
+// socket is a snapshot of a TCP or UDP socket as reported by sock_diag.
+type socket struct {
+	Cookie     uint64
+	Family     linux.AddressFamily
+	Protocol   uint8
+	State      linux.TCPState
+	LocalIP    net.IP
+	LocalPort  int
+	RemoteIP   net.IP
+	RemotePort int
+	UID        uint32
+	Inode      uint32
+
+	// Counters are only available for TCP sockets on Linux 4.2 or newer.
+	HasCounters     bool
+	BytesSent       uint64
+	BytesReceived   uint64
+	PacketsSent     uint64
+	PacketsReceived uint64
+}
+
+// sockDiagDump requests all sockets of the given address family and protocol
+// from the kernel over a NETLINK_SOCK_DIAG socket. readBuf is used to hold the
+// responses read from the socket.
+func sockDiagDump(family linux.AddressFamily, protocol uint8, seq uint32, readBuf []byte) ([]*socket, error) {
+	s, err := syscall.Socket(syscall.AF_NETLINK, syscall.SOCK_RAW, syscall.NETLINK_INET_DIAG)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to open netlink socket")
+	}
+	defer syscall.Close(s)
+
+	lsa := &syscall.SockaddrNetlink{Family: syscall.AF_NETLINK}
+	if err := syscall.Sendto(s, newSockDiagRequest(family, protocol, seq), 0, lsa); err != nil {
+		return nil, errors.Wrap(err, "failed to send sock_diag request")
+	}
+
+	if len(readBuf) == 0 {
+		readBuf = make([]byte, os.Getpagesize())
+	}
+
+	var sockets []*socket
+	for {
+		nr, _, err := syscall.Recvfrom(s, readBuf, 0)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read sock_diag response")
+		}
+		if nr < syscall.NLMSG_HDRLEN {
+			return nil, syscall.EINVAL
+		}
+
+		msgs, err := syscall.ParseNetlinkMessage(readBuf[:nr])
+		if err != nil {
+			return nil, err
+		}
+
+		for _, m := range msgs {
+			switch m.Header.Type {
+			case syscall.NLMSG_DONE:
+				return sockets, nil
+			case syscall.NLMSG_ERROR:
+				return nil, linux.ParseNetlinkError(m.Data)
+			}
+
+			sock, err := parseSockDiagMsg(m.Data, protocol)
+			if err != nil {
+				return nil, err
+			}
+			sockets = append(sockets, sock)
+		}
+	}
+}
+
+// newSockDiagRequest returns a serialized SOCK_DIAG_BY_FAMILY dump request
+// that asks for the tcp_info of each socket.
+func newSockDiagRequest(family linux.AddressFamily, protocol uint8, seq uint32) []byte {
+	req := linux.InetDiagReqV2{
+		Family:   uint8(family),
+		Protocol: protocol,
+		Ext:      1 << (inetDiagInfo - 1),
+		States:   linux.AllTCPStates,
+	}
+
+	var buf bytes.Buffer
+	hdr := syscall.NlMsghdr{
+		Len:   uint32(syscall.SizeofNlMsghdr + binary.Size(req)),
+		Type:  uint16(linux.SOCK_DIAG_BY_FAMILY),
+		Flags: uint16(syscall.NLM_F_DUMP | syscall.NLM_F_REQUEST),
+		Seq:   seq,
+	}
+	// Writing to a bytes.Buffer never returns an error.
+	binary.Write(&buf, byteOrder, hdr)
+	binary.Write(&buf, byteOrder, req)
+	return buf.Bytes()
+}
+
+// parseSockDiagMsg parses an inet_diag_msg and the tcp_info attribute
+// following it.
+func parseSockDiagMsg(b []byte, protocol uint8) (*socket, error) {
+	msg, err := linux.ParseInetDiagMsg(b)
+	if err != nil {
+		return nil, err
+	}
+
+	sock := &socket{
+		Cookie:     uint64(msg.ID.Cookie[0]) | uint64(msg.ID.Cookie[1])<<32,
+		Family:     linux.AddressFamily(msg.Family),
+		Protocol:   protocol,
+		State:      linux.TCPState(msg.State),
+		LocalIP:    msg.SrcIP(),
+		LocalPort:  msg.SrcPort(),
+		RemoteIP:   msg.DstIP(),
+		RemotePort: msg.DstPort(),
+		UID:        msg.UID,
+		Inode:      msg.Inode,
+	}
+
+	if len(b) > sizeofInetDiagMsg {
+		forEachAttr(b[sizeofInetDiagMsg:], func(typ uint16, data []byte) {
+			if typ == inetDiagInfo && len(data) >= tcpInfoMinLen {
+				sock.HasCounters = true
+				sock.BytesSent = byteOrder.Uint64(data[tcpInfoBytesAcked:])
+				sock.BytesReceived = byteOrder.Uint64(data[tcpInfoBytesReceived:])
+				sock.PacketsSent = uint64(byteOrder.Uint32(data[tcpInfoSegsOut:]))
+				sock.PacketsReceived = uint64(byteOrder.Uint32(data[tcpInfoSegsIn:]))
+			}
+		})
+	}
+	return sock, nil
+}
+
+// forEachAttr invokes f for each netlink route attribute (struct rtattr) in b.
+func forEachAttr(b []byte, f func(typ uint16, data []byte)) {
+	for len(b) >= sizeofRtAttr {
+		length := int(byteOrder.Uint16(b[0:2]))
+		typ := byteOrder.Uint16(b[2:4])
+		if length < sizeofRtAttr || length > len(b) {
+			return
+		}
+		f(typ, b[sizeofRtAttr:length])
+
+		aligned := (length + syscall.NLMSG_ALIGNTO - 1) &^ (syscall.NLMSG_ALIGNTO - 1)
+		if aligned >= len(b) {
+			return
+		}
+		b = b[aligned:]
+	}
+}
@@ -0,0 +1,359 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package socket
+
+import (
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"sync/atomic"
+	"syscall"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/common/cfgwarn"
+	"github.com/elastic/beats/libbeat/logp"
+	"github.com/elastic/beats/metricbeat/mb"
+	"github.com/elastic/beats/metricbeat/mb/parse"
+	"github.com/elastic/gosigar/sys/linux"
+)
+
+const (
+	moduleName    = "system"
+	metricsetName = "socket"
+	namespace     = "system.audit.socket"
+)
+
+const (
+	eventKindState = "state"
+	eventKindEvent = "event"
+)
+
+const (
+	eventActionExistingSocket = "existing_socket"
+	eventActionSocketOpened   = "socket_opened"
+	eventActionSocketClosed   = "socket_closed"
+)
+
+type direction uint8
+
+const (
+	directionOutgoing direction = iota
+	directionIncoming
+)
+
+// String returns the direction as used in the network.direction field.
+func (d direction) String() string {
+	if d == directionIncoming {
+		return "incoming"
+	}
+	return "outgoing"
+}
+
+func init() {
+	mb.Registry.MustAddMetricSet(moduleName, metricsetName, New,
+		mb.DefaultMetricSet(),
+		mb.WithHostParser(parse.EmptyHostParser),
+		mb.WithNamespace(namespace),
+	)
+}
+
+// MetricSet collects data about the TCP and UDP flows of a host.
+type MetricSet struct {
+	mb.BaseMetricSet
+	config    config
+	log       *logp.Logger
+	seq       uint32
+	readBuf   []byte
+	ptable    *processTable
+	users     userCache
+	flows     map[uint64]*flow
+	lastState time.Time
+
+	// listSockets returns all TCP and UDP sockets. It is replaced in tests.
+	listSockets func() ([]*socket, error)
+}
+
+// flow is a connected socket tracked between fetches.
+type flow struct {
+	socket    *socket
+	process   *process
+	username  string
+	direction direction
+}
+
+// New constructs a new MetricSet.
+func New(base mb.BaseMetricSet) (mb.MetricSet, error) {
+	cfgwarn.Beta("The %v/%v dataset is beta", moduleName, metricsetName)
+
+	config := defaultConfig
+	if err := base.Module().UnpackConfig(&config); err != nil {
+		return nil, errors.Wrapf(err, "failed to unpack the %v/%v config", moduleName, metricsetName)
+	}
+
+	ptable, err := newProcessTable("/proc")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to open the proc filesystem")
+	}
+
+	ms := &MetricSet{
+		BaseMetricSet: base,
+		config:        config,
+		log:           logp.NewLogger(metricsetName),
+		ptable:        ptable,
+		users:         userCache{},
+		flows:         map[uint64]*flow{},
+	}
+	ms.listSockets = ms.dumpSockets
+
+	if ptable.euid != 0 {
+		ms.log.Warn("Process information will only be available for the " +
+			"sockets of this process because it is not running as root")
+	}
+	return ms, nil
+}
+
+// Fetch compares the current sockets to those seen during the previous fetch
+// and reports opened and closed flows. The state of all flows is reported
+// periodically.
+func (ms *MetricSet) Fetch(report mb.ReporterV2) {
+	sockets, err := ms.listSockets()
+	if err != nil {
+		ms.log.Error(err)
+		report.Error(err)
+		return
+	}
+
+	listeners := map[string]struct{}{}
+	current := map[uint64]*socket{}
+	for _, s := range sockets {
+		if isListener(s) {
+			listeners[listenerKey(s.Protocol, s.LocalPort)] = struct{}{}
+		} else if isFlow(s) {
+			current[s.Cookie] = s
+		}
+	}
+
+	// Sockets seen during the first fetch are only reported as state.
+	initialized := !ms.lastState.IsZero()
+	refreshed := false
+	for _, cookie := range sortedKeys(current) {
+		s := current[cookie]
+		if f, found := ms.flows[cookie]; found {
+			f.socket = s
+			continue
+		}
+
+		f := &flow{socket: s, username: ms.users.LookupUID(s.UID)}
+		if _, found := listeners[listenerKey(s.Protocol, s.LocalPort)]; found {
+			f.direction = directionIncoming
+		}
+
+		// Refresh the process table at most once per fetch.
+		f.process = ms.ptable.ProcessBySocketInode(s.Inode)
+		if f.process == nil && s.Inode != 0 && !refreshed {
+			if err := ms.ptable.Refresh(); err != nil {
+				ms.log.Debugw("Failed to refresh the process table", "error", err)
+			}
+			refreshed = true
+			f.process = ms.ptable.ProcessBySocketInode(s.Inode)
+		}
+
+		ms.flows[cookie] = f
+		if initialized {
+			report.Event(flowEvent(f, eventKindEvent, eventActionSocketOpened))
+		}
+	}
+
+	for _, cookie := range sortedFlowKeys(ms.flows) {
+		if _, found := current[cookie]; !found {
+			report.Event(flowEvent(ms.flows[cookie], eventKindEvent, eventActionSocketClosed))
+			delete(ms.flows, cookie)
+		}
+	}
+
+	if time.Since(ms.lastState) >= ms.config.effectiveStatePeriod() {
+		for _, cookie := range sortedFlowKeys(ms.flows) {
+			report.Event(flowEvent(ms.flows[cookie], eventKindState, eventActionExistingSocket))
+		}
+		ms.lastState = time.Now()
+	}
+}
+
+// dumpSockets returns the TCP and UDP sockets of both address families.
+func (ms *MetricSet) dumpSockets() ([]*socket, error) {
+	if ms.readBuf == nil {
+		ms.readBuf = make([]byte, 32*1024)
+	}
+
+	var sockets []*socket
+	for _, family := range []linux.AddressFamily{linux.AF_INET, linux.AF_INET6} {
+		for _, protocol := range []uint8{syscall.IPPROTO_TCP, syscall.IPPROTO_UDP} {
+			s, err := sockDiagDump(family, protocol, atomic.AddUint32(&ms.seq, 1), ms.readBuf)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to list %v %v sockets", family, protocolName(protocol))
+			}
+			sockets = append(sockets, s...)
+		}
+	}
+	return sockets, nil
+}
+
+// isListener returns true for listening TCP sockets and unconnected UDP
+// sockets.
+func isListener(s *socket) bool {
+	if s.Protocol == syscall.IPPROTO_TCP {
+		return s.State == linux.TCP_LISTEN
+	}
+	return s.RemotePort == 0
+}
+
+// isFlow returns true for sockets that are connected to a peer and still
+// held open by a process.
+func isFlow(s *socket) bool {
+	if s.RemotePort == 0 {
+		return false
+	}
+	switch s.State {
+	case linux.TCP_LISTEN, linux.TCP_TIME_WAIT, linux.TCP_CLOSE:
+		return false
+	}
+	return true
+}
+
+func listenerKey(protocol uint8, port int) string {
+	return protocolName(protocol) + "/" + strconv.Itoa(port)
+}
+
+func protocolName(protocol uint8) string {
+	switch protocol {
+	case syscall.IPPROTO_TCP:
+		return "tcp"
+	case syscall.IPPROTO_UDP:
+		return "udp"
+	default:
+		return strconv.Itoa(int(protocol))
+	}
+}
+
+func flowEvent(f *flow, kind, action string) mb.Event {
+	s := f.socket
+
+	source, destination := endpoint(s.LocalIP, s.LocalPort), endpoint(s.RemoteIP, s.RemotePort)
+	if f.direction == directionIncoming {
+		source, destination = destination, source
+	}
+
+	event := mb.Event{
+		RootFields: common.MapStr{
+			"event": common.MapStr{
+				"kind":   kind,
+				"action": action,
+			},
+			"message":     flowMessage(f, action),
+			"source":      source,
+			"destination": destination,
+			"network": common.MapStr{
+				"direction": f.direction.String(),
+			},
+			"user": common.MapStr{
+				"uid": strconv.FormatUint(uint64(s.UID), 10),
+			},
+		},
+		MetricSetFields: common.MapStr{
+			"family":   s.Family.String(),
+			"protocol": protocolName(s.Protocol),
+			"local":    endpoint(s.LocalIP, s.LocalPort),
+			"remote":   endpoint(s.RemoteIP, s.RemotePort),
+			"inode":    s.Inode,
+		},
+	}
+
+	if f.username != "" {
+		event.RootFields.Put("user.name", f.username)
+	}
+	if p := f.process; p != nil {
+		proc := common.MapStr{"pid": strconv.Itoa(p.PID)}
+		if p.Name != "" {
+			proc["name"] = p.Name
+		}
+		if p.Executable != "" {
+			proc["exe"] = p.Executable
+		}
+		if len(p.Args) > 0 {
+			proc["args"] = p.Args
+		}
+		event.RootFields["process"] = proc
+	}
+	if s.HasCounters {
+		event.MetricSetFields["bytes_sent"] = s.BytesSent
+		event.MetricSetFields["bytes_received"] = s.BytesReceived
+		event.MetricSetFields["packets_sent"] = s.PacketsSent
+		event.MetricSetFields["packets_received"] = s.PacketsReceived
+	}
+	return event
+}
+
+func endpoint(ip net.IP, port int) common.MapStr {
+	return common.MapStr{
+		"ip":   ip.String(),
+		"port": port,
+	}
+}
+
+func flowMessage(f *flow, action string) string {
+	var verb string
+	switch action {
+	case eventActionExistingSocket:
+		verb = "Open"
+	case eventActionSocketOpened:
+		verb = "Opened"
+	case eventActionSocketClosed:
+		verb = "Closed"
+	}
+
+	s := f.socket
+	msg := fmt.Sprintf("%v %v socket from %v to %v", verb, protocolName(s.Protocol),
+		net.JoinHostPort(s.LocalIP.String(), strconv.Itoa(s.LocalPort)),
+		net.JoinHostPort(s.RemoteIP.String(), strconv.Itoa(s.RemotePort)))
+	if f.process != nil {
+		msg += fmt.Sprintf(" by %v (PID %d)", f.process.Name, f.process.PID)
+	}
+	return msg
+}
+
+func sortedKeys(m map[uint64]*socket) []uint64 {
+	keys := make([]uint64, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+	return keys
+}
+
+func sortedFlowKeys(m map[uint64]*flow) []uint64 {
+	keys := make([]uint64, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+	return keys
+}
@@ -0,0 +1,326 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package socket
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"syscall"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elastic/beats/auditbeat/core"
+	"github.com/elastic/beats/metricbeat/mb"
+	mbtest "github.com/elastic/beats/metricbeat/mb/testing"
+	"github.com/elastic/gosigar/sys/linux"
+)
+
+func TestData(t *testing.T) {
+	f := mbtest.NewReportingMetricSetV2(t, getConfig())
+	ms := f.(*MetricSet)
+	ms.listSockets = func() ([]*socket, error) {
+		return []*socket{
+			newTestSocket(1, syscall.IPPROTO_TCP, linux.TCP_ESTABLISHED, "10.0.2.15", 48210, "93.184.216.34", 443),
+		}, nil
+	}
+	ms.ptable.inodes = map[uint32]*process{
+		1001: {PID: 2546, Name: "curl", Executable: "/usr/bin/curl", Args: []string{"curl", "https://example.com"}},
+	}
+
+	events, errs := mbtest.ReportingFetchV2(f)
+	if len(errs) > 0 {
+		t.Fatalf("received error: %+v", errs[0])
+	}
+	if !assert.Len(t, events, 1) {
+		return
+	}
+
+	fullEvent := mbtest.StandardizeEvent(f, events[0], core.AddDatasetToEvent)
+	mbtest.WriteEventToDataJSON(t, fullEvent, "")
+}
+
+func TestFlows(t *testing.T) {
+	f := mbtest.NewReportingMetricSetV2(t, getConfig())
+	ms := f.(*MetricSet)
+	ms.ptable.inodes = map[uint32]*process{}
+
+	listener := newTestSocket(1, syscall.IPPROTO_TCP, linux.TCP_LISTEN, "0.0.0.0", 22, "0.0.0.0", 0)
+	outgoing := newTestSocket(2, syscall.IPPROTO_TCP, linux.TCP_ESTABLISHED, "10.0.2.15", 48210, "93.184.216.34", 443)
+	sockets := []*socket{listener, outgoing}
+	ms.listSockets = func() ([]*socket, error) { return sockets, nil }
+
+	// The first fetch reports the state of all flows.
+	events, errs := mbtest.ReportingFetchV2(f)
+	if len(errs) > 0 {
+		t.Fatalf("received error: %+v", errs[0])
+	}
+	if assert.Len(t, events, 1) {
+		assertEventKindAction(t, events[0], eventKindState, eventActionExistingSocket)
+	}
+
+	incoming := newTestSocket(3, syscall.IPPROTO_TCP, linux.TCP_ESTABLISHED, "10.0.2.15", 22, "10.0.2.2", 51562)
+	udp := newTestSocket(4, syscall.IPPROTO_UDP, linux.TCP_ESTABLISHED, "10.0.2.15", 37214, "8.8.8.8", 53)
+	timeWait := newTestSocket(5, syscall.IPPROTO_TCP, linux.TCP_TIME_WAIT, "10.0.2.15", 48210, "93.184.216.34", 443)
+	sockets = []*socket{listener, incoming, udp, timeWait}
+
+	events, errs = mbtest.ReportingFetchV2(f)
+	if len(errs) > 0 {
+		t.Fatalf("received error: %+v", errs[0])
+	}
+	if !assert.Len(t, events, 3) {
+		return
+	}
+
+	assertEventKindAction(t, events[0], eventKindEvent, eventActionSocketOpened)
+	assertRootField(t, events[0], "network.direction", "incoming")
+	assertRootField(t, events[0], "source.ip", "10.0.2.2")
+	assertRootField(t, events[0], "destination.port", 22)
+	assert.EqualValues(t, 4096, events[0].MetricSetFields["bytes_sent"])
+
+	assertEventKindAction(t, events[1], eventKindEvent, eventActionSocketOpened)
+	assertRootField(t, events[1], "network.direction", "outgoing")
+	assertRootField(t, events[1], "destination.ip", "8.8.8.8")
+	assert.Equal(t, "udp", events[1].MetricSetFields["protocol"])
+	assert.NotContains(t, events[1].MetricSetFields, "bytes_sent")
+
+	assertEventKindAction(t, events[2], eventKindEvent, eventActionSocketClosed)
+	assertRootField(t, events[2], "destination.port", 443)
+	assert.Equal(t, "Closed tcp socket from 10.0.2.15:48210 to 93.184.216.34:443", events[2].RootFields["message"])
+
+	events, _ = mbtest.ReportingFetchV2(f)
+	assert.Empty(t, events)
+}
+
+func TestDumpSockets(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+
+	conn, err := net.Dial("tcp", l.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	f := mbtest.NewReportingMetricSetV2(t, getConfig())
+	ms := f.(*MetricSet)
+	sockets, err := ms.dumpSockets()
+	if err != nil {
+		t.Skipf("sock_diag is not available: %v", err)
+	}
+
+	local := conn.LocalAddr().(*net.TCPAddr)
+	var found *socket
+	for _, s := range sockets {
+		if s.Protocol == syscall.IPPROTO_TCP && s.LocalPort == local.Port && s.LocalIP.Equal(local.IP) {
+			found = s
+			break
+		}
+	}
+	if !assert.NotNil(t, found, "connection %v not found", local) {
+		return
+	}
+	assert.Equal(t, l.Addr().(*net.TCPAddr).Port, found.RemotePort)
+	assert.Equal(t, linux.TCP_ESTABLISHED, found.State)
+	assert.NotZero(t, found.Cookie)
+
+	if err := ms.ptable.Refresh(); err != nil {
+		t.Logf("process table refresh had failures: %v", err)
+	}
+	if p := ms.ptable.ProcessBySocketInode(found.Inode); assert.NotNil(t, p) {
+		assert.Equal(t, os.Getpid(), p.PID)
+	}
+}
+
+func TestParseSockDiagMsg(t *testing.T) {
+	msg := linux.InetDiagMsg{
+		Family: uint8(linux.AF_INET),
+		State:  uint8(linux.TCP_ESTABLISHED),
+		UID:    1000,
+		Inode:  98765,
+	}
+	binary.BigEndian.PutUint16(msg.ID.SPort[:], 48210)
+	binary.BigEndian.PutUint16(msg.ID.DPort[:], 443)
+	copy(msg.ID.Src[:], net.ParseIP("10.0.2.15").To4())
+	copy(msg.ID.Dst[:], net.ParseIP("93.184.216.34").To4())
+	msg.ID.Cookie = [2]uint32{7, 1}
+
+	tcpInfo := make([]byte, 160)
+	byteOrder.PutUint64(tcpInfo[tcpInfoBytesAcked:], 1200)
+	byteOrder.PutUint64(tcpInfo[tcpInfoBytesReceived:], 45000)
+	byteOrder.PutUint32(tcpInfo[tcpInfoSegsOut:], 12)
+	byteOrder.PutUint32(tcpInfo[tcpInfoSegsIn:], 40)
+
+	var buf bytes.Buffer
+	binary.Write(&buf, byteOrder, msg)
+	// An unrelated attribute whose length needs padding.
+	writeAttr(&buf, 5, []byte{0})
+	writeAttr(&buf, inetDiagInfo, tcpInfo)
+
+	s, err := parseSockDiagMsg(buf.Bytes(), syscall.IPPROTO_TCP)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.EqualValues(t, 1<<32|7, s.Cookie)
+	assert.Equal(t, linux.AddressFamily(linux.AF_INET), s.Family)
+	assert.Equal(t, "10.0.2.15", s.LocalIP.String())
+	assert.Equal(t, 48210, s.LocalPort)
+	assert.Equal(t, "93.184.216.34", s.RemoteIP.String())
+	assert.Equal(t, 443, s.RemotePort)
+	assert.EqualValues(t, 1000, s.UID)
+	assert.EqualValues(t, 98765, s.Inode)
+	assert.True(t, s.HasCounters)
+	assert.EqualValues(t, 1200, s.BytesSent)
+	assert.EqualValues(t, 45000, s.BytesReceived)
+	assert.EqualValues(t, 12, s.PacketsSent)
+	assert.EqualValues(t, 40, s.PacketsReceived)
+
+	// Kernels older than 4.2 send a shorter tcp_info without counters.
+	buf.Reset()
+	binary.Write(&buf, byteOrder, msg)
+	writeAttr(&buf, inetDiagInfo, tcpInfo[:104])
+	s, err = parseSockDiagMsg(buf.Bytes(), syscall.IPPROTO_TCP)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.False(t, s.HasCounters)
+}
+
+func TestProcessTable(t *testing.T) {
+	dir, err := ioutil.TempDir("", "audit-socket-proc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	pidDir := filepath.Join(dir, "2546")
+	if err = os.MkdirAll(filepath.Join(pidDir, "fd"), 0700); err != nil {
+		t.Fatal(err)
+	}
+	files := map[string]string{
+		"comm":    "curl\n",
+		"cmdline": "curl\x00https://example.com\x00",
+	}
+	for name, content := range files {
+		if err = ioutil.WriteFile(filepath.Join(pidDir, name), []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	links := map[string]string{
+		"exe":  "/usr/bin/curl",
+		"fd/0": "/dev/null",
+		"fd/3": "socket:[98765]",
+	}
+	for name, target := range links {
+		if err = os.Symlink(target, filepath.Join(pidDir, name)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	ptable, err := newProcessTable(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ptable.euid = 0
+	if err = ptable.Refresh(); err != nil {
+		t.Fatal(err)
+	}
+
+	p := ptable.ProcessBySocketInode(98765)
+	if assert.NotNil(t, p) {
+		assert.Equal(t, 2546, p.PID)
+		assert.Equal(t, "curl", p.Name)
+		assert.Equal(t, "/usr/bin/curl", p.Executable)
+		assert.Equal(t, []string{"curl", "https://example.com"}, p.Args)
+	}
+	assert.Nil(t, ptable.ProcessBySocketInode(1))
+}
+
+func TestSocketInode(t *testing.T) {
+	inode, ok := socketInode("socket:[98765]")
+	assert.True(t, ok)
+	assert.EqualValues(t, 98765, inode)
+
+	for _, target := range []string{"/dev/null", "pipe:[1234]", "socket:[]", "socket:[abc]"} {
+		_, ok = socketInode(target)
+		assert.False(t, ok, target)
+	}
+}
+
+func newTestSocket(cookie uint64, protocol uint8, state linux.TCPState, localIP string, localPort int, remoteIP string, remotePort int) *socket {
+	s := &socket{
+		Cookie:     cookie,
+		Family:     linux.AF_INET,
+		Protocol:   protocol,
+		State:      state,
+		LocalIP:    net.ParseIP(localIP),
+		LocalPort:  localPort,
+		RemoteIP:   net.ParseIP(remoteIP),
+		RemotePort: remotePort,
+		Inode:      uint32(1000 + cookie),
+	}
+	if protocol == syscall.IPPROTO_TCP {
+		s.HasCounters = true
+		s.BytesSent = 4096
+		s.BytesReceived = 8192
+		s.PacketsSent = 10
+		s.PacketsReceived = 12
+	}
+	return s
+}
+
+func writeAttr(buf *bytes.Buffer, typ uint16, data []byte) {
+	length := sizeofRtAttr + len(data)
+	binary.Write(buf, byteOrder, uint16(length))
+	binary.Write(buf, byteOrder, typ)
+	buf.Write(data)
+	for ; length%syscall.NLMSG_ALIGNTO != 0; length++ {
+		buf.WriteByte(0)
+	}
+}
+
+func assertEventKindAction(t testing.TB, e mb.Event, kind, action string) {
+	t.Helper()
+	assertRootField(t, e, "event.kind", kind)
+	assertRootField(t, e, "event.action", action)
+}
+
+func assertRootField(t testing.TB, e mb.Event, key string, expected interface{}) {
+	t.Helper()
+	v, err := e.RootFields.GetValue(key)
+	if assert.NoError(t, err, key) {
+		assert.Equal(t, expected, v, key)
+	}
+}
+
+func getConfig() map[string]interface{} {
+	return map[string]interface{}{
+		"module":     "system",
+		"metricsets": []string{"socket"},
+	}
+}
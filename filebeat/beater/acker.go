@@ -38,6 +38,12 @@ type statelessLogger interface {
 	Published(c int) bool
 }
 
+// privateACKer is implemented by event private data of inputs that need to be
+// notified once an event has been acknowledged (e.g. to commit offsets).
+type privateACKer interface {
+	ACK()
+}
+
 func newEventACKer(stateless statelessLogger, stateful statefulLogger) *eventACKer {
 	return &eventACKer{stateless: stateless, stateful: stateful, log: logp.NewLogger("acker")}
 }
@@ -53,6 +59,9 @@ func (a *eventACKer) ackEvents(data []interface{}) {
 
 		st, ok := datum.(file.State)
 		if !ok {
+			if acker, ok := datum.(privateACKer); ok {
+				acker.ACK()
+			}
 			stateless++
 			continue
 		}
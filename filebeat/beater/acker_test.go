@@ -86,3 +86,24 @@ func TestACKer(t *testing.T) {
 		})
 	}
 }
+
+type mockPrivateACKer struct {
+	acked int
+}
+
+func (p *mockPrivateACKer) ACK() {
+	p.acked++
+}
+
+func TestACKerNotifiesPrivateData(t *testing.T) {
+	sl := &mockStatelessLogger{}
+	sf := &mockStatefulLogger{}
+	private := &mockPrivateACKer{}
+
+	h := newEventACKer(sl, sf)
+	h.ackEvents([]interface{}{private, nil, private, file.State{Source: "-"}})
+
+	assert.Equal(t, 2, private.acked)
+	assert.Equal(t, 3, sl.count)
+	assert.Equal(t, []file.State{file.State{Source: "-"}}, sf.states)
+}
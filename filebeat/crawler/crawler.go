@@ -29,6 +29,7 @@ import (
 	"github.com/elastic/beats/libbeat/beat"
 	"github.com/elastic/beats/libbeat/cfgfile"
 	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/control"
 	"github.com/elastic/beats/libbeat/logp"
 
 	_ "github.com/elastic/beats/filebeat/include"
@@ -129,6 +130,7 @@ func (c *Crawler) startInput(
 	}
 
 	c.inputs[p.ID] = p
+	control.AddRunner("inputs", p.ID, p.String())
 
 	p.Start()
 
@@ -149,6 +151,7 @@ func (c *Crawler) Stop() {
 	logp.Info("Stopping %v inputs", len(c.inputs))
 	for _, p := range c.inputs {
 		// Stop inputs in parallel
+		control.RemoveRunner("inputs", p.ID)
 		asyncWaitStop(p.Stop)
 	}
 
@@ -31,5 +31,5 @@ func init() {
 
 // Asset returns asset data
 func Asset() string {
-	return "eJzsfetz2ziy7/f5K1D+ssktRXEek7PjU3XqZP1ItJvXxs7u3ZNNyRAJURiTAAcA7WhO7f9+Cw2AT5AiJcqeuSt/SEUS2f0D0Gg0Gt2NH56gG7I+QQuC1Q8IKapicoL+ZD6FRAaCpopydoL+6weEEDrlTGHKJAp4knAG76ElJXEoEb7FNMaLmCDKEI5jRG4JU0itUyKnPyD72MkPQOgJYjghhvFU/xe+9fLUf1crAi8gvkRqRQAhkoSFlEXwRcwjlBApcUTkFM1KT8FrVOakJFEaoP494GxJo0xgzQ4taUwm+nv9I1boFseZfhNlkoRAkyr9kXFVJgavoBWXynKyz19xYFXBMdG/wVfX+uN1TodDi9txTZud5jhu7rgcG5ZIEJUJRkK0WAMrnhLNhkVIrqUiCeIM3a1osCqAl/pOZIxRFnnQKJqQXznrgcY9uU80t0RIytlmMPZBJ1YgzjD4EWEaCgmRWlFpRHlaFd2j/9ZNkQon6ZElqmX9BIVYuX4Q5JeMChKeICUy9+WSiwSrynPkO05SPfVeZ1EmFXr+Sq3Q8+Nnrybo2fOTFz+e/Phi+uLF8369C5DQnRFkYqehniCCBFyE6A7Lon21RikcyW4ur8WCKoHFGp41vRVgrQpA3lMizEBhFsIHJTCTOFDFeJh+qjE22qHSj3zxMwncXDMf5uaXG7K+4yLsBprrqkwSUcwpraAMsxoCIgQXFQCR4FnazeRcv+Q0YGA4avnFYUj1szhGlC25ntkBlqC/gI+cOmGwWtERdGisMsu/d5gU+a5KX7bAKqBZOtMGg4CHTeoxZ9EQ6ppIk7Sm1SBdHbNe1I2Y2CUqiHkWFmvUqf6IUsFvaUh0MxUOscL+Zeu9/RUtBU8MpfxVqceqUEE4DOfwwNyR1E8GREouWlcx/egU3po6svWJTYINs/dDaXmrIpyiT1xKqgUX1iSJsCCa4ARFAZkgLlBII6pwzAOC2bQVG2VSYRaQOd0wdWb2QTQ7c5D0IoISHKwoq09dH4fNK1POo7yu9+NiH5iX5CzvZ/V8mpCQZkk39/eGBIjYMObWzKExVet5acnLEWTyCcFSPXkWbFCkJUIIVkRarHZUGjhUFstch8iBbsxHNYdif3nyvb/o2Vc0ljecRzExM62duyDRxqX2MzyzqX12ooc8uIH5Y2f6mfvsIW5+Q1JhpdVvHJNAr9kwzc1ves7KFRdqblaAE7TEsdSDhlmw4sLxe5LP8h+qStk1OYeFvOtDmx63awIRUxruphO/MPpLRgqCiIY+rZ6zS3zLxyCOZbkAcs46tQC0IbHIaKwQZ11QSspgSySnOU9Nq4tXjBcklg1uFVsCddsTG7DMoCcMn1xotTAXIvvWfPIQmWljoCSoepVrqJ5CNvX3GyXT8h4ml7uPyVu7rWiOxkiSbhSER8ixCFZUkUBlYoQ2VMihR2QaTdH3P76av3o5QVgkE5SmwQQlNJWPm1C4nKYxVtqk3w3Jx0vkCFkMAWGKywnKFhlT2QTdURbyuxYQ1R3P9hgsHS+PJU5ovN6ZhSFjGylIuMJqgkKyoJhN0FIQspBhV2tp2oBQ+aqD+zsqlVZos09PcBgKIiWRTQYJDnZrpGOzwiK8w4IUzCYokxmO4zV6//q0jMHpkZtsQQQjishCm/yl/J2HbfF7bgZXbdqCKCrrku5lsXhpowKqgEaD1FDKwxGWh1IPpDw0us3LKttVNZU4feIh+jI7azLS/8oUB+M1qqDYZKZ3YKP2oKbY0oV9F9d+jAw1lOC0yQkzxhX4v0ZjVyLp5zmmwVLiG1Rsly62I5hsXr6GrtUwMY8K1fKOR+BfhIcJ2+T1jd3jMWVVp265QZJnIhd+Xxu8XrEOpxawBJu+cAZqBGCACoLDKbrSOwoA49otzXZ+IXmcKYJSrFZIcfiy8Kjqvwsuih3T9dNbLJ7GPHpqPJDTmEfXtc0PXy4lqVpcJb9J0TinUfu0ztAEdIKkXGjjEJooFRZKIlz3Plb9Qw3fEI0YF2SOF/yWnKDjLTveSoXbAwAg3d9mMJzf3XRnVQSUIDjpJQI9eklLqaFovJoaAmVRScJjHsmJc0P+QaqQZ+oPiAv4PxHiD1V4qeAyJYHiYlryIQztHcrSzJxv1IXTuFyrftayiFJpzgaMOJqDBg2ILikpJrrbHFxrFte1MwLDXBJwrLoBuqAxAR+2WdTNyKBHZ+efPp+fvr46PztBkhB0DS9D068fV3um+OX/706ptloL1Dx3nXc3cmY9uYZfRKRCKU0JzI0UC0mM4ikc8ZW5YmeUnCCqkFRckGJ9gxMQQSPKcIyui9OFa/RIkFQQSZhy5136x8LFrylXFOJj0yOlwxLo44bPPSaSqGnCwyzuMbZ5T5oXep+UOD79jqtyLva13mzkWsY8mi5xAD618RS0JYjIdyVw4WAy7jLKBVVrPxT362hQHEEn24ZPV29Ickv0G3OwtsbSyHCsmCXY6GI4U3GMugdl7zAco2lD4+stzDQVPBLjrUz1c2lLvo15vvsYQRJoWGIK5GvHWSATblRG4+sIOuZe0SPilgaVbcmAc7tL87b19FUIa0mKyW2nALXbEJFWnvC6t6sCQbR+qZAuHdG20K28W7U99cvVE+xiCbQvTPPlhC9zkvmMlkbXUdmh6fUe32lMQ40nKRZUlpxBxVKiaZVERmtT/zqleUDMw4KrFZw30VAvPwGOc7KcxesybbniWRxqCwwiIKp9vFIqnQoiU84kmUqFVSbnpTPIhmS29Pfbq6tPyNFBJTrOoM9N+ZfHL7sgkBinkphlfyCGc/OqWYQXRN0RMEp/ybQxgFlY4KMMJTSOqbZJOGscOVcRWdtgHhMWqdVATKfWVDcvu9lZ7a0FD+t60SIA6NOEqBUPh8+tz7bp5v3cJ45THKzI82K3efTafHPk32baX9F7Z45UHVTW/vTtOAtOaMjhvWPowgHMt22uKhzoKdPYnpf5dPBC5X20jRdwtqzDoUfrzPKBLc209HodFqrYrwlXZF5xg7aNYQ+cgDWmWpxmn5D1Uk69nDNJxLzmLtmRs15lIGQDFlatSoz6W2BJA4QztTKKyBj+NtzKC64iz32Q5brjzfnVcNBOA8Ckc3PB22kiHre7Kpy/fH7nZ6vn+rx5UDACf+DbODpAFQk1OmheCztBbaEnQzjnCq4ajlLmr1XfXG+gpou1IrIvAheq5XupBzqWJQsitDIGArnVTsQtEXW97O+2JREiP3au4t1tuBxpP2McGW9gk2stAKkHy9OyUZSxJ7BLDs0cBz5IKkFZNEUftUVhd7qIms7SjzVImtfOYywVDSTBIlihNM4iymyEZikalQv4ol1NgA5rb3BdwQ9tsW3ul6K5Zi8+VmuLlmrTo9lM/9JR7oCQaIO78XO3nPXoBuTbIa3WUluRlmkdavkU7mfe7IqOuToAENCuB38W8tgBirL9gdK0twGVYhWs9jd6QH4bXB6zoA+sfBE+XQnupbCF2PXBy+savg/aLbDUo5y7EM3vfRoMQ3ff82EQui0FcPQhdZAiwltM9F3WmDeEzz5BlLG2VXRfRVitiCChNplJiDiz50p2k+B8wXWKvvXIEO+19DTobbMU6T0wZXoXfH+Dl/NsF6aAZ0yJ9ZxK7rNgRwJ2arig2eVHjymLKo4ws/9pxRERPk85bZg0A7pIz16qstDYFTFW8KEdkwkE3fO4GSa1KMA6koCq9Z5xaBYbUNj+2K/I2MjapsT4shrQNk6LC+urcN5B46wwdAc5KcqO2z490GMjkzujgbabz42UhzKKAPwa48IonCRFAD94TxonV7bf2twV9ZQPtMOuy0zhKCJhd4ek1O8h2W4/bh3LaHbm56ZG5aZW4AJvY1Y516zy23qs7dFnKniYBaXsw0o/OwdoFlIVlv2f8EWL+9O4PcEpqLeMkEUEz+ezrL8/1DFGQ9yh9Zle4446fKMmqKjaxTvomHeUZd8NfzjzQR/0jj6O81RTQVDIgywhTM8rbeygBQlwJqujrVZkbR5eM5zQAFayWyzWaLG25Isk1f7O1oCLcF5LcuopPl1MS7ZrHM5x1pgqG+hfGIVMWf30CCzUOLTMZ2fGqeq8z7A1goNlpHiDKNAAqn6ojNyNDZWRuxzqtNRrszMX4QP4fWAFDghaZhDJ7SjzopX6K2vZUmFPtNQaBSvMIiLRo5jeNNfpBQl4omej4Fw9bh8wOdRDuHG8JJGw9xl/xMbFqgeswDpFM1UbKKQoQdi3QdAtqA3YYl0m5m2CJL9khDVcVrssJeWJ6chb/22LhzQItliRjR8igP2E2YRgKXlAwT64o2pVPlT3sW0u130MlLNGrICX9j6JU0WSnVzoQABqC7CuDtKPDWej33JxaCykAVZE2oN7+IlneRSk4grHdVzNbQBEttmnqES/EsGfwH78PxG20W18iY5RQjCTtrSACU4VUgHRFrk7Ht46QxOLCFZMpxJtjn2A47j10GY4L0FkFqtSgJLjgR7JzBxtcoGWmMaZIC3q9GEdJdfG8Jlqy0Pb9dcNkh0O/IPD5L624BVEEPHVBuZePBNlOIbhwZ1U7p8t3Un37D6xOzdSnr+lDVzl+5Z9XOWZIpjFt0+rs0H9t2uteZ2efKAKgaNyVq9++qj0ZH72cnT79w9/lv/z4qixrav3dxE5HpLv3Zxn+hF43M9zaePYnigi1RMI5h7Kn7bmyVjuNPTzxh/fRGd3iy+fl6d/+/E/Xl8GvyxOo7v+7OUKi7CTfR6vCY/6URz3ZwiL1Pab7k5PHV43TqGrjYEJrZ+qBvm7wGwXxq5ICFaEmpgyMCkX+jdE0/mSxoqIoxqXoif0W/Vf2yd8JZJz49Yc4LvKD3YvvsIK8SDIBATDYsbZOuGZnJtorHlIGCXhpBZ+NNdmDHxde8p8jARmSn8OOGMmKcH7nXtN4STV5sjcxvNMkMjYHJcI2c/mhfbOq/If3o1m+Db349/B86JKEUj1gUePmr8YmcHo8/nlFXr9aeZeflyWkvw9E+gaEHpbWGjFY3rrzkj8eAJrWDyH5MVHxicXaDNdf6ZSZtb96li1911BZ+t+s87gjSJY8hvXcmWandYO+NlPz6fPXv1x+mz68rkfcs2WLsLSKQtoiutO+SbQ/En0SG9g9euPzZQxE6A2LdqxzvOJNbxzazWk2rCW7TDzikGq5Yh8J0HW2ZlBnElFxEnCGVVcPE0wbTRnM9RM0I04QfoJC8GsQl8+z1pBPZ1/T3Fw81SSIBNUrZ/OS93d371dGFYgW70VpJPFAb14GhMsLgPB49jGBg/vQ8t2vuDheiNW/VBhfFvlSZeIML3Z6kCqX/Rjq5y4FJFSqSANW3nHpTff9TbLDgzwob85zROmqvHMPpZltukKS78UbbHZtp58m7cXIMU1MGAxdGe7v+1a2QJ+c+rKsWhN4QVaGn5bk3EuSdAKbRlzvOU+6bSGJGcILkNhql0a582f8S1Gt1SoDMflyjF+4DIQ2WIu18mCx3Ol5wTkPeyrHegThkKXNIEUCJv8gIKYYKiOl6XIYEGAxeM9qwGH+NB7AN4DN0DZiPuO4Ju5IEs5t05RwL9H5Fcas0y1LVtwBBgm0pewgMhSo7rCCQWOYxLPBZEBZveFutTfCRY3kKtFb4mt9gDO2JggnKZxKfZfKp6mTadZ+bgfSznPWMxthu89tMRwA3lhcAACIHr2fpBm5ZSkJkafUu6J8ZM9nD/99MXIuJUXIpZcJCaj3ikgD8R2lY3q0dT+TkYbO7pnQ/RfrRE8U5KGZjNyQwQjsa8BlbzUB0BJWR0k6kQpCI7vA+YVnGnYlLg6aMUhqTAmyhWAy1cp2LZAiQo4x6OMypXfpf/zbTIXGWuZgu0N6RMFoqECkj//7b1Fk6Wl2TZBWCJsyGspNyZ31+GeCSyRczjrmWst06Y8tkb+BosFjiq9abnaEybN1Q6DT2kUpTZ4msLq4jCP3cUaguL8Rg+xAWVxduIqVRKuQtgq9ObNKQTZmKU3amG5Ini0U6O3BKcIx84zDk5rOy7018G2rH5nfrNoVeqUKRJ5Mj/6LT0ASzce+GjBv6Exh5Sj9oVGr0x7g/RFQlgOTjvAlGMnIuLPSNti4D7GoQu5g7jxIMhSzIL1b38EYfD4EkI/Si34DQxna59uHt01z1g05vj+QxP8nY/wut6G38AYd/SrH1252kOFadU9c2mSHV2hqOYBR10GmuNUHJsmKWf18N0qu3dQZMc+V/XsFF4fPiXTYJpM3xOFz7DCp1CNAQ6IbPWJ6pttC5fXc1NHZJYuH8Gm9Hf5aUBouubKkRnCN6ft7i6/q8s3C/2zJdfZrLlBqWKpc+pC0RG5lVsTd81At9EZFsM557dErAgOO8a1Tbh8I11hlE+cmN9VA2drM8f87uLiwMI9rx9AN/l/fX787I9Pjl89ef7T1bPjk+NXJ89eTn568eLb19mHi4/o21dzUmpITC2I6S8ZEetv6Ovt/G9/Xv38t2/oa0KUoAGcx76avpgeP9F0p8evps9ffft6/A1Mwq8vpz8m8tsEPsyh1IX8+hI+a8N5RZX8+uynly9+1F+tUyK/fpuYat3wH4AAx0xf//rl/PM/5ldvzz/ML86vTt/mNOC0VH59pp+HwoJf//efR4D2n0cn//vPowSrYDXHcWw+LjiX6p9HJ8+mx//617++TXbRNxDWLbqVTWQLFbRJg7ezl0RVR2+zitEd3IEEjHSqcjvd+uhhvwad1YbvxfFxIn1QahkHOQ49il1A9O9DpkZ7k0FOOlhdKqwozIYh/FraVZLFLpYmqEM/1cazLsgD2wwiPoch68IR87vucR0wSQb0ElRAm1cKfPrgnevHbFvKAXcjjFNJ0WyaDjAXXAUeu1dtQfDy+cDJ6LRbFwazLaNqVKZGHW5kq8eektDEmrQBeD4MgOCZorUVusr7s3mibZjl8bO3//P8r3+6+ennu5eRivCFYsOmB+1YkGfhKFpngwa46pj6IQ+6eNnYMhpQFuFSUNkMvmiJJjM/doeR5RTR7vFjIVlkO+XytBU5sg0B+oPyBmv1HDeN4wZ4+u/SVhWV6A5ur3LbBSgNb2DajK6N6YS1+nojgPPd9XMEvXY0QUeMK707mWjDolCrE3R0hwXTUw958vGPAkGhdNvRQyceFsU96A4H6BuFTJM/yNi/uYzBQUDWvCxiPDGzHA6S9m8maW4hp7K8is8u+yf2zmaXuUestY4+pe33afRI4W3wQPde3FBD2KKcofEojlrO8Koou7appOGhamCFLewibQTOfvgDBxtYA1F7mLWUw0u58Jdu2C7n0wGASOKu4+DfdJXLPRT/vCpKWGyaLQ9WofChK0pKiEvDKutdTLIvc5ktDOEO7neUvXg+Pv+/m1u30Eb+LjoXgg0aeb1jTEoXhFf23rSMBFVkD7NTk7Up2ixENL+utENP2IVrfCzloHa7jFUKi+cX8xDI+Kvd8OyD+pDFYAPOb+jIPVS7qsawQNIkI+UJJN3LS+1K9ZGQwTWGK4JDq+y7MfxOC9UCbNfLvynoRpu0Iz9Uuh0lub5U6TY7VLo9VLo9VLrdDOtQ6baE6FDp9lDpdqQCLodKt/bvUOl2n6VJ9jtuh0q31b/9V7pt82APL3X70C454D6ys9Qy3+grfVjnveU+ctst841tf0inyuHYosL2od3DgmDJ2Txdibbs+l2d45o+MvRbT26yfThG4VSvlIebch57VoiDLZj/HWzBgy14sAXHw9JWtu8GL2/KkZV/0Z9bojLgt6JEvC8Aw5FDu4dV7lgg3YB1F7P2tkPrV5r37OKr9vvL40aZkbyFnusditDYv7/+/KGehdMv8sbd2Ovj+TA3JvjT0HZaVk/zoC3X0VQiW3Zc93/bXRa4UWVs28ZDPRkgOAgCVFwfa3FH6AoKuFPWIW89VlNPt6BxFE+tl0y9+a5+QhulFXUNWk9YCL03tFGKRVGoWqNrh7PM4vp8HQcLVJzO4th1T300nbKmC8zK2tp80aKuzY/dcfA5RfS7VdijFk34i+mzzYUTpBpSSG6zNsuEMIedcAP60gJp3bfWbwYwrE3hn9pP5su5N7Ei5pFUWJbr9bqvWoTK/dwtViW6aHTBskDflYBWu2GXa1Rc84b4rsZdU1tOxPXE8DHqMiZ23LXmpkTtmqiJqVsbcGF29XBfxTsevfzZPN4WJbq/u4W4sEvMXV5etVZVt6tozUgDNyvtrPGCZ8YyERlj5tIjuHipAKh7dwO8mEdzaEf/2b4B4w1Z2xt94oyYzCJQdCWvQAGlMRGb6deDJ1yTxGFmHWbWvc+s9lk1HN1nfIfCLEnz817DOvYwyaMywDM2sqOxXKPTMOji3Uja3E1ibOXbgvcJmrE0U3KCLqBuuZygj5nS32iZOuUhCdrKYHF+M6fMl7K8vSP6HLL7oWQY1D6zaUnORdknaNbhYpg1okH2BguYdaGyw5ligVuCiodL9KWp2JlfuVOCZK7SszVWNwOaexep3davJ/9VRVaBZPICFusSZs+C1vUfaxonnEU8XJQsY/tN/5Sl9/qFsz9tTlsqeKEhqUtV87XEbd+XD3oOftsQ+FBsyJ7bJJyNG0x9i3fuR5tVvt6Uyt6jXlIT0UXGoBohjlGAFYm4oL/aglAbwJ1+fP/+9YezgRBZY0b3MHzId7URDmVUYRbGVKpa9bFNoHxk+xgZ1gfT6b4qaTE3N9fyl7g0M9+vL//6rv+81KzglerM7H1XqGOPts0zbAGAOmbs+KEaVSDDIzbu01NuTLz5aDfhvoagd9PyH6f/MX0+qdzOaC1KGk7hFkfznA0lkPk1kuU3GxzMxXhBJdbb1hKmLcf+G44DbH5vR0O7txoPfR4w4iZygyxrDoNE2RNY36Ohhpm5kRPSyKEkXlgUkGpPmRnODFJi7JUnbp/TwdqNQtuNpo3wgj5BDI27V0YAYnJltUKYjl3jGKr6gFldoNE2/GSnOscxD272ghcncMOpNmqrmO8wVaWrbDUArX0WpAirgDt/G1SNlUzlTu0V/E5CdtVIqreagKSpI0FUJlhhtndMHkCjlSJlZMxr0WuIZIBZP0Btq+AuYDJGv5fWSIVvCCt03PXl+VXx63UXuGZNtH6xe3mptBblMWbPF5d6odlZLuSWu7X3WETZ95K990F/HmbvwStb2nuOPdrF3vMAQPdeXaIAskWNiTwubK43CF4RwELggQL3mpm3zL1JmkNpoSESrsamWmdppu7Kenv5b8CThDOtDCkL4iwkE7QgkobEGFwm/rbBsSA/qbAyY2XSTiWK6Q1B1//3yQUXd1iEJNT/u56iS0IQjqW5UuY675NrX7DcHoObTxuBzaVri9NsEdOgsWBXEcMoXpvOn6LZEjFevNjgV/QSFsQF/1mr2WPrWhyC3mLVtBx8QJocAVirvfabLS5xiCqusH3IAO+Hjmj+nWamP1iBkkNi+diJ5V8OieWHxPJDYvkhsfyQWH5ILK+DOSQTHZKJDslEh2SiwVgeJrG8cF4NP6wcOYbv3ACAAIRHZBpNDaQJcoV2H7cE64zmOv2UHyYSpuiSEoEefZqdtfBVI7ps7dGoY9uW8OO8uuMd2p4WnuJN7Mc/1TQi5+havzSXzsPuPNMfZX7FiIeo9QmT7ykXqjheuLZ0rrtz6wpuaPeYekFkFqvdpig4X5f+Nhn6yNyIJInqO1HH9+qVF117CLjCqij2aHyYEKvZ4pUIPIveDqAuuECUBYIkhCm9J8UKT+AGZ4iy1VaUibPNC1PiMGycdiFTpDHhtyQEJ3mAGVoQxBm09gjeOZqgI/vM0US/cCQZTuWKq5ZK4Csu1byYXeOORElXOX0Ox9qVupxWyq37gUoX5ttc8j5o0zOO1zmh5sqYO1sY/e67I3pbVfSlekJnpQtkqHy6jCRlgQ2aTnmwmppbPnXjA56kcPOL0QD/XTrQC3icJW11QHFMWIiFtzHZ1qNjAz4FsYZ4Hr1Wu7xYc4UjZGP22/luhyw/rku5VJEg1RitT+bLwYFaxXtbnt5V0KDt4yurQPYdYlk/PmzrBvf3m4nUogn5tXnXYU9Wv1rtlbO9n3Cwsjnl1x9NB2kRmYXDhLJBcVkuUr9BNveNYoUXzSooBc9kbQKRB7P0Uu4XgXbx+ur1u7Hjz0JfKHlXJE35Hr7p8SA4Zy5GnC8RHho3UfC9PH93fnqF/g+6+PzxPYyh/M9BOP5qq/djBSbAQwXmWW0tSFi5leOz/tyio+G37tRPRw49eEKxAZtry57Kcrwt2lUp5nN25lZTg8ocxrXFOI2dy6UpVvm72uxTdFoxG68TLBUR1xN0LWN8S/R/ghWNw2v0SK/Mn88unr7+eIHu9D6XRQh+ezzx2abX2pCgjMTX/cNdx0qrazQLMh11Y26JWHAJ7TJX6VyDXXxtr89pwbqXydigOmKE7KULgYVwDaF3YeRWm556FTcicEsxwogRdcfFTWnD3teqCJIhQQ69IsGSBLPQXcTacm7qFozpaLc4vIWuYlHbZbAOFySJBaI7HWtU7VFojY7F6oaMePmU5npD1tUtmesAvRXtHhwsxizGAFGxIsoSuMz5jqpVC6gAx7GGZFc0cxpSWtIu4Yv++w5DYMv9Rs4d7RIu6IOAuuIFM7Uac7/xjrLsO1AtspnuPTsESwTexhyVxtNdaajlQoqeEfbgK9qCayp4JHCyvX2wNeNR9c2nQuE4YOArk67M0mZA46+UvXLEdsvkAHdOkcRQOARNvJJEinuSSMt8pazHQmx9xGpnojR3FAZ6Nbq8fKvbTZm92L7f+WZXrnuPLbHumBrjull19DoISKqMn/EC0zh3M87YLY5peDQtPePhkRDMJMJIZhCOvMxiw25aULDP2IGxMRU23Mpl/ubHzR4W9mg8x1enVzQRK0WSVKEVlmgJD9f7uTPEc0CX1sJJbdRmvXNTLKVeNI+gR01o7g1ZH7WhapzyOyH0/NALalE8uZbvU+0vvQInuHlIm1tsgqcpCZvhzyPj0z1bmLF2iLX5y1PCzI1USUJCihWJ1w5VG2hPOeTOAJMhgKEo8k5dKmnEsMpEU+B74chfz128FpgJ/74h6zbGvmCSLl3XA9DgkJJrO6X1LJq2RN6bv7FjS/zRJe3xJQMiTDafy/c6mR8UZ9IvdmF/yKhqyBnqHdqxN1iGbWdvbY7LGQ3d5uicXvE5fSJ0BvRX3yidIXEpo3VZa3RKGY/MQr5Hi83YaXk6rDvo11yv3dZ1oBVXC6kxf7lXGsyiDx+v4PQxCzkRzbjSXmtDJdBBUwuwNEuUJptvu7sNJNW4ELsn96urf5QWxQpH2uZ8KC3ad1saZYEtvxhSQQLFxXoHEN5g+nycBOdb2uIKi4gou03hJU9IHaC8oypYeY7MS0VOEt/y1q+ral468CNqCBt2SBo3Dv271b3OOct4y2nnXX16dVSRTbYglEUmiKNVaBr7+N7WZhf72VmrITc6QxjEDo4rX1h9D7r6PbTkcVgKG2HkDhrYah+viKdSbw9mIVniLFaGQAc7r4hDDzyIjDvO9y7kZcNJ9xIA2YPMtQIoPFYe9iWX7L4qjhjSJXftA3tILZ5795H24bsnL2kv1g3RG8Md2ofzPTpE7fGHEpgs6U3p/OPKfDMs8Mq+tLl6XcEP7XLi4eWHHqREgoOyS5EE74CPlOrfamAdkuIPSfGHpHgfukNSPDokxR+S4tkhKf6QFN8b1iEp/pAUf0iKPyTFH5LiD0nxDVCHpPjqX69jR7s9nIMUj7j5KtUvNRykl/1ScKYIC9v9BNu5pMpz2PEApePfAeLgRoNo23xvwOB3U4j8Lh1L3h7huQ05BfeOKeX4w/8LAAD//5d6IKQ="
+	return "eJzsfXtz2ziW7//+FCj/M0qVotjyo5Pcurduxk46ms3DGzs9dzfTxUAkJKFNAWwAtK3Zmu9+6+BBghRISrao6dlNTWqqLZLn98PBwcHBwevgObolq9doSrA6QEhRlZLX6M/mr4TIWNBMUc5eo/9zgBBCF5wpTJlEMV8uOdPfoRklaSIRvsM0xdOUIMoQTlNE7ghTSK0yIkcHyL72+kALeo4YXhIDPIL/1L8GMeHfzYLoDxCfIbUgmiGShCWUzfUPKZ+jJZESz4kcoYn3lv6MykKUJAoIwvOYsxmd5wJDEdGMpmQI38FDrNAdTnOCqES5JImWSRX8ybjyhelP0IJLZZHs+zdcQ1V4DOGZfv87vPy9kMN1iZt5jdaV5hC7FVdwwxIJonLBSIKmK82DZwSKz+ZIrqQiS8QZul/QeFES93QncsYomwfYKLokf+dsAzbuzT7Z3BEhKWfdZOyLzqzgY1P5c8JAMSRBakGlMeVR1XQP/y8URSq8zA6tULD11yjByulBkN9zKkjyGimRux9nXCyxqrxHHvAyg6b3Jp/nUqHxuVqg8dHx+RAdj1+fnL0+OxmdnIy7C1RQQvfGkIlthtBABIm5SNA9lmX5aoVSeC7bUd6IKVUCi5V+12grxuAKtL1nRJiKwizRfyiBmcSxKusDaZ9QAzbewb4Bz18jPv2NxK6tmT8i8+SWrO65SNqJFr4ql0SUbQoclAGrMSBCcGG/NjBzwfOsHeQtfGTlAQZ4R/BJOEkovItTRNmMQ8uOsSRgaBpHe0SESq/oBDo21pkVvztOijyU7qeRVknNyhmtAcQ8WZeecjbfRjoIWRcNsryXQ3W2kXT4cOS6qDjleVL2URfwJ8oEv6MJgWIqnGCFw93WR/sUzQRforjyqUQ4SUoXhJMk0i9ETiSAxERKLhp7MXh1pL8aObH1hk3ijtb7yeveqgxH6IpLScFwdZ8kERYEkXg8RPOYDBEXKKFzqnDKY4LZqJEbZVJhFpOIdjSdiX0RTS4dJehE0BLHC8rIBgjdPVOB4ffrm6HYFyLPzgo9q/FoSRKaL9vRPxoRulFtB27DHJpStYq8Lq9gkMvnBEv1/Dhup/DGE4RAEKJlb0elDikgnCi6uSZGmeDaN9KkTsU+ef7QzsQ3PfsJcPmZ83lKTEtrRhdk3tnVftHvdJXPNvSEx7dElC390v0dEG6eIamwgpg0TUmsSGKauXkGbVYuuFCR6QFeoxlOJZgNZvGCC4f3vGjlXiP3i1zQCvcP/if+Z7ZPIGJEk6f5xK+M/p6TUiCiyagNbonnT/TCvl1ocS46tQQgkJjmNFWIszYqnjN4JBPblxOh7a8NK8VTkso1tEos0RFPdHCZaE0YnMJoobGWJvve/BUQMoFgwDNULgKup7RNENtpmRZ7O7t8ep28t8OK9drYkaVDuYJGjkW8oIrEKhc7KENFHBqQ0XyEHl6eR+enQ4TFcoiyLB6iJc3ks3UqXI6yFCsI6Z/G5PM1coIsh5gwxeUQ5dOcqXyI7ilL+H0DieqI5/EcrJwgxgwvabp6MoQRYwspSLLAaogSMqWYDdFMEDKVSVtpabZGgWaboX+gUoFDm1w9x0kiiJRErgMscbyGsFUhHcwCi+QeC1KCQQIgx2m6Qh/fXPgcnB+5zadEMKKILL3Jv/m/BWDL50UYXI1pS6FlLNvZLZYfdTqg8tWt3VDGkx10D54GMp5o0QdBqJwmO0O64gn6OrlcB4L/lxmOd1eoUuI6GIzAdqpBxhPSoMJNO9fNgIw0tMTZOhJmjCud/9oZnCcyjLnLgMXDLcQ2KLWE3UHIFsQ1cq2HSfm8dC0f+FznPfXLhHVlfVP3ekpZNanrFyjl8xG8NcqwWhw0F6VMjjnf01I4SG6BUBPbu2GEYaIzWoJgyLpCtk6TcuWXOsDCU8nTXBEEnJDi+scyswr/e8dFOXL6/uIOixcpn78wedFRyuffa4MgKKfkuYjJyHryXRX2WktFVupakfncJvVcudELSIYr/WKNJJ/NJFEHDUmex1WBkalVKEjGBUSyuh6kwkJJhOup0moyay2RReeMCxLhKb8jr9HRGjcvd9pCzZqwG7BoQlo5oJVCcabOK+ykEgQvd1V30KSMRJOCBQqQESybY8rncuhypn+SKuG5+hNkceC/iRB/qtLLBJcZiRUXIy/hsa12KMtyMxlTN6fSlMqksN+OqNSJXdtmdAYKASE6o8RpCBUjme8A8b02oWHAJdFZYFdB72hKdMLdDMy1aY3Q4PLt1Ze3F29u3l6+RpIQ9F1/rIv+/VlVM+WT/95KqZYaDCoq8vzthZzYtLPBmxOpUEYzottGhoUkxjuWswaVtmJblBwiqpBUXBQBHtLvcEHnlOEUfS+nQr6jgSCZIOCP3OQcPCznI6ATqXjtZ0Yj3syONrxascE8JFGjJU/ydIO6LTRpPth4WsfheDHAJij2s41h5EpC/zHDsU4A7s5BW4GIPCiBy2wYaD4TlAuqVmEq7unOqDiBzrZNkdu0IckdgS8iHRruyiPfQIIlX2KmrU3PUDug9krpnYYDCtOojumfXh9WnquOL+8u0Nnp+NTCwdBQ8ZinYTJLOafJLlXhMu94jUgxZRXiAWPR3RJhnD1n+ZIIGrvhMaIJuK8ZJaKDohP0yQooPzSzNNprJuDgrOhRRpNwyaQSuU5pJZGdyWoY8mxdwgwLvCQKOK3VfQEK09EYkZQsjcufrtD15fPJJcLMqRaVkmwetlKOooSCz8XuAqr62g8rvgmcJjtrMBlNPFAtvgpqXJlzJjvDdQIdeNBjEnFH48rQP6TpBpRr83WoFsEUU3JH0u2lfuDzOfT5+vOaWFOGWBAIpZqWSzTIrXxbHdfBGorqKpEycrMfjIooiM8KkUVHBGKohHiuOUCBPJrr6I00vsywoNJLuJYREMjyTAaWkITDKwiC9LqiKVcL7S2M94ix0z1CnKUrX7Zc8DxNYOCgVxlVdbxQKhsJIjPOJBnBpFguI2+ef80yG/T9/ubmCjk5yJMzqk8wnh6dtlEgKc4kMdHqlhzemk+17tCUqHuix1K/5xDDwtqSgh9laEnTlCJJYs4S2aoUG9JGKWFztdiS04UdYZqPXeusamvKk1WYgaY+WhK14Mn2beuL+R6Z72sIt3h2i0eKZzTeXvK/wcdIf6zN3/ZtpfXrMYFLJeuX9dAlyCHDQlFvrc+mqjUkiq+biQRR25McDZCf9UeuFh2YHZzV+ARRb8nqEdomhVs3GBZ4iOgMxtk1JHwvR/JkNM3j2+qYZAtIfxr3+gQZWZqBCSvCNS1P7Pi6jRAWbHs+b7582pxOWB/mzSfXwPWJw2yzN5s4ZUTNUn5fJk8/EfXO/BBAegdDWJu6sy9CJIUmV+8m/w+BILscz1+3YFVvkZz+w1lW3XuNKgvrtlACBFXmWxTjzASAFl0LHjoSkaYK2R2fc7hzx4rMuXhElQAb97Wrm5LGPRe3kRJ4NqPxZkx0HnLLIOMGOhqsvEhCF1iLWutpDQxhyW5ACEsaIBK7CnhL33bpFg9bZVpzQgwzHu4mrZ79bNqWFTi5qo8wAXWIaHZ3CtlNmt2dN4BSzHDE8uWUiO2xJ28+vSkGr8hIcQz04lPIUDeNbotiuxe3x/fd6zreEOl5bhVnoIM8yRoIJFSQR7blS/epYwF6h+XVfCqJuPNa9gOUkIghomzKc5YAJZ4r/d8NvKar+rSuZ31uJbP/UpOWinrRL/tUh2iZp4pmKS2pSggwYSwBabQGahmGjqyRXCcR+/1TqNjpn2L9QW05QgOFyVUxtWOxjZyhXfxJ7TQGhrEOc3VrVgrBpgdt34U0rH1ImNiaRXfr5wqst0IrLHpOeONsf4PonwmfXOn10GA24J7wlOfKg/JUM0KfYexjU8kQHakFqYl6m2KpaCwJLOFBWZrPKUPU34oCXa4ZKXmZZduT+oWCcQFlMDLwYqymdthSxFDCopA9Ogjg5kyJVUQl90dqj0S+MNLQ5PqzXZq9Bpjy2O9QSqA54VHGKVObQUEhwZioyhOj5hQr/UcA1KwR3YFq7UJSL23hw8SQOn46yAWkzxsgbEl2U122NLXaclAJkYoyXVnbOpjL8lOvRTl7DLgLH+sRPsPHyzz/EUCyYeVBxfS6XcfEcxouR+mj2HBwqFEShGeQo4SHOt4uxNCQmITMqN2EBLFEl6vwIqRH1ruf/gaOBXcv5B4drAG77nu96Xr62wA6ITr3p2eqrMykrkinhJAifE7WtCrPmnXSQQ7+vbG2Ch7FNyVX+NFBkIeNPGvi1ux3QxJuwFaLZwtluQo7G6JX0DEfH+lxiba1BoKmhy93XjyVol0iMrlEg4Ltq2dDG/MZC0/4ElMGmzgGmtoz4ErYnBabFsr/AQ/txisSz55tqP8iQ1kTvDYw2rB46wMlb/Oaq4cGLnkGbCKdFHTR4ZPVrVcBWdF1nRQpSDu0KlPJhvSatEoh0CcOywBU0IRcmXisiIoSkiocxdDLH3SUqaU82hZCrtCgXAKIjiTQ4PhZgIuJm3snY2B8NuMQG0MDXFePXDQIJHssk5OgXuyQLyonAXsh44aWkwIGDU5DhGgWxSmWMuKzCEaCNCa9EKLZBcB8nrlppcFZiI6KswhiYcHTaEqV7IWLijNI0wue/pkqiQbnISbWGRej9ciLeXZLxyDdOCA9uBr81MIJsiXReq9ai/ueyGdydXfqOtrByy42mSAz+lCdMulDS8DqSmN90FBo8CpEjbI5RAgRZYqIWWiZ9S4oWZSJA0GD46MQGy9y3oc9eXA1ozo+7qLXs2l5UBX7Oh5vRKx/K/Mg103tOOjSyT5MjaxZWpM3Z+RBRQue9V2VNPtEHtR7nlUrMujVp/Msst4Cy2oSd7dqms4zE/S+kTaHNzgOendg5NV1/7S84a/HLejlp/P91eJ0Hq7GoMPPuFTRMsZSRfuK77hUHy+wVFdrgd7xqw6K+wmHC4af63HxONgX6CCUsCSSKxnlmb/sYbfEAOgtS65X8mumR0qDcdD7w3uRnsHaC6VrQPJJBT1/xvdaget1Fw7e+X4tf93ox0Gvv6SMLvNlRLNIcYXTPjtIizXJbgDJ9YzjoNtf4of98cIPIV5toT1MNu4jjD4v3Oo46O/9fqhnTh5UlVhHgH++zwD/vBp1jYOO3ivJvgh6kOssT5q9vV4hrkn2Qgsc+AeAAFJocBL08jReZvogAz2DBGROeyEDODerjFzwBKryFA1Ogg6ezi2ffljMDQs0OAk6cjdda8aHdzjthYVDmVgQNDg5bWWD0znsvVgse6XzxqGgwUnQbYNBRbDw547o8ITn/XRxgPNGw0B8ApO8g5Ogv4YXI5qk/dOZJKlHJuitTW68P9s18q31vmxhQJMe8ScJGpwEHa/LSdvwzHTs/cVBDk6HaLpvt6HQ6VErO7tQb2/8Phq8CsPjVoZgb5FJ/u+NJaSnv2jICtFxe+/vEi89BCT1rB4anAZ9ZEMqqAdGwQwQGpyGI9wslZHime3je3MKyyyVNzzT3bxxDafnG/DpOXngs6qkD06DnlN3QET05bisePBcpy/b8Jc86aeWLMBHOBxjcPqqjYPALOHLPcQfRHzRUGUQcnbUNoZUKu1z3Hhz8wENzsZto8XeGBj5hkEwLJwJPAfDLibnGpZB7YKNw3ITdAYKDc6C7k/nQ/Y1VQdgk/XpurOWTmKJ40Y/s9Wyl2ZWBukjjgsvcxZ0gcC+ktrdAzfA9BK8FZJBV3iXYtaXHwTZ4ATPgk4QqEZ9wgPAL45C0AfSLFpfirM7AjT7xS7JGZwHXZ0OvOprqXfLASDKJdeD8+MNJmvO9zRZU2Z9zsebTD+c72v6wSMWdM+aC3lQhEHtRguCEyJkL/UHUG8d0nsDhAbnpxvEXFLh+DayR3b05XD8yOsaAK+dqf0UNPk9+8Mk7AtfBrmBw3Czg3vy1ddrfcnLcAt18607WDHczKhA0btEBi/H7VQ8jN4ZXZZfo8HLYKvcTxqA14f/L4PhiJ2c6ZuNganQOW8NKCubT/sJJO1W1cHLYLc/4+IeC7gKA+b6VC774VKgXGsQNHgZjABwlqU24g2FITuyYg8F4qFXZ11U+mzkHo5p5q/OW7JDou+hu8OpzvqfHHVxOt8PpzIUOD45bp1GFwT7Ryzs1JrNDPoXjQBMgjXmLaY2e2P6Cqw9oCvAAZs+Pnm52WzPeS+UarM950Ao2OBN1YL3sSde9KWkAujK4GglnZ62zCr0QgNkG+iXXTZjFuDvwWguNZBh9aprJYhZpH7QsUj/0boxq0DsSvjB8dlRaxu3S+Z7YwPLZEouzf7G6MZfxd8bJa2gj/5+gcHx2bhVS3vh9ZYldVYnnfqKhdtQ3hsvq68SCZg1N3tYgLUXXlpfVVZnHfry9t/3Rkur61MJBLzOW7W1D1ZvWVLjFJ5H0PtsIsqoirxdPD2SM+f7ThhVMDlcawDnR+1dcO9rLSCGg7WkwQrUPPpf81Gu9zj+6adOffQbjdhI5KeXnfo471sfEBH9FOxs86RYEt3bRoA8sauh7er/cP4FmPj5oT7peElyxynY3aq4f+2ouKadYPeq4j1pR8Uh7QQ7V5r1NklGMz0/dvwqqAydqmO4SNf1PEgFuE9vbq6LNQLFqHA8Pmvl59fYfkh6dVdjet7CNFPR/vaZgXY+vbm6uQ7tNxuPf2rnuedNTI7sZeNmpnF40SqEBRQrLswCJdkLuwJEL0uCag6nKcyZggnplUwBUpIJtt4ZFeQep2mkT6bqhYqDeAsIQOSkbXfenZj1Ne61EL98eQd71ccnpy07t3qkQaosgk5rSvcwITmltSnJcUOiRi301Ss9rm+0CBCpwcaB8/ZGXD3AqadWfGVPeRqMX71sb8Z90ilQPDqvWhcM2+mOvaxiNqS8tcxHZ5twC19ytHti14ADrM67Em56yNjnaNEDg+FikVo6GY834tb7cLZGsDqePRmDz7bnWeIMxwsyLs+zPHxjfjkMn2hpn6KP7gKG6v1h9sYN/7AYp4USKXxmTUNBHaC7rbn9WB4cx6EgcIuzcYprjux1zu72DscDDvq9tDhwSHRBKUTLpybIkitIFlSeNk9CdfCEfxcpBXP0jksLIsPV1/X57Sciw+FFIFYjwH32iTnuZIoljRHO4QJ9ZWfDitvwg+QqRyFvwqw4dvrntzfbkxb2BGWoxuIY5RCvXKRbkNoW+euXD2FYOCY6sHhqB/i6xFZyGNsdX10/1KzRq2+DXJyNXb0t3MeHU7MjuDJm5B8l2ckgdP7kFuxY+ExKcKBElLSBXJPaZkQIIoJ8n1ZdTnQYGM+rUX7wWq0NIQu3B74uZ8/1vUD61ERhcJBUAi6gDh3IqF9bE2k+azqdkXHlndDIhf6h2U0AQtRc4LqD37bEtrhfy+JqV76z0jafRdnWdfgKMOe1rT1ut7MN1BA6qzJbrCSNcWpBR42klvg3Lho5BdrqFoS07Prha6U9tpCirD9SlD2OVIZV7G9a3XHtafGP4RUICzahVXTCFwvBl+TxxH2z24Qvl49g+wguPIP73uDsYXv1YwujaO/NYDt2+24PW7F7pAHuvEodpTnhNNt5H7N+6PEcQ5oEDhzFED3b2y6KQYLtf9YkhvqjLY5BXpP3mK6o8ZjkHivPOz7ZvbJOq/EU5R0SWz9d2T2p8wkcslwzqMBhy1swaTt82b1T5xQ+g3mH6gFSwj+f2T2oMwkd07xjHnF5hLP7uc6i+STnHXJZO+G5zoIIUfHQYX/TguTfM1ImK4zcrZIU/p1fm2igo/SVe8y0bNee7e7tUZCFOfx+tzTWT8C2R+yv3dVp9RamZnnvaNQFxFI+n5OkXSHlfXadnfcGiHaFJppchtHUTtHUQl/j0wRWucl1R3VtZMJtH0keu/tn63p2CdA8oSrx85/6h4b0p0l76kN6YcgIsrF+v2hlm+dDHXC4xTcUs97Sa+ih9u0AzZ3vCDUhbuljPlCWP5hSALw5wRinqcXXV9klPM4hIU0SBMEOmpIY58Xlh5bIgqzMyyuGl5A9ZAm6w2IFV28Y8Xo6Uxuhb0P1cvpldacuVOe4NjSfNtASgqdJhHOabCcf7pdP+Zyy+sWDUJk8TWyJJ5eQU0mKu6P10EhfpYsUP6hIBOuBe7q11DBVRu53TZWR+4LqyNPa5NLdaa75h8gKmMeZ5XDdVCGZl6WEn2xkS4W9g1WtULzAbE4kGqT0tl6nCAyLL6E1Cs7Vs+YKk0TuUAlQX5JIPfbZfY3tlitUWMl1hCaqVlFIUYLwQUWiGSAIeFqtsOnKFxYsgoR8N4vJDrsSv2E68faupzAHHBf35W4Bo6sOx3o8oQuDsJQ8pjo+uKfKu2J00+56A9TJpQtMbP/cILtP4VSR5ZNS6FoAnISPrRE242wPA1+5m/dZArNLRNq7AfQjONPJllLvD6zzqnKB/+m7/O1bVKK/E8GfT7Ekyf9C2N7nz2foCC0JZhLuv7eNaUaFVFpouHz4aPvSGZlYzHWP6VyiyaCgGKdpGMq/jn9jLEFknhbK8jDQQOZmahNu2MM0zQV59kdMlHzXviAZQeQBG9a+r4lsSeD/SJiYhEn/Q/AKI6pWzWT2kpnw6RjAH+mkHaST9pw+sSM34rdfbwBX+b1hHFd5p1zM4jdkV8bKqwcVhXt+LliOul9wIhkUo2bpFQGH/nWS8PbhQWDu5fDur5/+Iv/z5PCgS98OmLKEPLQjT+AV/XoYc2avQH+uiFTP9eW02+LTpAOdJmFs/Pnn+eX99OuX2cUvZz+9uY5/n17M7zeHlwssklb44qp//WqYxdHmgLqTOujqH4O209SvONEpXq3NQlcLoxs0vGWyBe4SJSptBM0FncPCa5JAQ5Zq6F1cqm9qjWY0VUT4xa1qAr6qPw0rxGeu0TuH5of+/Wh2LA6ZOh7HuRDAGTPOVkuey8isxooSwihJhrXlR9EM01T/XHvL/DkXGPITQ++WzeBv7jO4gB/mbSK7nmeIRM4i7Amyf5sPmpVnSdvPtlejqb5uPf4Voifb42nGaxWPButPjM1g9OXt9Q16czVxHz/zraT4Dq6yEiQm9K6M0MrXYOjOSPpsqPuwNAKHhgbASP+N9N9UytymXx1Us+5KOY/Wm00Gt6quljeuNKOQOpsJH78aj47PX46OR6fjMGWaBdlmgrKYZjjtJFq8iQYwgIXCPjPJbdMAas2imWtUNKztlVu5ibyZqx+H2QvINVOwI/JA4rxVmXGaS0XE6yVnVHHxAna9b081F7STp7Z+whJ97AP6+mXSSOpF9ADroV9IEucw2/Ei8tRNtiZnbauToHOQzha30OJFSrC4jgVP0y/m68PH0oxgcVwnV3jJVbr9cAiJPsJgCVgLU/jwsHvGxZFKSCbIWqz8xK7XCZ/Hj5eJ0M8XCOInfc9fZT1zV2+fLXAtbd6E3sHAy+TLjMRwBCakGn6+MBD1UD/EyedVCyW7LWcjgvUL1X++QDFPU7uzJUi0pOQuy4cT2gKSDbVZyvEjx0kXNSYFICwK5/psJJe8+Qu+w+iOCpXjFC1xvKCshbiMRT6N5Go55WmkoE0UOxn6KAe6gqkYBBCQsHPbA+KUYAZlyDNkuCDNRXYS1+tD90B8A96aSifve4JvI0Fm0p1Zo/n3yBw2iyCZ2esbLaKmYVb6Qj5beoVqpp5hgdOUpJEgMsbllpeeWXv6XmJxC+xTekcQn/5GYqWTsSnxT8KCfJpUPMtI0lwYcz1lzlKOk32VxKBBAXIGKT1DYkPtx1lev0Op2ylvyNEea4Qurr4i5dkLEbB2DAiXrjBAsdll+wWAALFByd2K3rAg8K9WCJ4rSROio+db2CNYy2nXacJ1VftnSVmdJGplKQhO90FTn0aISIozsNcaacVRzCFeUsSmf4teSg9b9Mkz0C/NKKNyMToIleS3u2UkctbQBJsL0lEAiPhBphlT/uWXj5ZNnnmtbYiwRNjoCazchNxtk3tmYYm0J1OCl4l2zfxnLKZ4XtGmRUUaFQGqrYaQ03BU4bVM9y6O865VDBQU57dQxYDmtNPOS+G5PNg8dOvS1gVMP8+h9kBwGHJBcHawqc/sAHxPcIZw6jLjeuWIrRf6961jWUn/TqLb6dpzR5AyReaBnR+dNMvGC4XXOGD4tzTlesvRqJES9Ey9UfoKbkQzaibjiMDaiTlhu6q4z2niltxB4Aw5vQyzePXHr0FdeXyGeLUEf4DqbNRpd+2ueM7mu6zf/wCB/+I1vKqX4Q9Qxy16DbMr9KZ3Mx40gB3CHRBEL3TS+YnDgy4bWK8nhwRRCGf15btVuA98Xr53eBDO+vARGcWj5egjUfgSK3whCFZET0/ZKysODzbpuIKZmzoj03UdHmxi/SEbdSDaaCpP6kimCn++aE531Z808QgzKblwtj5AqXKpI7WxaFm55QDVPe8f0IHN44jfEQF3BBxsCtgEFgByMDLl99WFs1WAa/PcrYvTEW5lYcnhQQj/2/jo+OXzo/Pn41c3x0evj85fH58OX52c/Ppt8undZ/TrNzNTaua2R5bE6PeciNWv6Ntd9MtfFr/98iv6tiRK0FjPx56PTkZHz0Hu6Oh8ND7/9dvRrzok/HY6OlvKX4f6D3uU4rdT/TcEzguq5LfjV6cnZ/DTKiPy269DiNCV+Q9NQU8zffv3r2+//Ed08/7tp+jd25uL94UMPVsqvx3D+/rsqW//9bdDzfZvh6//62+HS9ieGOE0NX9OOZfqb4evj0dH//jHP34dHh50Wfu6pbsKgoiTiBYT+KBfWJvR9msjqOwZUfEiZCfNLgYU3MJEJ1KoKuJ0m6PX4zWtrCZ+J0dHS3l40JH/9nhALbYRgedNYNsVWdtJCxQcE0/1Mo1t8BrK5dliG6R+S5tyE2bdkLcsszbxSFdZG4+U37fX6xaNZAstkQclsD11roXeW3jNlsVfcNdEdgsGnqNpIVCOWSlD/pE4DQxOxwEGzbVUerc2DvASgpd2CWrcYScs2AYlCTKvNxAYb0dA8By2uLZgfzFvNMAdyqPj9/85/vc/37767f50rub4nWKHW1GgSTP6JGmA3Q6iwwPctDT9hMdtWHZt2QJngj+svFVl799cwS+b7wsCr24/KgJrnbD1+zJXoBIv3AcGIEN7coJ4of7T4QZvSlzXewO8S+5p7lqWm7GbCc4UYZX1HPVVIkN92A2CDRJxVg5Z1jg6WfW1oY8g608qFhQHsG2CSgWj1Wd2fUdBE4ardq7GrE6pyCvXB7Xwn+L4dvf0rdQge1C0JJD/Bc4cLTGDLGYz+2K1uR4HtpTFnIqz46JAuGyhgUi5wsYjaIrkr2Ze46ZzaZGoDgiCY4imE4Na+N7UNhToT81SqCVVVs/erlJ9GpbddgiTYMS81UJfEZjesVPXCqsnKviCs4TajDUpNuuAGikr2bnfCUtI0l3vv+ckJ13a3UKL1jO4XRz3sFKtbG1TMuN66RqViDOdM62bsLUazWuDJviH42+J/UmiecqnOO0sSW3aMTxaaisB1dvkpoLg24TfFyeKlE1tWA/HRugX2Akj9SbN58d2E0VFqtdQtSWVRSdIKpJZ1w+b0j0rW++W/JJa3UZrG5KClbbpHImZ4rvHFGKgYrOe2wrOkXSd1ixPU3cIl12rxLgf7LqSw3wZm8OxjRgNbn5/cfPl2ch8aDpElnq7HoIl1Va5w3LqRqbWS2vsD/bWUp5LY2oSDW7uvR0+QYK2evdTFWud0owynJb9w5owImGhB5ULsNw4zfUKHAGJEdguehN3lc4d8baX4hXFMJaGK3ZmeGxmaGJbKzNDkz6szE1JT4m6J7ZjgdXNmTLzOa6Lj1MOpYO15UxzXxOqy+IWhd7ZWWProWwzRIMb9eIGhyq1Zq6UM/kUV1nsSXMNRleBPiDQQ3BUbeOy3n9TJ2c+3mmVlL1TzJleK8+UrxLEK0yLWGBNnB8z6CxbKEAIBer/lOIU4fzuymM75/0WZ4FZksKxUKtKmA+lWhNZj+w2KpXxQPstlFQ0TV1LsRUW5IHWQ9ONC2Zd/o5K9skvky1I0auQhwxSNyx2FUVlyVcXQKzqWRj4n16lo9XiOrfOEReMkQ/qxdnCi/khjHbHrptBCVGYpnKIeGWLLCu7lU29mBUf1Hyd6ubrkwVJsTew8gviE2siVyGI791+nSilgXmx5iHWhpzh34VbgFXROeD5kQmUxRw8PCzegXoJdojwz52uZw9gLd5pLO360cU7KqB3jDFJqmcYN5JpOdB4h6z8w43dkGbNVho5xjiDwy2SKOb8lvZkHZ/1U5yiQ7DJ/61PFzhEBI6sc8cZGPPAyh+TLDCMR6gMyjR8XezxiAKvX7G+wxJ/oFKfMWBBCtQaX5TkxDVy44ViF/QFxR5aOcX3Rv6hnrN09z5UYoIGjThtOI94ENLArv2XgdrWgf0hLdSOZRZ6bK9ywUgIGCHs7FSfnUKVDKihu+T/ZFN1neajbdUK2MBY7RwEhaPLsDcFMdE/NMxAmIftW9kLiWHzDuqpbplOVkKm+fygq7VsMryy54W5EbItiJZfOdesqZ04RjMc05Sq1cHm1tFCD/5dmx1S+pQZrMolS1DjlqY9Va7zSEN3CuLuyF1xKSmcUnJXZucOtdYOh+iQcQUrpIbo0J/aHaLDeyxgm8whCpwJfBgLChsW08NwIWwJa98Fp4KfePhhgYgp69HIYOfoDxv7H25jejNCnvVoZhbhh6X9D7M015FT6ffik+vNFxFMJtfdiwdoMTpYN9wG1jZE1csV1zBCpumwIK8r5a7bClB4xJVKdlaSZruzw5syri1PfhgF0X/cXFS5uQhWM63sLuB+8DWCTYPokwMwW4W51C4tfUoe0CcAYm2y81/wpq0eLiC7KfMUXa3ln3ZLkht5hU4oe4pRVMbzgbPbHL7Ue+OxyuWuwWU+hVFkLlvQ7yk7Ge8e/6+UJfxeok5823TsvYeyh0bpDgKoLFkIcpFUkR5aJ4i1x8QySBhIhbvOpbUd1+65+Afr2G6MM29FlevnbVYH5lbsbiKS/PEupAsmu56ICudi2qAXlGQzU7C0DFYiunV/7d3Lgku1+7oDqTYBpc2pncO/6GV5mrbT8h+KuvEmzcx/3La369v28h+37f24be/HbXs/btv7cdvej9v2fty29+O2vR+37f24be9f4La9pgz29tft/bNTckuuSPT/2bu23saN6/+uTzHQU/KHw3/QCwqkQFHXXiNG7PVmrUXaJ2lEjmRWFEedoezVfvrizJ3UIUWKtN0ATF7WIjm/39zPnDmXgZWlRh94Ulf6vsp7gz5w3Q34ybq/p1JlvLYoXVtYnQW2Ir2FelgwKnk+3z2Jugi/ZzeAoQDlE10+TkE5AmG7Q098WBPDWKA7zjNkhxhlwVEWHGXBURZ8C1nQWGRs6GoTWlb+An/XWGWoZz5NbThFbV1scfiKhfKszvSBkrRqsnDLA24HreVQuEOTkNmk9LQRyXa1+9RHobDwEYqFpZh2Isb0t8vPH6fdWShIKBjHNBY5A2nvMVMfDNVZWE3aD+wT0FfOaMs2dAq3v8qnG9q/hgjEth6o8iqmvQqW3YmCyvpaKQwf3S04EDKD4qzlND7e8BF/qllO9U8rdketpGrf2E6nR2tjp7WkRci9HrBwBePuQBW7ejrgofwqXGbWzb7Ae9Mu1umS5uFqrX+oWa71w2Y7eFciPgpR8tXB9M4L9qCBm39R7dEieHM1LklP3CvjnayKhdGoieDYW1bYLrP/aWidfKDySP84L5MzAwo0NAWVYc5A+1PNoLKPm4eVfWtSNxbQ5qh2c1Ca+80TvTMY0z6DLrT+hAXUFtpJd4WvUrWrQtvzWvlGHJZ2DKhJmOgxIEuihF0eDf6FcsMjMRdaZaAcu+74+k//1q/XTBknOA5IUZcJ1/hqCyMvLsVbJbMfTkmHhh+o426DkzVdQgpmaDOxz8Eu20AFBKF1T9DL+Hqu6tF+tp/guGE67rTylCHKR0YtdIFWwFOZVPmYgKCTKpMOE+64iHFmjTPrzWdW/azqzu4zfSHJfruzfWmgMwTEwutbUkz10KPXwkByGqAJuzjsBsSeHXYV7J/ILWTElRfkRuVOlRfkYV/AL7BaX/GExTWjWcVMTXMsbOr5iugPKsIwqEDgmO7ckqyKso3RrOWV05y/GS0F1sTKdCekwdrKgUb0o3Is8Gn/A0oxz1fp2uR5O01ojm5S/favH/5WZlaipJTJNmSM5uzbrdU/jGi85fmaJ8tAMja/tHdZuocPrv9x2m3JY+F7am2jhOJrgOaGSnVvtYA9N3Hk4reOAb7DN3rPNQIT8mi+8Rsotnk7PdrtpM0SZwnhiqoTjG72eWziBUCK0zUX6TeTlOIEuauH+/vLj9cdKeZHM/oEQegt9rU4SSfN0wKCLqkQsJ1IYcWeIDXzYk+z+ipYxezcPMj/ZMHMvD88/nrXfl4ClPqkPDPlExfFXK8mP5FC7OtOtxYenzs11a6eNBECTTN2eFONMpHuFhtO3V162qrb3aeeBd71DkuJePNKPO3zt91LZa+va/7n6C/RH4zgnRolpYIjaRKRGy5MCxlTAkl2IgXpgYdfHiGoliNxeOKwYebSBK8kds5wU3P6m/Hvbaho81EDB8Um7vmCQ9N9wICHyBNjGRA6DWXEsL5FRfWwgG91cvFYpeVJfBKLCAUD55buYPCVTbtuzzkN0LYX0hwnke66U/CGRAMSUS8pn7hoyCSAsMj4sJyeDcjwF71yLWY83rwKX7oFC09YlyqcIUQvS9zZAAjA6rNk3qwighKOStVScip71VfwF0g9mhdoXbsvvWUHJCjdhUyyYnvD5IH357AopjkbajNAGEEm4XaE6nbBPmT2efrVF0wKumEmdym0zuLxw8w/XTSRO87L0gpfunQteLGDbcPGC9HGlLy9doPcoBt5L1+n+ddA3vsIf3eT99QnZ8p7Fh7fq1rKewgBbFuymDoGxKTaxiFw103SNK0mckaMCWcXNocsC6VXLD0qBO044C5z/ZWaewoh2GiYjMhtAdfFVCWmIEsWq5znaWHukLegOuEmqCS7IEsGKYtlEDfwCNEXf1GC0lPMxj3L0g0ji3/+cMPFCxUJS+Bfi4g8MkZoJnXgs4VrkwVmLHfUchUudaeqFs12dWTYrKbuKhWyILv9Mkvj4GGwejguqhcXuvEjcrsiOfcfHuGZgkw8GmP8Z6RmRNY1PET6TAvWisgxoiKGtuf/dHCJ0aq4ZFX8ngbe723R/Dv1TH+3ACWjY/nQjuVfRsfy0bF8dCwfHctHx/LRsXx0LB8dy0fH8tGx/HfqWO6VV90vKwe24fugCUCh5DsWrSPtSn9BbKDd7yOUxm4w1eknd5nI8iJdpUyQ7z7dXtfgFgOqbM3VqIXFAb1Wd7hL2yuvKT4Fby4fBzpKwrqk+teVa/TSXFoNu9VMP0iX5hwp1OiE2Vdwi/fXCwtTzsIbcoZj2VbKo+FTCq1FdVLYwgST+6zoN0WV8nWF10mXT7aQJiqWrLTLVTmFvJAFtMc8rW665hIQbipdsEetw1S2mvhgojGy6fUgBSYFaR4LlfMCzqS0oBdkS8UGsiswkKJUE/rAlDRJkIQiKkjjlj+zRCnJY5qTJeQUUyLGVH0DccLNO1OVbnAqc7qTT7yoiQQO18xzP7uGqzT0hC/XreeAV47LaUa5UT+k0pr5lvnC/x9BTZZlB1fQ8c5oqwU3avNKwtg+S9GX8g2dGV1qDIW3y0SmEP8U+LEdj58i8kWam1wwNNubJDKMLP4eXOjFPNtvazSaMc1YnlCBVmZ/du8Yg0/BjCDurNeAXMyzzKy7gKquzLXYb+Y7l+Xruh2XxVqwso3WJ/1jZ0Mt/92Zt3clNvhKV9s64SVemYiTPKqL2UAmliFyUzMQgtMIqbixVXraasq6T1tbaqVb9o3n7Dyob2b1crBvYw4WilMoIKIgdQqjKU22aT5tQKy11D8q1uJBCuXlcRQUj7k9JMuzINGSm6Rkj3lzObu8G9r+LMFMyZssaTyfP/4Y/diJzrW1EecrQrvaTXjcxw93H65m5P/IzeeHe9A3CPnXTjx+NdH7TdornIMVNbF2OU+ENZZYpmC3WguWlLJyfIa/a9Zo9YzcN0mptjh81UNpVteugZZQTdatlsGzKuDrHNFmgc3n7bXdTTWrarLTkIHgQ/tyQYllfBubPSJXJbFxsaWyYGJxQRYyo88M/hE/pVmyIN+B2PL5+ub/Lx9uyAucc/M1Uc++vzhC5YIs4D4tzVm2iFovNj3r6deaarWUpyNU5pmJJZeqXjqVzkLJxQuTPmfxhpPxqNQBLWQfrQmsMtfQyXqfQfSEXVwPgeeUEkpyVrxwsQkO7FHLiRJvk2F7L+bbLSj9mPKJqmpxqxtGNFgWh59VU4ETbKHsQ8GeyHAwmlrNSzmJxaLZHWvQ1cOvGg2b1YYdhu0H8LEqHclsA8BRtLlzqBgyGAMsXVSs93BOljplJk4qplnGfLpIfRsSbGmP6of25w5dwJnnDYeOT9yaOlflfYwCNiNdy++Lpz4LRhX/Ls33X5Xdk/dm6qJwdRJ86WmrLnefwkETBH3PCvjYZTNCcWsSUrSAtV+eg7oTfC2o7fQOoFY+OBt40PXmk19wLDHl2SBtmKXThMzDAXfKVj5iDQe1FhBKneOdGLxCUNsrSVJwD4fiShfrpHkGtiBkZqLUOQpj2I0eH3+Geqe5ZlWahHUTsdnX/SQLvfpWgKti1fQyjtmu0HrGG5pmTs14mz/TLE2mUfAOgrFlNAfbXrlX5sirfabrGfkSzDsu1bLqJmNuZT1/3XUzAmGuxh2/anm+iqDP2u4KlcV5pSoT1bQoauLZoUkr5qTGarPauDsqJWyakNKRTLVp7oYdpnWsjm757SBMd+dR9fnMKv4+5faCHXhLE1bHKxF8t2PJ/LX5QU96MdZ0MYi/fMdysBUg6XbLkpQWLDtYVnWkkXDIDWtrN8JQdr8mlek6p5BN+zwe7nO72ltiaoyBsFYHjBmTNK11LQh1NilZmCkNsyiqsbx/HdsS3Lqkbv3tZGHSLCi3bErsyqvBzqSd7cLrMUuLQxOpZtOOV6OlYRtb67RdzmDsTlvntLLPaWOh06G92lrpHHXmWzRZrXVKyEfuEz5BW2gQiU2JS9K5w9qLfkBd2KNrNGmzitSZ1FS00kos+vgwU7eP+4QzISedW+/I0AFKi6nUWxSQd8fuZgGpKA7noc9m/wo2xRJiWqd88LC7l+Q82NiEX0xSweKCi0MPEsgRJOgnwXlxHseCijUrjK81D9QzVYLyJS3iJ+TK3DI0755HwwLZZlB6RKDg0SYYKPCmSfL2c84Anznt0N2nVUN5b7IlA6WSMsiIamD2R+f41tJmE/ztdR3genBA1YkNiE+YWX2LcuE7suJZEpiN5OxFVbAOSz6xLDsHLGErus8KXUAD3ARDVS3wLmPcIr/5IA8FJ+gURSSqgekx5moJ3F43wFtgeZA971OO7FGtik4XHahr31lDaviY/TtCkV9DR9oG95W0pK2g06Q77El1aBtk8/AtFKLm+qMQlK3STXD/MdO/tL8AgXLNR+UriHBA2xp6PHxq1VTJrG6uG1E8bBJZXB3CYFJt1D6zmosSlT5BEkpPm882nV39UeTRKX50ih+d4ken+NEpfnSKH53iR6f40Sl+dIofneJHp/jRKX50ih+d4t/RKb7MRB0P5yq0w6TlSt3peGMQJAq/EhAJPk+wLumjkgrnsMUAKS5BWSxpvGF5Mq87fJ/ggKsphMulY4o3V3imPWCNXHHxQkXCksl/BwCSK8h9"
 }
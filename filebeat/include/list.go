@@ -26,9 +26,14 @@ modules.
 package include
 
 import (
+	_ "github.com/elastic/beats/filebeat/input/container"
 	_ "github.com/elastic/beats/filebeat/input/docker"
+	_ "github.com/elastic/beats/filebeat/input/http_endpoint"
+	_ "github.com/elastic/beats/filebeat/input/kafka"
 	_ "github.com/elastic/beats/filebeat/input/log"
+	_ "github.com/elastic/beats/filebeat/input/netflow"
 	_ "github.com/elastic/beats/filebeat/input/redis"
+	_ "github.com/elastic/beats/filebeat/input/s3"
 	_ "github.com/elastic/beats/filebeat/input/stdin"
 	_ "github.com/elastic/beats/filebeat/input/syslog"
 	_ "github.com/elastic/beats/filebeat/input/tcp"
@@ -0,0 +1,104 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package http_endpoint
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/elastic/beats/libbeat/common/transport/tlscommon"
+)
+
+type config struct {
+	ListenAddress string                  `config:"listen_address"`
+	ListenPort    uint16                  `config:"listen_port"`
+	URL           string                  `config:"url"`
+	TLS           *tlscommon.ServerConfig `config:"ssl"`
+
+	Prefix        string `config:"prefix"`
+	ContentType   string `config:"content_type"`
+	MaxBodySize   int64  `config:"max_body_size" validate:"min=1"`
+	ResponseCode  int    `config:"response_code"`
+	ResponseBody  string `config:"response_body"`
+	ResponseError bool   `config:"include_error_details"`
+
+	BasicAuth bool   `config:"basic_auth"`
+	Username  string `config:"username"`
+	Password  string `config:"password"`
+
+	Secret secretConfig `config:"secret"`
+	HMAC   hmacConfig   `config:"hmac"`
+}
+
+// secretConfig requires requests to send a shared secret in a header.
+type secretConfig struct {
+	Header string `config:"header"`
+	Value  string `config:"value"`
+}
+
+// hmacConfig requires requests to be signed with a shared key. The signature
+// of the body is sent in a header, hex encoded, optionally with a prefix.
+type hmacConfig struct {
+	Header string `config:"header"`
+	Key    string `config:"key"`
+	Type   string `config:"type"`
+	Prefix string `config:"prefix"`
+}
+
+var defaultConfig = config{
+	ListenAddress: "localhost",
+	ListenPort:    8000,
+	URL:           "/",
+	Prefix:        "json",
+	ContentType:   "application/json",
+	MaxBodySize:   10 * 1024 * 1024,
+	ResponseCode:  http.StatusOK,
+	ResponseBody:  `{"message": "success"}`,
+	HMAC: hmacConfig{
+		Type: "sha256",
+	},
+}
+
+func (c *config) Validate() error {
+	if !strings.HasPrefix(c.URL, "/") {
+		return fmt.Errorf("url '%v' must start with /", c.URL)
+	}
+
+	if c.ResponseCode < 200 || c.ResponseCode > 299 {
+		return fmt.Errorf("response_code %v must be a 2xx status code", c.ResponseCode)
+	}
+
+	if c.BasicAuth && (c.Username == "" || c.Password == "") {
+		return errors.New("username and password are required when basic_auth is enabled")
+	}
+
+	if (c.Secret.Header == "") != (c.Secret.Value == "") {
+		return errors.New("both secret.header and secret.value must be set")
+	}
+
+	if (c.HMAC.Header == "") != (c.HMAC.Key == "") {
+		return errors.New("both hmac.header and hmac.key must be set")
+	}
+	if _, ok := hmacHashes[strings.ToLower(c.HMAC.Type)]; !ok {
+		return fmt.Errorf("hmac.type '%v' not supported (must be sha1 or sha256)", c.HMAC.Type)
+	}
+
+	return nil
+}
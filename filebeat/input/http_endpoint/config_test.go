@@ -0,0 +1,57 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package http_endpoint
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elastic/beats/libbeat/common"
+)
+
+func TestConfigValidate(t *testing.T) {
+	tests := map[string]struct {
+		settings map[string]interface{}
+		valid    bool
+	}{
+		"defaults":             {map[string]interface{}{}, true},
+		"relative url":         {map[string]interface{}{"url": "webhook"}, false},
+		"non 2xx response":     {map[string]interface{}{"response_code": 302}, false},
+		"basic auth":           {map[string]interface{}{"basic_auth": true, "username": "u", "password": "p"}, true},
+		"basic auth no creds":  {map[string]interface{}{"basic_auth": true, "username": "u"}, false},
+		"secret":               {map[string]interface{}{"secret.header": "X-Secret", "secret.value": "v"}, true},
+		"secret without value": {map[string]interface{}{"secret.header": "X-Secret"}, false},
+		"hmac sha1":            {map[string]interface{}{"hmac.header": "X-Sig", "hmac.key": "k", "hmac.type": "sha1"}, true},
+		"hmac without key":     {map[string]interface{}{"hmac.header": "X-Sig"}, false},
+		"hmac unknown type":    {map[string]interface{}{"hmac.header": "X-Sig", "hmac.key": "k", "hmac.type": "md5"}, false},
+		"zero max body size":   {map[string]interface{}{"max_body_size": 0}, false},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			config := defaultConfig
+			err := common.MustNewConfigFrom(test.settings).Unpack(&config)
+			if test.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
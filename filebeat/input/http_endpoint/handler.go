@@ -0,0 +1,142 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package http_endpoint
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/common/jsontransform"
+	"github.com/elastic/beats/libbeat/logp"
+)
+
+var errBodyEmpty = errors.New("body cannot be empty")
+
+// handler accepts JSON payloads and publishes every object they contain.
+type handler struct {
+	config    *config
+	validator *validator
+	publish   func(common.MapStr)
+	log       *logp.Logger
+}
+
+func newHandler(config *config, publish func(common.MapStr), log *logp.Logger) *handler {
+	return &handler{
+		config:    config,
+		validator: &validator{config: config},
+		publish:   publish,
+		log:       log,
+	}
+}
+
+func (h *handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if err := h.validator.validateRequest(r); err != nil {
+		h.sendError(w, err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, h.config.MaxBodySize))
+	if err != nil {
+		h.sendError(w, newValidationError(http.StatusRequestEntityTooLarge, "failed reading body: "+err.Error()))
+		return
+	}
+
+	if err := h.validator.validateSignature(r, body); err != nil {
+		h.sendError(w, err)
+		return
+	}
+
+	objs, err := decodeJSON(body)
+	if err != nil {
+		h.sendError(w, newValidationError(http.StatusBadRequest, err.Error()))
+		return
+	}
+
+	for _, obj := range objs {
+		h.publish(obj)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(h.config.ResponseCode)
+	io.WriteString(w, h.config.ResponseBody)
+}
+
+func (h *handler) sendError(w http.ResponseWriter, err error) {
+	status := http.StatusInternalServerError
+	if verr, ok := err.(*validationError); ok {
+		status = verr.status
+	}
+	h.log.Debugw("Rejected request", "status", status, "error", err)
+
+	msg := http.StatusText(status)
+	if h.config.ResponseError {
+		msg = err.Error()
+	}
+	body, _ := json.Marshal(common.MapStr{"message": msg})
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	w.Write(body)
+}
+
+// decodeJSON decodes a body holding either a single JSON object or an array
+// of objects.
+func decodeJSON(body []byte) ([]common.MapStr, error) {
+	body = bytes.TrimSpace(body)
+	if len(body) == 0 {
+		return nil, errBodyEmpty
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+
+	var raw interface{}
+	if err := dec.Decode(&raw); err != nil {
+		return nil, fmt.Errorf("malformed JSON body: %v", err)
+	}
+	if dec.More() {
+		return nil, fmt.Errorf("malformed JSON body: unexpected data after the top-level value")
+	}
+
+	var objs []common.MapStr
+	switch v := raw.(type) {
+	case map[string]interface{}:
+		objs = append(objs, common.MapStr(v))
+	case []interface{}:
+		for i, elem := range v {
+			obj, ok := elem.(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("array element %d is not a JSON object", i)
+			}
+			objs = append(objs, common.MapStr(obj))
+		}
+	default:
+		return nil, fmt.Errorf("JSON body must be an object or an array of objects")
+	}
+
+	for _, obj := range objs {
+		jsontransform.TransformNumbers(obj)
+	}
+	return objs, nil
+}
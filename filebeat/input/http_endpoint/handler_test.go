@@ -0,0 +1,236 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package http_endpoint
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/logp"
+)
+
+func serve(t *testing.T, config config, req *http.Request) (*httptest.ResponseRecorder, []common.MapStr) {
+	require.NoError(t, config.Validate())
+
+	var published []common.MapStr
+	h := newHandler(&config, func(obj common.MapStr) {
+		published = append(published, obj)
+	}, logp.NewLogger(inputName))
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+	return w, published
+}
+
+func newRequest(method, body string) *http.Request {
+	req := httptest.NewRequest(method, "/", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	return req
+}
+
+func TestHandlerSingleObject(t *testing.T) {
+	w, published := serve(t, defaultConfig, newRequest("POST", `{"a": 1, "b": {"c": 1.5}, "d": "x"}`))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, defaultConfig.ResponseBody, w.Body.String())
+	assert.Equal(t, []common.MapStr{
+		{"a": int64(1), "b": map[string]interface{}{"c": 1.5}, "d": "x"},
+	}, published)
+}
+
+func TestHandlerArray(t *testing.T) {
+	w, published := serve(t, defaultConfig, newRequest("POST", `[{"id": 1}, {"id": 2}]`))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, []common.MapStr{{"id": int64(1)}, {"id": int64(2)}}, published)
+}
+
+func TestHandlerCustomResponse(t *testing.T) {
+	config := defaultConfig
+	config.ResponseCode = http.StatusAccepted
+	config.ResponseBody = `{"status": "queued"}`
+
+	w, _ := serve(t, config, newRequest("POST", `{}`))
+
+	assert.Equal(t, http.StatusAccepted, w.Code)
+	assert.JSONEq(t, `{"status": "queued"}`, w.Body.String())
+}
+
+func TestHandlerRejects(t *testing.T) {
+	tests := map[string]struct {
+		req    *http.Request
+		status int
+	}{
+		"wrong method": {
+			req:    newRequest("GET", ""),
+			status: http.StatusMethodNotAllowed,
+		},
+		"empty body": {
+			req:    newRequest("POST", "  "),
+			status: http.StatusBadRequest,
+		},
+		"malformed JSON": {
+			req:    newRequest("POST", `{"a": `),
+			status: http.StatusBadRequest,
+		},
+		"trailing data": {
+			req:    newRequest("POST", `{"a": 1} {"b": 2}`),
+			status: http.StatusBadRequest,
+		},
+		"scalar": {
+			req:    newRequest("POST", `42`),
+			status: http.StatusBadRequest,
+		},
+		"array of scalars": {
+			req:    newRequest("POST", `[{"a": 1}, 2]`),
+			status: http.StatusBadRequest,
+		},
+		"wrong content type": {
+			req: func() *http.Request {
+				req := newRequest("POST", `{}`)
+				req.Header.Set("Content-Type", "text/plain")
+				return req
+			}(),
+			status: http.StatusUnsupportedMediaType,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			w, published := serve(t, defaultConfig, test.req)
+			assert.Equal(t, test.status, w.Code)
+			assert.Empty(t, published)
+		})
+	}
+}
+
+func TestHandlerContentTypeParameters(t *testing.T) {
+	req := newRequest("POST", `{}`)
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
+
+	w, published := serve(t, defaultConfig, req)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Len(t, published, 1)
+}
+
+func TestHandlerBasicAuth(t *testing.T) {
+	config := defaultConfig
+	config.BasicAuth = true
+	config.Username = "user"
+	config.Password = "pass"
+
+	req := newRequest("POST", `{}`)
+	w, _ := serve(t, config, req)
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+
+	req = newRequest("POST", `{}`)
+	req.SetBasicAuth("user", "wrong")
+	w, _ = serve(t, config, req)
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+
+	req = newRequest("POST", `{}`)
+	req.SetBasicAuth("user", "pass")
+	w, _ = serve(t, config, req)
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestHandlerSecret(t *testing.T) {
+	config := defaultConfig
+	config.Secret = secretConfig{Header: "X-Secret", Value: "s3cr3t"}
+
+	req := newRequest("POST", `{}`)
+	req.Header.Set("X-Secret", "nope")
+	w, _ := serve(t, config, req)
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+
+	req = newRequest("POST", `{}`)
+	req.Header.Set("X-Secret", "s3cr3t")
+	w, _ = serve(t, config, req)
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestHandlerHMAC(t *testing.T) {
+	const body = `{"action": "opened"}`
+
+	config := defaultConfig
+	config.HMAC = hmacConfig{Header: "X-Hub-Signature", Key: "key", Type: "sha256", Prefix: "sha256="}
+
+	mac := hmac.New(sha256.New, []byte("key"))
+	mac.Write([]byte(body))
+	signature := "sha256=" + hex.EncodeToString(mac.Sum(nil))
+
+	tests := map[string]struct {
+		signature string
+		status    int
+	}{
+		"valid":          {signature, http.StatusOK},
+		"missing":        {"", http.StatusUnauthorized},
+		"missing prefix": {strings.TrimPrefix(signature, "sha256="), http.StatusUnauthorized},
+		"not hex":        {"sha256=zz", http.StatusUnauthorized},
+		"wrong":          {"sha256=" + strings.Repeat("0", 64), http.StatusUnauthorized},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			req := newRequest("POST", body)
+			if test.signature != "" {
+				req.Header.Set("X-Hub-Signature", test.signature)
+			}
+			w, _ := serve(t, config, req)
+			assert.Equal(t, test.status, w.Code)
+		})
+	}
+}
+
+func TestHandlerErrorDetails(t *testing.T) {
+	w, _ := serve(t, defaultConfig, newRequest("POST", `[1]`))
+	assert.JSONEq(t, `{"message": "Bad Request"}`, w.Body.String())
+
+	config := defaultConfig
+	config.ResponseError = true
+	w, _ = serve(t, config, newRequest("POST", `[1]`))
+	assert.JSONEq(t, `{"message": "array element 0 is not a JSON object"}`, w.Body.String())
+}
+
+func TestHandlerMaxBodySize(t *testing.T) {
+	config := defaultConfig
+	config.MaxBodySize = 10
+
+	w, published := serve(t, config, newRequest("POST", `{"message": "too long"}`))
+	assert.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+	assert.Empty(t, published)
+}
+
+func TestCreateEvent(t *testing.T) {
+	obj := common.MapStr{"a": "b"}
+
+	data := createEvent("json", obj)
+	assert.Equal(t, common.MapStr{"json": common.MapStr{"a": "b"}}, data.Event.Fields)
+
+	data = createEvent("", obj)
+	assert.Equal(t, common.MapStr{"a": "b"}, data.Event.Fields)
+}
@@ -0,0 +1,173 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package http_endpoint
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/elastic/beats/filebeat/channel"
+	"github.com/elastic/beats/filebeat/harvester"
+	"github.com/elastic/beats/filebeat/input"
+	"github.com/elastic/beats/filebeat/util"
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/common/cfgwarn"
+	"github.com/elastic/beats/libbeat/common/transport/tlscommon"
+	"github.com/elastic/beats/libbeat/logp"
+)
+
+const inputName = "http_endpoint"
+
+func init() {
+	err := input.Register(inputName, NewInput)
+	if err != nil {
+		panic(err)
+	}
+}
+
+// Input accepts JSON payloads sent over HTTP(S) and publishes every object as
+// an event.
+type Input struct {
+	sync.Mutex
+	config    *config
+	outlet    channel.Outleter
+	server    *http.Server
+	tlsConfig *tlscommon.TLSConfig
+	started   bool
+	log       *logp.Logger
+}
+
+// NewInput creates a new http_endpoint input
+func NewInput(
+	cfg *common.Config,
+	connector channel.Connector,
+	context input.Context,
+) (input.Input, error) {
+	cfgwarn.Experimental("HTTP endpoint input type is used")
+
+	config := defaultConfig
+	if err := cfg.Unpack(&config); err != nil {
+		return nil, err
+	}
+
+	tlsConfig, err := tlscommon.LoadTLSServerConfig(config.TLS)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := connector(cfg, context.DynamicFields)
+	if err != nil {
+		return nil, err
+	}
+	forwarder := harvester.NewForwarder(out)
+
+	address := net.JoinHostPort(config.ListenAddress, fmt.Sprintf("%d", config.ListenPort))
+	log := logp.NewLogger(inputName).With("address", address)
+
+	publish := func(obj common.MapStr) {
+		forwarder.Send(createEvent(config.Prefix, obj))
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle(config.URL, newHandler(&config, publish, log))
+
+	return &Input{
+		config:    &config,
+		outlet:    out,
+		server:    &http.Server{Addr: address, Handler: mux},
+		tlsConfig: tlsConfig,
+		log:       log,
+	}, nil
+}
+
+// Run starts the HTTP server, it is a no-op if already running.
+func (p *Input) Run() {
+	p.Lock()
+	defer p.Unlock()
+
+	if p.started {
+		return
+	}
+
+	p.log.Info("Starting HTTP endpoint input")
+	listener, err := p.listen()
+	if err != nil {
+		p.log.Errorw("Error starting the HTTP server", "error", err)
+		return
+	}
+	p.started = true
+
+	go func() {
+		err := p.server.Serve(listener)
+		if err != nil && err != http.ErrServerClosed {
+			p.log.Errorw("Error serving HTTP requests", "error", err)
+		}
+	}()
+}
+
+func (p *Input) listen() (net.Listener, error) {
+	if p.tlsConfig != nil {
+		p.log.Info("Listening over TLS")
+		return tls.Listen("tcp", p.server.Addr, p.tlsConfig.BuildModuleConfig(p.config.ListenAddress))
+	}
+	return net.Listen("tcp", p.server.Addr)
+}
+
+// Stop shuts the HTTP server down, waiting for in-flight requests.
+func (p *Input) Stop() {
+	defer p.outlet.Close()
+	p.Lock()
+	defer p.Unlock()
+
+	p.log.Info("Stopping HTTP endpoint input")
+	if p.started {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		if err := p.server.Shutdown(ctx); err != nil {
+			p.log.Errorw("Error stopping the HTTP server", "error", err)
+		}
+		p.started = false
+	}
+}
+
+// Wait stops the HTTP server
+func (p *Input) Wait() {
+	p.Stop()
+}
+
+func createEvent(prefix string, obj common.MapStr) *util.Data {
+	fields := common.MapStr{}
+	if prefix == "" {
+		fields = obj
+	} else {
+		fields.Put(prefix, obj)
+	}
+
+	data := util.NewData()
+	data.Event = beat.Event{
+		Timestamp: time.Now(),
+		Fields:    fields,
+	}
+	return data
+}
@@ -0,0 +1,120 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package http_endpoint
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"hash"
+	"mime"
+	"net/http"
+	"strings"
+)
+
+var hmacHashes = map[string]func() hash.Hash{
+	"sha1":   sha1.New,
+	"sha256": sha256.New,
+}
+
+// validationError is returned to the client, with the status code set.
+type validationError struct {
+	status int
+	msg    string
+}
+
+func (e *validationError) Error() string {
+	return e.msg
+}
+
+func newValidationError(status int, msg string) *validationError {
+	return &validationError{status: status, msg: msg}
+}
+
+// validator checks a request is acceptable before its body is read.
+type validator struct {
+	config *config
+}
+
+func (v *validator) validateRequest(r *http.Request) error {
+	config := v.config
+
+	if r.Method != http.MethodPost {
+		return newValidationError(http.StatusMethodNotAllowed, "only POST requests are allowed")
+	}
+
+	if config.BasicAuth {
+		username, password, ok := r.BasicAuth()
+		if !ok || !equal(username, config.Username) || !equal(password, config.Password) {
+			return newValidationError(http.StatusUnauthorized, "incorrect username or password")
+		}
+	}
+
+	if config.Secret.Header != "" {
+		if !equal(r.Header.Get(config.Secret.Header), config.Secret.Value) {
+			return newValidationError(http.StatusUnauthorized, "incorrect secret")
+		}
+	}
+
+	if config.ContentType != "" {
+		mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+		if err != nil || !strings.EqualFold(mediaType, config.ContentType) {
+			return newValidationError(http.StatusUnsupportedMediaType, "wrong content-type header, expecting "+config.ContentType)
+		}
+	}
+
+	return nil
+}
+
+// validateSignature checks the HMAC signature of the body, if configured.
+func (v *validator) validateSignature(r *http.Request, body []byte) error {
+	config := v.config.HMAC
+	if config.Header == "" {
+		return nil
+	}
+
+	signature := r.Header.Get(config.Header)
+	if signature == "" {
+		return newValidationError(http.StatusUnauthorized, "missing HMAC signature")
+	}
+	if config.Prefix != "" {
+		if !strings.HasPrefix(signature, config.Prefix) {
+			return newValidationError(http.StatusUnauthorized, "invalid HMAC signature prefix")
+		}
+		signature = signature[len(config.Prefix):]
+	}
+
+	expected, err := hex.DecodeString(signature)
+	if err != nil {
+		return newValidationError(http.StatusUnauthorized, "invalid HMAC signature encoding")
+	}
+
+	mac := hmac.New(hmacHashes[strings.ToLower(config.Type)], []byte(config.Key))
+	mac.Write(body)
+	if !hmac.Equal(mac.Sum(nil), expected) {
+		return newValidationError(http.StatusUnauthorized, "invalid HMAC signature")
+	}
+	return nil
+}
+
+// equal compares secrets in constant time.
+func equal(a, b string) bool {
+	return subtle.ConstantTimeCompare([]byte(a), []byte(b)) == 1
+}
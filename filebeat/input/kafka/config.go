@@ -0,0 +1,225 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kafka
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Shopify/sarama"
+
+	"github.com/elastic/beats/libbeat/common/transport/tlscommon"
+	"github.com/elastic/beats/libbeat/outputs"
+)
+
+type kafkaInputConfig struct {
+	Hosts                  []string          `config:"hosts" validate:"required"`
+	Topics                 []string          `config:"topics" validate:"required"`
+	GroupID                string            `config:"group_id" validate:"required"`
+	ClientID               string            `config:"client_id"`
+	Version                string            `config:"version"`
+	InitialOffset          initialOffset     `config:"initial_offset"`
+	Offset                 offsetConfig      `config:"offset"`
+	Group                  groupConfig       `config:"group"`
+	MaxPendingPerPartition int               `config:"max_pending_per_partition" validate:"min=1"`
+	ChanBufferSize         int               `config:"channel_buffer_size" validate:"min=1"`
+	Fetch                  fetchConfig       `config:"fetch"`
+	Timeout                time.Duration     `config:"timeout" validate:"min=1"`
+	KeepAlive              time.Duration     `config:"keep_alive" validate:"min=0"`
+	ConnectBackoff         time.Duration     `config:"connect_backoff" validate:"min=0"`
+	TLS                    *tlscommon.Config `config:"ssl"`
+	Username               string            `config:"username"`
+	Password               string            `config:"password"`
+}
+
+type offsetConfig struct {
+	Commit         commitMode    `config:"commit"`
+	CommitInterval time.Duration `config:"commit_interval" validate:"min=1"`
+}
+
+type groupConfig struct {
+	Assignment        assignmentStrategy `config:"assignment"`
+	SessionTimeout    time.Duration      `config:"session_timeout" validate:"min=1"`
+	RebalanceTimeout  time.Duration      `config:"rebalance_timeout" validate:"min=1"`
+	HeartbeatInterval time.Duration      `config:"heartbeat_interval" validate:"min=1"`
+}
+
+type fetchConfig struct {
+	Min     int32         `config:"min" validate:"min=1"`
+	Default int32         `config:"default" validate:"min=1"`
+	Max     int32         `config:"max" validate:"min=0"`
+	MaxWait time.Duration `config:"max_wait_time" validate:"min=1"`
+}
+
+// initialOffset selects where to start reading partitions without committed
+// offset.
+type initialOffset int64
+
+// commitMode selects when the offset of a message is committed.
+type commitMode uint8
+
+const (
+	// commitACK commits offsets of events acknowledged by the output, which
+	// guarantees at-least-once delivery.
+	commitACK commitMode = iota
+
+	// commitRead commits offsets of events as soon as they have been read,
+	// which might lose events not yet published on shutdown.
+	commitRead
+)
+
+// assignmentStrategy selects how the group leader distributes partitions to
+// the group members.
+type assignmentStrategy string
+
+const (
+	assignRange      assignmentStrategy = "range"
+	assignRoundRobin assignmentStrategy = "roundrobin"
+)
+
+var defaultConfig = kafkaInputConfig{
+	ClientID:      "filebeat",
+	Version:       "1.0.0",
+	InitialOffset: initialOffset(sarama.OffsetOldest),
+	Offset: offsetConfig{
+		Commit:         commitACK,
+		CommitInterval: 1 * time.Second,
+	},
+	Group: groupConfig{
+		Assignment:        assignRange,
+		SessionTimeout:    30 * time.Second,
+		RebalanceTimeout:  60 * time.Second,
+		HeartbeatInterval: 3 * time.Second,
+	},
+	MaxPendingPerPartition: 1024,
+	ChanBufferSize:         256,
+	Fetch: fetchConfig{
+		Min:     1,
+		Default: 1024 * 1024,
+		Max:     0,
+		MaxWait: 250 * time.Millisecond,
+	},
+	Timeout:        30 * time.Second,
+	ConnectBackoff: 30 * time.Second,
+}
+
+func (c *kafkaInputConfig) Validate() error {
+	version, err := sarama.ParseKafkaVersion(c.Version)
+	if err != nil {
+		return fmt.Errorf("unknown/unsupported kafka version '%v'", c.Version)
+	}
+	if !version.IsAtLeast(sarama.V0_9_0_0) {
+		return fmt.Errorf("consumer groups require kafka version 0.9.0 or newer, configured '%v'", c.Version)
+	}
+
+	if c.Username != "" && c.Password == "" {
+		return errors.New("password must be set when username is configured")
+	}
+
+	if c.Group.HeartbeatInterval >= c.Group.SessionTimeout {
+		return errors.New("group.heartbeat_interval must be less than group.session_timeout")
+	}
+
+	return nil
+}
+
+func (o *initialOffset) Unpack(s string) error {
+	switch strings.ToLower(s) {
+	case "oldest":
+		*o = initialOffset(sarama.OffsetOldest)
+	case "newest":
+		*o = initialOffset(sarama.OffsetNewest)
+	default:
+		return fmt.Errorf("invalid initial_offset '%v' (must be oldest or newest)", s)
+	}
+	return nil
+}
+
+func (m *commitMode) Unpack(s string) error {
+	switch strings.ToLower(s) {
+	case "ack":
+		*m = commitACK
+	case "read":
+		*m = commitRead
+	default:
+		return fmt.Errorf("invalid offset.commit mode '%v' (must be ack or read)", s)
+	}
+	return nil
+}
+
+func (a *assignmentStrategy) Unpack(s string) error {
+	switch strategy := assignmentStrategy(strings.ToLower(s)); strategy {
+	case assignRange, assignRoundRobin:
+		*a = strategy
+	default:
+		return fmt.Errorf("invalid group.assignment '%v' (must be range or roundrobin)", s)
+	}
+	return nil
+}
+
+func newSaramaConfig(config *kafkaInputConfig) (*sarama.Config, error) {
+	k := sarama.NewConfig()
+
+	// configure network level properties
+	timeout := config.Timeout
+	k.Net.DialTimeout = timeout
+	k.Net.ReadTimeout = timeout
+	k.Net.WriteTimeout = timeout
+	k.Net.KeepAlive = config.KeepAlive
+
+	tls, err := outputs.LoadTLSConfig(config.TLS)
+	if err != nil {
+		return nil, err
+	}
+	if tls != nil {
+		k.Net.TLS.Enable = true
+		k.Net.TLS.Config = tls.BuildModuleConfig("")
+	}
+
+	if config.Username != "" {
+		k.Net.SASL.Enable = true
+		k.Net.SASL.User = config.Username
+		k.Net.SASL.Password = config.Password
+	}
+
+	// configure consumer API properties
+	k.Consumer.Return.Errors = true
+	k.Consumer.Offsets.Initial = int64(config.InitialOffset)
+	k.Consumer.Fetch.Min = config.Fetch.Min
+	k.Consumer.Fetch.Default = config.Fetch.Default
+	k.Consumer.Fetch.Max = config.Fetch.Max
+	k.Consumer.MaxWaitTime = config.Fetch.MaxWait
+
+	// configure per partition go channel buffering
+	k.ChannelBufferSize = config.ChanBufferSize
+
+	k.ClientID = config.ClientID
+
+	version, err := sarama.ParseKafkaVersion(config.Version)
+	if err != nil {
+		return nil, err
+	}
+	k.Version = version
+
+	if err := k.Validate(); err != nil {
+		return nil, err
+	}
+	return k, nil
+}
@@ -0,0 +1,388 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kafka
+
+import (
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/Shopify/sarama"
+
+	"github.com/elastic/beats/libbeat/logp"
+)
+
+// groupMember implements the membership in a Kafka consumer group. The group
+// coordinator is used to join the group, to receive the partitions assigned
+// to the member, to send heartbeats and to store the group offsets.
+type groupMember struct {
+	log    *logp.Logger
+	client sarama.Client
+	config *kafkaInputConfig
+
+	memberID   string
+	generation int32
+}
+
+// errRebalance is returned if the member must rejoin the group, because the
+// group is rebalancing or the member has been removed from the group.
+var errRebalance = errors.New("consumer group is rebalancing")
+
+func newGroupMember(log *logp.Logger, client sarama.Client, config *kafkaInputConfig) *groupMember {
+	return &groupMember{
+		log:        log,
+		client:     client,
+		config:     config,
+		generation: sarama.GroupGenerationUndefined,
+	}
+}
+
+func (g *groupMember) coordinator() (*sarama.Broker, error) {
+	return g.client.Coordinator(g.config.GroupID)
+}
+
+// checkError converts a group coordinator error code into an error. The
+// member state and coordinator are reset if required.
+func (g *groupMember) checkError(err sarama.KError) error {
+	switch err {
+	case sarama.ErrNoError:
+		return nil
+	case sarama.ErrRebalanceInProgress, sarama.ErrIllegalGeneration:
+		return errRebalance
+	case sarama.ErrUnknownMemberId:
+		g.memberID = ""
+		return errRebalance
+	case sarama.ErrNotCoordinatorForConsumer, sarama.ErrConsumerCoordinatorNotAvailable:
+		if err := g.client.RefreshCoordinator(g.config.GroupID); err != nil {
+			g.log.Debugf("Failed to refresh group coordinator: %v", err)
+		}
+	}
+	return err
+}
+
+// join joins the consumer group and returns the partitions assigned to this
+// member, by topic. If the member is elected as group leader, the partitions
+// of all topics are assigned to the members of the group.
+func (g *groupMember) join() (map[string][]int32, error) {
+	coordinator, err := g.coordinator()
+	if err != nil {
+		return nil, err
+	}
+
+	config := g.config
+	join := &sarama.JoinGroupRequest{
+		GroupId:        config.GroupID,
+		SessionTimeout: int32(config.Group.SessionTimeout / time.Millisecond),
+		MemberId:       g.memberID,
+		ProtocolType:   "consumer",
+	}
+	if g.client.Config().Version.IsAtLeast(sarama.V0_10_1_0) {
+		join.Version = 1
+		join.RebalanceTimeout = int32(config.Group.RebalanceTimeout / time.Millisecond)
+	}
+	err = join.AddGroupProtocolMetadata(string(config.Group.Assignment),
+		&sarama.ConsumerGroupMemberMetadata{Topics: config.Topics})
+	if err != nil {
+		return nil, err
+	}
+
+	joined, err := coordinator.JoinGroup(join)
+	if err != nil {
+		return nil, err
+	}
+	if err := g.checkError(joined.Err); err != nil {
+		return nil, err
+	}
+	g.memberID = joined.MemberId
+	g.generation = joined.GenerationId
+
+	sync := &sarama.SyncGroupRequest{
+		GroupId:      config.GroupID,
+		GenerationId: g.generation,
+		MemberId:     g.memberID,
+	}
+	if joined.LeaderId == joined.MemberId {
+		members, err := joined.GetMembers()
+		if err != nil {
+			return nil, err
+		}
+
+		plan, err := g.plan(assignmentStrategy(joined.GroupProtocol), members)
+		if err != nil {
+			return nil, err
+		}
+		for memberID, topics := range plan {
+			assignment := &sarama.ConsumerGroupMemberAssignment{Topics: topics}
+			if err := sync.AddGroupAssignmentMember(memberID, assignment); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	synced, err := coordinator.SyncGroup(sync)
+	if err != nil {
+		return nil, err
+	}
+	if err := g.checkError(synced.Err); err != nil {
+		return nil, err
+	}
+
+	if len(synced.MemberAssignment) == 0 {
+		return nil, nil
+	}
+	assignment, err := synced.GetMemberAssignment()
+	if err != nil {
+		return nil, err
+	}
+	return assignment.Topics, nil
+}
+
+// plan distributes the partitions of all topics subscribed to by the group
+// members.
+func (g *groupMember) plan(
+	strategy assignmentStrategy,
+	members map[string]sarama.ConsumerGroupMemberMetadata,
+) (map[string]map[string][]int32, error) {
+	subscriptions := map[string][]string{}
+	for memberID, meta := range members {
+		for _, topic := range meta.Topics {
+			subscriptions[topic] = append(subscriptions[topic], memberID)
+		}
+	}
+
+	partitions := map[string][]int32{}
+	for topic := range subscriptions {
+		ids, err := g.client.Partitions(topic)
+		if err != nil {
+			return nil, err
+		}
+		partitions[topic] = ids
+	}
+
+	plan := assignPartitions(strategy, subscriptions, partitions)
+	for memberID := range members {
+		if plan[memberID] == nil {
+			plan[memberID] = map[string][]int32{}
+		}
+	}
+	return plan, nil
+}
+
+// assignPartitions assigns partitions to the members subscribed to their
+// topic. With the range strategy, each member gets a consecutive range of
+// partitions of each topic. With the roundrobin strategy, the partitions of
+// all topics are assigned to the members in turn.
+func assignPartitions(
+	strategy assignmentStrategy,
+	subscriptions map[string][]string,
+	partitions map[string][]int32,
+) map[string]map[string][]int32 {
+	plan := map[string]map[string][]int32{}
+	assign := func(memberID, topic string, partition int32) {
+		if plan[memberID] == nil {
+			plan[memberID] = map[string][]int32{}
+		}
+		plan[memberID][topic] = append(plan[memberID][topic], partition)
+	}
+
+	topics := make([]string, 0, len(subscriptions))
+	for topic, members := range subscriptions {
+		topics = append(topics, topic)
+		sort.Strings(members)
+	}
+	sort.Strings(topics)
+
+	switch strategy {
+	case assignRoundRobin:
+		// Cycle through all members, skipping members not subscribed to the
+		// topic of the partition.
+		all := map[string]bool{}
+		for _, members := range subscriptions {
+			for _, m := range members {
+				all[m] = true
+			}
+		}
+		cycle := make([]string, 0, len(all))
+		for m := range all {
+			cycle = append(cycle, m)
+		}
+		sort.Strings(cycle)
+
+		next := 0
+		for _, topic := range topics {
+			subscribed := map[string]bool{}
+			for _, m := range subscriptions[topic] {
+				subscribed[m] = true
+			}
+			for _, partition := range sortedPartitions(partitions[topic]) {
+				for !subscribed[cycle[next%len(cycle)]] {
+					next++
+				}
+				assign(cycle[next%len(cycle)], topic, partition)
+				next++
+			}
+		}
+
+	default:
+		for _, topic := range topics {
+			members := subscriptions[topic]
+			ids := sortedPartitions(partitions[topic])
+			n, extra := len(ids)/len(members), len(ids)%len(members)
+
+			start := 0
+			for i, memberID := range members {
+				count := n
+				if i < extra {
+					count++
+				}
+				for _, partition := range ids[start : start+count] {
+					assign(memberID, topic, partition)
+				}
+				start += count
+			}
+		}
+	}
+
+	return plan
+}
+
+func sortedPartitions(ids []int32) []int32 {
+	sorted := append([]int32(nil), ids...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	return sorted
+}
+
+// heartbeat signals the coordinator the member is still alive. errRebalance
+// is returned if the member must rejoin the group.
+func (g *groupMember) heartbeat() error {
+	coordinator, err := g.coordinator()
+	if err != nil {
+		return err
+	}
+
+	resp, err := coordinator.Heartbeat(&sarama.HeartbeatRequest{
+		GroupId:      g.config.GroupID,
+		GenerationId: g.generation,
+		MemberId:     g.memberID,
+	})
+	if err != nil {
+		return err
+	}
+	return g.checkError(resp.Err)
+}
+
+// fetchOffsets returns the offsets committed by the group for the assigned
+// partitions. Partitions without committed offset are reported with offset
+// -1.
+func (g *groupMember) fetchOffsets(assignment map[string][]int32) (map[string]map[int32]int64, error) {
+	coordinator, err := g.coordinator()
+	if err != nil {
+		return nil, err
+	}
+
+	req := &sarama.OffsetFetchRequest{
+		Version:       1,
+		ConsumerGroup: g.config.GroupID,
+	}
+	for topic, ids := range assignment {
+		for _, id := range ids {
+			req.AddPartition(topic, id)
+		}
+	}
+
+	resp, err := coordinator.FetchOffset(req)
+	if err != nil {
+		return nil, err
+	}
+
+	offsets := map[string]map[int32]int64{}
+	for topic, ids := range assignment {
+		offsets[topic] = map[int32]int64{}
+		for _, id := range ids {
+			offset := int64(-1)
+			if block := resp.GetBlock(topic, id); block != nil {
+				if err := g.checkError(block.Err); err != nil {
+					return nil, err
+				}
+				offset = block.Offset
+			}
+			offsets[topic][id] = offset
+		}
+	}
+	return offsets, nil
+}
+
+// commit stores the offsets of the next messages to be read in the group.
+func (g *groupMember) commit(offsets map[string]map[int32]int64) error {
+	if len(offsets) == 0 {
+		return nil
+	}
+
+	coordinator, err := g.coordinator()
+	if err != nil {
+		return err
+	}
+
+	req := &sarama.OffsetCommitRequest{
+		Version:                 2,
+		ConsumerGroup:           g.config.GroupID,
+		ConsumerGroupGeneration: g.generation,
+		ConsumerID:              g.memberID,
+		RetentionTime:           -1,
+	}
+	for topic, partitions := range offsets {
+		for id, offset := range partitions {
+			req.AddBlock(topic, id, offset, 0, "")
+		}
+	}
+
+	resp, err := coordinator.CommitOffset(req)
+	if err != nil {
+		return err
+	}
+	for _, partitions := range resp.Errors {
+		for _, kerr := range partitions {
+			if err := g.checkError(kerr); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// leave removes the member from the group, so its partitions are reassigned
+// without waiting for the session to time out.
+func (g *groupMember) leave() {
+	if g.memberID == "" {
+		return
+	}
+
+	coordinator, err := g.coordinator()
+	if err != nil {
+		return
+	}
+
+	_, err = coordinator.LeaveGroup(&sarama.LeaveGroupRequest{
+		GroupId:  g.config.GroupID,
+		MemberId: g.memberID,
+	})
+	if err != nil {
+		g.log.Debugf("Failed to leave consumer group: %v", err)
+	}
+	g.memberID = ""
+}
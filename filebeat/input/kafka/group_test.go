@@ -0,0 +1,234 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kafka
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/Shopify/sarama"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/logp"
+)
+
+func TestAssignPartitionsRange(t *testing.T) {
+	plan := assignPartitions(assignRange,
+		map[string][]string{
+			"logs":    {"m2", "m1"},
+			"metrics": {"m1"},
+		},
+		map[string][]int32{
+			"logs":    {4, 3, 2, 1, 0},
+			"metrics": {0, 1},
+		},
+	)
+
+	assert.Equal(t, map[string]map[string][]int32{
+		"m1": {"logs": {0, 1, 2}, "metrics": {0, 1}},
+		"m2": {"logs": {3, 4}},
+	}, plan)
+}
+
+func TestAssignPartitionsRoundRobin(t *testing.T) {
+	plan := assignPartitions(assignRoundRobin,
+		map[string][]string{
+			"logs":    {"m2", "m1"},
+			"metrics": {"m1", "m2", "m3"},
+		},
+		map[string][]int32{
+			"logs":    {0, 1, 2},
+			"metrics": {0, 1, 2},
+		},
+	)
+
+	assert.Equal(t, map[string]map[string][]int32{
+		"m1": {"logs": {0, 2}, "metrics": {2}},
+		"m2": {"logs": {1}, "metrics": {0}},
+		"m3": {"metrics": {1}},
+	}, plan)
+}
+
+func TestAssignPartitionsMoreMembersThanPartitions(t *testing.T) {
+	plan := assignPartitions(assignRange,
+		map[string][]string{"logs": {"m1", "m2", "m3"}},
+		map[string][]int32{"logs": {0, 1}},
+	)
+
+	assert.Equal(t, map[string]map[string][]int32{
+		"m1": {"logs": {0}},
+		"m2": {"logs": {1}},
+	}, plan)
+}
+
+// encodeMemberMetadata encodes the consumer protocol subscription of a member.
+func encodeMemberMetadata(topics ...string) []byte {
+	buf := make([]byte, 6)
+	binary.BigEndian.PutUint32(buf[2:], uint32(len(topics)))
+	for _, topic := range topics {
+		buf = appendString(buf, topic)
+	}
+	return append(buf, 0xff, 0xff, 0xff, 0xff) // null user data
+}
+
+// encodeMemberAssignment encodes the consumer protocol assignment of a member.
+func encodeMemberAssignment(topic string, partitions ...int32) []byte {
+	buf := make([]byte, 2)
+	buf = appendInt32(buf, 1)
+	buf = appendString(buf, topic)
+	buf = appendInt32(buf, int32(len(partitions)))
+	for _, p := range partitions {
+		buf = appendInt32(buf, p)
+	}
+	return append(buf, 0xff, 0xff, 0xff, 0xff)
+}
+
+func appendString(buf []byte, s string) []byte {
+	l := make([]byte, 2)
+	binary.BigEndian.PutUint16(l, uint16(len(s)))
+	return append(append(buf, l...), s...)
+}
+
+func appendInt32(buf []byte, i int32) []byte {
+	b := make([]byte, 4)
+	binary.BigEndian.PutUint32(b, uint32(i))
+	return append(buf, b...)
+}
+
+func newTestGroupMember(t *testing.T, broker *sarama.MockBroker) *groupMember {
+	config := defaultConfig
+	config.Hosts = []string{broker.Addr()}
+	config.Topics = []string{"logs"}
+	config.GroupID = "filebeat"
+
+	saramaConfig, err := newSaramaConfig(&config)
+	require.NoError(t, err)
+	saramaConfig.Version = sarama.V0_10_0_0
+	saramaConfig.Metadata.Retry.Max = 0
+
+	client, err := sarama.NewClient(config.Hosts, saramaConfig)
+	require.NoError(t, err)
+
+	return newGroupMember(logp.NewLogger("kafka input"), client, &config)
+}
+
+func TestGroupMemberJoinAsLeader(t *testing.T) {
+	broker := sarama.NewMockBroker(t, 1)
+	defer broker.Close()
+
+	broker.SetHandlerByMap(map[string]sarama.MockResponse{
+		"MetadataRequest": sarama.NewMockMetadataResponse(t).
+			SetBroker(broker.Addr(), broker.BrokerID()).
+			SetLeader("logs", 0, broker.BrokerID()).
+			SetLeader("logs", 1, broker.BrokerID()),
+		"FindCoordinatorRequest": sarama.NewMockFindCoordinatorResponse(t).
+			SetCoordinator(sarama.CoordinatorGroup, "filebeat", broker),
+		"JoinGroupRequest": sarama.NewMockWrapper(&sarama.JoinGroupResponse{
+			GenerationId:  3,
+			GroupProtocol: "range",
+			LeaderId:      "member-1",
+			MemberId:      "member-1",
+			Members: map[string][]byte{
+				"member-1": encodeMemberMetadata("logs"),
+			},
+		}),
+		"SyncGroupRequest": sarama.NewMockWrapper(&sarama.SyncGroupResponse{
+			MemberAssignment: encodeMemberAssignment("logs", 0, 1),
+		}),
+		"OffsetFetchRequest": sarama.NewMockOffsetFetchResponse(t).
+			SetOffset("filebeat", "logs", 0, 42, "", sarama.ErrNoError),
+		"OffsetCommitRequest": sarama.NewMockOffsetCommitResponse(t),
+	})
+
+	member := newTestGroupMember(t, broker)
+	defer member.client.Close()
+
+	assignment, err := member.join()
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]int32{"logs": {0, 1}}, assignment)
+	assert.Equal(t, "member-1", member.memberID)
+	assert.Equal(t, int32(3), member.generation)
+
+	offsets, err := member.fetchOffsets(assignment)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]map[int32]int64{"logs": {0: 42, 1: -1}}, offsets)
+
+	err = member.commit(map[string]map[int32]int64{"logs": {0: 43}})
+	assert.NoError(t, err)
+}
+
+func TestGroupMemberRejoinOnUnknownMember(t *testing.T) {
+	broker := sarama.NewMockBroker(t, 1)
+	defer broker.Close()
+
+	broker.SetHandlerByMap(map[string]sarama.MockResponse{
+		"MetadataRequest": sarama.NewMockMetadataResponse(t).
+			SetBroker(broker.Addr(), broker.BrokerID()),
+		"FindCoordinatorRequest": sarama.NewMockFindCoordinatorResponse(t).
+			SetCoordinator(sarama.CoordinatorGroup, "filebeat", broker),
+		"JoinGroupRequest": sarama.NewMockWrapper(&sarama.JoinGroupResponse{
+			Err: sarama.ErrUnknownMemberId,
+		}),
+	})
+
+	member := newTestGroupMember(t, broker)
+	defer member.client.Close()
+	member.memberID = "stale"
+
+	_, err := member.join()
+	assert.Equal(t, errRebalance, err)
+	assert.Equal(t, "", member.memberID)
+}
+
+func TestConfigValidate(t *testing.T) {
+	for name, settings := range map[string]map[string]interface{}{
+		"old version":     {"version": "0.8.2.0"},
+		"invalid version": {"version": "2"},
+		"commit mode":     {"offset.commit": "never"},
+		"initial offset":  {"initial_offset": "latest"},
+		"assignment":      {"group.assignment": "sticky"},
+		"heartbeat":       {"group.heartbeat_interval": "1m"},
+		"no password":     {"username": "beats"},
+	} {
+		cfg := common.MustNewConfigFrom(map[string]interface{}{
+			"hosts":    []string{"localhost:9092"},
+			"topics":   []string{"logs"},
+			"group_id": "filebeat",
+		})
+		require.NoError(t, cfg.Merge(settings))
+
+		config := defaultConfig
+		assert.Error(t, cfg.Unpack(&config), name)
+	}
+
+	cfg := common.MustNewConfigFrom(map[string]interface{}{
+		"hosts":            []string{"localhost:9092"},
+		"topics":           []string{"logs"},
+		"group_id":         "filebeat",
+		"initial_offset":   "newest",
+		"offset.commit":    "read",
+		"group.assignment": "roundrobin",
+	})
+	config := defaultConfig
+	require.NoError(t, cfg.Unpack(&config))
+	assert.Equal(t, initialOffset(sarama.OffsetNewest), config.InitialOffset)
+	assert.Equal(t, commitRead, config.Offset.Commit)
+	assert.Equal(t, assignRoundRobin, config.Group.Assignment)
+}
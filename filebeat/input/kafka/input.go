@@ -0,0 +1,356 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kafka
+
+import (
+	"sync"
+	"time"
+
+	"github.com/Shopify/sarama"
+
+	"github.com/elastic/beats/filebeat/channel"
+	"github.com/elastic/beats/filebeat/input"
+	"github.com/elastic/beats/filebeat/util"
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/common/cfgwarn"
+	"github.com/elastic/beats/libbeat/logp"
+)
+
+func init() {
+	err := input.Register("kafka", NewInput)
+	if err != nil {
+		panic(err)
+	}
+}
+
+// Input consumes messages from Kafka topics as member of a consumer group.
+type Input struct {
+	config       kafkaInputConfig
+	saramaConfig *sarama.Config
+	outlet       channel.Outleter
+	log          *logp.Logger
+
+	runOnce sync.Once
+	done    chan struct{}
+	wg      sync.WaitGroup
+}
+
+// NewInput creates a new kafka input
+func NewInput(
+	cfg *common.Config,
+	outlet channel.Connector,
+	context input.Context,
+) (input.Input, error) {
+	cfgwarn.Experimental("Kafka input type is used")
+
+	config := defaultConfig
+	if err := cfg.Unpack(&config); err != nil {
+		return nil, err
+	}
+
+	saramaConfig, err := newSaramaConfig(&config)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := outlet(cfg, context.DynamicFields)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Input{
+		config:       config,
+		saramaConfig: saramaConfig,
+		outlet:       out,
+		log:          logp.NewLogger("kafka input").With("group_id", config.GroupID),
+		done:         make(chan struct{}),
+	}, nil
+}
+
+// Run starts consuming the configured topics.
+func (p *Input) Run() {
+	p.runOnce.Do(func() {
+		p.log.Infow("Starting Kafka input", "topics", p.config.Topics)
+		p.wg.Add(1)
+		go func() {
+			defer p.wg.Done()
+			p.run()
+		}()
+	})
+}
+
+// Stop stops the kafka input, leaving the consumer group.
+func (p *Input) Stop() {
+	defer p.outlet.Close()
+
+	p.log.Info("Stopping Kafka input")
+	select {
+	case <-p.done:
+	default:
+		close(p.done)
+	}
+	p.wg.Wait()
+}
+
+// Wait stops the kafka input.
+func (p *Input) Wait() {
+	p.Stop()
+}
+
+// run connects to the cluster and consumes the topics until the input is
+// stopped. The connection is reestablished after connect_backoff on errors.
+func (p *Input) run() {
+	for {
+		client, err := sarama.NewClient(p.config.Hosts, p.saramaConfig)
+		if err == nil {
+			err = p.consumeGroup(client)
+			client.Close()
+		}
+		if err == nil {
+			return
+		}
+
+		p.log.Errorf("Kafka consumer failed, reconnecting in %v: %v", p.config.ConnectBackoff, err)
+		if !p.wait(p.config.ConnectBackoff) {
+			return
+		}
+	}
+}
+
+// wait returns false if the input has been stopped before the duration
+// passed.
+func (p *Input) wait(d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-p.done:
+		return false
+	case <-timer.C:
+		return true
+	}
+}
+
+// consumeGroup joins the consumer group and consumes the assigned partitions.
+// The group is rejoined on rebalance. It returns nil if the input has been
+// stopped.
+func (p *Input) consumeGroup(client sarama.Client) error {
+	consumer, err := sarama.NewConsumerFromClient(client)
+	if err != nil {
+		return err
+	}
+	defer consumer.Close()
+
+	member := newGroupMember(p.log, client, &p.config)
+	defer member.leave()
+
+	for {
+		select {
+		case <-p.done:
+			return nil
+		default:
+		}
+
+		assignment, err := member.join()
+		if err != nil {
+			if _, ok := err.(sarama.KError); ok || err == errRebalance {
+				// The coordinator is changing or the group is rebalancing.
+				p.log.Debugf("Failed to join consumer group, retrying: %v", err)
+				if !p.wait(p.config.Group.HeartbeatInterval) {
+					return nil
+				}
+				continue
+			}
+			return err
+		}
+
+		p.log.Infow("Joined consumer group", "generation", member.generation, "assignment", assignment)
+		if err := p.consumeSession(member, consumer, assignment); err != nil {
+			return err
+		}
+	}
+}
+
+// consumeSession consumes the assigned partitions, sending heartbeats and
+// committing offsets, until the group is rebalancing or the input is
+// stopped.
+func (p *Input) consumeSession(
+	member *groupMember,
+	consumer sarama.Consumer,
+	assignment map[string][]int32,
+) error {
+	offsets, err := member.fetchOffsets(assignment)
+	if err != nil {
+		return err
+	}
+
+	stop := make(chan struct{})
+	var wg sync.WaitGroup
+	var partitions []*partition
+	defer func() {
+		close(stop)
+		for _, part := range partitions {
+			part.consumer.AsyncClose()
+		}
+		wg.Wait()
+
+		// Commit the final offsets. This fails if the group has already been
+		// rebalanced, in which case messages might be consumed twice.
+		if err := member.commit(pendingOffsets(partitions)); err != nil {
+			p.log.Debugf("Failed to commit offsets: %v", err)
+		}
+	}()
+
+	for topic, ids := range assignment {
+		for _, id := range ids {
+			pc, err := p.consumePartition(consumer, topic, id, offsets[topic][id])
+			if err != nil {
+				return err
+			}
+
+			part := newPartition(topic, id, pc, p.config.MaxPendingPerPartition)
+			partitions = append(partitions, part)
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				p.forward(part, stop)
+			}()
+		}
+	}
+
+	heartbeat := time.NewTicker(p.config.Group.HeartbeatInterval)
+	defer heartbeat.Stop()
+	commit := time.NewTicker(p.config.Offset.CommitInterval)
+	defer commit.Stop()
+
+	for {
+		select {
+		case <-p.done:
+			return nil
+
+		case <-heartbeat.C:
+			if err := member.heartbeat(); err != nil {
+				if err == errRebalance {
+					p.log.Info("Consumer group is rebalancing")
+					return nil
+				}
+				return err
+			}
+
+		case <-commit.C:
+			pending := pendingOffsets(partitions)
+			if err := member.commit(pending); err != nil {
+				if err == errRebalance {
+					return nil
+				}
+				p.log.Errorf("Failed to commit offsets: %v", err)
+				continue
+			}
+			for _, part := range partitions {
+				if offset, ok := pending[part.topic][part.id]; ok {
+					part.committed(offset)
+				}
+			}
+		}
+	}
+}
+
+// consumePartition starts consuming the partition from the committed offset,
+// or initial_offset if the group has no valid offset for the partition.
+func (p *Input) consumePartition(
+	consumer sarama.Consumer,
+	topic string,
+	id int32,
+	offset int64,
+) (sarama.PartitionConsumer, error) {
+	initial := int64(p.config.InitialOffset)
+	if offset < 0 {
+		return consumer.ConsumePartition(topic, id, initial)
+	}
+
+	pc, err := consumer.ConsumePartition(topic, id, offset)
+	if err == sarama.ErrOffsetOutOfRange {
+		p.log.Warnf("Committed offset %v of %v/%v out of range, using initial_offset", offset, topic, id)
+		return consumer.ConsumePartition(topic, id, initial)
+	}
+	return pc, err
+}
+
+// forward publishes the messages of the partition. In ack mode, at most
+// max_pending_per_partition messages not yet acknowledged are published, so a
+// slow output stops reading from Kafka.
+func (p *Input) forward(part *partition, stop <-chan struct{}) {
+	ackMode := p.config.Offset.Commit == commitACK
+	for {
+		select {
+		case <-stop:
+			return
+
+		case err, ok := <-part.consumer.Errors():
+			if ok {
+				p.log.Errorf("Failed to consume %v/%v: %v", part.topic, part.id, err.Err)
+			}
+
+		case msg, ok := <-part.consumer.Messages():
+			if !ok {
+				return
+			}
+
+			data := util.NewData()
+			data.Event = makeEvent(msg)
+			if ackMode {
+				if !part.acquire(stop) {
+					return
+				}
+				data.Event.Private = &eventACK{partition: part, offset: msg.Offset}
+			}
+
+			if !p.outlet.OnEvent(data) {
+				return
+			}
+			if !ackMode {
+				part.mark(msg.Offset)
+			}
+		}
+	}
+}
+
+func makeEvent(msg *sarama.ConsumerMessage) beat.Event {
+	ts := msg.Timestamp
+	if ts.IsZero() {
+		ts = time.Now()
+	}
+
+	kafka := common.MapStr{
+		"topic":     msg.Topic,
+		"partition": msg.Partition,
+		"offset":    msg.Offset,
+	}
+	if len(msg.Key) > 0 {
+		kafka["key"] = string(msg.Key)
+	}
+
+	return beat.Event{
+		Timestamp: ts,
+		Fields: common.MapStr{
+			"message": string(msg.Value),
+			"kafka":   kafka,
+		},
+	}
+}
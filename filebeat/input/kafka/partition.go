@@ -0,0 +1,123 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kafka
+
+import (
+	"sync"
+
+	"github.com/Shopify/sarama"
+)
+
+// partition tracks the offsets of a partition assigned to the group member.
+type partition struct {
+	topic    string
+	id       int32
+	consumer sarama.PartitionConsumer
+
+	// pending limits the number of published events not yet acknowledged.
+	pending chan struct{}
+
+	mutex  sync.Mutex
+	offset int64 // offset of the next message to be consumed by the group
+	dirty  bool  // offset has not been committed yet
+}
+
+// eventACK is stored as event private data. It is notified by the filebeat
+// ACK handler once the event has been published.
+type eventACK struct {
+	partition *partition
+	offset    int64
+}
+
+func newPartition(topic string, id int32, consumer sarama.PartitionConsumer, maxPending int) *partition {
+	return &partition{
+		topic:    topic,
+		id:       id,
+		consumer: consumer,
+		pending:  make(chan struct{}, maxPending),
+		offset:   -1,
+	}
+}
+
+// ACK marks the message as consumed and allows another event to be published.
+func (a *eventACK) ACK() {
+	a.partition.mark(a.offset)
+	a.partition.release()
+}
+
+// acquire blocks until another event can be published. It returns false if
+// stop has been closed.
+func (p *partition) acquire(stop <-chan struct{}) bool {
+	select {
+	case p.pending <- struct{}{}:
+		return true
+	case <-stop:
+		return false
+	}
+}
+
+func (p *partition) release() {
+	select {
+	case <-p.pending:
+	default:
+	}
+}
+
+// mark marks the message at offset as consumed.
+func (p *partition) mark(offset int64) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if next := offset + 1; next > p.offset {
+		p.offset = next
+		p.dirty = true
+	}
+}
+
+// uncommitted returns the offset to commit, if there is any.
+func (p *partition) uncommitted() (int64, bool) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	return p.offset, p.dirty
+}
+
+// committed marks the offset as committed.
+func (p *partition) committed(offset int64) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if p.offset == offset {
+		p.dirty = false
+	}
+}
+
+// pendingOffsets collects the offsets not yet committed, by topic.
+func pendingOffsets(partitions []*partition) map[string]map[int32]int64 {
+	offsets := map[string]map[int32]int64{}
+	for _, part := range partitions {
+		offset, dirty := part.uncommitted()
+		if !dirty {
+			continue
+		}
+		if offsets[part.topic] == nil {
+			offsets[part.topic] = map[int32]int64{}
+		}
+		offsets[part.topic][part.id] = offset
+	}
+	return offsets
+}
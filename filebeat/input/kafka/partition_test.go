@@ -0,0 +1,92 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kafka
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elastic/beats/libbeat/common"
+)
+
+func TestPartitionACK(t *testing.T) {
+	part := newPartition("logs", 1, nil, 2)
+	stop := make(chan struct{})
+
+	_, dirty := part.uncommitted()
+	assert.False(t, dirty)
+
+	assert.True(t, part.acquire(stop))
+	assert.True(t, part.acquire(stop))
+
+	// a third event must wait for an ACK
+	acquired := make(chan bool)
+	go func() { acquired <- part.acquire(stop) }()
+	select {
+	case <-acquired:
+		t.Fatal("acquired more than max pending events")
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	(&eventACK{partition: part, offset: 10}).ACK()
+	assert.True(t, <-acquired)
+
+	offset, dirty := part.uncommitted()
+	assert.True(t, dirty)
+	assert.Equal(t, int64(11), offset)
+
+	assert.Equal(t, map[string]map[int32]int64{"logs": {1: 11}}, pendingOffsets([]*partition{part}))
+
+	part.committed(11)
+	_, dirty = part.uncommitted()
+	assert.False(t, dirty)
+	assert.Empty(t, pendingOffsets([]*partition{part}))
+
+	// older offsets do not move the offset back
+	part.mark(5)
+	offset, dirty = part.uncommitted()
+	assert.False(t, dirty)
+	assert.Equal(t, int64(11), offset)
+
+	close(stop)
+	assert.False(t, part.acquire(stop))
+}
+
+func TestMakeEvent(t *testing.T) {
+	ts := time.Date(2018, 7, 1, 10, 0, 0, 0, time.UTC)
+	event := makeEvent(&sarama.ConsumerMessage{
+		Key:       []byte("key"),
+		Value:     []byte("hello world"),
+		Topic:     "logs",
+		Partition: 2,
+		Offset:    123,
+		Timestamp: ts,
+	})
+
+	assert.Equal(t, ts, event.Timestamp)
+	assert.Equal(t, "hello world", event.Fields["message"])
+	assert.Equal(t, common.MapStr{
+		"topic":     "logs",
+		"partition": int32(2),
+		"offset":    int64(123),
+		"key":       "key",
+	}, event.Fields["kafka"])
+}
@@ -108,6 +108,9 @@ type config struct {
 
 		// TODO move this to true by default
 		Partial bool `config:"partial"`
+
+		// Format can be auto, docker or cri
+		Format string `config:"format"`
 	} `config:"docker-json"`
 }
 
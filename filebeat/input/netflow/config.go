@@ -0,0 +1,89 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package netflow
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/dustin/go-humanize"
+
+	"github.com/elastic/beats/filebeat/harvester"
+	"github.com/elastic/beats/filebeat/inputsource/udp"
+)
+
+type config struct {
+	udp.Config                `config:",inline"`
+	harvester.ForwarderConfig `config:",inline"`
+	// Protocols lists the enabled protocols. All protocols are enabled
+	// when empty.
+	Protocols         []protocol    `config:"protocols"`
+	ExpirationTimeout time.Duration `config:"expiration_timeout"`
+}
+
+// protocol is a NetFlow protocol version.
+type protocol uint16
+
+var protocols = map[string]protocol{
+	"v5":    versionNetflowV5,
+	"v9":    versionNetflowV9,
+	"ipfix": versionIPFIX,
+}
+
+// Unpack sets the protocol from its name.
+func (p *protocol) Unpack(s string) error {
+	v, ok := protocols[strings.ToLower(s)]
+	if !ok {
+		return fmt.Errorf("invalid protocol '%v' (must be v5, v9 or ipfix)", s)
+	}
+	*p = v
+	return nil
+}
+
+// allProtocols are the protocols enabled by default. They are not part of
+// defaultConfig, as unpacking a list merges it with the default list.
+var allProtocols = []protocol{versionNetflowV5, versionNetflowV9, versionIPFIX}
+
+var defaultConfig = config{
+	ForwarderConfig: harvester.ForwarderConfig{
+		Type: "netflow",
+	},
+	Config: udp.Config{
+		MaxMessageSize: 10 * humanize.KiByte,
+		Host:           "localhost:2055",
+		Timeout:        time.Minute * 5,
+	},
+	ExpirationTimeout: 30 * time.Minute,
+}
+
+// enabledProtocols returns the configured protocols or all protocols if
+// none are configured.
+func (c *config) enabledProtocols() []protocol {
+	if len(c.Protocols) == 0 {
+		return allProtocols
+	}
+	return c.Protocols
+}
+
+func (c *config) Validate() error {
+	if c.ExpirationTimeout < 0 {
+		return fmt.Errorf("expiration_timeout %v must not be negative", c.ExpirationTimeout)
+	}
+	return nil
+}
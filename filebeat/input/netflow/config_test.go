@@ -0,0 +1,59 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package netflow
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elastic/beats/libbeat/common"
+)
+
+func TestConfig(t *testing.T) {
+	cfg := common.MustNewConfigFrom(map[string]interface{}{
+		"protocols":          []string{"v9", "IPFIX"},
+		"expiration_timeout": "10m",
+	})
+
+	c := defaultConfig
+	if assert.NoError(t, cfg.Unpack(&c)) {
+		assert.Equal(t, []protocol{versionNetflowV9, versionIPFIX}, c.Protocols)
+		assert.Equal(t, "localhost:2055", c.Host)
+	}
+}
+
+func TestConfigDefaultProtocols(t *testing.T) {
+	c := defaultConfig
+	if assert.NoError(t, common.NewConfig().Unpack(&c)) {
+		assert.Equal(t, allProtocols, c.enabledProtocols())
+	}
+}
+
+func TestConfigInvalid(t *testing.T) {
+	for name, settings := range map[string]map[string]interface{}{
+		"unknown protocol": {"protocols": []string{"v5", "sflow"}},
+		"negative timeout": {"expiration_timeout": "-1m"},
+	} {
+		c := defaultConfig
+		err := common.MustNewConfigFrom(settings).Unpack(&c)
+		assert.Error(t, err, name)
+	}
+}
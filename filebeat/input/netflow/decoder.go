@@ -0,0 +1,436 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package netflow
+
+import (
+	"encoding/binary"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/libbeat/logp"
+)
+
+// Protocol versions as found in the version field of the packet header.
+const (
+	versionNetflowV5 = 5
+	versionNetflowV9 = 9
+	versionIPFIX     = 10
+)
+
+// Set IDs of template and options template sets.
+const (
+	v9TemplateSetID           = 0
+	v9OptionsTemplateSetID    = 1
+	ipfixTemplateSetID        = 2
+	ipfixOptionsTemplateSetID = 3
+	minDataSetID              = 256
+)
+
+const (
+	v5HeaderLength    = 24
+	v5RecordLength    = 48
+	v9HeaderLength    = 20
+	ipfixHeaderLength = 16
+	setHeaderLength   = 4
+
+	// variableLength is the field length of variable length IPFIX fields.
+	variableLength = 65535
+
+	// enterpriseBit marks IPFIX fields followed by an enterprise number.
+	enterpriseBit = 0x8000
+)
+
+// exporter identifies the device and packet a flow was exported in.
+type exporter struct {
+	address   string
+	version   uint16
+	domain    uint32    // Source ID (v9), observation domain ID (IPFIX) or engine type and ID (v5).
+	timestamp time.Time // Export time.
+	uptime    uint32    // System uptime in milliseconds (v5 and v9).
+}
+
+// flow is a decoded flow record.
+type flow struct {
+	exporter     exporter
+	values       map[uint16]interface{}
+	samplingRate uint64
+}
+
+// templateField is a field specifier of a template.
+type templateField struct {
+	id         uint16
+	length     uint16
+	enterprise uint32
+}
+
+type template struct {
+	fields    []templateField
+	options   bool
+	minLength int
+	updated   time.Time
+}
+
+type sessionKey struct {
+	address string
+	version uint16
+	domain  uint32
+}
+
+// session holds the templates and the sampling rate announced by an
+// exporter for an observation domain.
+type session struct {
+	templates    map[uint16]*template
+	samplingRate uint64
+}
+
+// decoder decodes NetFlow v5, v9 and IPFIX packets. It keeps track of the
+// templates of all exporters and is not safe for concurrent use.
+type decoder struct {
+	protocols map[uint16]bool
+	timeout   time.Duration
+	sessions  map[sessionKey]*session
+	lastPurge time.Time
+	log       *logp.Logger
+
+	// now returns the current time. It is replaced in tests.
+	now func() time.Time
+}
+
+func newDecoder(protocols []protocol, timeout time.Duration, log *logp.Logger) *decoder {
+	enabled := make(map[uint16]bool, len(protocols))
+	for _, p := range protocols {
+		enabled[uint16(p)] = true
+	}
+	return &decoder{
+		protocols: enabled,
+		timeout:   timeout,
+		sessions:  map[sessionKey]*session{},
+		log:       log,
+		now:       time.Now,
+	}
+}
+
+// decode decodes the flow records of a packet received from the exporter
+// with the given address. Data records referencing unknown templates are
+// dropped.
+func (d *decoder) decode(data []byte, address string) ([]*flow, error) {
+	if len(data) < 2 {
+		return nil, errors.New("packet too short")
+	}
+
+	version := binary.BigEndian.Uint16(data)
+	if !d.protocols[version] {
+		return nil, errors.Errorf("unsupported protocol version %d", version)
+	}
+
+	d.purge()
+
+	switch version {
+	case versionNetflowV5:
+		return decodeV5(data, address)
+	case versionNetflowV9:
+		return d.decodeV9(data, address)
+	default:
+		return d.decodeIPFIX(data, address)
+	}
+}
+
+// purge removes expired templates and sessions without templates.
+func (d *decoder) purge() {
+	now := d.now()
+	if d.timeout <= 0 || now.Sub(d.lastPurge) < d.timeout {
+		return
+	}
+	d.lastPurge = now
+
+	for key, s := range d.sessions {
+		for id, t := range s.templates {
+			if now.Sub(t.updated) > d.timeout {
+				delete(s.templates, id)
+			}
+		}
+		if len(s.templates) == 0 {
+			delete(d.sessions, key)
+		}
+	}
+}
+
+func (d *decoder) session(exp exporter) *session {
+	key := sessionKey{address: exp.address, version: exp.version, domain: exp.domain}
+	s, found := d.sessions[key]
+	if !found {
+		s = &session{templates: map[uint16]*template{}}
+		d.sessions[key] = s
+	}
+	return s
+}
+
+func (d *decoder) decodeV9(data []byte, address string) ([]*flow, error) {
+	if len(data) < v9HeaderLength {
+		return nil, errors.New("NetFlow v9 packet too short")
+	}
+
+	exp := exporter{
+		address:   address,
+		version:   versionNetflowV9,
+		uptime:    binary.BigEndian.Uint32(data[4:]),
+		timestamp: time.Unix(int64(binary.BigEndian.Uint32(data[8:])), 0).UTC(),
+		domain:    binary.BigEndian.Uint32(data[16:]),
+	}
+	return d.decodeSets(data[v9HeaderLength:], exp)
+}
+
+func (d *decoder) decodeIPFIX(data []byte, address string) ([]*flow, error) {
+	if len(data) < ipfixHeaderLength {
+		return nil, errors.New("IPFIX message too short")
+	}
+
+	length := int(binary.BigEndian.Uint16(data[2:]))
+	if length < ipfixHeaderLength || length > len(data) {
+		return nil, errors.Errorf("invalid IPFIX message length %d", length)
+	}
+
+	exp := exporter{
+		address:   address,
+		version:   versionIPFIX,
+		timestamp: time.Unix(int64(binary.BigEndian.Uint32(data[4:])), 0).UTC(),
+		domain:    binary.BigEndian.Uint32(data[12:]),
+	}
+	return d.decodeSets(data[ipfixHeaderLength:length], exp)
+}
+
+// decodeSets decodes the sets (flowsets in NetFlow v9) of a packet.
+func (d *decoder) decodeSets(data []byte, exp exporter) ([]*flow, error) {
+	s := d.session(exp)
+	ipfix := exp.version == versionIPFIX
+
+	var flows []*flow
+	for len(data) >= setHeaderLength {
+		id := binary.BigEndian.Uint16(data)
+		length := int(binary.BigEndian.Uint16(data[2:]))
+		if length < setHeaderLength || length > len(data) {
+			return flows, errors.Errorf("invalid length %d of set %d", length, id)
+		}
+		body := data[setHeaderLength:length]
+		data = data[length:]
+
+		var err error
+		switch {
+		case !ipfix && id == v9TemplateSetID:
+			err = d.decodeTemplates(s, body, false, false)
+		case !ipfix && id == v9OptionsTemplateSetID:
+			err = d.decodeV9OptionsTemplates(s, body)
+		case ipfix && id == ipfixTemplateSetID:
+			err = d.decodeTemplates(s, body, true, false)
+		case ipfix && id == ipfixOptionsTemplateSetID:
+			err = d.decodeTemplates(s, body, true, true)
+		case id >= minDataSetID:
+			flows = append(flows, d.decodeData(s, id, body, exp)...)
+		}
+		if err != nil {
+			return flows, err
+		}
+	}
+	return flows, nil
+}
+
+// decodeTemplates decodes a NetFlow v9 template set or an IPFIX template or
+// options template set.
+func (d *decoder) decodeTemplates(s *session, body []byte, ipfix, options bool) error {
+	headerLength := 4
+	if options {
+		headerLength = 6
+	}
+
+	for len(body) >= headerLength {
+		id := binary.BigEndian.Uint16(body)
+		count := int(binary.BigEndian.Uint16(body[2:]))
+		if id < minDataSetID {
+			// Padding.
+			return nil
+		}
+		body = body[headerLength:]
+
+		if count == 0 {
+			// IPFIX template withdrawal.
+			delete(s.templates, id)
+			continue
+		}
+
+		t := &template{options: options, updated: d.now()}
+		for i := 0; i < count; i++ {
+			if len(body) < 4 {
+				return errors.Errorf("template %d truncated", id)
+			}
+			f := templateField{
+				id:     binary.BigEndian.Uint16(body),
+				length: binary.BigEndian.Uint16(body[2:]),
+			}
+			body = body[4:]
+
+			if ipfix && f.id&enterpriseBit != 0 {
+				if len(body) < 4 {
+					return errors.Errorf("template %d truncated", id)
+				}
+				f.id &^= enterpriseBit
+				f.enterprise = binary.BigEndian.Uint32(body)
+				body = body[4:]
+			}
+			t.add(f)
+		}
+		s.templates[id] = t
+	}
+	return nil
+}
+
+// decodeV9OptionsTemplates decodes a NetFlow v9 options template set. Unlike
+// the other templates its header contains the length in bytes of the scope
+// and option field specifiers.
+func (d *decoder) decodeV9OptionsTemplates(s *session, body []byte) error {
+	for len(body) >= 6 {
+		id := binary.BigEndian.Uint16(body)
+		scopeLength := int(binary.BigEndian.Uint16(body[2:]))
+		optionLength := int(binary.BigEndian.Uint16(body[4:]))
+		if id < minDataSetID {
+			// Padding.
+			return nil
+		}
+		body = body[6:]
+
+		length := scopeLength + optionLength
+		if length%4 != 0 || length > len(body) {
+			return errors.Errorf("invalid length of options template %d", id)
+		}
+
+		t := &template{options: true, updated: d.now()}
+		for i := 0; i < length; i += 4 {
+			f := templateField{
+				id:     binary.BigEndian.Uint16(body[i:]),
+				length: binary.BigEndian.Uint16(body[i+2:]),
+			}
+			// Scope field types have their own numbering, they are not
+			// information elements.
+			if i < scopeLength {
+				f.enterprise = ^uint32(0)
+			}
+			t.add(f)
+		}
+		s.templates[id] = t
+		body = body[length:]
+	}
+	return nil
+}
+
+func (t *template) add(f templateField) {
+	t.fields = append(t.fields, f)
+	if f.length == variableLength {
+		t.minLength++
+	} else {
+		t.minLength += int(f.length)
+	}
+}
+
+// decodeData decodes the records of a data set. Records of options templates
+// update the sampling rate of the session and are not returned.
+func (d *decoder) decodeData(s *session, id uint16, body []byte, exp exporter) []*flow {
+	t, found := s.templates[id]
+	if found && d.timeout > 0 && d.now().Sub(t.updated) > d.timeout {
+		delete(s.templates, id)
+		found = false
+	}
+	if !found {
+		d.log.Debugw("Dropping data set with unknown template",
+			"exporter", exp.address, "domain", exp.domain, "template", id)
+		return nil
+	}
+
+	var flows []*flow
+	for len(body) >= t.minLength && t.minLength > 0 {
+		values, n, ok := t.decodeRecord(body)
+		if !ok {
+			break
+		}
+		body = body[n:]
+
+		if t.options {
+			if rate, found := samplingRate(values); found {
+				s.samplingRate = rate
+			}
+			continue
+		}
+
+		f := &flow{exporter: exp, values: values, samplingRate: s.samplingRate}
+		if rate, found := samplingRate(values); found {
+			f.samplingRate = rate
+		}
+		flows = append(flows, f)
+	}
+	return flows
+}
+
+// decodeRecord decodes a single data record. It returns the decoded values
+// and the length of the record.
+func (t *template) decodeRecord(b []byte) (map[uint16]interface{}, int, bool) {
+	values := make(map[uint16]interface{}, len(t.fields))
+	offset := 0
+	for _, f := range t.fields {
+		length := int(f.length)
+		if f.length == variableLength {
+			// https://tools.ietf.org/html/rfc7011#section-7
+			if offset+1 > len(b) {
+				return nil, 0, false
+			}
+			length = int(b[offset])
+			offset++
+			if length == 255 {
+				if offset+2 > len(b) {
+					return nil, 0, false
+				}
+				length = int(binary.BigEndian.Uint16(b[offset:]))
+				offset += 2
+			}
+		}
+		if offset+length > len(b) {
+			return nil, 0, false
+		}
+		value := b[offset : offset+length]
+		offset += length
+
+		if f.enterprise != 0 {
+			continue
+		}
+		if def, found := fields[f.id]; found {
+			if v, ok := def.decode(value); ok {
+				values[f.id] = v
+			}
+		}
+	}
+	return values, offset, true
+}
+
+// samplingRate returns the sampling rate from the sampling related
+// information elements of a record.
+func samplingRate(values map[uint16]interface{}) (uint64, bool) {
+	for _, id := range []uint16{samplingInterval, samplerRandomInterval, samplingPacketInterval} {
+		if v, found := values[id]; found {
+			return v.(uint64), true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,395 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package netflow
+
+import (
+	"bytes"
+	"encoding/binary"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/logp"
+)
+
+const testExporter = "192.0.2.1:2055"
+
+var testExportTime = time.Date(2018, 11, 6, 10, 30, 0, 0, time.UTC)
+
+// packet builds NetFlow packets in network byte order.
+type packet struct {
+	bytes.Buffer
+}
+
+func (p *packet) u8(v uint8) *packet   { p.WriteByte(v); return p }
+func (p *packet) u16(v uint16) *packet { binary.Write(p, binary.BigEndian, v); return p }
+func (p *packet) u32(v uint32) *packet { binary.Write(p, binary.BigEndian, v); return p }
+func (p *packet) u64(v uint64) *packet { binary.Write(p, binary.BigEndian, v); return p }
+func (p *packet) ip(s string) *packet {
+	ip := net.ParseIP(s)
+	if v4 := ip.To4(); v4 != nil {
+		ip = v4
+	}
+	p.Write(ip)
+	return p
+}
+
+// set appends a set (flowset) with the given ID and body.
+func (p *packet) set(id uint16, body *packet) *packet {
+	p.u16(id).u16(uint16(setHeaderLength + body.Len()))
+	p.Write(body.Bytes())
+	return p
+}
+
+func v9Header(count uint16, uptime, sourceID uint32) *packet {
+	p := &packet{}
+	return p.u16(9).u16(count).u32(uptime).u32(uint32(testExportTime.Unix())).u32(1).u32(sourceID)
+}
+
+func ipfixHeader(domain uint32) *packet {
+	p := &packet{}
+	// The length is set by ipfixMessage.
+	return p.u16(10).u16(0).u32(uint32(testExportTime.Unix())).u32(1).u32(domain)
+}
+
+func ipfixMessage(p *packet) []byte {
+	b := p.Bytes()
+	binary.BigEndian.PutUint16(b[2:], uint16(len(b)))
+	return b
+}
+
+func newTestDecoder() *decoder {
+	return newDecoder(allProtocols, 30*time.Minute, logp.NewLogger("netflow"))
+}
+
+func TestDecodeV5(t *testing.T) {
+	p := &packet{}
+	p.u16(5).u16(2).u32(360000).u32(uint32(testExportTime.Unix())).u32(0).u32(42)
+	p.u8(1).u8(2).u16(0x4000 | 100) // engine type, engine ID and sampling interval.
+	for i := 0; i < 2; i++ {
+		p.ip("10.0.0.1").ip("198.51.100.7").ip("10.0.0.254")
+		p.u16(3).u16(4)           // input and output interfaces.
+		p.u32(10).u32(1500)       // packets and bytes.
+		p.u32(300000).u32(359000) // first and last.
+		p.u16(40000).u16(uint16(443 + i))
+		p.u8(0).u8(0x1b).u8(6).u8(0) // pad, TCP flags, protocol and ToS.
+		p.u16(64500).u16(64501).u8(24).u8(16).u16(0)
+	}
+
+	flows, err := newTestDecoder().decode(p.Bytes(), testExporter)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !assert.Len(t, flows, 2) {
+		return
+	}
+
+	f := flows[0]
+	assert.Equal(t, exporter{
+		address:   testExporter,
+		version:   5,
+		domain:    0x0102,
+		timestamp: testExportTime,
+		uptime:    360000,
+	}, f.exporter)
+	assert.EqualValues(t, 100, f.samplingRate)
+	assert.Equal(t, "10.0.0.1", f.values[sourceIPv4Address].(net.IP).String())
+	assert.EqualValues(t, 1500, f.values[octetDeltaCount])
+	assert.EqualValues(t, 0x1b, f.values[tcpControlBits])
+	assert.EqualValues(t, 64501, f.values[bgpDestinationAsNumber])
+	assert.EqualValues(t, 444, flows[1].values[destinationTransportPort])
+
+	event := toEvent(f)
+	assert.Equal(t, testExportTime, event.Timestamp)
+	assertFields(t, event.Fields, common.MapStr{
+		"log.source.address":             testExporter,
+		"source.ip":                      "10.0.0.1",
+		"source.port":                    uint64(40000),
+		"destination.ip":                 "198.51.100.7",
+		"destination.port":               uint64(443),
+		"network.type":                   "ipv4",
+		"network.transport":              "tcp",
+		"network.iana_number":            "6",
+		"network.bytes":                  uint64(150000),
+		"network.packets":                uint64(1000),
+		"event.action":                   "netflow_flow",
+		"event.start":                    testExportTime.Add(-time.Minute),
+		"event.end":                      testExportTime.Add(-time.Second),
+		"event.duration":                 int64(59 * time.Second),
+		"netflow.type":                   "netflow_flow",
+		"netflow.exporter.version":       uint16(5),
+		"netflow.exporter.uptime_millis": uint32(360000),
+		"netflow.source_ipv4_address":    "10.0.0.1",
+		"netflow.octet_delta_count":      uint64(1500),
+		"netflow.sampling_interval":      uint64(100),
+	})
+}
+
+func TestDecodeV9(t *testing.T) {
+	templates := &packet{}
+	templates.u16(256).u16(5)
+	templates.u16(sourceIPv6Address).u16(16)
+	templates.u16(destinationIPv6Address).u16(16)
+	templates.u16(protocolIdentifier).u16(1)
+	templates.u16(octetDeltaCount).u16(4) // Reduced size encoding.
+	templates.u16(flowDirection).u16(1)
+
+	data := &packet{}
+	data.ip("2001:db8::1").ip("2001:db8::2").u8(17).u32(4321).u8(1)
+	data.ip("2001:db8::3").ip("2001:db8::4").u8(58).u32(64).u8(0)
+	data.u8(0).u8(0) // Padding.
+
+	p := v9Header(3, 1000, 7).set(v9TemplateSetID, templates).set(256, data)
+
+	d := newTestDecoder()
+	flows, err := d.decode(p.Bytes(), testExporter)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !assert.Len(t, flows, 2) {
+		return
+	}
+
+	assert.EqualValues(t, 7, flows[0].exporter.domain)
+	assertFields(t, toEvent(flows[0]).Fields, common.MapStr{
+		"source.ip":                        "2001:db8::1",
+		"destination.ip":                   "2001:db8::2",
+		"network.type":                     "ipv6",
+		"network.transport":                "udp",
+		"network.bytes":                    uint64(4321),
+		"network.direction":                "outbound",
+		"netflow.source_ipv6_address":      "2001:db8::1",
+		"netflow.exporter.source_id":       uint32(7),
+		"netflow.exporter.timestamp":       testExportTime,
+		"netflow.exporter.uptime_millis":   uint32(1000),
+		"netflow.destination_ipv6_address": "2001:db8::2",
+	})
+	assertFields(t, toEvent(flows[1]).Fields, common.MapStr{
+		"network.transport": "ipv6-icmp",
+		"network.direction": "inbound",
+	})
+
+	// Later packets only contain data sets using the cached template.
+	p = v9Header(1, 2000, 7).set(256, data)
+	flows, err = d.decode(p.Bytes(), testExporter)
+	if assert.NoError(t, err) {
+		assert.Len(t, flows, 2)
+	}
+
+	// Templates are not shared between source IDs.
+	p = v9Header(1, 2000, 8).set(256, data)
+	flows, err = d.decode(p.Bytes(), testExporter)
+	if assert.NoError(t, err) {
+		assert.Empty(t, flows)
+	}
+}
+
+func TestDecodeV9OptionsSampling(t *testing.T) {
+	options := &packet{}
+	options.u16(257).u16(4).u16(8)
+	options.u16(1).u16(4) // System scope.
+	options.u16(samplingInterval).u16(4)
+	options.u16(35).u16(1) // sampling_algorithm
+	options.u16(0)         // Padding.
+
+	optionsData := &packet{}
+	optionsData.u32(0x0a000001).u32(512).u8(2)
+	optionsData.u8(0).u8(0).u8(0)
+
+	templates := &packet{}
+	templates.u16(256).u16(2)
+	templates.u16(octetDeltaCount).u16(8)
+	templates.u16(packetDeltaCount).u16(8)
+
+	data := &packet{}
+	data.u64(100).u64(2)
+
+	p := v9Header(4, 1000, 0).
+		set(v9OptionsTemplateSetID, options).
+		set(257, optionsData).
+		set(v9TemplateSetID, templates).
+		set(256, data)
+
+	flows, err := newTestDecoder().decode(p.Bytes(), testExporter)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !assert.Len(t, flows, 1) {
+		return
+	}
+
+	assert.EqualValues(t, 512, flows[0].samplingRate)
+	assertFields(t, toEvent(flows[0]).Fields, common.MapStr{
+		"network.bytes":              uint64(51200),
+		"network.packets":            uint64(1024),
+		"netflow.octet_delta_count":  uint64(100),
+		"netflow.packet_delta_count": uint64(2),
+	})
+}
+
+func TestDecodeIPFIX(t *testing.T) {
+	start := time.Date(2018, 11, 6, 10, 29, 30, 250*int(time.Millisecond), time.UTC)
+
+	templates := &packet{}
+	templates.u16(300).u16(6)
+	templates.u16(sourceIPv4Address).u16(4)
+	templates.u16(destinationIPv4Address).u16(4)
+	templates.u16(destinationTransportPort).u16(2)
+	templates.u16(enterpriseBit | 1).u16(4).u32(9) // Enterprise specific.
+	templates.u16(82).u16(variableLength)          // interface_name
+	templates.u16(flowStartMilliseconds).u16(8)
+
+	data := &packet{}
+	data.ip("192.0.2.10").ip("203.0.113.5").u16(53).u32(0xffffffff)
+	data.u8(4).WriteString("eth0")
+	data.u64(uint64(start.UnixNano() / int64(time.Millisecond)))
+	data.ip("192.0.2.11").ip("203.0.113.6").u16(123).u32(0)
+	data.u8(255).u16(3).WriteString("br0")
+	data.u64(uint64(start.UnixNano() / int64(time.Millisecond)))
+
+	p := ipfixHeader(99).set(ipfixTemplateSetID, templates).set(300, data)
+
+	d := newTestDecoder()
+	flows, err := d.decode(ipfixMessage(p), testExporter)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !assert.Len(t, flows, 2) {
+		return
+	}
+
+	event := toEvent(flows[0])
+	assertFields(t, event.Fields, common.MapStr{
+		"destination.ip":             "203.0.113.5",
+		"destination.port":           uint64(53),
+		"event.start":                start,
+		"netflow.interface_name":     "eth0",
+		"netflow.exporter.version":   uint16(10),
+		"netflow.exporter.source_id": uint32(99),
+	})
+	_, err = event.Fields.GetValue("netflow.exporter.uptime_millis")
+	assert.Error(t, err)
+	assert.Len(t, flows[0].values, 5, "enterprise specific field must be skipped")
+	assert.Equal(t, "br0", flows[1].values[82])
+
+	// Withdrawn templates are no longer used.
+	withdrawal := &packet{}
+	withdrawal.u16(300).u16(0)
+	p = ipfixHeader(99).set(ipfixTemplateSetID, withdrawal).set(300, data)
+	flows, err = d.decode(ipfixMessage(p), testExporter)
+	if assert.NoError(t, err) {
+		assert.Empty(t, flows)
+	}
+}
+
+func TestDecodeIPFIXOptionsSampling(t *testing.T) {
+	options := &packet{}
+	options.u16(400).u16(2).u16(1)
+	options.u16(149).u16(4) // observation_domain_id scope.
+	options.u16(samplingPacketInterval).u16(4)
+
+	optionsData := &packet{}
+	optionsData.u32(1).u32(10)
+
+	templates := &packet{}
+	templates.u16(401).u16(1)
+	templates.u16(packetDeltaCount).u16(4)
+
+	data := &packet{}
+	data.u32(3)
+
+	p := ipfixHeader(1).
+		set(ipfixOptionsTemplateSetID, options).
+		set(400, optionsData).
+		set(ipfixTemplateSetID, templates).
+		set(401, data)
+
+	flows, err := newTestDecoder().decode(ipfixMessage(p), testExporter)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if assert.Len(t, flows, 1) {
+		assertFields(t, toEvent(flows[0]).Fields, common.MapStr{
+			"network.packets": uint64(30),
+		})
+	}
+}
+
+func TestTemplateExpiration(t *testing.T) {
+	templates := &packet{}
+	templates.u16(256).u16(1)
+	templates.u16(octetDeltaCount).u16(4)
+
+	data := &packet{}
+	data.u32(1)
+
+	now := testExportTime
+	d := newTestDecoder()
+	d.now = func() time.Time { return now }
+
+	flows, err := d.decode(v9Header(2, 0, 0).set(v9TemplateSetID, templates).set(256, data).Bytes(), testExporter)
+	if assert.NoError(t, err) {
+		assert.Len(t, flows, 1)
+	}
+
+	now = now.Add(29 * time.Minute)
+	flows, err = d.decode(v9Header(1, 0, 0).set(256, data).Bytes(), testExporter)
+	if assert.NoError(t, err) {
+		assert.Len(t, flows, 1)
+	}
+
+	now = now.Add(2 * time.Minute)
+	flows, err = d.decode(v9Header(1, 0, 0).set(256, data).Bytes(), testExporter)
+	if assert.NoError(t, err) {
+		assert.Empty(t, flows)
+	}
+	for _, s := range d.sessions {
+		assert.Empty(t, s.templates)
+	}
+}
+
+func TestDecodeErrors(t *testing.T) {
+	d := newDecoder([]protocol{versionNetflowV9}, time.Minute, logp.NewLogger("netflow"))
+
+	for name, data := range map[string][]byte{
+		"empty":               {},
+		"disabled protocol":   {0, 5, 0, 0},
+		"unsupported version": {0, 7, 0, 0},
+		"short header":        v9Header(0, 0, 0).Bytes()[:10],
+		"invalid set length":  append(v9Header(1, 0, 0).Bytes(), 1, 0, 0, 40),
+	} {
+		_, err := d.decode(data, testExporter)
+		assert.Error(t, err, name)
+	}
+}
+
+// assertFields asserts that fields contains the expected values.
+func assertFields(t testing.TB, fields, expected common.MapStr) {
+	t.Helper()
+	for key, value := range expected {
+		v, err := fields.GetValue(key)
+		if assert.NoError(t, err, key) {
+			assert.Equal(t, value, v, key)
+		}
+	}
+}
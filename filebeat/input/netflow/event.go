@@ -0,0 +1,193 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package netflow
+
+import (
+	"net"
+	"strconv"
+	"time"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+)
+
+// transports maps IANA protocol numbers to the names used in
+// network.transport.
+var transports = map[uint64]string{
+	1:   "icmp",
+	2:   "igmp",
+	6:   "tcp",
+	17:  "udp",
+	47:  "gre",
+	50:  "esp",
+	51:  "ah",
+	58:  "ipv6-icmp",
+	132: "sctp",
+}
+
+// toEvent converts a flow record to an event. The information elements are
+// reported under netflow, the ECS fields describe the source, the destination
+// and the network traffic. The address of the exporter is reported in
+// log.source.address like in the other network inputs.
+func toEvent(f *flow) beat.Event {
+	netflow := common.MapStr{
+		"type": "netflow_flow",
+		"exporter": common.MapStr{
+			"address":   f.exporter.address,
+			"version":   f.exporter.version,
+			"source_id": f.exporter.domain,
+			"timestamp": f.exporter.timestamp,
+		},
+	}
+	if f.exporter.version != versionIPFIX {
+		netflow.Put("exporter.uptime_millis", f.exporter.uptime)
+	}
+	for id, v := range f.values {
+		if ip, ok := v.(net.IP); ok {
+			v = ip.String()
+		}
+		netflow[fields[id].name] = v
+	}
+
+	event := common.MapStr{
+		"action":   "netflow_flow",
+		"category": "network_traffic",
+	}
+	start, end := f.times()
+	if !start.IsZero() {
+		event["start"] = start
+	}
+	if !end.IsZero() {
+		event["end"] = end
+	}
+	if !start.IsZero() && !end.IsZero() && !end.Before(start) {
+		event["duration"] = end.Sub(start).Nanoseconds()
+	}
+
+	evt := common.MapStr{
+		"log": common.MapStr{
+			"source": common.MapStr{
+				"address": f.exporter.address,
+			},
+		},
+		"netflow": netflow,
+		"event":   event,
+	}
+
+	network := common.MapStr{}
+	if proto, found := f.values[protocolIdentifier]; found {
+		network["iana_number"] = strconv.FormatUint(proto.(uint64), 10)
+		if name, found := transports[proto.(uint64)]; found {
+			network["transport"] = name
+		}
+	}
+	if octets, found := f.values[octetDeltaCount]; found {
+		network["bytes"] = f.normalize(octets.(uint64))
+	}
+	if packets, found := f.values[packetDeltaCount]; found {
+		network["packets"] = f.normalize(packets.(uint64))
+	}
+	if direction, found := f.values[flowDirection]; found {
+		switch direction.(uint64) {
+		case 0:
+			network["direction"] = "inbound"
+		case 1:
+			network["direction"] = "outbound"
+		}
+	}
+
+	source := common.MapStr{}
+	if ip, found := f.values[sourceIPv4Address]; found {
+		source["ip"] = ip.(net.IP).String()
+	} else if ip, found := f.values[sourceIPv6Address]; found {
+		source["ip"] = ip.(net.IP).String()
+	}
+	if port, found := f.values[sourceTransportPort]; found {
+		source["port"] = port
+	}
+
+	destination := common.MapStr{}
+	if ip, found := f.values[destinationIPv4Address]; found {
+		network["type"] = "ipv4"
+		destination["ip"] = ip.(net.IP).String()
+	} else if ip, found := f.values[destinationIPv6Address]; found {
+		network["type"] = "ipv6"
+		destination["ip"] = ip.(net.IP).String()
+	}
+	if port, found := f.values[destinationTransportPort]; found {
+		destination["port"] = port
+	}
+
+	if len(network) > 0 {
+		evt["network"] = network
+	}
+	if len(source) > 0 {
+		evt["source"] = source
+	}
+	if len(destination) > 0 {
+		evt["destination"] = destination
+	}
+
+	return beat.Event{
+		Timestamp: f.exporter.timestamp,
+		Fields:    evt,
+	}
+}
+
+// normalize scales a counter of a sampled flow by the sampling rate to
+// estimate the actual traffic.
+func (f *flow) normalize(v uint64) uint64 {
+	if f.samplingRate > 1 {
+		return v * f.samplingRate
+	}
+	return v
+}
+
+// times returns the start and end of the flow. Times relative to the system
+// uptime of NetFlow v5 and v9 exporters are converted to absolute times.
+func (f *flow) times() (start, end time.Time) {
+	for _, ids := range [][2]uint16{
+		{flowStartMilliseconds, flowEndMilliseconds},
+		{flowStartSeconds, flowEndSeconds},
+		{flowStartMicroseconds, flowEndMicroseconds},
+		{flowStartNanoseconds, flowEndNanoseconds},
+	} {
+		if v, found := f.values[ids[0]]; found && start.IsZero() {
+			start = v.(time.Time)
+		}
+		if v, found := f.values[ids[1]]; found && end.IsZero() {
+			end = v.(time.Time)
+		}
+	}
+
+	if f.exporter.version == versionIPFIX {
+		return start, end
+	}
+	if v, found := f.values[flowStartSysUpTime]; found && start.IsZero() {
+		start = f.uptimeToTime(v.(uint64))
+	}
+	if v, found := f.values[flowEndSysUpTime]; found && end.IsZero() {
+		end = f.uptimeToTime(v.(uint64))
+	}
+	return start, end
+}
+
+func (f *flow) uptimeToTime(uptime uint64) time.Time {
+	ago := time.Duration(int64(f.exporter.uptime)-int64(uptime)) * time.Millisecond
+	return f.exporter.timestamp.Add(-ago)
+}
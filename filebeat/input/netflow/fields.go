@@ -0,0 +1,276 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package netflow
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"net"
+	"time"
+)
+
+// fieldType is the abstract data type of an information element.
+// https://tools.ietf.org/html/rfc7011#section-6.1
+type fieldType uint8
+
+const (
+	unsignedType fieldType = iota
+	macAddressType
+	octetArrayType
+	stringType
+	dateTimeSecondsType
+	dateTimeMillisecondsType
+	dateTimeMicrosecondsType
+	dateTimeNanosecondsType
+	ipv4AddressType
+	ipv6AddressType
+)
+
+// field describes an information element.
+type field struct {
+	name string
+	typ  fieldType
+}
+
+// Information elements that are decoded into flow events. NetFlow v9 field
+// types share their numbers with the IANA IPFIX information elements. The
+// names are the IPFIX names in snake case, elements not in this table are
+// ignored.
+// https://www.iana.org/assignments/ipfix/ipfix.xhtml
+var fields = map[uint16]field{
+	1:   {"octet_delta_count", unsignedType},
+	2:   {"packet_delta_count", unsignedType},
+	3:   {"delta_flow_count", unsignedType},
+	4:   {"protocol_identifier", unsignedType},
+	5:   {"ip_class_of_service", unsignedType},
+	6:   {"tcp_control_bits", unsignedType},
+	7:   {"source_transport_port", unsignedType},
+	8:   {"source_ipv4_address", ipv4AddressType},
+	9:   {"source_ipv4_prefix_length", unsignedType},
+	10:  {"ingress_interface", unsignedType},
+	11:  {"destination_transport_port", unsignedType},
+	12:  {"destination_ipv4_address", ipv4AddressType},
+	13:  {"destination_ipv4_prefix_length", unsignedType},
+	14:  {"egress_interface", unsignedType},
+	15:  {"ip_next_hop_ipv4_address", ipv4AddressType},
+	16:  {"bgp_source_as_number", unsignedType},
+	17:  {"bgp_destination_as_number", unsignedType},
+	18:  {"bgp_next_hop_ipv4_address", ipv4AddressType},
+	19:  {"post_mcast_packet_delta_count", unsignedType},
+	20:  {"post_mcast_octet_delta_count", unsignedType},
+	21:  {"flow_end_sys_up_time", unsignedType},
+	22:  {"flow_start_sys_up_time", unsignedType},
+	23:  {"post_octet_delta_count", unsignedType},
+	24:  {"post_packet_delta_count", unsignedType},
+	25:  {"minimum_ip_total_length", unsignedType},
+	26:  {"maximum_ip_total_length", unsignedType},
+	27:  {"source_ipv6_address", ipv6AddressType},
+	28:  {"destination_ipv6_address", ipv6AddressType},
+	29:  {"source_ipv6_prefix_length", unsignedType},
+	30:  {"destination_ipv6_prefix_length", unsignedType},
+	31:  {"flow_label_ipv6", unsignedType},
+	32:  {"icmp_type_code_ipv4", unsignedType},
+	33:  {"igmp_type", unsignedType},
+	34:  {"sampling_interval", unsignedType},
+	35:  {"sampling_algorithm", unsignedType},
+	36:  {"flow_active_timeout", unsignedType},
+	37:  {"flow_idle_timeout", unsignedType},
+	38:  {"engine_type", unsignedType},
+	39:  {"engine_id", unsignedType},
+	40:  {"exported_octet_total_count", unsignedType},
+	41:  {"exported_message_total_count", unsignedType},
+	42:  {"exported_flow_record_total_count", unsignedType},
+	44:  {"source_ipv4_prefix", ipv4AddressType},
+	45:  {"destination_ipv4_prefix", ipv4AddressType},
+	46:  {"mpls_top_label_type", unsignedType},
+	47:  {"mpls_top_label_ipv4_address", ipv4AddressType},
+	48:  {"sampler_id", unsignedType},
+	49:  {"sampler_mode", unsignedType},
+	50:  {"sampler_random_interval", unsignedType},
+	52:  {"minimum_ttl", unsignedType},
+	53:  {"maximum_ttl", unsignedType},
+	54:  {"fragment_identification", unsignedType},
+	55:  {"post_ip_class_of_service", unsignedType},
+	56:  {"source_mac_address", macAddressType},
+	57:  {"post_destination_mac_address", macAddressType},
+	58:  {"vlan_id", unsignedType},
+	59:  {"post_vlan_id", unsignedType},
+	60:  {"ip_version", unsignedType},
+	61:  {"flow_direction", unsignedType},
+	62:  {"ip_next_hop_ipv6_address", ipv6AddressType},
+	63:  {"bgp_next_hop_ipv6_address", ipv6AddressType},
+	64:  {"ipv6_extension_headers", unsignedType},
+	70:  {"mpls_top_label_stack_section", octetArrayType},
+	80:  {"destination_mac_address", macAddressType},
+	81:  {"post_source_mac_address", macAddressType},
+	82:  {"interface_name", stringType},
+	83:  {"interface_description", stringType},
+	85:  {"octet_total_count", unsignedType},
+	86:  {"packet_total_count", unsignedType},
+	88:  {"fragment_offset", unsignedType},
+	89:  {"forwarding_status", unsignedType},
+	95:  {"application_id", octetArrayType},
+	96:  {"application_name", stringType},
+	130: {"exporter_ipv4_address", ipv4AddressType},
+	131: {"exporter_ipv6_address", ipv6AddressType},
+	136: {"flow_end_reason", unsignedType},
+	138: {"observation_point_id", unsignedType},
+	139: {"icmp_type_code_ipv6", unsignedType},
+	144: {"exporting_process_id", unsignedType},
+	148: {"flow_id", unsignedType},
+	149: {"observation_domain_id", unsignedType},
+	150: {"flow_start_seconds", dateTimeSecondsType},
+	151: {"flow_end_seconds", dateTimeSecondsType},
+	152: {"flow_start_milliseconds", dateTimeMillisecondsType},
+	153: {"flow_end_milliseconds", dateTimeMillisecondsType},
+	154: {"flow_start_microseconds", dateTimeMicrosecondsType},
+	155: {"flow_end_microseconds", dateTimeMicrosecondsType},
+	156: {"flow_start_nanoseconds", dateTimeNanosecondsType},
+	157: {"flow_end_nanoseconds", dateTimeNanosecondsType},
+	160: {"system_init_time_milliseconds", dateTimeMillisecondsType},
+	176: {"icmp_type_ipv4", unsignedType},
+	177: {"icmp_code_ipv4", unsignedType},
+	178: {"icmp_type_ipv6", unsignedType},
+	179: {"icmp_code_ipv6", unsignedType},
+	180: {"udp_source_port", unsignedType},
+	181: {"udp_destination_port", unsignedType},
+	182: {"tcp_source_port", unsignedType},
+	183: {"tcp_destination_port", unsignedType},
+	192: {"ip_ttl", unsignedType},
+	225: {"post_nat_source_ipv4_address", ipv4AddressType},
+	226: {"post_nat_destination_ipv4_address", ipv4AddressType},
+	227: {"post_napt_source_transport_port", unsignedType},
+	228: {"post_napt_destination_transport_port", unsignedType},
+	231: {"initiator_octets", unsignedType},
+	232: {"responder_octets", unsignedType},
+	233: {"firewall_event", unsignedType},
+	234: {"ingress_vrf_id", unsignedType},
+	235: {"egress_vrf_id", unsignedType},
+	239: {"biflow_direction", unsignedType},
+	256: {"ethernet_type", unsignedType},
+	298: {"initiator_packets", unsignedType},
+	299: {"responder_packets", unsignedType},
+	305: {"sampling_packet_interval", unsignedType},
+	306: {"sampling_packet_space", unsignedType},
+	322: {"observation_time_seconds", dateTimeSecondsType},
+	323: {"observation_time_milliseconds", dateTimeMillisecondsType},
+}
+
+// Information elements used when converting records to events.
+const (
+	octetDeltaCount             = 1
+	packetDeltaCount            = 2
+	protocolIdentifier          = 4
+	ipClassOfService            = 5
+	tcpControlBits              = 6
+	sourceTransportPort         = 7
+	sourceIPv4Address           = 8
+	sourceIPv4PrefixLength      = 9
+	ingressInterface            = 10
+	destinationTransportPort    = 11
+	destinationIPv4Address      = 12
+	destinationIPv4PrefixLength = 13
+	egressInterface             = 14
+	ipNextHopIPv4Address        = 15
+	bgpSourceAsNumber           = 16
+	bgpDestinationAsNumber      = 17
+	flowEndSysUpTime            = 21
+	flowStartSysUpTime          = 22
+	sourceIPv6Address           = 27
+	destinationIPv6Address      = 28
+	samplingInterval            = 34
+	engineType                  = 38
+	engineID                    = 39
+	samplerRandomInterval       = 50
+	flowDirection               = 61
+	flowStartSeconds            = 150
+	flowEndSeconds              = 151
+	flowStartMilliseconds       = 152
+	flowEndMilliseconds         = 153
+	flowStartMicroseconds       = 154
+	flowEndMicroseconds         = 155
+	flowStartNanoseconds        = 156
+	flowEndNanoseconds          = 157
+	samplingPacketInterval      = 305
+)
+
+// ntpEpochOffset is the number of seconds between the NTP epoch (1900) and the
+// Unix epoch (1970).
+const ntpEpochOffset = 2208988800
+
+// decode decodes a field value. ok is false if the length of the value is not
+// valid for the type of the field.
+func (f field) decode(b []byte) (v interface{}, ok bool) {
+	switch f.typ {
+	case unsignedType:
+		// Reduced size encoding may shorten unsigned values.
+		// https://tools.ietf.org/html/rfc7011#section-6.2
+		if len(b) == 0 || len(b) > 8 {
+			return nil, false
+		}
+		return decodeUnsigned(b), true
+	case macAddressType:
+		if len(b) != 6 {
+			return nil, false
+		}
+		return net.HardwareAddr(b).String(), true
+	case octetArrayType:
+		return hex.EncodeToString(b), true
+	case stringType:
+		return string(b), true
+	case dateTimeSecondsType:
+		if len(b) != 4 {
+			return nil, false
+		}
+		return time.Unix(int64(binary.BigEndian.Uint32(b)), 0).UTC(), true
+	case dateTimeMillisecondsType:
+		if len(b) != 8 {
+			return nil, false
+		}
+		ms := int64(binary.BigEndian.Uint64(b))
+		return time.Unix(ms/1000, (ms%1000)*int64(time.Millisecond)).UTC(), true
+	case dateTimeMicrosecondsType, dateTimeNanosecondsType:
+		// NTP timestamps, the fraction of the microseconds type has a
+		// resolution of 1/2^20 seconds, which is ignored here.
+		if len(b) != 8 {
+			return nil, false
+		}
+		sec := int64(binary.BigEndian.Uint32(b)) - ntpEpochOffset
+		frac := uint64(binary.BigEndian.Uint32(b[4:]))
+		return time.Unix(sec, int64((frac*uint64(time.Second))>>32)).UTC(), true
+	case ipv4AddressType:
+		if len(b) != net.IPv4len {
+			return nil, false
+		}
+		return net.IP(append([]byte(nil), b...)), true
+	case ipv6AddressType:
+		if len(b) != net.IPv6len {
+			return nil, false
+		}
+		return net.IP(append([]byte(nil), b...)), true
+	}
+	return nil, false
+}
+
+func decodeUnsigned(b []byte) uint64 {
+	var v uint64
+	for _, c := range b {
+		v = v<<8 | uint64(c)
+	}
+	return v
+}
@@ -0,0 +1,72 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package netflow
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFieldDecode(t *testing.T) {
+	for name, tc := range map[string]struct {
+		typ      fieldType
+		data     []byte
+		expected interface{}
+	}{
+		"unsigned":              {unsignedType, []byte{1, 0, 0, 0, 0, 0, 0, 1}, uint64(1<<56 + 1)},
+		"unsigned reduced size": {unsignedType, []byte{1, 2}, uint64(258)},
+		"mac address":           {macAddressType, []byte{0, 0x1b, 0x21, 0xaa, 0xbb, 0xcc}, "00:1b:21:aa:bb:cc"},
+		"octet array":           {octetArrayType, []byte{0xde, 0xad}, "dead"},
+		"string":                {stringType, []byte("eth0"), "eth0"},
+		"ipv4 address":          {ipv4AddressType, []byte{10, 1, 2, 3}, net.IP{10, 1, 2, 3}},
+		"ipv6 address":          {ipv6AddressType, net.ParseIP("2001:db8::1"), net.ParseIP("2001:db8::1")},
+		"seconds":               {dateTimeSecondsType, []byte{0x5b, 0xe1, 0x6d, 0x28}, time.Date(2018, 11, 6, 10, 30, 0, 0, time.UTC)},
+		"milliseconds":          {dateTimeMillisecondsType, []byte{0, 0, 1, 0x66, 0xe8, 0x92, 0x65, 0x6c}, time.Date(2018, 11, 6, 10, 30, 0, 300*int(time.Millisecond), time.UTC)},
+		"nanoseconds (ntp)":     {dateTimeNanosecondsType, []byte{0xdf, 0x8b, 0xeb, 0xa8, 0x80, 0, 0, 0}, time.Date(2018, 11, 6, 10, 30, 0, 500*int(time.Millisecond), time.UTC)},
+		"microseconds (ntp)":    {dateTimeMicrosecondsType, []byte{0xdf, 0x8b, 0xeb, 0xa8, 0x40, 0, 0, 0}, time.Date(2018, 11, 6, 10, 30, 0, 250*int(time.Millisecond), time.UTC)},
+	} {
+		v, ok := field{typ: tc.typ}.decode(tc.data)
+		if assert.True(t, ok, name) {
+			assert.Equal(t, tc.expected, v, name)
+		}
+	}
+}
+
+func TestFieldDecodeInvalidLength(t *testing.T) {
+	for name, tc := range map[string]struct {
+		typ  fieldType
+		data []byte
+	}{
+		"unsigned too long":  {unsignedType, make([]byte, 9)},
+		"unsigned empty":     {unsignedType, nil},
+		"short mac address":  {macAddressType, make([]byte, 4)},
+		"short ipv4 address": {ipv4AddressType, make([]byte, 3)},
+		"ipv6 as ipv4":       {ipv6AddressType, make([]byte, 4)},
+		"short seconds":      {dateTimeSecondsType, make([]byte, 2)},
+		"short milliseconds": {dateTimeMillisecondsType, make([]byte, 4)},
+		"short nanoseconds":  {dateTimeNanosecondsType, make([]byte, 4)},
+	} {
+		_, ok := field{typ: tc.typ}.decode(tc.data)
+		assert.False(t, ok, name)
+	}
+}
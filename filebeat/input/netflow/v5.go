@@ -0,0 +1,87 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package netflow
+
+import (
+	"encoding/binary"
+	"net"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// decodeV5 decodes a NetFlow v5 packet. Its records have a fixed format that
+// is mapped to the equivalent information elements.
+// https://www.cisco.com/c/en/us/td/docs/net_mgmt/netflow_collection_engine/3-6/user/guide/format.html
+func decodeV5(data []byte, address string) ([]*flow, error) {
+	if len(data) < v5HeaderLength {
+		return nil, errors.New("NetFlow v5 packet too short")
+	}
+
+	count := int(binary.BigEndian.Uint16(data[2:]))
+	if len(data) < v5HeaderLength+count*v5RecordLength {
+		return nil, errors.Errorf("NetFlow v5 packet too short for %d records", count)
+	}
+
+	engType, engID := data[20], data[21]
+	exp := exporter{
+		address: address,
+		version: versionNetflowV5,
+		domain:  uint32(engType)<<8 | uint32(engID),
+		uptime:  binary.BigEndian.Uint32(data[4:]),
+		timestamp: time.Unix(
+			int64(binary.BigEndian.Uint32(data[8:])),
+			int64(binary.BigEndian.Uint32(data[12:])),
+		).UTC(),
+	}
+
+	// The two most significant bits hold the sampling mode.
+	rate := uint64(binary.BigEndian.Uint16(data[22:]) & 0x3fff)
+
+	flows := make([]*flow, 0, count)
+	for i := 0; i < count; i++ {
+		r := data[v5HeaderLength+i*v5RecordLength:]
+		values := map[uint16]interface{}{
+			sourceIPv4Address:           net.IP(append([]byte(nil), r[0:4]...)),
+			destinationIPv4Address:      net.IP(append([]byte(nil), r[4:8]...)),
+			ipNextHopIPv4Address:        net.IP(append([]byte(nil), r[8:12]...)),
+			ingressInterface:            uint64(binary.BigEndian.Uint16(r[12:])),
+			egressInterface:             uint64(binary.BigEndian.Uint16(r[14:])),
+			packetDeltaCount:            uint64(binary.BigEndian.Uint32(r[16:])),
+			octetDeltaCount:             uint64(binary.BigEndian.Uint32(r[20:])),
+			flowStartSysUpTime:          uint64(binary.BigEndian.Uint32(r[24:])),
+			flowEndSysUpTime:            uint64(binary.BigEndian.Uint32(r[28:])),
+			sourceTransportPort:         uint64(binary.BigEndian.Uint16(r[32:])),
+			destinationTransportPort:    uint64(binary.BigEndian.Uint16(r[34:])),
+			tcpControlBits:              uint64(r[37]),
+			protocolIdentifier:          uint64(r[38]),
+			ipClassOfService:            uint64(r[39]),
+			bgpSourceAsNumber:           uint64(binary.BigEndian.Uint16(r[40:])),
+			bgpDestinationAsNumber:      uint64(binary.BigEndian.Uint16(r[42:])),
+			sourceIPv4PrefixLength:      uint64(r[44]),
+			destinationIPv4PrefixLength: uint64(r[45]),
+			engineType:                  uint64(engType),
+			engineID:                    uint64(engID),
+		}
+		if rate > 0 {
+			values[samplingInterval] = rate
+		}
+		flows = append(flows, &flow{exporter: exp, values: values, samplingRate: rate})
+	}
+	return flows, nil
+}
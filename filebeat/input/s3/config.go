@@ -0,0 +1,144 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package s3
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+)
+
+type config struct {
+	QueueURL            string        `config:"queue_url" validate:"required"`
+	Region              string        `config:"region"`
+	S3Endpoint          string        `config:"s3_endpoint"`
+	AccessKeyID         string        `config:"access_key_id"`
+	SecretAccessKey     string        `config:"secret_access_key"`
+	SessionToken        string        `config:"session_token"`
+	VisibilityTimeout   time.Duration `config:"visibility_timeout"`
+	WaitTime            time.Duration `config:"wait_time"`
+	MaxNumberOfMessages int           `config:"max_number_of_messages"`
+	Backoff             time.Duration `config:"backoff" validate:"min=0"`
+	Decoding            decoding      `config:"decoding"`
+	JSON                jsonConfig    `config:"json"`
+}
+
+type jsonConfig struct {
+	// ExpandField names the field holding the list of events in JSON
+	// objects, e.g. `Records` for CloudTrail logs.
+	ExpandField string `config:"expand_event_list_from_field"`
+}
+
+// decoding selects how objects are split into events.
+type decoding uint8
+
+const (
+	// decodingAuto decodes objects with a JSON content type or extension as
+	// JSON, all other objects as lines.
+	decodingAuto decoding = iota
+	decodingLines
+	decodingJSON
+)
+
+var decodings = map[string]decoding{
+	"auto":  decodingAuto,
+	"lines": decodingLines,
+	"json":  decodingJSON,
+}
+
+// Unpack validates and unpacks the decoding setting.
+func (d *decoding) Unpack(s string) error {
+	v, ok := decodings[strings.ToLower(s)]
+	if !ok {
+		return fmt.Errorf("invalid decoding '%v' (must be auto, lines or json)", s)
+	}
+	*d = v
+	return nil
+}
+
+var defaultConfig = config{
+	VisibilityTimeout:   300 * time.Second,
+	WaitTime:            20 * time.Second,
+	MaxNumberOfMessages: 5,
+	Backoff:             10 * time.Second,
+}
+
+const (
+	// Limits of the SQS API.
+	maxVisibilityTimeout   = 12 * time.Hour
+	maxWaitTime            = 20 * time.Second
+	maxNumberOfMessagesSQS = 10
+)
+
+func (c *config) Validate() error {
+	if _, err := url.Parse(c.QueueURL); err != nil {
+		return fmt.Errorf("invalid queue_url: %v", err)
+	}
+
+	if c.VisibilityTimeout < time.Second || c.VisibilityTimeout > maxVisibilityTimeout {
+		return fmt.Errorf("visibility_timeout %v must be between 1s and %v", c.VisibilityTimeout, maxVisibilityTimeout)
+	}
+
+	if c.WaitTime < 0 || c.WaitTime > maxWaitTime {
+		return fmt.Errorf("wait_time %v must be between 0s and %v", c.WaitTime, maxWaitTime)
+	}
+
+	if c.MaxNumberOfMessages < 1 || c.MaxNumberOfMessages > maxNumberOfMessagesSQS {
+		return fmt.Errorf("max_number_of_messages %v must be between 1 and %v", c.MaxNumberOfMessages, maxNumberOfMessagesSQS)
+	}
+
+	return nil
+}
+
+// regionFromQueueURL returns the region of queue URLs of the form
+// https://sqs.<region>.amazonaws.com/<account>/<queue>.
+func regionFromQueueURL(queueURL string) string {
+	u, err := url.Parse(queueURL)
+	if err != nil {
+		return ""
+	}
+
+	parts := strings.Split(u.Hostname(), ".")
+	if len(parts) >= 4 && parts[0] == "sqs" && parts[2] == "amazonaws" {
+		return parts[1]
+	}
+	return ""
+}
+
+// newCredentials returns the configured credentials, falling back to the
+// standard AWS environment variables.
+func newCredentials(c *config) (credentials, error) {
+	creds := credentials{
+		accessKeyID:     c.AccessKeyID,
+		secretAccessKey: c.SecretAccessKey,
+		sessionToken:    c.SessionToken,
+	}
+	if creds.accessKeyID == "" && creds.secretAccessKey == "" {
+		creds.accessKeyID = os.Getenv("AWS_ACCESS_KEY_ID")
+		creds.secretAccessKey = os.Getenv("AWS_SECRET_ACCESS_KEY")
+		creds.sessionToken = os.Getenv("AWS_SESSION_TOKEN")
+	}
+
+	if creds.accessKeyID == "" || creds.secretAccessKey == "" {
+		return credentials{}, errors.New("access_key_id and secret_access_key are required, either in the configuration or the AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY environment variables")
+	}
+	return creds, nil
+}
@@ -0,0 +1,109 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package s3
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/libbeat/common"
+)
+
+func TestConfigValidate(t *testing.T) {
+	tests := map[string]struct {
+		settings map[string]interface{}
+		valid    bool
+	}{
+		"defaults":                {map[string]interface{}{}, true},
+		"missing queue_url":       {map[string]interface{}{"queue_url": nil}, false},
+		"visibility_timeout zero": {map[string]interface{}{"visibility_timeout": 0}, false},
+		"wait_time too long":      {map[string]interface{}{"wait_time": "21s"}, false},
+		"too many messages":       {map[string]interface{}{"max_number_of_messages": 11}, false},
+		"json decoding":           {map[string]interface{}{"decoding": "json"}, true},
+		"invalid decoding":        {map[string]interface{}{"decoding": "xml"}, false},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			settings := map[string]interface{}{
+				"queue_url": "https://sqs.eu-west-1.amazonaws.com/123456789012/logs",
+			}
+			for k, v := range test.settings {
+				if v == nil {
+					delete(settings, k)
+				} else {
+					settings[k] = v
+				}
+			}
+
+			config := defaultConfig
+			err := common.MustNewConfigFrom(settings).Unpack(&config)
+			if test.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestConfigDefaults(t *testing.T) {
+	config := defaultConfig
+	err := common.MustNewConfigFrom(map[string]interface{}{
+		"queue_url": "https://sqs.eu-west-1.amazonaws.com/123456789012/logs",
+	}).Unpack(&config)
+	require.NoError(t, err)
+
+	assert.Equal(t, 300*time.Second, config.VisibilityTimeout)
+	assert.Equal(t, 20*time.Second, config.WaitTime)
+	assert.Equal(t, 5, config.MaxNumberOfMessages)
+	assert.Equal(t, decodingAuto, config.Decoding)
+}
+
+func TestRegionFromQueueURL(t *testing.T) {
+	assert.Equal(t, "eu-west-1", regionFromQueueURL("https://sqs.eu-west-1.amazonaws.com/123456789012/logs"))
+	assert.Equal(t, "", regionFromQueueURL("http://localhost:4576/queue/logs"))
+}
+
+func TestNewCredentialsFromEnv(t *testing.T) {
+	for k, v := range map[string]string{
+		"AWS_ACCESS_KEY_ID":     "env-key",
+		"AWS_SECRET_ACCESS_KEY": "env-secret",
+		"AWS_SESSION_TOKEN":     "",
+	} {
+		old, found := os.LookupEnv(k)
+		os.Setenv(k, v)
+		if found {
+			defer os.Setenv(k, old)
+		} else {
+			defer os.Unsetenv(k)
+		}
+	}
+
+	creds, err := newCredentials(&config{})
+	require.NoError(t, err)
+	assert.Equal(t, credentials{accessKeyID: "env-key", secretAccessKey: "env-secret"}, creds)
+
+	creds, err = newCredentials(&config{AccessKeyID: "key", SecretAccessKey: "secret"})
+	require.NoError(t, err)
+	assert.Equal(t, credentials{accessKeyID: "key", secretAccessKey: "secret"}, creds)
+}
@@ -0,0 +1,158 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package s3
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// messageFunc is called for every event read from an object. offset is the
+// offset of the line in the uncompressed object, or -1 for JSON objects.
+// Reading stops if it returns false.
+type messageFunc func(message string, offset int64) bool
+
+// objectDecoder splits objects into messages.
+type objectDecoder struct {
+	decoding    decoding
+	expandField string
+}
+
+// decode reads the object, uncompressing it if needed. It returns false if
+// reading was stopped by fn.
+func (d *objectDecoder) decode(key string, obj *s3Object, fn messageFunc) (bool, error) {
+	r := bufio.NewReader(obj.body)
+
+	// Objects are often stored compressed without Content-Encoding header.
+	if magic, err := r.Peek(2); err == nil && magic[0] == 0x1f && magic[1] == 0x8b {
+		gz, err := gzip.NewReader(r)
+		if err != nil {
+			return true, fmt.Errorf("opening gzip stream: %v", err)
+		}
+		defer gz.Close()
+		r = bufio.NewReader(gz)
+	}
+
+	if d.isJSON(key, obj) {
+		return d.decodeJSON(r, fn)
+	}
+	return decodeLines(r, fn)
+}
+
+func (d *objectDecoder) isJSON(key string, obj *s3Object) bool {
+	switch d.decoding {
+	case decodingJSON:
+		return true
+	case decodingLines:
+		return false
+	}
+
+	key = strings.TrimSuffix(key, ".gz")
+	return strings.Contains(obj.contentType, "json") || strings.HasSuffix(key, ".json")
+}
+
+// decodeLines emits every non empty line.
+func decodeLines(r *bufio.Reader, fn messageFunc) (bool, error) {
+	var offset int64
+	for {
+		line, err := r.ReadBytes('\n')
+		if len(line) > 0 {
+			lineOffset := offset
+			offset += int64(len(line))
+
+			line = bytes.TrimRight(line, "\r\n")
+			if len(line) > 0 && !fn(string(line), lineOffset) {
+				return false, nil
+			}
+		}
+
+		if err == io.EOF {
+			return true, nil
+		}
+		if err != nil {
+			return true, err
+		}
+	}
+}
+
+// decodeJSON reads a stream of JSON values. Arrays are expanded into their
+// elements, objects holding the list of events in expandField are expanded
+// into the list elements.
+func (d *objectDecoder) decodeJSON(r io.Reader, fn messageFunc) (bool, error) {
+	dec := json.NewDecoder(r)
+	for {
+		var raw json.RawMessage
+		err := dec.Decode(&raw)
+		if err == io.EOF {
+			return true, nil
+		}
+		if err != nil {
+			return true, fmt.Errorf("decoding JSON: %v", err)
+		}
+
+		events, err := d.expand(raw)
+		if err != nil {
+			return true, err
+		}
+		for _, event := range events {
+			if !fn(string(event), -1) {
+				return false, nil
+			}
+		}
+	}
+}
+
+func (d *objectDecoder) expand(raw json.RawMessage) ([]json.RawMessage, error) {
+	trimmed := bytes.TrimSpace(raw)
+	if len(trimmed) == 0 {
+		return nil, nil
+	}
+
+	switch trimmed[0] {
+	case '[':
+		var list []json.RawMessage
+		if err := json.Unmarshal(trimmed, &list); err != nil {
+			return nil, fmt.Errorf("decoding JSON array: %v", err)
+		}
+		return list, nil
+	case '{':
+		if d.expandField == "" {
+			return []json.RawMessage{trimmed}, nil
+		}
+		var obj map[string]json.RawMessage
+		if err := json.Unmarshal(trimmed, &obj); err != nil {
+			return nil, fmt.Errorf("decoding JSON object: %v", err)
+		}
+		field, ok := obj[d.expandField]
+		if !ok {
+			return []json.RawMessage{trimmed}, nil
+		}
+		var list []json.RawMessage
+		if err := json.Unmarshal(field, &list); err != nil {
+			return nil, fmt.Errorf("field '%v' is not a list: %v", d.expandField, err)
+		}
+		return list, nil
+	default:
+		return []json.RawMessage{trimmed}, nil
+	}
+}
@@ -0,0 +1,142 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package s3
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type decodedMessage struct {
+	message string
+	offset  int64
+}
+
+func decodeAll(t *testing.T, d objectDecoder, key, contentType string, content []byte) []decodedMessage {
+	obj := &s3Object{
+		body:        ioutil.NopCloser(bytes.NewReader(content)),
+		contentType: contentType,
+	}
+
+	var messages []decodedMessage
+	completed, err := d.decode(key, obj, func(message string, offset int64) bool {
+		messages = append(messages, decodedMessage{message, offset})
+		return true
+	})
+	require.NoError(t, err)
+	assert.True(t, completed)
+	return messages
+}
+
+func gzipped(t *testing.T, content string) []byte {
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	_, err := w.Write([]byte(content))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	return buf.Bytes()
+}
+
+func TestDecodeLines(t *testing.T) {
+	content := "first\r\n\nsecond\nthird"
+	expected := []decodedMessage{{"first", 0}, {"second", 8}, {"third", 15}}
+
+	d := objectDecoder{}
+	assert.Equal(t, expected, decodeAll(t, d, "a.log", "text/plain", []byte(content)))
+	assert.Equal(t, expected, decodeAll(t, d, "a.log.gz", "", gzipped(t, content)))
+}
+
+func TestDecodeJSON(t *testing.T) {
+	tests := map[string]struct {
+		decoder  objectDecoder
+		key      string
+		content  string
+		expected []string
+	}{
+		"ndjson": {
+			key:      "a.json",
+			content:  "{\"a\": 1}\n{\"a\": 2}\n",
+			expected: []string{`{"a": 1}`, `{"a": 2}`},
+		},
+		"array": {
+			key:      "a.json",
+			content:  `[{"a": 1}, {"a": 2}]`,
+			expected: []string{`{"a": 1}`, `{"a": 2}`},
+		},
+		"expand field": {
+			decoder:  objectDecoder{expandField: "Records"},
+			key:      "a.json.gz",
+			content:  `{"Records": [{"a": 1}, {"a": 2}]}`,
+			expected: []string{`{"a": 1}`, `{"a": 2}`},
+		},
+		"missing expand field": {
+			decoder:  objectDecoder{expandField: "Records"},
+			key:      "a.json",
+			content:  `{"a": 1}`,
+			expected: []string{`{"a": 1}`},
+		},
+		"forced decoding": {
+			decoder:  objectDecoder{decoding: decodingJSON},
+			key:      "a.log",
+			content:  `{"a": 1}`,
+			expected: []string{`{"a": 1}`},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var expected []decodedMessage
+			for _, message := range test.expected {
+				expected = append(expected, decodedMessage{message, -1})
+			}
+
+			content := []byte(test.content)
+			if strings.HasSuffix(test.key, ".gz") {
+				content = gzipped(t, test.content)
+			}
+			assert.Equal(t, expected, decodeAll(t, test.decoder, test.key, "", content))
+		})
+	}
+}
+
+func TestDecodeJSONContentType(t *testing.T) {
+	messages := decodeAll(t, objectDecoder{}, "events", "application/json", []byte(`[1, 2]`))
+	assert.Equal(t, []decodedMessage{{"1", -1}, {"2", -1}}, messages)
+
+	messages = decodeAll(t, objectDecoder{decoding: decodingLines}, "events.json", "application/json", []byte(`[1, 2]`))
+	assert.Equal(t, []decodedMessage{{"[1, 2]", 0}}, messages)
+}
+
+func TestDecodeStop(t *testing.T) {
+	obj := &s3Object{body: ioutil.NopCloser(strings.NewReader("a\nb\nc\n"))}
+
+	var messages []string
+	completed, err := (&objectDecoder{}).decode("a.log", obj, func(message string, _ int64) bool {
+		messages = append(messages, message)
+		return len(messages) < 2
+	})
+	require.NoError(t, err)
+	assert.False(t, completed)
+	assert.Equal(t, []string{"a", "b"}, messages)
+}
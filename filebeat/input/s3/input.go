@@ -0,0 +1,336 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package s3
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/elastic/beats/filebeat/channel"
+	"github.com/elastic/beats/filebeat/input"
+	"github.com/elastic/beats/filebeat/util"
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/common/cfgwarn"
+	"github.com/elastic/beats/libbeat/logp"
+)
+
+const inputName = "s3"
+
+var errStopped = errors.New("input stopped")
+
+func init() {
+	err := input.Register(inputName, NewInput)
+	if err != nil {
+		panic(err)
+	}
+}
+
+// Input reads the S3 objects announced by S3 event notifications sent to an
+// SQS queue.
+type Input struct {
+	config  config
+	sqs     *sqsClient
+	s3      *s3Client
+	decoder *objectDecoder
+	outlet  channel.Outleter
+	log     *logp.Logger
+
+	runOnce sync.Once
+	ctx     context.Context
+	cancel  context.CancelFunc
+	wg      sync.WaitGroup
+}
+
+// NewInput creates a new s3 input
+func NewInput(
+	cfg *common.Config,
+	outlet channel.Connector,
+	context input.Context,
+) (input.Input, error) {
+	cfgwarn.Experimental("S3 input type is used")
+
+	config := defaultConfig
+	if err := cfg.Unpack(&config); err != nil {
+		return nil, err
+	}
+
+	out, err := outlet(cfg, context.DynamicFields)
+	if err != nil {
+		return nil, err
+	}
+
+	return newInput(config, out)
+}
+
+func newInput(config config, out channel.Outleter) (*Input, error) {
+	creds, err := newCredentials(&config)
+	if err != nil {
+		return nil, err
+	}
+
+	if config.Region == "" {
+		config.Region = regionFromQueueURL(config.QueueURL)
+		if config.Region == "" {
+			return nil, fmt.Errorf("region is required, it cannot be derived from queue_url %v", config.QueueURL)
+		}
+	}
+
+	// Requests are canceled through their context, downloads can take longer
+	// than any fixed timeout.
+	client := &http.Client{}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Input{
+		config:  config,
+		sqs:     newSQSClient(config.QueueURL, creds, config.Region, client),
+		s3:      &s3Client{endpoint: config.S3Endpoint, credentials: creds, client: client},
+		decoder: &objectDecoder{decoding: config.Decoding, expandField: config.JSON.ExpandField},
+		outlet:  out,
+		log:     logp.NewLogger("s3 input").With("queue_url", config.QueueURL),
+		ctx:     ctx,
+		cancel:  cancel,
+	}, nil
+}
+
+// Run starts polling the SQS queue.
+func (p *Input) Run() {
+	p.runOnce.Do(func() {
+		p.log.Info("Starting S3 input")
+		p.wg.Add(1)
+		go func() {
+			defer p.wg.Done()
+			p.run()
+		}()
+	})
+}
+
+// Stop stops the s3 input. Messages not yet deleted become visible in the
+// queue again after their visibility timeout.
+func (p *Input) Stop() {
+	defer p.outlet.Close()
+
+	p.log.Info("Stopping S3 input")
+	p.cancel()
+	p.wg.Wait()
+}
+
+// Wait stops the s3 input.
+func (p *Input) Wait() {
+	p.Stop()
+}
+
+func (p *Input) run() {
+	for p.ctx.Err() == nil {
+		msgs, err := p.sqs.receiveMessages(p.ctx, p.config.MaxNumberOfMessages, p.config.VisibilityTimeout, p.config.WaitTime)
+		if err != nil {
+			if p.ctx.Err() != nil {
+				return
+			}
+			p.log.Errorf("Failed to receive SQS messages, retrying in %v: %v", p.config.Backoff, err)
+			p.wait(p.config.Backoff)
+			continue
+		}
+
+		var wg sync.WaitGroup
+		for i := range msgs {
+			wg.Add(1)
+			go func(msg *sqsMessage) {
+				defer wg.Done()
+				p.handleMessage(msg)
+			}(&msgs[i])
+		}
+		wg.Wait()
+	}
+}
+
+// wait returns false if the input has been stopped before the duration
+// passed.
+func (p *Input) wait(d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return true
+	case <-p.ctx.Done():
+		return false
+	}
+}
+
+// handleMessage publishes the content of all objects of the message and
+// deletes it once all events have been acknowledged. On failure the message
+// is kept, to be received and processed again after its visibility timeout.
+func (p *Input) handleMessage(msg *sqsMessage) {
+	stopKeepalive := make(chan struct{})
+	defer close(stopKeepalive)
+	go p.keepalive(msg, stopKeepalive)
+
+	ack := newMessageACK()
+	err := p.processMessage(msg, ack)
+	ack.ACK()
+	if err == errStopped {
+		return
+	}
+	if err != nil {
+		p.log.Errorw("Failed to process SQS message, it will be retried after the visibility timeout",
+			"message_id", msg.MessageID, "error", err)
+		return
+	}
+
+	select {
+	case <-ack.done:
+	case <-p.ctx.Done():
+		return
+	}
+
+	if err := p.sqs.deleteMessage(p.ctx, msg); err != nil {
+		p.log.Errorw("Failed to delete SQS message", "message_id", msg.MessageID, "error", err)
+	}
+}
+
+// keepalive extends the visibility timeout of the message until stop is
+// closed, so long running downloads are not processed twice.
+func (p *Input) keepalive(msg *sqsMessage, stop <-chan struct{}) {
+	ticker := time.NewTicker(p.config.VisibilityTimeout / 2)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stop:
+			return
+		case <-p.ctx.Done():
+			return
+		case <-ticker.C:
+			err := p.sqs.changeMessageVisibility(p.ctx, msg, p.config.VisibilityTimeout)
+			if err != nil {
+				p.log.Warnw("Failed to extend SQS message visibility timeout",
+					"message_id", msg.MessageID, "error", err)
+			}
+		}
+	}
+}
+
+func (p *Input) processMessage(msg *sqsMessage, ack *messageACK) error {
+	objects, err := parseNotification(msg.Body)
+	if err != nil {
+		return err
+	}
+
+	for _, info := range objects {
+		if err := p.processObject(info, ack); err != nil {
+			if err == errStopped {
+				return err
+			}
+			return fmt.Errorf("reading s3://%v/%v: %v", info.bucket, info.key, err)
+		}
+	}
+	return nil
+}
+
+func (p *Input) processObject(info s3Info, ack *messageACK) error {
+	region := info.region
+	if region == "" {
+		region = p.config.Region
+	}
+
+	obj, err := p.s3.getObject(p.ctx, region, info.bucket, info.key)
+	if err != nil {
+		return err
+	}
+	defer obj.body.Close()
+
+	completed, err := p.decoder.decode(info.key, obj, func(message string, offset int64) bool {
+		data := makeEvent(info, message, offset)
+		data.Event.Private = ack
+
+		ack.add()
+		return p.outlet.OnEvent(data)
+	})
+	if !completed || p.ctx.Err() != nil {
+		return errStopped
+	}
+	return err
+}
+
+func makeEvent(info s3Info, message string, offset int64) *util.Data {
+	fields := common.MapStr{
+		"message": message,
+		"log": common.MapStr{
+			"file": common.MapStr{
+				"path": "s3://" + info.bucket + "/" + info.key,
+			},
+		},
+		"aws": common.MapStr{
+			"s3": common.MapStr{
+				"bucket": common.MapStr{
+					"name": info.bucket,
+					"arn":  info.arn,
+				},
+				"object": common.MapStr{
+					"key": info.key,
+				},
+			},
+		},
+	}
+	if offset >= 0 {
+		fields["offset"] = offset
+	}
+
+	data := util.NewData()
+	data.Event = beat.Event{
+		Timestamp: time.Now(),
+		Fields:    fields,
+	}
+	return data
+}
+
+// messageACK counts the events of a SQS message not yet acknowledged. It is
+// stored as event private data and notified by the filebeat ACK handler.
+type messageACK struct {
+	mutex   sync.Mutex
+	pending int
+	done    chan struct{}
+}
+
+// newMessageACK creates a messageACK with one pending acknowledgement,
+// released once all events of the message have been published.
+func newMessageACK() *messageACK {
+	return &messageACK{pending: 1, done: make(chan struct{})}
+}
+
+func (a *messageACK) add() {
+	a.mutex.Lock()
+	a.pending++
+	a.mutex.Unlock()
+}
+
+// ACK marks one event as acknowledged.
+func (a *messageACK) ACK() {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	a.pending--
+	if a.pending == 0 {
+		close(a.done)
+	}
+}
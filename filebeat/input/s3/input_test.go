@@ -0,0 +1,167 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package s3
+
+import (
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/filebeat/util"
+	"github.com/elastic/beats/libbeat/common"
+)
+
+// fakeAWS serves a single SQS message announcing one S3 object.
+type fakeAWS struct {
+	mutex    sync.Mutex
+	received bool
+	deleted  chan string
+	content  string
+}
+
+func (f *fakeAWS) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 ") {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	if r.Method == "GET" {
+		if r.URL.Path != "/logs/2019/01/access log:1.gz" {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `<Error><Code>NoSuchKey</Code><Message>not found</Message></Error>`)
+			return
+		}
+		fmt.Fprint(w, f.content)
+		return
+	}
+
+	r.ParseForm()
+	switch r.Form.Get("Action") {
+	case "ReceiveMessage":
+		f.mutex.Lock()
+		first := !f.received
+		f.received = true
+		f.mutex.Unlock()
+
+		type message struct {
+			MessageId     string
+			ReceiptHandle string
+			Body          string
+		}
+		var resp struct {
+			XMLName  xml.Name  `xml:"ReceiveMessageResponse"`
+			Messages []message `xml:"ReceiveMessageResult>Message"`
+		}
+		if first {
+			resp.Messages = append(resp.Messages, message{"1", "handle-1", testNotification})
+		} else {
+			time.Sleep(10 * time.Millisecond)
+		}
+		xml.NewEncoder(w).Encode(resp)
+	case "DeleteMessage":
+		f.deleted <- r.Form.Get("ReceiptHandle")
+		fmt.Fprint(w, `<DeleteMessageResponse></DeleteMessageResponse>`)
+	default:
+		w.WriteHeader(http.StatusBadRequest)
+	}
+}
+
+// ackOutlet collects published events and acknowledges them when ack is
+// called.
+type ackOutlet struct {
+	mutex  sync.Mutex
+	events []*util.Data
+}
+
+func (o *ackOutlet) Close() error { return nil }
+
+func (o *ackOutlet) OnEvent(data *util.Data) bool {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+	o.events = append(o.events, data)
+	return true
+}
+
+func (o *ackOutlet) published() []*util.Data {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+	return append([]*util.Data(nil), o.events...)
+}
+
+func TestInputDeletesMessageAfterACK(t *testing.T) {
+	aws := &fakeAWS{deleted: make(chan string, 1), content: "line 1\nline 2\n"}
+	server := httptest.NewServer(aws)
+	defer server.Close()
+
+	config := defaultConfig
+	config.QueueURL = server.URL + "/123456789012/logs"
+	config.Region = "eu-west-1"
+	config.S3Endpoint = server.URL
+	config.AccessKeyID = "key"
+	config.SecretAccessKey = "secret"
+	config.WaitTime = 0
+
+	outlet := &ackOutlet{}
+	input, err := newInput(config, outlet)
+	require.NoError(t, err)
+	input.Run()
+	defer input.Stop()
+
+	var events []*util.Data
+	for i := 0; i < 100 && len(events) < 2; i++ {
+		time.Sleep(10 * time.Millisecond)
+		events = outlet.published()
+	}
+	require.Len(t, events, 2)
+
+	for i, data := range events {
+		message, _ := data.Event.Fields.GetValue("message")
+		assert.Equal(t, fmt.Sprintf("line %d", i+1), message)
+		path, _ := data.Event.Fields.GetValue("log.file.path")
+		assert.Equal(t, "s3://logs/2019/01/access log:1.gz", path)
+		key, _ := data.Event.Fields.GetValue("aws.s3.object.key")
+		assert.Equal(t, "2019/01/access log:1.gz", key)
+	}
+	assert.Equal(t, common.MapStr{"name": "logs", "arn": "arn:aws:s3:::logs"},
+		events[0].Event.Fields["aws"].(common.MapStr)["s3"].(common.MapStr)["bucket"])
+
+	select {
+	case <-aws.deleted:
+		t.Fatal("message deleted before its events are acknowledged")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	for _, data := range events {
+		data.Event.Private.(*messageACK).ACK()
+	}
+
+	select {
+	case handle := <-aws.deleted:
+		assert.Equal(t, "handle-1", handle)
+	case <-time.After(5 * time.Second):
+		t.Fatal("message not deleted")
+	}
+}
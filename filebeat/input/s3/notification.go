@@ -0,0 +1,98 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package s3
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// s3Info identifies an object to be read.
+type s3Info struct {
+	region string
+	bucket string
+	arn    string
+	key    string
+}
+
+type s3EventNotification struct {
+	Records []s3EventRecord `json:"Records"`
+}
+
+type s3EventRecord struct {
+	AWSRegion   string `json:"awsRegion"`
+	EventSource string `json:"eventSource"`
+	EventName   string `json:"eventName"`
+	S3          struct {
+		Bucket struct {
+			Name string `json:"name"`
+			ARN  string `json:"arn"`
+		} `json:"bucket"`
+		Object struct {
+			Key string `json:"key"`
+		} `json:"object"`
+	} `json:"s3"`
+}
+
+// snsNotification wraps S3 notifications delivered to the queue through an
+// SNS topic.
+type snsNotification struct {
+	Type    string `json:"Type"`
+	Message string `json:"Message"`
+}
+
+// parseNotification returns the objects created according to the S3 event
+// notification in the SQS message body. Other events, like the test event
+// sent when configuring notifications, contain no objects.
+func parseNotification(body string) ([]s3Info, error) {
+	var sns snsNotification
+	if err := json.Unmarshal([]byte(body), &sns); err != nil {
+		return nil, fmt.Errorf("decoding SQS message body: %v", err)
+	}
+	if sns.Type == "Notification" && sns.Message != "" {
+		body = sns.Message
+	}
+
+	var notification s3EventNotification
+	if err := json.Unmarshal([]byte(body), &notification); err != nil {
+		return nil, fmt.Errorf("decoding S3 event notification: %v", err)
+	}
+
+	var objects []s3Info
+	for _, record := range notification.Records {
+		if record.EventSource != "aws:s3" || !strings.HasPrefix(record.EventName, "ObjectCreated:") {
+			continue
+		}
+
+		// Keys are URL encoded in notifications
+		key, err := url.QueryUnescape(record.S3.Object.Key)
+		if err != nil {
+			return nil, fmt.Errorf("decoding object key '%v': %v", record.S3.Object.Key, err)
+		}
+
+		objects = append(objects, s3Info{
+			region: record.AWSRegion,
+			bucket: record.S3.Bucket.Name,
+			arn:    record.S3.Bucket.ARN,
+			key:    key,
+		})
+	}
+	return objects, nil
+}
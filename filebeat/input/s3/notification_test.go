@@ -0,0 +1,88 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package s3
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testNotification = `{
+  "Records": [
+    {
+      "eventVersion": "2.1",
+      "eventSource": "aws:s3",
+      "awsRegion": "eu-west-1",
+      "eventName": "ObjectCreated:Put",
+      "s3": {
+        "bucket": {"name": "logs", "arn": "arn:aws:s3:::logs"},
+        "object": {"key": "2019/01/access+log%3A1.gz", "size": 1024}
+      }
+    },
+    {
+      "eventVersion": "2.1",
+      "eventSource": "aws:s3",
+      "awsRegion": "eu-west-1",
+      "eventName": "ObjectRemoved:Delete",
+      "s3": {
+        "bucket": {"name": "logs", "arn": "arn:aws:s3:::logs"},
+        "object": {"key": "old.log"}
+      }
+    }
+  ]
+}`
+
+var testObject = s3Info{
+	region: "eu-west-1",
+	bucket: "logs",
+	arn:    "arn:aws:s3:::logs",
+	key:    "2019/01/access log:1.gz",
+}
+
+func TestParseNotification(t *testing.T) {
+	objects, err := parseNotification(testNotification)
+	require.NoError(t, err)
+	assert.Equal(t, []s3Info{testObject}, objects)
+}
+
+func TestParseNotificationSNS(t *testing.T) {
+	body, err := json.Marshal(map[string]string{
+		"Type":     "Notification",
+		"TopicArn": "arn:aws:sns:eu-west-1:123456789012:logs",
+		"Message":  testNotification,
+	})
+	require.NoError(t, err)
+
+	objects, err := parseNotification(string(body))
+	require.NoError(t, err)
+	assert.Equal(t, []s3Info{testObject}, objects)
+}
+
+func TestParseNotificationTestEvent(t *testing.T) {
+	objects, err := parseNotification(`{"Service": "Amazon S3", "Event": "s3:TestEvent", "Bucket": "logs"}`)
+	require.NoError(t, err)
+	assert.Empty(t, objects)
+}
+
+func TestParseNotificationInvalid(t *testing.T) {
+	_, err := parseNotification(`not json`)
+	assert.Error(t, err)
+}
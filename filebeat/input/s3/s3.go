@@ -0,0 +1,87 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package s3
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// s3Client downloads objects using path-style requests.
+type s3Client struct {
+	endpoint    string // fixed endpoint, if empty the regional endpoint is used
+	credentials credentials
+	client      *http.Client
+}
+
+type s3Object struct {
+	body            io.ReadCloser
+	contentType     string
+	contentEncoding string
+}
+
+func (c *s3Client) regionEndpoint(region string) string {
+	if c.endpoint != "" {
+		return strings.TrimRight(c.endpoint, "/")
+	}
+	if region == "us-east-1" {
+		return "https://s3.amazonaws.com"
+	}
+	return "https://s3." + region + ".amazonaws.com"
+}
+
+// getObject returns the content of the object, the caller must close the
+// returned body.
+func (c *s3Client) getObject(ctx context.Context, region, bucket, key string) (*s3Object, error) {
+	req, err := http.NewRequest("GET", c.regionEndpoint(region)+"/"+bucket+"/"+escapeKey(key), nil)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set(headerContentSHA256, emptyPayloadHash)
+
+	s := signer{credentials: c.credentials, region: region, service: "s3"}
+	s.sign(req, emptyPayloadHash, time.Now())
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		defer resp.Body.Close()
+		return nil, readAPIError(resp)
+	}
+
+	return &s3Object{
+		body:            resp.Body,
+		contentType:     resp.Header.Get("Content-Type"),
+		contentEncoding: resp.Header.Get("Content-Encoding"),
+	}, nil
+}
+
+// escapeKey URI encodes every segment of the object key.
+func escapeKey(key string) string {
+	segments := strings.Split(key, "/")
+	for i, segment := range segments {
+		segments[i] = uriEncode(segment)
+	}
+	return strings.Join(segments, "/")
+}
@@ -0,0 +1,156 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package s3
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const sqsAPIVersion = "2012-11-05"
+
+// sqsClient implements the subset of the SQS query API used by the input.
+type sqsClient struct {
+	queueURL string
+	signer   signer
+	client   *http.Client
+}
+
+type sqsMessage struct {
+	MessageID     string `xml:"MessageId"`
+	ReceiptHandle string `xml:"ReceiptHandle"`
+	Body          string `xml:"Body"`
+}
+
+type receiveMessageResponse struct {
+	Messages []sqsMessage `xml:"ReceiveMessageResult>Message"`
+}
+
+// apiError is an error returned by an AWS API.
+type apiError struct {
+	StatusCode int
+	Code       string `xml:"Error>Code"`
+	Message    string `xml:"Error>Message"`
+}
+
+func (e *apiError) Error() string {
+	if e.Code == "" {
+		return fmt.Sprintf("request failed with status %v", e.StatusCode)
+	}
+	return fmt.Sprintf("%v: %v (status %v)", e.Code, e.Message, e.StatusCode)
+}
+
+func newSQSClient(queueURL string, creds credentials, region string, client *http.Client) *sqsClient {
+	return &sqsClient{
+		queueURL: queueURL,
+		signer:   signer{credentials: creds, region: region, service: "sqs"},
+		client:   client,
+	}
+}
+
+// receiveMessages long polls the queue for up to max messages. Received
+// messages are hidden from other consumers for the visibility timeout.
+func (c *sqsClient) receiveMessages(ctx context.Context, max int, visibility, wait time.Duration) ([]sqsMessage, error) {
+	var resp receiveMessageResponse
+	err := c.call(ctx, "ReceiveMessage", url.Values{
+		"MaxNumberOfMessages": {strconv.Itoa(max)},
+		"VisibilityTimeout":   {strconv.Itoa(int(visibility / time.Second))},
+		"WaitTimeSeconds":     {strconv.Itoa(int(wait / time.Second))},
+	}, &resp)
+	return resp.Messages, err
+}
+
+// deleteMessage deletes a processed message from the queue.
+func (c *sqsClient) deleteMessage(ctx context.Context, msg *sqsMessage) error {
+	return c.call(ctx, "DeleteMessage", url.Values{
+		"ReceiptHandle": {msg.ReceiptHandle},
+	}, nil)
+}
+
+// changeMessageVisibility hides the message from other consumers for another
+// timeout, counted from now.
+func (c *sqsClient) changeMessageVisibility(ctx context.Context, msg *sqsMessage, timeout time.Duration) error {
+	return c.call(ctx, "ChangeMessageVisibility", url.Values{
+		"ReceiptHandle":     {msg.ReceiptHandle},
+		"VisibilityTimeout": {strconv.Itoa(int(timeout / time.Second))},
+	}, nil)
+}
+
+func (c *sqsClient) call(ctx context.Context, action string, params url.Values, result interface{}) error {
+	params.Set("Action", action)
+	params.Set("Version", sqsAPIVersion)
+	body := params.Encode()
+
+	req, err := http.NewRequest("POST", c.queueURL, strings.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	c.signer.sign(req, hashHex([]byte(body)), time.Now())
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return readAPIError(resp)
+	}
+	if result == nil {
+		io.Copy(ioutil.Discard, resp.Body)
+		return nil
+	}
+	if err := xml.NewDecoder(resp.Body).Decode(result); err != nil {
+		return fmt.Errorf("decoding %v response: %v", action, err)
+	}
+	return nil
+}
+
+// readAPIError reads the XML error document of failed requests, SQS and S3
+// both report the error code and message under the Error element.
+func readAPIError(resp *http.Response) error {
+	apiErr := &apiError{StatusCode: resp.StatusCode}
+
+	body, err := ioutil.ReadAll(io.LimitReader(resp.Body, 64*1024))
+	if err != nil || len(body) == 0 {
+		return apiErr
+	}
+
+	// S3 errors use Error as root element, SQS wraps it in ErrorResponse.
+	var s3Err struct {
+		Code    string `xml:"Code"`
+		Message string `xml:"Message"`
+	}
+	if xml.Unmarshal(body, apiErr) == nil && apiErr.Code != "" {
+		return apiErr
+	}
+	if xml.Unmarshal(body, &s3Err) == nil {
+		apiErr.Code, apiErr.Message = s3Err.Code, s3Err.Message
+	}
+	return apiErr
+}
@@ -19,6 +19,7 @@ package syslog
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/dustin/go-humanize"
@@ -33,12 +34,39 @@ import (
 type config struct {
 	harvester.ForwarderConfig `config:",inline"`
 	Protocol                  common.ConfigNamespace `config:"protocol"`
+	Format                    syslogFormat           `config:"format"`
+}
+
+// syslogFormat selects the syslog message format accepted by the input.
+type syslogFormat uint8
+
+const (
+	formatAuto syslogFormat = iota
+	formatRFC3164
+	formatRFC5424
+)
+
+var formats = map[string]syslogFormat{
+	"auto":    formatAuto,
+	"rfc3164": formatRFC3164,
+	"rfc5424": formatRFC5424,
 }
 
 var defaultConfig = config{
 	ForwarderConfig: harvester.ForwarderConfig{
 		Type: "syslog",
 	},
+	Format: formatAuto,
+}
+
+// Unpack sets the format from its name.
+func (f *syslogFormat) Unpack(s string) error {
+	format, ok := formats[strings.ToLower(s)]
+	if !ok {
+		return fmt.Errorf("invalid syslog format '%v' (must be auto, rfc3164 or rfc5424)", s)
+	}
+	*f = format
+	return nil
 }
 
 var defaultTCP = tcp.Config{
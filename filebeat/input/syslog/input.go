@@ -18,6 +18,7 @@
 package syslog
 
 import (
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -124,30 +125,39 @@ func NewInput(
 
 	forwarder := harvester.NewForwarder(out)
 	cb := func(data []byte, metadata inputsource.NetworkMetadata) {
-		ev := newEvent()
-		Parse(data, ev)
-		var d *util.Data
-		if !ev.IsValid() {
-			log.Errorw("can't not parse event as syslog rfc3164", "message", string(data))
+		var event *beat.Event
+		if config.Format == formatRFC5424 || (config.Format == formatAuto && isRFC5424(data)) {
+			ev, err := parseRFC5424(data)
+			if err != nil {
+				log.Errorw("can't not parse event as syslog rfc5424", "message", string(data), "error", err)
+			} else {
+				event = createRFC5424Event(ev, metadata, log)
+			}
+		} else {
+			ev := newEvent()
+			Parse(data, ev)
+			if !ev.IsValid() {
+				log.Errorw("can't not parse event as syslog rfc3164", "message", string(data))
+			} else {
+				event = createEvent(ev, metadata, time.Local, log)
+			}
+		}
+
+		if event == nil {
 			// On error revert to the raw bytes content, we need a better way to communicate this kind of
 			// error upstream this should be a global effort.
-			d = &util.Data{
-				Event: beat.Event{
-					Timestamp: time.Now(),
-					Meta: common.MapStr{
-						"truncated": metadata.Truncated,
-					},
-					Fields: common.MapStr{
-						"message": string(data),
-					},
+			event = &beat.Event{
+				Timestamp: time.Now(),
+				Meta: common.MapStr{
+					"truncated": metadata.Truncated,
+				},
+				Fields: common.MapStr{
+					"message": string(data),
 				},
 			}
-		} else {
-			event := createEvent(ev, metadata, time.Local, log)
-			d = &util.Data{Event: *event}
 		}
 
-		forwarder.Send(d)
+		forwarder.Send(&util.Data{Event: *event})
 	}
 
 	server, err := factory(cb, config.Protocol)
@@ -198,7 +208,11 @@ func (p *Input) Wait() {
 func createEvent(ev *event, metadata inputsource.NetworkMetadata, timezone *time.Location, log *logp.Logger) *beat.Event {
 	f := common.MapStr{
 		"message": strings.TrimRight(ev.Message(), "\n"),
-		"source":  metadata.RemoteAddr.String(),
+		"log": common.MapStr{
+			"source": common.MapStr{
+				"address": metadata.RemoteAddr.String(),
+			},
+		},
 	}
 
 	syslog := common.MapStr{}
@@ -250,6 +264,83 @@ func createEvent(ev *event, metadata inputsource.NetworkMetadata, timezone *time
 	}
 }
 
+func createRFC5424Event(ev *rfc5424Event, metadata inputsource.NetworkMetadata, log *logp.Logger) *beat.Event {
+	f := common.MapStr{
+		"message": strings.TrimRight(ev.message, "\n"),
+		"log": common.MapStr{
+			"source": common.MapStr{
+				"address": metadata.RemoteAddr.String(),
+			},
+		},
+	}
+
+	syslog := common.MapStr{
+		"priority": ev.priority,
+		"version":  ev.version,
+	}
+	event := common.MapStr{
+		"severity": ev.severity(),
+	}
+	process := common.MapStr{}
+
+	if v, err := mapValueToName(ev.severity(), severityLabels); err != nil {
+		log.Debugw("could not find severity label", "error", err)
+	} else {
+		syslog["severity_label"] = v
+	}
+	syslog["facility"] = ev.facility()
+	if v, err := mapValueToName(ev.facility(), facilityLabels); err != nil {
+		log.Debugw("could not find facility label", "error", err)
+	} else {
+		syslog["facility_label"] = v
+	}
+
+	if ev.hostname != "" {
+		f["hostname"] = ev.hostname
+	}
+	if ev.appName != "" {
+		process["program"] = ev.appName
+	}
+	if ev.procID != "" {
+		if pid, err := strconv.Atoi(ev.procID); err == nil {
+			process["pid"] = pid
+		} else {
+			syslog["procid"] = ev.procID
+		}
+	}
+	if ev.msgID != "" {
+		syslog["msgid"] = ev.msgID
+	}
+	if len(ev.structuredData) > 0 {
+		sd := common.MapStr{}
+		for id, params := range ev.structuredData {
+			elem := common.MapStr{}
+			for k, v := range params {
+				elem[k] = v
+			}
+			sd[id] = elem
+		}
+		syslog["structured_data"] = sd
+	}
+
+	f["syslog"] = syslog
+	f["event"] = event
+	f["process"] = process
+
+	ts := ev.timestamp
+	if ts.IsZero() {
+		ts = time.Now()
+	}
+
+	return &beat.Event{
+		Timestamp: ts,
+		Meta: common.MapStr{
+			"truncated": metadata.Truncated,
+		},
+		Fields: f,
+	}
+}
+
 func mapValueToName(v int, m mapper) (string, error) {
 	if v < 0 || v >= len(m) {
 		return "", errors.Errorf("value out of bound: %d", v)
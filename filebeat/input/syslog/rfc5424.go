@@ -0,0 +1,286 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package syslog
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const nilValue = "-"
+
+var bom = []byte{0xEF, 0xBB, 0xBF}
+
+// rfc5424Event is a syslog message parsed according to
+// https://tools.ietf.org/html/rfc5424#section-6. Fields set to the NILVALUE
+// are left empty.
+type rfc5424Event struct {
+	priority       int
+	version        int
+	timestamp      time.Time
+	hostname       string
+	appName        string
+	procID         string
+	msgID          string
+	structuredData map[string]map[string]string
+	message        string
+}
+
+// isRFC5424 returns true if the message starts with a priority followed by a
+// version number, which is not valid for RFC 3164 messages.
+func isRFC5424(data []byte) bool {
+	if len(data) < 4 || data[0] != '<' {
+		return false
+	}
+	end := bytes.IndexByte(data, '>')
+	if end < 2 || end > 4 || end+2 >= len(data) {
+		return false
+	}
+	space := bytes.IndexByte(data[end+1:], ' ')
+	return data[end+1] >= '1' && data[end+1] <= '9' && space > 0 && space <= 3
+}
+
+// parseRFC5424 parses a RFC 5424 formatted syslog message.
+func parseRFC5424(data []byte) (*rfc5424Event, error) {
+	p := &rfc5424Parser{data: data}
+	ev := &rfc5424Event{}
+
+	var err error
+	if ev.priority, err = p.priority(); err != nil {
+		return nil, err
+	}
+	if ev.version, err = p.version(); err != nil {
+		return nil, err
+	}
+
+	timestamp, err := p.field("timestamp")
+	if err != nil {
+		return nil, err
+	}
+	if timestamp != "" {
+		if ev.timestamp, err = time.Parse(time.RFC3339Nano, timestamp); err != nil {
+			return nil, errors.Wrap(err, "invalid timestamp")
+		}
+	}
+
+	fields := []*string{&ev.hostname, &ev.appName, &ev.procID, &ev.msgID}
+	for i, name := range []string{"hostname", "app-name", "procid", "msgid"} {
+		if *fields[i], err = p.field(name); err != nil {
+			return nil, err
+		}
+	}
+
+	if ev.structuredData, err = p.structuredData(); err != nil {
+		return nil, err
+	}
+
+	ev.message = p.message()
+	return ev, nil
+}
+
+type rfc5424Parser struct {
+	data []byte
+	pos  int
+}
+
+func (p *rfc5424Parser) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("invalid RFC 5424 message at position %v: %v", p.pos, fmt.Sprintf(format, args...))
+}
+
+func (p *rfc5424Parser) priority() (int, error) {
+	if p.pos >= len(p.data) || p.data[p.pos] != '<' {
+		return 0, p.errorf("missing priority")
+	}
+	end := bytes.IndexByte(p.data, '>')
+	if end < 2 || end > 4 {
+		return 0, p.errorf("invalid priority")
+	}
+
+	priority, err := strconv.Atoi(string(p.data[1:end]))
+	if err != nil || priority > 191 {
+		return 0, p.errorf("invalid priority '%s'", p.data[1:end])
+	}
+	p.pos = end + 1
+	return priority, nil
+}
+
+func (p *rfc5424Parser) version() (int, error) {
+	v, err := p.token()
+	if err != nil {
+		return 0, err
+	}
+	version, err := strconv.Atoi(v)
+	if err != nil || version < 1 || v[0] == '0' {
+		return 0, p.errorf("invalid version '%v'", v)
+	}
+	return version, nil
+}
+
+// token reads the header field up to the next space, consuming the space.
+func (p *rfc5424Parser) token() (string, error) {
+	if p.pos >= len(p.data) {
+		return "", p.errorf("unexpected end of message")
+	}
+	end := bytes.IndexByte(p.data[p.pos:], ' ')
+	if end <= 0 {
+		return "", p.errorf("missing header field")
+	}
+	token := string(p.data[p.pos : p.pos+end])
+	p.pos += end + 1
+	return token, nil
+}
+
+// field reads a header field, which might be the NILVALUE.
+func (p *rfc5424Parser) field(name string) (string, error) {
+	v, err := p.token()
+	if err != nil {
+		return "", errors.Wrapf(err, "missing %v", name)
+	}
+	if v == nilValue {
+		return "", nil
+	}
+	return v, nil
+}
+
+// structuredData reads the structured data elements. Parameters are indexed
+// by SD-ID and parameter name.
+func (p *rfc5424Parser) structuredData() (map[string]map[string]string, error) {
+	if p.pos >= len(p.data) {
+		return nil, p.errorf("missing structured data")
+	}
+	if p.data[p.pos] == '-' {
+		p.pos++
+		p.skipSpace()
+		return nil, nil
+	}
+
+	sd := map[string]map[string]string{}
+	for p.pos < len(p.data) && p.data[p.pos] == '[' {
+		p.pos++
+		id, err := p.sdName()
+		if err != nil {
+			return nil, err
+		}
+
+		params := sd[id]
+		if params == nil {
+			params = map[string]string{}
+			sd[id] = params
+		}
+
+		for {
+			if p.pos >= len(p.data) {
+				return nil, p.errorf("unterminated structured data element")
+			}
+			if p.data[p.pos] == ']' {
+				p.pos++
+				break
+			}
+			if p.data[p.pos] != ' ' {
+				return nil, p.errorf("expected space in structured data element '%v'", id)
+			}
+			p.pos++
+
+			name, err := p.sdName()
+			if err != nil {
+				return nil, err
+			}
+			if p.pos+1 >= len(p.data) || p.data[p.pos] != '=' || p.data[p.pos+1] != '"' {
+				return nil, p.errorf("expected quoted value for parameter '%v'", name)
+			}
+			p.pos += 2
+
+			value, err := p.sdValue()
+			if err != nil {
+				return nil, err
+			}
+			params[name] = value
+		}
+	}
+
+	if len(sd) == 0 {
+		return nil, p.errorf("invalid structured data")
+	}
+	p.skipSpace()
+	return sd, nil
+}
+
+// sdName reads a SD-ID or PARAM-NAME.
+func (p *rfc5424Parser) sdName() (string, error) {
+	start := p.pos
+	for p.pos < len(p.data) {
+		c := p.data[p.pos]
+		if c == '=' || c == ' ' || c == ']' || c == '"' || c < 33 || c > 126 {
+			break
+		}
+		p.pos++
+	}
+	if p.pos == start || p.pos-start > 32 {
+		return "", p.errorf("invalid structured data name")
+	}
+	return string(p.data[start:p.pos]), nil
+}
+
+// sdValue reads a PARAM-VALUE, removing the escaping of '"', '\' and ']'.
+func (p *rfc5424Parser) sdValue() (string, error) {
+	var value []byte
+	for p.pos < len(p.data) {
+		c := p.data[p.pos]
+		switch {
+		case c == '"':
+			p.pos++
+			return string(value), nil
+		case c == '\\' && p.pos+1 < len(p.data):
+			next := p.data[p.pos+1]
+			if next == '"' || next == '\\' || next == ']' {
+				value = append(value, next)
+				p.pos += 2
+				continue
+			}
+		}
+		value = append(value, c)
+		p.pos++
+	}
+	return "", p.errorf("unterminated parameter value")
+}
+
+func (p *rfc5424Parser) skipSpace() {
+	if p.pos < len(p.data) && p.data[p.pos] == ' ' {
+		p.pos++
+	}
+}
+
+// message returns the remaining message, without the optional UTF-8 BOM.
+func (p *rfc5424Parser) message() string {
+	if p.pos >= len(p.data) {
+		return ""
+	}
+	return string(bytes.TrimPrefix(p.data[p.pos:], bom))
+}
+
+func (e *rfc5424Event) severity() int {
+	return e.priority & severityMask
+}
+
+func (e *rfc5424Event) facility() int {
+	return e.priority >> facilityShift
+}
@@ -0,0 +1,168 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package syslog
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/logp"
+)
+
+func TestIsRFC5424(t *testing.T) {
+	assert.True(t, isRFC5424([]byte("<34>1 2003-10-11T22:14:15.003Z mymachine su - ID47 - hello")))
+	assert.True(t, isRFC5424([]byte("<165>12 - - - - - -")))
+	assert.False(t, isRFC5424([]byte("<34>Oct 11 22:14:15 wopr su: 'su root' failed")))
+	assert.False(t, isRFC5424([]byte("<13>10.0.0.99 Use the quad dmg.")))
+	assert.False(t, isRFC5424([]byte("hello world")))
+}
+
+func TestParseRFC5424(t *testing.T) {
+	tests := []struct {
+		title    string
+		log      string
+		expected rfc5424Event
+	}{
+		{
+			title: "no structured data",
+			log:   "<34>1 2003-10-11T22:14:15.003Z mymachine.example.com su - ID47 - \xEF\xBB\xBF'su root' failed for lonvick on /dev/pts/8",
+			expected: rfc5424Event{
+				priority:  34,
+				version:   1,
+				timestamp: time.Date(2003, 10, 11, 22, 14, 15, 3000000, time.UTC),
+				hostname:  "mymachine.example.com",
+				appName:   "su",
+				msgID:     "ID47",
+				message:   "'su root' failed for lonvick on /dev/pts/8",
+			},
+		},
+		{
+			title: "structured data with message",
+			log:   `<165>1 2003-10-11T22:14:15.003-07:00 mymachine.example.com evntslog 1234 ID47 [exampleSDID@32473 iut="3" eventSource="Application" eventID="1011"][examplePriority@32473 class="high"] An application event log entry...`,
+			expected: rfc5424Event{
+				priority:  165,
+				version:   1,
+				timestamp: time.Date(2003, 10, 11, 22, 14, 15, 3000000, time.FixedZone("", -7*3600)),
+				hostname:  "mymachine.example.com",
+				appName:   "evntslog",
+				procID:    "1234",
+				msgID:     "ID47",
+				structuredData: map[string]map[string]string{
+					"exampleSDID@32473": {
+						"iut":         "3",
+						"eventSource": "Application",
+						"eventID":     "1011",
+					},
+					"examplePriority@32473": {
+						"class": "high",
+					},
+				},
+				message: "An application event log entry...",
+			},
+		},
+		{
+			title: "escaped values without message",
+			log:   `<13>1 - - - - - [meta text="say \"hi\" \]\\ ok" empty=""]`,
+			expected: rfc5424Event{
+				priority: 13,
+				version:  1,
+				structuredData: map[string]map[string]string{
+					"meta": {
+						"text":  `say "hi" ]\ ok`,
+						"empty": "",
+					},
+				},
+			},
+		},
+		{
+			title: "nil values",
+			log:   "<13>1 - - - - - -",
+			expected: rfc5424Event{
+				priority: 13,
+				version:  1,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.title, func(t *testing.T) {
+			ev, err := parseRFC5424([]byte(test.log))
+			require.NoError(t, err)
+			assert.True(t, test.expected.timestamp.Equal(ev.timestamp))
+			ev.timestamp = test.expected.timestamp
+			assert.Equal(t, test.expected, *ev)
+		})
+	}
+}
+
+func TestParseRFC5424Invalid(t *testing.T) {
+	for _, log := range []string{
+		"",
+		"<34>Oct 11 22:14:15 wopr su: 'su root' failed",
+		"<192>1 - - - - - -",
+		"<13>0 - - - - - -",
+		"<13>1 yesterday - - - - -",
+		"<13>1 - - - -",
+		"<13>1 - - - - - [id",
+		`<13>1 - - - - - [id key="value]`,
+		`<13>1 - - - - - [id key=value]`,
+		"<13>1 - - - - - hello",
+	} {
+		_, err := parseRFC5424([]byte(log))
+		assert.Error(t, err, log)
+	}
+}
+
+func TestCreateRFC5424Event(t *testing.T) {
+	ev, err := parseRFC5424([]byte(`<165>1 2003-10-11T22:14:15.003Z host app worker-1 ID47 [origin ip="10.0.0.1"] hello`))
+	require.NoError(t, err)
+
+	event := createRFC5424Event(ev, dummyMetadata(), logp.NewLogger("syslog"))
+	assert.Equal(t, time.Date(2003, 10, 11, 22, 14, 15, 3000000, time.UTC), event.Timestamp)
+	assert.Equal(t, common.MapStr{
+		"log": common.MapStr{
+			"source": common.MapStr{
+				"address": "127.0.0.1",
+			},
+		},
+		"message":  "hello",
+		"hostname": "host",
+		"process": common.MapStr{
+			"program": "app",
+		},
+		"event": common.MapStr{
+			"severity": 5,
+		},
+		"syslog": common.MapStr{
+			"priority":       165,
+			"version":        1,
+			"facility":       20,
+			"facility_label": "local4",
+			"severity_label": "Notice",
+			"procid":         "worker-1",
+			"msgid":          "ID47",
+			"structured_data": common.MapStr{
+				"origin": common.MapStr{"ip": "10.0.0.1"},
+			},
+		},
+	}, event.Fields)
+}
@@ -64,11 +64,21 @@ func newClient(
 			TLS:        extractSSLInformation(conn),
 		},
 	}
-	extractSSLInformation(conn)
 	return client
 }
 
 func (c *client) handle() error {
+	// Complete the TLS handshake first, so the metadata include the TLS
+	// session and client certificates.
+	if conn, ok := c.conn.(*tls.Conn); ok {
+		conn.SetDeadline(time.Now().Add(c.timeout))
+		if err := conn.Handshake(); err != nil {
+			return errors.Wrap(err, "tls handshake failed")
+		}
+		conn.SetDeadline(time.Time{})
+		c.metadata.TLS = extractSSLInformation(conn)
+	}
+
 	r := NewResetableLimitedReader(NewDeadlineReader(c.conn, c.timeout), c.maxMessageSize)
 	buf := bufio.NewReader(r)
 	scanner := bufio.NewScanner(buf)
@@ -19,6 +19,7 @@ package tcp
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/elastic/beats/libbeat/common/cfgtype"
@@ -37,6 +38,34 @@ type Config struct {
 	Timeout        time.Duration           `config:"timeout" validate:"nonzero,positive"`
 	MaxMessageSize cfgtype.ByteSize        `config:"max_message_size" validate:"nonzero,positive"`
 	TLS            *tlscommon.ServerConfig `config:"ssl"`
+	Framing        framing                 `config:"framing"`
+}
+
+// framing selects how messages are separated in the stream.
+type framing uint8
+
+const (
+	// framingDelimiter splits messages at the line delimiter.
+	framingDelimiter framing = iota
+
+	// framingRFC6587 splits octet counted messages, falling back to the line
+	// delimiter for messages without length prefix.
+	framingRFC6587
+)
+
+var framings = map[string]framing{
+	"delimiter": framingDelimiter,
+	"rfc6587":   framingRFC6587,
+}
+
+// Unpack sets the framing from its name.
+func (f *framing) Unpack(s string) error {
+	v, ok := framings[strings.ToLower(s)]
+	if !ok {
+		return fmt.Errorf("invalid framing '%v' (must be delimiter or rfc6587)", s)
+	}
+	*f = v
+	return nil
 }
 
 // Validate validates the Config option for the tcp input.
@@ -20,8 +20,15 @@ package tcp
 import (
 	"bufio"
 	"bytes"
+	"errors"
+	"strconv"
 )
 
+// maxFrameLengthDigits limits the length prefix of octet counted frames.
+const maxFrameLengthDigits = 10
+
+var errIncompleteFrame = errors.New("incomplete octet counted frame")
+
 // factoryDelimiter return a function to split line using a custom delimiter supporting multibytes
 // delimiter, the delimiter is stripped from the returned value.
 func factoryDelimiter(delimiter []byte) bufio.SplitFunc {
@@ -46,3 +53,39 @@ func dropDelimiter(data []byte, delimiter []byte) []byte {
 	}
 	return data
 }
+
+// factoryRFC6587Framing returns a function to split octet counted frames
+// ("MSG-LEN SP MSG") as described in RFC 6587 and required by RFC 5425 for
+// syslog over TLS. Frames not starting with a length prefix are split using
+// the delimiter (non-transparent framing).
+func factoryRFC6587Framing(delimiter []byte) bufio.SplitFunc {
+	splitDelimiter := splitFunc(delimiter)
+	return func(data []byte, eof bool) (int, []byte, error) {
+		if len(data) == 0 || data[0] < '1' || data[0] > '9' {
+			return splitDelimiter(data, eof)
+		}
+
+		sp := bytes.IndexByte(data, ' ')
+		if sp < 0 {
+			if !eof && len(data) <= maxFrameLengthDigits {
+				// wait for the complete length prefix
+				return 0, nil, nil
+			}
+			return splitDelimiter(data, eof)
+		}
+
+		length, err := strconv.Atoi(string(data[:sp]))
+		if err != nil || sp > maxFrameLengthDigits {
+			return splitDelimiter(data, eof)
+		}
+
+		end := sp + 1 + length
+		if len(data) < end {
+			if eof {
+				return 0, nil, errIncompleteFrame
+			}
+			return 0, nil, nil
+		}
+		return end, data[sp+1 : end], nil
+	}
+}
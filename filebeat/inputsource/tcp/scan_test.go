@@ -106,3 +106,57 @@ func TestCustomDelimiter(t *testing.T) {
 		})
 	}
 }
+
+func TestRFC6587Framing(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		expected []string
+	}{
+		{
+			name:     "Octet counted",
+			text:     "5 hello11 hello\nworld3 hey",
+			expected: []string{"hello", "hello\nworld", "hey"},
+		},
+		{
+			name:     "Non-transparent",
+			text:     "<13>hello\n<13>world\n",
+			expected: []string{"<13>hello", "<13>world"},
+		},
+		{
+			name:     "Mixed",
+			text:     "9 <13>hello<13>world\n5 hello",
+			expected: []string{"<13>hello", "<13>world", "hello"},
+		},
+		{
+			name:     "Digits without length prefix",
+			text:     "12345\n",
+			expected: []string{"12345"},
+		},
+		{
+			name:     "Empty string",
+			text:     "",
+			expected: []string(nil),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			scanner := bufio.NewScanner(strings.NewReader(test.text))
+			scanner.Split(factoryRFC6587Framing([]byte("\n")))
+			var elements []string
+			for scanner.Scan() {
+				elements = append(elements, scanner.Text())
+			}
+			assert.NoError(t, scanner.Err())
+			assert.EqualValues(t, test.expected, elements)
+		})
+	}
+}
+
+func TestRFC6587FramingIncompleteFrame(t *testing.T) {
+	scanner := bufio.NewScanner(strings.NewReader("10 hello"))
+	scanner.Split(factoryRFC6587Framing([]byte("\n")))
+	assert.False(t, scanner.Scan())
+	assert.Equal(t, errIncompleteFrame, scanner.Err())
+}
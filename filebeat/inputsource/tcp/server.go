@@ -61,6 +61,9 @@ func New(
 	}
 
 	sf := splitFunc([]byte(config.LineDelimiter))
+	if config.Framing == framingRFC6587 {
+		sf = factoryRFC6587Framing([]byte(config.LineDelimiter))
+	}
 	return &Server{
 		config:    config,
 		callback:  callback,
@@ -76,6 +76,14 @@ func TestReceiveEventsAndMetadata(t *testing.T) {
 			expectedMessages: expectedMessages,
 			messageSent:      strings.Join(expectedMessages, "\r\n"),
 		},
+		{
+			name: "RFC6587Framing",
+			cfg: map[string]interface{}{
+				"framing": "rfc6587",
+			},
+			expectedMessages: expectedMessages,
+			messageSent:      octetCounted(expectedMessages),
+		},
 		{
 			name: "CustomDelimiter",
 			cfg: map[string]interface{}{
@@ -260,3 +268,11 @@ func generateMessages(c int, l int) []string {
 	}
 	return messages
 }
+
+func octetCounted(messages []string) string {
+	var b strings.Builder
+	for _, m := range messages {
+		fmt.Fprintf(&b, "%d %s", len(m), m)
+	}
+	return b.String()
+}
@@ -33,7 +33,7 @@ func init() {
 	//Add a container indexer config by default.
 	add_kubernetes_metadata.Indexing.AddDefaultIndexerConfig(add_kubernetes_metadata.ContainerIndexerName, *cfg)
 
-	//Add a log path matcher which can extract container ID from the "source" field.
+	//Add a log path matcher which can extract container ID from the "log.file.path" field.
 	add_kubernetes_metadata.Indexing.AddDefaultMatcherConfig(LogPathMatcherName, *cfg)
 }
 
@@ -77,7 +77,7 @@ const containerIdLen = 64
 const podUIDPos = 5
 
 func (f *LogPathMatcher) MetadataIndex(event common.MapStr) string {
-	if value, ok := event["source"]; ok {
+	if value, err := event.GetValue("log.file.path"); err == nil {
 		source := value.(string)
 		logp.Debug("kubernetes", "Incoming source value: %s", source)
 
@@ -37,6 +37,9 @@ type Reader struct {
 
 	// join partial lines
 	partial bool
+
+	// log format, `auto`, `docker` or `cri`
+	format string
 }
 
 type dockerLog struct {
@@ -45,17 +48,18 @@ type dockerLog struct {
 	Stream    string `json:"stream"`
 }
 
-type crioLog struct {
-	Timestamp time.Time
-	Stream    string
-	Log       []byte
+// logLine holds the attributes of a parsed line common to all formats.
+type logLine struct {
+	Stream  string
+	Partial bool
 }
 
 // New creates a new reader renaming a field
-func New(r reader.Reader, stream string, partial bool) *Reader {
+func New(r reader.Reader, stream string, partial bool, format string) *Reader {
 	return &Reader{
 		stream:  stream,
 		partial: partial,
+		format:  format,
 		reader:  r,
 	}
 }
@@ -63,52 +67,115 @@ func New(r reader.Reader, stream string, partial bool) *Reader {
 // parseCRILog parses logs in CRI log format.
 // CRI log format example :
 // 2017-09-12T22:32:21.212861448Z stdout 2017-09-12 22:32:21.212 [INFO][88] table.go 710: Invalidating dataplane cache
-func parseCRILog(message reader.Message, msg *crioLog) (reader.Message, error) {
-	log := strings.SplitN(string(message.Content), " ", 3)
+//
+// Newer runtimes add tags after the stream, the `P` tag flags a partial line
+// to be joined with the following ones, `F` a full or last line:
+// 2017-09-12T22:32:21.212861448Z stdout F 2017-09-12 22:32:21.212 [INFO][88] table.go 710: Invalidating dataplane cache
+func parseCRILog(message reader.Message, msg *logLine) (reader.Message, error) {
+	log := bytes.SplitN(message.Content, []byte{' '}, 3)
 	if len(log) < 3 {
 		return message, errors.New("invalid CRI log")
 	}
-	ts, err := time.Parse(time.RFC3339, log[0])
+	ts, err := time.Parse(time.RFC3339, string(log[0]))
 	if err != nil {
 		return message, errors.Wrap(err, "parsing CRI timestamp")
 	}
 
-	msg.Timestamp = ts
-	msg.Stream = log[1]
-	msg.Log = []byte(log[2])
+	content := log[2]
+	partial := false
+	if tags, rest, ok := splitCRITags(content); ok {
+		content = rest
+		partial = tags.partial
+	}
+
+	// The line delimiter is not part of the content of partial lines
+	if partial {
+		content = bytes.TrimRight(content, "\r\n")
+	}
+
+	msg.Stream = string(log[1])
+	msg.Partial = partial
 	message.AddFields(common.MapStr{
 		"stream": msg.Stream,
 	})
-	message.Content = msg.Log
+	message.Content = content
 	message.Ts = ts
 
 	return message, nil
 }
 
+type criTags struct {
+	partial bool
+}
+
+// splitCRITags splits the tags from the content of a CRI line. The tags are
+// only recognized if they are the first word of the content and consist of
+// known tags separated by `:`.
+func splitCRITags(content []byte) (criTags, []byte, bool) {
+	var tags criTags
+
+	word := content
+	rest := []byte{}
+	if idx := bytes.IndexByte(content, ' '); idx >= 0 {
+		word, rest = content[:idx], content[idx+1:]
+	} else if trimmed := bytes.TrimRight(content, "\r\n"); len(trimmed) < len(content) {
+		word, rest = trimmed, content[len(trimmed):]
+	}
+
+	for _, tag := range bytes.Split(word, []byte{':'}) {
+		switch string(tag) {
+		case "P":
+			tags.partial = true
+		case "F":
+		default:
+			return criTags{}, content, false
+		}
+	}
+	return tags, rest, true
+}
+
 // parseReaderLog parses logs in Docker JSON log format.
 // Docker JSON log format example:
 // {"log":"1:M 09 Nov 13:27:36.276 # User requested shutdown...\n","stream":"stdout"}
-func parseDockerJSONLog(message reader.Message, msg *dockerLog) (reader.Message, error) {
+func parseDockerJSONLog(message reader.Message, msg *logLine) (reader.Message, error) {
+	var line dockerLog
 	dec := json.NewDecoder(bytes.NewReader(message.Content))
-	if err := dec.Decode(&msg); err != nil {
+	if err := dec.Decode(&line); err != nil {
 		return message, errors.Wrap(err, "decoding docker JSON")
 	}
 
 	// Parse timestamp
-	ts, err := time.Parse(time.RFC3339, msg.Timestamp)
+	ts, err := time.Parse(time.RFC3339, line.Timestamp)
 	if err != nil {
 		return message, errors.Wrap(err, "parsing docker timestamp")
 	}
 
+	// Lines not ending with \n were split by docker
+	msg.Stream = line.Stream
+	msg.Partial = !strings.HasSuffix(line.Log, "\n")
 	message.AddFields(common.MapStr{
 		"stream": msg.Stream,
 	})
-	message.Content = []byte(msg.Log)
+	message.Content = []byte(line.Log)
 	message.Ts = ts
 
 	return message, nil
 }
 
+func (p *Reader) parseLine(message reader.Message, msg *logLine) (reader.Message, error) {
+	switch p.format {
+	case "docker":
+		return parseDockerJSONLog(message, msg)
+	case "cri":
+		return parseCRILog(message, msg)
+	default:
+		if bytes.HasPrefix(message.Content, []byte("{")) {
+			return parseDockerJSONLog(message, msg)
+		}
+		return parseCRILog(message, msg)
+	}
+}
+
 // Next returns the next line.
 func (p *Reader) Next() (reader.Message, error) {
 	for {
@@ -117,34 +184,30 @@ func (p *Reader) Next() (reader.Message, error) {
 			return message, err
 		}
 
-		var dockerLine dockerLog
-		var crioLine crioLog
+		var line logLine
+		message, err = p.parseLine(message, &line)
+		if err != nil {
+			return message, err
+		}
 
-		if strings.HasPrefix(string(message.Content), "{") {
-			message, err = parseDockerJSONLog(message, &dockerLine)
+		// Handle multiline messages, join partial lines
+		for p.partial && line.Partial {
+			next, err := p.reader.Next()
 			if err != nil {
 				return message, err
 			}
-			// Handle multiline messages, join lines that don't end with \n
-			for p.partial && message.Content[len(message.Content)-1] != byte('\n') {
-				next, err := p.reader.Next()
-				if err != nil {
-					return message, err
-				}
-				next, err = parseDockerJSONLog(next, &dockerLine)
-				if err != nil {
-					return message, err
-				}
-				message.Content = append(message.Content, next.Content...)
+			next, err = p.parseLine(next, &line)
+			if err != nil {
+				return message, err
 			}
-		} else {
-			message, err = parseCRILog(message, &crioLine)
+			message.Content = append(message.Content, next.Content...)
+			message.Bytes += next.Bytes
 		}
 
-		if p.stream != "all" && p.stream != dockerLine.Stream && p.stream != crioLine.Stream {
+		if p.stream != "all" && p.stream != line.Stream {
 			continue
 		}
 
-		return message, err
+		return message, nil
 	}
 }
@@ -32,6 +32,7 @@ func TestDockerJSON(t *testing.T) {
 		input           [][]byte
 		stream          string
 		partial         bool
+		format          string
 		expectedError   bool
 		expectedMessage reader.Message
 	}{
@@ -135,11 +136,74 @@ func TestDockerJSON(t *testing.T) {
 				Ts:      time.Date(2017, 11, 9, 13, 27, 36, 277747246, time.UTC),
 			},
 		},
+		// CRI log with tags
+		{
+			input:  [][]byte{[]byte("2017-09-12T22:32:21.212861448Z stdout F 2017-09-12 22:32:21.212 [INFO][88] table.go 710: Invalidating dataplane cache\n")},
+			stream: "all",
+			expectedMessage: reader.Message{
+				Content: []byte("2017-09-12 22:32:21.212 [INFO][88] table.go 710: Invalidating dataplane cache\n"),
+				Fields:  common.MapStr{"stream": "stdout"},
+				Ts:      time.Date(2017, 9, 12, 22, 32, 21, 212861448, time.UTC),
+			},
+		},
+		// CRI split lines
+		{
+			input: [][]byte{
+				[]byte("2017-09-12T22:32:21.212861448Z stdout P 2017-09-12 22:32:21.212 [INFO][88] \n"),
+				[]byte("2017-09-12T22:32:21.212861448Z stdout P table.go 710: \n"),
+				[]byte("2017-09-12T22:32:21.212861448Z stdout F Invalidating dataplane cache\n"),
+			},
+			stream:  "stdout",
+			partial: true,
+			expectedMessage: reader.Message{
+				Content: []byte("2017-09-12 22:32:21.212 [INFO][88] table.go 710: Invalidating dataplane cache\n"),
+				Fields:  common.MapStr{"stream": "stdout"},
+				Ts:      time.Date(2017, 9, 12, 22, 32, 21, 212861448, time.UTC),
+			},
+		},
+		// CRI split lines with partial disabled
+		{
+			input: [][]byte{
+				[]byte("2017-09-12T22:32:21.212861448Z stdout P 2017-09-12 22:32:21.212 \n"),
+				[]byte("2017-09-12T22:32:21.212861448Z stdout F [INFO][88] table.go 710\n"),
+			},
+			stream:  "stdout",
+			partial: false,
+			expectedMessage: reader.Message{
+				Content: []byte("2017-09-12 22:32:21.212 "),
+				Fields:  common.MapStr{"stream": "stdout"},
+				Ts:      time.Date(2017, 9, 12, 22, 32, 21, 212861448, time.UTC),
+			},
+		},
+		// CRI empty full line
+		{
+			input:  [][]byte{[]byte("2017-09-12T22:32:21.212861448Z stderr F\n")},
+			stream: "all",
+			expectedMessage: reader.Message{
+				Content: []byte("\n"),
+				Fields:  common.MapStr{"stream": "stderr"},
+				Ts:      time.Date(2017, 9, 12, 22, 32, 21, 212861448, time.UTC),
+			},
+		},
+		// Forced docker format
+		{
+			input:         [][]byte{[]byte(`2017-09-12T22:32:21.212861448Z stdout F message`)},
+			stream:        "all",
+			format:        "docker",
+			expectedError: true,
+		},
+		// Forced CRI format
+		{
+			input:         [][]byte{[]byte(`{"log":"message\n","stream":"stdout","time":"2017-11-09T13:27:36.277747246Z"}`)},
+			stream:        "all",
+			format:        "cri",
+			expectedError: true,
+		},
 	}
 
 	for _, test := range tests {
 		r := &mockReader{messages: test.input}
-		json := New(r, test.stream, test.partial)
+		json := New(r, test.stream, test.partial, test.format)
 		message, err := json.Next()
 
 		assert.Equal(t, test.expectedError, err != nil)
@@ -150,14 +214,34 @@ func TestDockerJSON(t *testing.T) {
 	}
 }
 
+func TestPartialBytes(t *testing.T) {
+	lines := [][]byte{
+		[]byte("2017-09-12T22:32:21.212861448Z stdout P first \n"),
+		[]byte("2017-09-12T22:32:21.212861448Z stdout F second\n"),
+	}
+	r := &mockReader{messages: lines, bytes: true}
+
+	message, err := New(r, "all", true, "auto").Next()
+	assert.NoError(t, err)
+	assert.Equal(t, "first second\n", string(message.Content))
+	assert.Equal(t, len(lines[0])+len(lines[1]), message.Bytes)
+}
+
 type mockReader struct {
 	messages [][]byte
+
+	// report the size of the messages read
+	bytes bool
 }
 
 func (m *mockReader) Next() (reader.Message, error) {
 	message := m.messages[0]
 	m.messages = m.messages[1:]
-	return reader.Message{
+	msg := reader.Message{
 		Content: message,
-	}, nil
+	}
+	if m.bytes {
+		msg.Bytes = len(message)
+	}
+	return msg, nil
 }
@@ -0,0 +1,76 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package multiline
+
+import "bytes"
+
+// jsonBoundary detects the end of a JSON document spread over multiple lines
+// by counting opening and closing braces and brackets. Braces inside JSON
+// strings are ignored. Only lines starting with '{' or '[' start a multiline
+// event, so every other line is returned as its own event.
+type jsonBoundary struct {
+	depth    int
+	inString bool
+	escaped  bool
+}
+
+// feed processes the next line of the event and reports whether it completes
+// the current JSON document.
+func (b *jsonBoundary) feed(line []byte) bool {
+	if b.depth == 0 {
+		// Outside of a document, a document starts only if the line does.
+		line = bytes.TrimSpace(line)
+		if len(line) == 0 || (line[0] != '{' && line[0] != '[') {
+			return true
+		}
+	}
+
+	for _, c := range line {
+		if b.inString {
+			switch {
+			case b.escaped:
+				b.escaped = false
+			case c == '\\':
+				b.escaped = true
+			case c == '"':
+				b.inString = false
+			}
+			continue
+		}
+
+		switch c {
+		case '"':
+			b.inString = true
+		case '{', '[':
+			b.depth++
+		case '}', ']':
+			b.depth--
+		}
+
+		if b.depth == 0 {
+			// Anything following the end of the document belongs to it.
+			return true
+		}
+	}
+
+	return false
+}
+
+func (b *jsonBoundary) reset() {
+	*b = jsonBoundary{}
+}
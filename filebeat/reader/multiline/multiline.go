@@ -31,7 +31,8 @@ import (
 // MultiLine reader combining multiple line events into one multi-line event.
 //
 // Lines to be combined are matched by some configurable predicate using
-// regular expression.
+// regular expression, or are collected until a complete JSON document has
+// been read.
 //
 // The maximum number of bytes and lines to be returned is fully configurable.
 // Even if limits are reached subsequent lines are matched, until event is
@@ -43,6 +44,7 @@ type Reader struct {
 	reader       reader.Reader
 	pred         matcher
 	flushMatcher *match.Matcher
+	boundary     *jsonBoundary
 	maxBytes     int // bytes stored in content
 	maxLines     int
 	separator    []byte
@@ -77,27 +79,42 @@ func New(
 	maxBytes int,
 	config *Config,
 ) (*Reader, error) {
-	types := map[string]func(match.Matcher) (matcher, error){
-		"before": beforeMatcher,
-		"after":  afterMatcher,
-	}
+	var (
+		pred     matcher
+		boundary *jsonBoundary
+	)
+	switch config.Type {
+	case "", patternMode:
+		types := map[string]func(match.Matcher) (matcher, error){
+			"before": beforeMatcher,
+			"after":  afterMatcher,
+		}
 
-	matcherType, ok := types[config.Match]
-	if !ok {
-		return nil, fmt.Errorf("unknown matcher type: %s", config.Match)
-	}
+		matcherType, ok := types[config.Match]
+		if !ok {
+			return nil, fmt.Errorf("unknown matcher type: %s", config.Match)
+		}
 
-	matcher, err := matcherType(*config.Pattern)
-	if err != nil {
-		return nil, err
+		var err error
+		pred, err = matcherType(*config.Pattern)
+		if err != nil {
+			return nil, err
+		}
+
+		if config.Negate {
+			pred = negatedMatcher(pred)
+		}
+	case jsonMode:
+		// Every line belongs to the current event, until the JSON
+		// document is complete.
+		pred = func(last, current []byte) bool { return true }
+		boundary = &jsonBoundary{}
+	default:
+		return nil, fmt.Errorf("unknown multiline type: %s", config.Type)
 	}
 
 	flushMatcher := config.FlushPattern
 
-	if config.Negate {
-		matcher = negatedMatcher(matcher)
-	}
-
 	maxLines := defaultMaxLines
 	if config.MaxLines != nil {
 		maxLines = *config.MaxLines
@@ -117,8 +134,9 @@ func New(
 
 	mlr := &Reader{
 		reader:       r,
-		pred:         matcher,
+		pred:         pred,
 		flushMatcher: flushMatcher,
+		boundary:     boundary,
 		state:        (*Reader).readFirst,
 		maxBytes:     maxBytes,
 		maxLines:     maxLines,
@@ -155,6 +173,12 @@ func (mlr *Reader) readFirst() (reader.Message, error) {
 		// Start new multiline event
 		mlr.clear()
 		mlr.load(message)
+
+		// Line is a complete event on its own
+		if mlr.boundary != nil && mlr.boundary.feed(message.Content) {
+			return mlr.finalize(), nil
+		}
+
 		mlr.setState((*Reader).readNext)
 		return mlr.readNext()
 	}
@@ -237,6 +261,13 @@ func (mlr *Reader) readNext() (reader.Message, error) {
 
 		// add line to current multiline event
 		mlr.addLine(message)
+
+		// handle case when the end of the JSON document is reached
+		if mlr.boundary != nil && mlr.boundary.feed(message.Content) {
+			msg := mlr.finalize()
+			mlr.resetState()
+			return msg, nil
+		}
 	}
 }
 
@@ -263,6 +294,9 @@ func (mlr *Reader) clear() {
 	mlr.last = nil
 	mlr.numLines = 0
 	mlr.err = nil
+	if mlr.boundary != nil {
+		mlr.boundary.reset()
+	}
 }
 
 // finalize writes the existing content into the returned message and resets all reader variables.
@@ -18,26 +18,45 @@
 package multiline
 
 import (
+	"errors"
 	"fmt"
 	"time"
 
 	"github.com/elastic/beats/libbeat/common/match"
 )
 
+const (
+	// patternMode combines lines based on a pattern matching consecutive lines.
+	patternMode = "pattern"
+
+	// jsonMode combines lines until a complete JSON document has been read.
+	jsonMode = "json"
+)
+
 // Config holds the options of multiline readers.
 type Config struct {
+	Type         string         `config:"type"`
 	Negate       bool           `config:"negate"`
-	Match        string         `config:"match" validate:"required"`
+	Match        string         `config:"match"`
 	MaxLines     *int           `config:"max_lines"`
-	Pattern      *match.Matcher `config:"pattern" validate:"required"`
+	Pattern      *match.Matcher `config:"pattern"`
 	Timeout      *time.Duration `config:"timeout" validate:"positive"`
 	FlushPattern *match.Matcher `config:"flush_pattern"`
 }
 
 // Validate validates the Config option for multiline reader.
 func (c *Config) Validate() error {
-	if c.Match != "after" && c.Match != "before" {
-		return fmt.Errorf("unknown matcher type: %s", c.Match)
+	switch c.Type {
+	case "", patternMode:
+		if c.Pattern == nil {
+			return errors.New("multiline.pattern is required")
+		}
+		if c.Match != "after" && c.Match != "before" {
+			return fmt.Errorf("unknown matcher type: %s", c.Match)
+		}
+	case jsonMode:
+	default:
+		return fmt.Errorf("unknown multiline type: %s", c.Type)
 	}
 	return nil
 }
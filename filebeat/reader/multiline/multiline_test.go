@@ -151,6 +151,74 @@ func TestMultilineBeforeNegateOKWithEmptyLine(t *testing.T) {
 	)
 }
 
+func TestMultilineJSONOK(t *testing.T) {
+	testMultilineOK(t,
+		Config{
+			Type: "json",
+		},
+		7,
+		"{\n  \"a\": {\n    \"b\": [1, 2]\n  }\n}\n",
+		"{\"single\": \"line\"}\n",
+		"not json\n",
+		"not json {\n",
+		"  [1, 2] trailing\n",
+		"[\n  {\"msg\": \"braces } and ] in \\\"strings\\\" {\"},\n  {}\n]\n",
+		"{\n  \"last\": true\n}\n",
+	)
+}
+
+func TestMultilineJSONFlushOnEOF(t *testing.T) {
+	testMultilineOK(t,
+		Config{
+			Type: "json",
+		},
+		2,
+		"{\"a\": 1}\n",
+		"{\n  \"truncated\": \"doc\"\n",
+	)
+}
+
+func TestMultilineJSONMaxLines(t *testing.T) {
+	maxLines := 2
+	_, buf := createLineBuffer("{\n  \"a\": 1,\n  \"b\": 2\n}\n", "{}\n")
+	r := createMultilineTestReader(t, buf, Config{Type: "json", MaxLines: &maxLines})
+
+	message, err := r.Next()
+	assert.NoError(t, err)
+	assert.Equal(t, "{\n  \"a\": 1,", string(message.Content))
+
+	message, err = r.Next()
+	assert.NoError(t, err)
+	assert.Equal(t, "{}", string(message.Content))
+}
+
+func TestConfigValidate(t *testing.T) {
+	pattern := match.MustCompile(`^-`)
+
+	tests := map[string]struct {
+		config Config
+		valid  bool
+	}{
+		"pattern":          {Config{Pattern: &pattern, Match: "after"}, true},
+		"explicit pattern": {Config{Type: "pattern", Pattern: &pattern, Match: "before"}, true},
+		"missing pattern":  {Config{Match: "after"}, false},
+		"unknown match":    {Config{Pattern: &pattern, Match: "around"}, false},
+		"json":             {Config{Type: "json"}, true},
+		"unknown type":     {Config{Type: "xml"}, false},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := test.config.Validate()
+			if test.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
 func testMultilineOK(t *testing.T, cfg Config, events int, expected ...string) {
 	_, buf := createLineBuffer(expected...)
 	r := createMultilineTestReader(t, buf, cfg)
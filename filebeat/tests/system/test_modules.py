@@ -190,8 +190,8 @@ def clean_keys(obj):
     host_keys = ["host.name", "beat.hostname", "beat.name"]
     # The create timestamps area always new
     time_keys = ["read_timestamp", "event.created"]
-    # source path and beat.version can be different for each run
-    other_keys = ["source", "beat.version"]
+    # log file path and beat.version can be different for each run
+    other_keys = ["log.file.path", "beat.version"]
 
     for key in host_keys + time_keys + other_keys:
         delete_key(obj, key)
@@ -31,5 +31,5 @@ func init() {
 
 // Asset returns asset data
 func Asset() string {
-	return "eJzsW99v5Lbxf/dfMbin7xdYC81d71D4oejVlzaL5BLj7DyvueTsijFF6kjK9gb94wv+kqiVtD+8e6kLdB8SS6JmhjMffmaG1F1cwgNurmCJxF4AWG4FXsHfwxVDQzWvLVfyCv56AQBwraQlXBqgqqqU9O/BiqNgBsgj4YIsBQKXQIQAfERpwW5qNMUFxGFXF17QJUhSYVBcuD/93VGd7ndXon8B1Apsid5CMCgZl2t/Q6g1VGgMWaMpYJ6N8q9x04oyaJ2B7jlVcsXXjSZOHay4wJm77x4SC49ENO5NaAwyL5NbdymVzYX5V6BUxkZNcfyd8qp6dszcM3/r3l3et3KUn/G0XcXQaUnjfse1thEDGm2jJTJYbrwqVaNTI9dgNsZiBUrCU8lp2Rme+U43UnK5HrHG8gp/V/IAa9LIb2nNI2rDldxvTByYYOXh7IO/RulMQQa25CZAuehD983f3FSMJVX9Jgp1WL8CRmzyg8avDdfIrsDqJt1cKV0R2xuHz6Sq3dL72KwbY+HtB1vC2z9992EG3729evf+6v274t27t4d515sETwHIGJehWyAaqdIMnojp5rc1KUvWZreWj3rJrSZ648cGb1HiqMDjvUYdAkUk8xdWE2kItV08gp+2FAd26PlRLX9DmtZauFiEJw+4eVKa7Ta05arGoO7WlCOooGzLAtRa6Z4Ba62aereS791LiQFp0OjwSxjjbiwRwOVKuZVNifH85fWYIoEhsmISmKyJZNbeTzZZfLbZzQmzOtOinGKggCo2lC6UXB8j3QkZinayBqL7MTtIeoBJTFFUqIZ1OeraXUKt1SNn6KZpCSOWjKetz/EprLSqgqT2VeNi1VEQYWzhByySSDeSojFKT2YxN7TwbxVJ7PbCRrpn9f6cpbe+hQXcKGO4A67PSQaIRidwBmuKM1AaGF9zS4SiSGQxaRuXxhJJccH3LJ15HAjzT8kkl0SgIrTkcnvpjmnYn5laHXleP0xLHLDIcNb62b4tKmS8qXZr/xxEeIgdpzyWOVxwu1lkKa+1oDGXSIy9/I7uIdJMEPiMyLtsx00wh5suze2AnOfGNqqtKfHJ5fPh0IuvOFv+qdRaYFhp09o1rvem2i9+zL75xYXOFH3w6yeu9E/pekR4eAbGEuvoVwikLmf7ZR6euTVrSqXtImSAK1gRYVzQiKSl0knfZbvKL/qknKbcmgWj+WGKx2NOQF1wdhon/ir51wY7gcDZGKu36qqx9HGUxhwXXlyqTqMBrpBYNlxYUHKXKRkZvNCS61ank7VLlyBLFGagrVdLwO56Yo8tc++JoKcFrQNzB9kfwtWIkLkrBjKguiw3oJ4Om+7+XmRG3cfh8vSY/BDbimE0zoT0QBAjICealtwitY0+wxx64uD/sFgX8PyXD4sPf54B0dUM6prOoOK1+f+hKcoUtSDWlfSnWfLLLSRB0QaK0iozg2bZSNvM4IlLpp4mjOh3PC+3IcoZ1bEiFRebk1UEMXGSGllJ7AwYLjmRM1hpxKVhu2bL64EJvVs7tP/EjXWENr+5JIxpNAbNUEFF6GmTTGpKotkT0dgpm0FjGiLEBj5/vM5tSDzy0CxRS7RoOjb5Mb83orZ73pbB/Zq2Ewo5l+xOi91LewmoZzQcRUO1YmdID5kHasUCt42qak6lpkzTjWLw6/zTUJH7r6kJPd+kOolDZa4DO6sHncQJFx6aXA9TFKRBReqhJiKlsn7/62zqMpHjOs9ZsGR6aa922aX2DCXbqN4gN/XRfue2Y5c312Ert0Sird8Aq5TkVuk3W2wzsfjj6MmVP7k747XGt/s7MtN0cfrOwl2JrdJsGwrOWhplSky784RTxHQqJ/W0rRoh4De1dK07CXvRLg200R2ZL4s7zQMr8jAObLhTloik12/Do7FjsrZjmatuTO/2xDbUQPentDfOJVScamWQKsnMcG6GlnhqND+GNA2NFlFeAf9QOrXZcG9pfT+DeyuM+19prbskkoW/zf2Iz7Oa/YVWpfLbFRoG9SOnCEt0gYgxQVbAddiYrbgxXK5nwLuxvO/69iWHlvnNiMkn1F3zm51WznOr+paks4tZT54/QeH1fcBWYjrj72s0SjwiA15DLLDaNos2WqO0XurIDI0ltofI0e37F8ZrLhmnxNEOX7UMRFUjGDwSwRmxob1OnrDKRa49VOtKxDjBjMF9FyOUemjqA0m7kwHHkHamqGXs8GSKsP8YnJ8brB1uGtk16Wv+iHIKO9oO57mTP1sOSyBzEY9xASKdGX4bqxy02X8wpUbYGUUfzPsMdbe/XP94+951FM+bA2HXyjgKdbki0Cj8sVzfBVPwOzoqW5n1p1sQZIMatEeC1bwOR2yHRoMqKfvF6rQhe4zxBvEKe4BBY8lScFMCSbpcEB85SW5zgySrFZfbVgAsiSsPlMzO7jMhVvVcX2y9PjZt2AVE2AXGweSnAZkQaUXWJL9xsUJJ9SYcpfuwHQhLK6Yb16mz1g4ZPUDu48P/OCBLIpkpyQN+M0iuuHR4dKa2yjKkCY2EbTLESbRPSj8MBHdIfDXIS5u91tZ5+r27uzmyaYoSxh0/lXydmuPQ1mgxQNvhB8i3Mdu6ujf1EXGaeUSqRli+GMakhTx5uhgGYlgD7EHaXVYbjVkEdyU3rn4kIJW8JJKIze/JU+EzhfBtzqoR23hSGsh6rXEdtgfG0juaWkkzrAGPWLzJn0kW1ESTCi3qg1dvqFAXW2f3nTVcWly3p1QH+RXgS7InSJ841D+RuTx6T6OuVCa/jLn+NZh3u86XaJ8QJay4NhaWG+uLzbjevjaurw0V55Pm1qJ0vd1AWhvVMDTumQaMRssdSjulW4w4EDhgyB4jDob/rKwDwKpTFj9WAyfembRUbANKg5JiAwRqjSv+PPOHsSOU6H6yqZaogSkMklaNEK4EqzUa/21f6foUS4QPJEhEhkPPxDApb0j4zkqx11NOjClLUFs4S8+ON5UHqV/+E+Y3Dnod4Qj3hF9w4/+QAN8QCW7J4yLSwIuQsBMH+TesVFW1QIs95hlhjCFTTNVUr7GGGlOWEL4okbBB+nqhm/uFaeL43OHGEm23o+CcP0LuIQ24tZllCd+gx2j12N/Fo4vcjr7rvz1yVEmL0hYv23XY30xotJrjI7L20xPHNsk0iLYV48Z5Qjo7e+fmxSzfAif/2rWAW4cvA0/clgNx/psayS0nAu6ub/K+m1iLVW0L+F6y8DaQlUXd8flAGuMMaIn0oZcwXnNueC2oji0dp1Xe0s2vP98c2MrFN+GYVm5+A7Xz9YF7BoF8hgehw2p/18dcIUp8BW5y8D0t1Zco2PPfOfY0W8nwJSPML1g7PPSr/gNr/nPvZqatI5pH262/o/aL6NERdyoStb9k36hWehiLo+Kfuk8nKS7Zc4R8a3/q+tQm78wbpqOsnW+abnHvEV1Zd07wWrjsG3TNOzzadTHuylisO+/hMzf+3/H03ftaHPXvAAAA///qNX+b"
+	return "eJzsW99vGzfyf9dfMchTC8iLb5NvgoMfisvZ6dXXJDVi91mmliOJ9S65JbmWVdwffxguucv9KclSihxwZ+Maabnz+XBmODMc0rMLeMTdJSyR2RmAFTbDS/hH9YmjSbUorFDyEn6cAQBcKWmZkAZSledKuvdgJTDjBtgTExlbZghCAssywCeUFuyuQJPMwA+7nDlBFyBZjhVwQv903w5i0u/9Bt0LoFZgN+gYgkHJhVy7LzK1hhyNYWs0CdxEo9xrwtSiDFoiSM9TJVdiXWpGU4SVyHBO79FDZuGJZSWCMFAa5E6msPRRKhsLc6/ARhnrkfz4e+WgWjzm9MyNf6DBD7Uc5WY8zivpKy0g7ldczY0Z0GhLLZHDcud4qAJp+nINZmcs5qAkbDci3TTEI93pUkoh1wNsrMjxTyUPYBNGfk02T6iNUHI/GT8wuBW9XBl/jZIUgxzsRpjKlZO26776O03FWJYXr7xQ8vVL4MwGPWj8oxQa+SVYXYYvV0rnzLbG4TPLC1p678t1aSy8fmc38Pr/fng3hx9eX755e/n2TfLmzev9E6opwbZyZPTLkBaIxlRpDltmmvl1JmXZ2kyjvNdLYTXTOze20lbKKBQ4fy9QV4ZikrsPVjNpWGobe4CLCR3gKjr4EfT8EtTyd0zDWqs+LKonj7jbKs2nidaxqjSomzVFAaoC6zBArZX2b1cwa63KYhrkA73k5REGRUeKSYxzQWNZBkKuFK3slBkkR3M4LiICNFExCAxsfDCrvw+cLD434WeUVkPNy0l6AKnifemZkutjpJOQvmiSFQ0estlB0unFJKSoNFMlb3LUFX2EQqsnwZGmaRlnlg2nrU/+Kay0yiFtvWqAcd6EIMb5wg1YBJEEkqIxSo9mMRqauLeSILa7sDHds3o/R+mtzTCBW2WMIMd1OckA0wiYvp7DOsU5KA1crIVlmUqRyWSUm5DGMpniQuxZOjd+INxcB0qURCBn6UZIPABhf2aqMeK8fhiKH7CI/KzWs32d5MhFmU+jf6pEuEV1HLgvc0Qm7G4RpbyaQWkukBl78UM6TeF9JAhIEIgm2wnjSgoqJ+o0N8ao0MrFRsG7VPyTi+dpJrHr+VeIyz+VWmdYrbRxdI3rvan2ixuzb35+oXOVPqJuVvp1+DwgvHoGxjJLNWmWYWqRV8u8ekZr1myUtosqA1zCimWG3IbJdKN0wLuoV3m0yOMp17SG80P8SvyazwmoE8FPi4m/SfFHiY1AEDyZgsvZ+sQoHPuFExeqU0+ACollKTILSk5RiYLBC5n4XI7a+d8UVsaWmJkeWquW2FNP7OFy4zRR4dROS4u1cdmfq08DQm6oGIgcVemB0NP4Jond65ke+zi/PN0mP/ttRd8aZ/J0mtegkzOdboTF1Jb6DHNoiYPvMFkn8Py3d4t3/z8HpvM5FEU6h1wU5vs+FWWSImOWSvrTmPx6B0GQ55CitMrMoVyW0pZz2ArJ1XaERHvH83IOXs4gxorlItudDFGJ8ZPUyDfMzoHjUjA5h5VGXBo+NVtR9CiI4jD0j8JYCmg3txeMc43GoOkD5CztIRw1yQCzYZpvmcYGjBoAJcuyHXx6fxVzCHHksVyilmjRNNHkl/i7AdjmeV0Gt2vaRmhTy+5Ni81LewNQM/ToMFQofob0EGmgUNyJng1ClYKfDelWcfjt5roPRP9vCpaeb1KNxD4Y7cDOqkGpOI6o8NDkehhQJQ1yVvSRmJTKuv7X2eAikcOY5yxYItxa7IhSG9gzlGyDuJVcH2Gqzm0TXV5duS9gg0xb1wDLlRRW6VedaDOy+P3o0ZU/Qtaj+re95GS2J1yc3lmgJlkAjdpQMcjp1o9ATN15wrHAJPgZ0VZllsHvaklbd1b1rikN1NYdmC/3neZIaN+MPQ73yrIs4FLLy6KxQ7K6toyhy3h1j7ahetjXHoV2lLlItTKYKslNf24m3eCp1nxfpWkodeblJfCT0mGbDQ82LR7m8GAzQ//ZWEsfqf/p/m0eBnQe1ewnlt9UaBjUTyKl/jUZwtuEDi2uqsZsLowRcj0H0YyNGun0W79E3nJzm8zOWXfd3E6yvIlZtZmEs4t5Sx4VNQ+ieKjiRIh0xm2lNBqVPSEHUYAvsOptVlpqTWdSJHVghtRGaHnkYPv+hfa6kVykjMKOWIU5QqrKjNNJk6DDAMcxaMIqslx9qNaUiH6CUQSnnRhkSj2WxYFBu5ExvNpH5hEBebHJbHiR/7V+fm5nbfymlM0mfS2eUI75jrb9ecYaHY9hwcnAqmBbYJJouDZWWAHNPqWv7a8aUr3bGZU+mreR1939evXL3VvaUTzvDnS7WsawjkaMEgOBxoyOrToqaH86wSptV7j/eAcZ26EG7TzBalFUR2yHWiNVUraL1XEie8jQ773IseUwaCxbZsJsgAUsMuKTYEFtNEjyQgnZZQGwZAY5HfnWYSYWYlWQ4WwcT3ls2pOOOOmMRzhkCIQ2izbJr8hWKFO9c+9XZjvQLW29p+kbZsQgkWe0HDKZDSvnm3HIDZPcbNhjnM3GqbzEJVdCkj+ShmqwyNMyjYzvIo+TaLdKP/YEN54Yz21sfn+J53WBUtRWrCit40Iqu3CJfLHElRpoTEbH/T3ID0xngupnsiUwW5+ToKuMUMdQdCjkkJJDGbGVRX0UoY/MnpnObJ+vTfjZXR+00GhQUibgpQ63gFo+d+iKEMaULe1MFSo9otfC0IWVUpiN3+CFE5OYbYWRzAYJmLLb0PgKDDzIGAUmXwrfjwjXn++cWOP2QE05hSZccPJkgGUWtWRWPPmOUxAR/ofPFmV8QyeaUnP2Ym3RnL28+vn+/jbUhgcmAC9h2DdHZutg2tVIMhv2tgBT6mzW1fDh9znufPFL29CwrffTjF09LzMrFl0KDQnNttG3U4ae4FI1HcwEI7ine1PCAAOp5AWTLNv9GTRV3RoSrj2xKmOl0A/V22y91rh2YTjc02lNAk2hpDk5qBD7IAsKplmOFvXBybTaMC46V2kaNkJaXPdiy6ReAb4EPpV0f8dmEH+p+G6RbjB97Ga8YW0chl5m1oT19q+7Xz87GGDG0NpTstXREt24AeD4JEGrye9GyXlY+ErTZR27wZ27w9IIijU+pvV45gWzm97DKWcemPwts5swT3expqLe2k92cSMBA+IHl/Qg9nXzIVCoFTwB3+tQvGjifav3GhQtQrBRdL3tu7L4ni4brZjIkMN3XG3l9xNk+1fYjtfTF2RGybZ+PIFk1gU8sbh2Ef206jp0cmZD8927Gv/deQhNKbpEu0Wky8DaWFjurEvyPgf9UVKtVjVFtlpYi5JSb09aHemqof5Yr4rbnrnSSQTaKdp7AntFfLR9HHCMz8pSH2bVgPn71EDiiZKLNEqDktkOGBQaV+KZblh3upXNjyzzJWrgyp1cWdf/3oFGXx86z7GuVe1Ka4nIcWiB0I9rwDC68osu5h0blL76vmPI1RbE9Oz+pmIjxbs7shQ5V40fmq7dfOw7DE6N//OEr+kJtORx4cPAizxh0g/iP7NIFZ14WGxFnoGI0RM4uu1vRYxvWMnBwxcbZM313hPV3O6dhBgfK9xYpm3XCqT8nixvDLc2oyzhesjeWq3oT/ZoLNcTt79h899iOTodQmmTHugo4BE2cynDaoF04hRuR1LeCdTAc0uGybnU9CJnmoreMT2f5WvHif8gI4E78i8DWzFQTtMbQgorWAb3V7eRvYFZi3lhE/ggefU2uB5TE8970rjgVX3dShjfcm745rw6ABmLhZntc5iJSrezyfOhw31mVQPhgjAqew1s3lrCtqiRLnGTM2FhvCOwsE1wgmiz5+q8shUrhjQ6cgtj3yanNdv44jLhjyy+dj/mKITfvnwM6a+5ZzcBNbhxOxjtx9nezRphgynT1BW4B+3VArd6LZ6hnXFE80Jbm7jw98LIDD+OB8C2Y8eu0F1dI+TcH2klnetGR9nsJyayUjd/YdJaEJOow/vm0T3zIGr05QC4b5yKNI8bpzdXn24PbJj6N4eDz4iRbm6hoLLmwIMyH5NmXQ30nXAEj34/V3FfrIAmBx/SjfoSgh2VWcmsD3xsE6GWDF60K8G+YJHtun2ESER33jGFF66H0QTizW3T2NqU0Y86JE2L2ZhORixAEKFYfMlhaaG0Pc3+ocdLknwRcA6Tt0Hur05tG/nKqvVsjMgxYXDspkCnmuvW+j2BTe3fnObEcxub36RTTzr24c49GwLzpSOeXaNNX4Q+uYRSaw+fq5O4jnq/FUX9ZwAS/YhH"
 }
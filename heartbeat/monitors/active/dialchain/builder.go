@@ -45,6 +45,9 @@ type BuilderSettings struct {
 	Timeout time.Duration
 	Socks5  transport.ProxyConfig
 	TLS     *transport.TLSConfig
+
+	// TLSCheck configures the validation of the server certificate.
+	TLSCheck TLSCheck
 }
 
 // Endpoint configures a host with all port numbers to be monitored by a dialer
@@ -73,7 +76,7 @@ func NewBuilder(settings BuilderSettings) (*Builder, error) {
 
 	// add tls layer doing the TLS handshake based on the original address
 	if tls := settings.TLS; tls != nil {
-		d.AddLayer(TLSLayer(tls, settings.Timeout))
+		d.AddLayer(TLSLayer(tls, settings.Timeout, settings.TLSCheck))
 	}
 
 	// validate dialerchain
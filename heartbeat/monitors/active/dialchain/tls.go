@@ -18,6 +18,9 @@
 package dialchain
 
 import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
 	"net"
 	"time"
 
@@ -26,16 +29,27 @@ import (
 	"github.com/elastic/beats/libbeat/outputs/transport"
 )
 
+// TLSCheck configures the validation of the certificate presented by the
+// server.
+type TLSCheck struct {
+	// NotAfterMinDays fails the check if the certificate expires in less than
+	// the given number of days. The check is disabled if set to 0.
+	NotAfterMinDays int `config:"not_after_min_days" validate:"min=0"`
+}
+
 // TLSLayer configures the TLS layer in a DialerChain.
 //
 // The layer will update the active event with:
 //
 //  {
 //    "tls": {
-//        "rtt": { "handshake": { "us": ... }}
+//        "rtt": { "handshake": { "us": ... }},
+//        "certificate_not_valid_before": ...,
+//        "certificate_not_valid_after": ...,
+//        "certificate": { "issuer": ..., "subject": ..., "san": [...] }
 //    }
 //  }
-func TLSLayer(cfg *transport.TLSConfig, to time.Duration) Layer {
+func TLSLayer(cfg *transport.TLSConfig, to time.Duration, check TLSCheck) Layer {
 	return func(event common.MapStr, next transport.Dialer) (transport.Dialer, error) {
 		var timer timer
 
@@ -49,11 +63,60 @@ func TLSLayer(cfg *transport.TLSConfig, to time.Duration) Layer {
 		}
 
 		return afterDial(dialer, func(conn net.Conn) (net.Conn, error) {
-			// TODO: extract TLS connection parameters from connection object.
-
 			timer.stop()
 			event.Put("tls.rtt.handshake", look.RTT(timer.duration()))
+
+			tlsConn, ok := conn.(*tls.Conn)
+			if !ok {
+				return conn, nil
+			}
+
+			certs := tlsConn.ConnectionState().PeerCertificates
+			if len(certs) == 0 {
+				return conn, nil
+			}
+
+			cert := certs[0]
+			addCertificateFields(event, cert)
+			if err := check.validate(cert, time.Now()); err != nil {
+				conn.Close()
+				return nil, err
+			}
 			return conn, nil
 		}), nil
 	}
 }
+
+func addCertificateFields(event common.MapStr, cert *x509.Certificate) {
+	var san []string
+	san = append(san, cert.DNSNames...)
+	for _, ip := range cert.IPAddresses {
+		san = append(san, ip.String())
+	}
+
+	event.DeepUpdate(common.MapStr{
+		"tls": common.MapStr{
+			"certificate_not_valid_before": cert.NotBefore,
+			"certificate_not_valid_after":  cert.NotAfter,
+			"certificate": common.MapStr{
+				"issuer":  cert.Issuer.String(),
+				"subject": cert.Subject.String(),
+				"san":     san,
+			},
+		},
+	})
+}
+
+func (c TLSCheck) validate(cert *x509.Certificate, now time.Time) error {
+	if c.NotAfterMinDays <= 0 {
+		return nil
+	}
+
+	minValidity := time.Duration(c.NotAfterMinDays) * 24 * time.Hour
+	if remaining := cert.NotAfter.Sub(now); remaining < minValidity {
+		return fmt.Errorf("certificate '%v' expires in %d days on %v, less than %d days",
+			cert.Subject.CommonName, int(remaining.Hours()/24),
+			cert.NotAfter.Format(time.RFC3339), c.NotAfterMinDays)
+	}
+	return nil
+}
@@ -23,16 +23,21 @@ import (
 	"io/ioutil"
 	"net/http"
 
+	"github.com/elastic/beats/libbeat/common"
 	"github.com/elastic/beats/libbeat/common/match"
 )
 
 type RespCheck func(*http.Response) error
 
+// ResponseValidator validates a response. Fields reporting details about the
+// validation are returned, to be added to http.response.
+type ResponseValidator func(*http.Response) (common.MapStr, error)
+
 var (
 	errBodyMismatch = errors.New("body mismatch")
 )
 
-func makeValidateResponse(config *responseParameters) RespCheck {
+func makeValidateResponse(config *responseParameters) ResponseValidator {
 	var checks []RespCheck
 
 	if config.Status > 0 {
@@ -49,7 +54,22 @@ func makeValidateResponse(config *responseParameters) RespCheck {
 		checks = append(checks, checkBody(config.RecvBody))
 	}
 
-	return checkAll(checks...)
+	check := checkAll(checks...)
+	if len(config.RecvJSON) == 0 {
+		return func(r *http.Response) (common.MapStr, error) {
+			return nil, check(r)
+		}
+	}
+
+	// JSON checks run first, as they restore the body read.
+	jsonCheck := checkJSON(makeJSONChecks(config.RecvJSON))
+	return func(r *http.Response) (common.MapStr, error) {
+		fields, err := jsonCheck(r)
+		if err != nil {
+			return fields, err
+		}
+		return fields, check(r)
+	}
 }
 
 func checkOK(_ *http.Response) error { return nil }
@@ -18,20 +18,23 @@
 package http
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 	"time"
 
+	"github.com/elastic/beats/libbeat/common"
 	"github.com/elastic/beats/libbeat/common/match"
 	"github.com/elastic/beats/libbeat/common/transport/tlscommon"
 
 	"github.com/elastic/beats/heartbeat/monitors"
+	"github.com/elastic/beats/heartbeat/monitors/active/dialchain"
 )
 
 type Config struct {
 	Name string `config:"name"`
 
-	URLs         []string      `config:"urls" validate:"required"`
+	URLs         []string      `config:"urls"`
 	ProxyURL     string        `config:"proxy_url"`
 	Timeout      time.Duration `config:"timeout"`
 	MaxRedirects int           `config:"max_redirects"`
@@ -47,11 +50,15 @@ type Config struct {
 
 	// http(s) ping validation
 	Check checkConfig `config:"check"`
+
+	// sequence of requests run as a single check instead of urls
+	Steps []*common.Config `config:"steps"`
 }
 
 type checkConfig struct {
 	Request  requestParameters  `config:"request"`
 	Response responseParameters `config:"response"`
+	TLS      dialchain.TLSCheck `config:"tls"`
 }
 
 type requestParameters struct {
@@ -71,6 +78,7 @@ type responseParameters struct {
 	Status      uint16            `config:"status" verify:"min=0, max=699"`
 	RecvHeaders map[string]string `config:"headers"`
 	RecvBody    []match.Matcher   `config:"body"`
+	RecvJSON    []jsonCheckConfig `config:"json"`
 }
 
 type compressionConfig struct {
@@ -97,6 +105,16 @@ var defaultConfig = Config{
 	},
 }
 
+func (c *Config) Validate() error {
+	switch {
+	case len(c.URLs) == 0 && len(c.Steps) == 0:
+		return errors.New("either urls or steps must be configured")
+	case len(c.URLs) > 0 && len(c.Steps) > 0:
+		return errors.New("urls and steps can not be used together")
+	}
+	return nil
+}
+
 func (r *requestParameters) Validate() error {
 	switch strings.ToUpper(r.Method) {
 	case "HEAD", "GET", "POST":
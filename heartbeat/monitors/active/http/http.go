@@ -50,6 +50,24 @@ func create(
 		return nil, err
 	}
 
+	if len(config.Steps) > 0 {
+		steps, err := compileSteps(config.Steps)
+		if err != nil {
+			return nil, err
+		}
+
+		transport, err := newRoundTripper(&config, tls)
+		if err != nil {
+			return nil, err
+		}
+
+		job, err := newHTTPMonitorStepsJob(&config, steps, transport)
+		if err != nil {
+			return nil, err
+		}
+		return []monitors.Job{job}, nil
+	}
+
 	var body []byte
 	var enc contentEncoder
 
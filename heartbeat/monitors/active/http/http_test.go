@@ -22,7 +22,9 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/phayes/freeport"
@@ -236,3 +238,59 @@ func TestUnreachableJob(t *testing.T) {
 		event.Fields,
 	)
 }
+
+func testTLSRequest(t *testing.T, url string, minDays int) beat.Event {
+	config, err := common.NewConfigFrom(map[string]interface{}{
+		"urls":                         []string{url},
+		"ssl.verification_mode":        "none",
+		"check.tls.not_after_min_days": minDays,
+	})
+	require.NoError(t, err)
+
+	jobs, err := create(monitors.Info{}, config)
+	require.NoError(t, err)
+
+	event, _, err := jobs[0].Run()
+	require.NoError(t, err)
+	return event
+}
+
+func TestTLSCertificateFields(t *testing.T) {
+	server := httptest.NewTLSServer(hbtest.HelloWorldHandler(http.StatusOK))
+	defer server.Close()
+	cert := server.Certificate()
+
+	event := testTLSRequest(t, server.URL, 1)
+
+	status, _ := event.Fields.GetValue("monitor.status")
+	assert.Equal(t, "up", status)
+
+	notAfter, _ := event.Fields.GetValue("tls.certificate_not_valid_after")
+	assert.Equal(t, cert.NotAfter, notAfter)
+	notBefore, _ := event.Fields.GetValue("tls.certificate_not_valid_before")
+	assert.Equal(t, cert.NotBefore, notBefore)
+	issuer, _ := event.Fields.GetValue("tls.certificate.issuer")
+	assert.Equal(t, cert.Issuer.String(), issuer)
+	san, _ := event.Fields.GetValue("tls.certificate.san")
+	assert.Contains(t, san, "127.0.0.1")
+}
+
+func TestTLSCertificateExpiresSoon(t *testing.T) {
+	server := httptest.NewTLSServer(hbtest.HelloWorldHandler(http.StatusOK))
+	defer server.Close()
+	cert := server.Certificate()
+
+	// require the certificate to be valid for a day longer than it is
+	minDays := int(time.Until(cert.NotAfter).Hours()/24) + 1
+	event := testTLSRequest(t, server.URL, minDays)
+
+	status, _ := event.Fields.GetValue("monitor.status")
+	assert.Equal(t, "down", status)
+
+	msg, _ := event.Fields.GetValue("error.message")
+	assert.Contains(t, msg, "certificate")
+	assert.Contains(t, msg, fmt.Sprintf("less than %d days", minDays))
+
+	notAfter, _ := event.Fields.GetValue("tls.certificate_not_valid_after")
+	assert.Equal(t, cert.NotAfter, notAfter)
+}
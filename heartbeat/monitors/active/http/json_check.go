@@ -0,0 +1,259 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package http
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/common/mapval"
+	"github.com/elastic/beats/libbeat/common/match"
+)
+
+// jsonCheckConfig configures an assertion on the value at Path in a JSON
+// response body. All conditions configured must hold.
+type jsonCheckConfig struct {
+	Description string         `config:"description"`
+	Path        string         `config:"path" validate:"required"`
+	Exists      *bool          `config:"exists"`
+	Type        string         `config:"type"`
+	Equals      interface{}    `config:"equals"`
+	GT          *float64       `config:"gt"`
+	GTE         *float64       `config:"gte"`
+	LT          *float64       `config:"lt"`
+	LTE         *float64       `config:"lte"`
+	Regex       *match.Matcher `config:"regex"`
+}
+
+type jsonCheck struct {
+	path        string
+	description string
+	validator   mapval.Validator
+}
+
+var jsonTypes = map[string]func(interface{}) bool{
+	"string":  func(v interface{}) bool { _, ok := v.(string); return ok },
+	"number":  func(v interface{}) bool { _, ok := v.(float64); return ok },
+	"boolean": func(v interface{}) bool { _, ok := v.(bool); return ok },
+	"object": func(v interface{}) bool {
+		switch v.(type) {
+		case map[string]interface{}, common.MapStr:
+			return true
+		}
+		return false
+	},
+	"array": func(v interface{}) bool { _, ok := v.([]interface{}); return ok },
+	"null":  func(v interface{}) bool { return v == nil },
+}
+
+func (c *jsonCheckConfig) Validate() error {
+	if c.Type != "" {
+		if _, ok := jsonTypes[strings.ToLower(c.Type)]; !ok {
+			return fmt.Errorf("unknown json type '%v'", c.Type)
+		}
+	}
+
+	if c.Exists != nil && !*c.Exists {
+		if c.Type != "" || c.Equals != nil || c.GT != nil || c.GTE != nil ||
+			c.LT != nil || c.LTE != nil || c.Regex != nil {
+			return errors.New("'exists: false' can not be combined with other conditions")
+		}
+	}
+	return nil
+}
+
+func makeJSONChecks(configs []jsonCheckConfig) []jsonCheck {
+	checks := make([]jsonCheck, len(configs))
+	for i, c := range configs {
+		checks[i] = jsonCheck{
+			path:        c.Path,
+			description: c.Description,
+			validator:   c.validator(),
+		}
+	}
+	return checks
+}
+
+// validator creates a mapval validator from the conditions configured for
+// the path.
+func (c *jsonCheckConfig) validator() mapval.Validator {
+	var defs []mapval.IsDef
+
+	if c.Exists != nil && !*c.Exists {
+		defs = append(defs, mapval.KeyMissing)
+	}
+	if c.Type != "" {
+		defs = append(defs, isJSONType(strings.ToLower(c.Type)))
+	}
+	if c.Equals != nil {
+		defs = append(defs, isJSONEqual(c.Equals))
+	}
+	if c.GT != nil {
+		defs = append(defs, isNumber("greater than", *c.GT, func(a, b float64) bool { return a > b }))
+	}
+	if c.GTE != nil {
+		defs = append(defs, isNumber("greater than or equal to", *c.GTE, func(a, b float64) bool { return a >= b }))
+	}
+	if c.LT != nil {
+		defs = append(defs, isNumber("less than", *c.LT, func(a, b float64) bool { return a < b }))
+	}
+	if c.LTE != nil {
+		defs = append(defs, isNumber("less than or equal to", *c.LTE, func(a, b float64) bool { return a <= b }))
+	}
+	if c.Regex != nil {
+		defs = append(defs, isMatching(*c.Regex))
+	}
+	if len(defs) == 0 {
+		defs = append(defs, mapval.KeyPresent)
+	}
+
+	validators := make([]mapval.Validator, len(defs))
+	for i, def := range defs {
+		validators[i] = mapval.Schema(mapval.Map{c.Path: def})
+	}
+	return mapval.Compose(validators...)
+}
+
+func isJSONType(typ string) mapval.IsDef {
+	is := jsonTypes[typ]
+	return mapval.Is("is "+typ, func(v interface{}) mapval.ValueResult {
+		if is(v) {
+			return mapval.ValidVR
+		}
+		return mapval.ValueResult{
+			Valid:   false,
+			Message: fmt.Sprintf("expected a %v, got '%v'", typ, v),
+		}
+	})
+}
+
+func isJSONEqual(to interface{}) mapval.IsDef {
+	// Numbers are decoded from JSON as float64, but might be unpacked from
+	// the configuration as integers.
+	if f, ok := toFloat(to); ok {
+		to = f
+	}
+	return mapval.IsEqualToValue(to)
+}
+
+func isNumber(name string, than float64, cmp func(a, b float64) bool) mapval.IsDef {
+	return mapval.Is(name, func(v interface{}) mapval.ValueResult {
+		f, ok := v.(float64)
+		if !ok {
+			return mapval.ValueResult{
+				Valid:   false,
+				Message: fmt.Sprintf("expected a number, got '%v'", v),
+			}
+		}
+		if cmp(f, than) {
+			return mapval.ValidVR
+		}
+		return mapval.ValueResult{
+			Valid:   false,
+			Message: fmt.Sprintf("%v is not %v %v", f, name, than),
+		}
+	})
+}
+
+func isMatching(m match.Matcher) mapval.IsDef {
+	return mapval.Is("matches", func(v interface{}) mapval.ValueResult {
+		s, ok := v.(string)
+		if !ok {
+			return mapval.ValueResult{
+				Valid:   false,
+				Message: fmt.Sprintf("expected a string, got '%v'", v),
+			}
+		}
+		if m.MatchString(s) {
+			return mapval.ValidVR
+		}
+		return mapval.ValueResult{
+			Valid:   false,
+			Message: fmt.Sprintf("'%v' does not match '%v'", s, m.String()),
+		}
+	})
+}
+
+func toFloat(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case int:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	case uint64:
+		return float64(n), true
+	case float64:
+		return n, true
+	}
+	return 0, false
+}
+
+// checkJSON runs all JSON checks against the response body. The outcome of
+// each check is reported, even if the response is valid. The body is
+// restored, so it can be read by other checks.
+func checkJSON(checks []jsonCheck) ResponseValidator {
+	return func(r *http.Response) (common.MapStr, error) {
+		content, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			return nil, err
+		}
+		r.Body = ioutil.NopCloser(bytes.NewReader(content))
+
+		var body map[string]interface{}
+		if err := json.Unmarshal(content, &body); err != nil {
+			return nil, fmt.Errorf("failed to parse JSON body: %v", err)
+		}
+
+		var failed []string
+		results := make([]common.MapStr, len(checks))
+		for i, check := range checks {
+			result := common.MapStr{
+				"path":   check.path,
+				"status": "up",
+			}
+			if check.description != "" {
+				result["description"] = check.description
+			}
+
+			var msgs []string
+			for _, err := range check.validator(common.MapStr(body)).Errors() {
+				msgs = append(msgs, err.Error())
+			}
+			if len(msgs) > 0 {
+				msg := strings.Join(msgs, "; ")
+				result["status"] = "down"
+				result["message"] = msg
+				failed = append(failed, msg)
+			}
+
+			results[i] = result
+		}
+
+		fields := common.MapStr{"body_checks": results}
+		if len(failed) > 0 {
+			return fields, fmt.Errorf("JSON body check failed: %v", strings.Join(failed, "; "))
+		}
+		return fields, nil
+	}
+}
@@ -0,0 +1,173 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package http
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/heartbeat/monitors"
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+)
+
+const jsonBody = `{
+	"status": "green",
+	"version": {"number": "6.4.0", "build": 1234},
+	"nodes": 3,
+	"ready": true,
+	"tags": ["a", "b"]
+}`
+
+func jsonServer(body string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		io.WriteString(w, body)
+	}))
+}
+
+func testJSONRequest(t *testing.T, url string, checks []map[string]interface{}) beat.Event {
+	config, err := common.NewConfigFrom(map[string]interface{}{
+		"urls":                url,
+		"check.response.json": checks,
+	})
+	require.NoError(t, err)
+
+	jobs, err := create(monitors.Info{}, config)
+	require.NoError(t, err)
+
+	event, _, err := jobs[0].Run()
+	require.NoError(t, err)
+	return event
+}
+
+func TestJSONChecksUp(t *testing.T) {
+	server := jsonServer(jsonBody)
+	defer server.Close()
+
+	event := testJSONRequest(t, server.URL, []map[string]interface{}{
+		{"path": "status", "equals": "green", "description": "cluster is green"},
+		{"path": "version.number", "regex": `^6\.`},
+		{"path": "version.build", "type": "number", "gte": 1000},
+		{"path": "nodes", "equals": 3, "lt": 10},
+		{"path": "ready", "type": "boolean", "equals": true},
+		{"path": "tags", "type": "array"},
+		{"path": "version", "type": "object"},
+		{"path": "status"},
+		{"path": "error", "exists": false},
+	})
+
+	status, _ := event.Fields.GetValue("monitor.status")
+	assert.Equal(t, "up", status)
+
+	v, err := event.Fields.GetValue("http.response.body_checks")
+	require.NoError(t, err)
+	results := v.([]common.MapStr)
+	require.Len(t, results, 9)
+	for _, result := range results {
+		assert.Equal(t, "up", result["status"], result)
+	}
+	assert.Equal(t, "status", results[0]["path"])
+	assert.Equal(t, "cluster is green", results[0]["description"])
+}
+
+func TestJSONChecksDown(t *testing.T) {
+	server := jsonServer(jsonBody)
+	defer server.Close()
+
+	event := testJSONRequest(t, server.URL, []map[string]interface{}{
+		{"path": "status", "equals": "green"},
+		{"path": "nodes", "gt": 5},
+		{"path": "version.number", "type": "number"},
+		{"path": "missing"},
+	})
+
+	status, _ := event.Fields.GetValue("monitor.status")
+	assert.Equal(t, "down", status)
+	errType, _ := event.Fields.GetValue("error.type")
+	assert.Equal(t, "validate", errType)
+
+	v, err := event.Fields.GetValue("http.response.body_checks")
+	require.NoError(t, err)
+	results := v.([]common.MapStr)
+	require.Len(t, results, 4)
+
+	assert.Equal(t, "up", results[0]["status"])
+	for _, result := range results[1:] {
+		assert.Equal(t, "down", result["status"], result)
+		assert.NotEmpty(t, result["message"], result)
+	}
+	assert.Contains(t, results[1]["message"], "not greater than 5")
+	assert.Contains(t, results[3]["message"], "expected this key to be present")
+}
+
+func TestJSONChecksInvalidBody(t *testing.T) {
+	server := jsonServer("not json")
+	defer server.Close()
+
+	event := testJSONRequest(t, server.URL, []map[string]interface{}{
+		{"path": "status", "equals": "green"},
+	})
+
+	status, _ := event.Fields.GetValue("monitor.status")
+	assert.Equal(t, "down", status)
+	msg, _ := event.Fields.GetValue("error.message")
+	assert.Contains(t, msg, "failed to parse JSON body")
+}
+
+func TestJSONChecksWithBodyRegex(t *testing.T) {
+	server := jsonServer(jsonBody)
+	defer server.Close()
+
+	config, err := common.NewConfigFrom(map[string]interface{}{
+		"urls":                server.URL,
+		"check.response.body": "green",
+		"check.response.json": []map[string]interface{}{{"path": "status", "equals": "green"}},
+	})
+	require.NoError(t, err)
+
+	jobs, err := create(monitors.Info{}, config)
+	require.NoError(t, err)
+	event, _, err := jobs[0].Run()
+	require.NoError(t, err)
+
+	status, _ := event.Fields.GetValue("monitor.status")
+	assert.Equal(t, "up", status)
+}
+
+func TestJSONCheckConfigValidate(t *testing.T) {
+	for name, check := range map[string]map[string]interface{}{
+		"missing path":       {"equals": "green"},
+		"unknown type":       {"path": "status", "type": "date"},
+		"missing with value": {"path": "status", "exists": false, "equals": "green"},
+	} {
+		config, err := common.NewConfigFrom(map[string]interface{}{
+			"urls":                "http://localhost",
+			"check.response.json": []map[string]interface{}{check},
+		})
+		require.NoError(t, err)
+
+		_, err = create(monitors.Info{}, config)
+		assert.Error(t, err, name)
+	}
+}
@@ -0,0 +1,362 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/cookiejar"
+	"strings"
+	"time"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/common/fmtstr"
+
+	"github.com/elastic/beats/heartbeat/look"
+	"github.com/elastic/beats/heartbeat/monitors"
+	"github.com/elastic/beats/heartbeat/reason"
+)
+
+// stepConfig configures a single request of a multi-step check. Values
+// extracted from the responses of earlier steps can be used in the URL,
+// headers and body of later steps with format strings like `%{[token]}`.
+type stepConfig struct {
+	Name    string                   `config:"name"`
+	URL     string                   `config:"url" validate:"required"`
+	Check   checkConfig              `config:"check"`
+	Extract map[string]extractConfig `config:"extract"`
+}
+
+// extractConfig selects the value of a variable from a response.
+type extractConfig struct {
+	JSON   string `config:"json"`   // path of a value in the JSON response body
+	Header string `config:"header"` // name of a response header
+	Cookie string `config:"cookie"` // name of a cookie set by the response
+}
+
+type step struct {
+	name      string
+	rawURL    string
+	url       *fmtstr.EventFormatString
+	method    string
+	headers   map[string]*fmtstr.EventFormatString
+	body      *fmtstr.EventFormatString
+	enc       contentEncoder
+	validator ResponseValidator
+	extract   map[string]extractConfig
+}
+
+func (e *extractConfig) Validate() error {
+	set := 0
+	for _, v := range []string{e.JSON, e.Header, e.Cookie} {
+		if v != "" {
+			set++
+		}
+	}
+	if set != 1 {
+		return errors.New("exactly one of json, header or cookie must be set to extract a value")
+	}
+	return nil
+}
+
+func compileSteps(configs []*common.Config) ([]step, error) {
+	steps := make([]step, len(configs))
+	for i, cfg := range configs {
+		config := stepConfig{Check: defaultConfig.Check}
+		if err := cfg.Unpack(&config); err != nil {
+			return nil, fmt.Errorf("invalid step %v: %v", i, err)
+		}
+
+		s, err := compileStep(&config)
+		if err != nil {
+			return nil, fmt.Errorf("invalid step %v: %v", i, err)
+		}
+		if s.name == "" {
+			s.name = fmt.Sprintf("step%v", i)
+		}
+		steps[i] = s
+	}
+	return steps, nil
+}
+
+func compileStep(config *stepConfig) (step, error) {
+	request := &config.Check.Request
+
+	url, err := fmtstr.CompileEvent(config.URL)
+	if err != nil {
+		return step{}, err
+	}
+
+	headers := make(map[string]*fmtstr.EventFormatString, len(request.SendHeaders))
+	for k, v := range request.SendHeaders {
+		if headers[k], err = fmtstr.CompileEvent(v); err != nil {
+			return step{}, err
+		}
+	}
+
+	var body *fmtstr.EventFormatString
+	if request.SendBody != "" {
+		if body, err = fmtstr.CompileEvent(request.SendBody); err != nil {
+			return step{}, err
+		}
+	}
+
+	var enc contentEncoder
+	if request.Compression.Type != "" {
+		enc, err = getContentEncoder(request.Compression.Type, request.Compression.Level)
+		if err != nil {
+			return step{}, err
+		}
+	}
+
+	return step{
+		name:      config.Name,
+		rawURL:    config.URL,
+		url:       url,
+		method:    strings.ToUpper(request.Method),
+		headers:   headers,
+		body:      body,
+		enc:       enc,
+		validator: makeValidateResponse(&config.Check.Response),
+		extract:   config.Extract,
+	}, nil
+}
+
+func newHTTPMonitorStepsJob(
+	config *Config,
+	steps []step,
+	transport http.RoundTripper,
+) (monitors.Job, error) {
+	typ := config.Name
+	jobName := fmt.Sprintf("%v@%v", typ, steps[0].rawURL)
+
+	settings := monitors.MakeJobSetting(jobName)
+	return monitors.MakeSimpleJob(settings, func() (common.MapStr, error) {
+		// Cookies are shared by all steps of a single run only.
+		jar, err := cookiejar.New(nil)
+		if err != nil {
+			return nil, err
+		}
+
+		client := &http.Client{
+			CheckRedirect: makeCheckRedirect(config.MaxRedirects),
+			Transport:     transport,
+			Timeout:       config.Timeout,
+			Jar:           jar,
+		}
+		return runSteps(client, config, steps)
+	}), nil
+}
+
+// runSteps executes the steps in order until a step fails. The results of all
+// executed steps are reported in `http.steps`.
+func runSteps(client *http.Client, config *Config, steps []step) (common.MapStr, error) {
+	vars := &beat.Event{Fields: common.MapStr{}}
+	results := make([]common.MapStr, 0, len(steps))
+
+	start := time.Now()
+	var errReason reason.Reason
+	for _, s := range steps {
+		result, r := s.run(client, config, vars)
+		results = append(results, result)
+		if r != nil {
+			errReason = stepFailed(s.name, r)
+			break
+		}
+	}
+
+	event := common.MapStr{"http": common.MapStr{
+		"steps": results,
+		"rtt": common.MapStr{
+			"total": look.RTT(time.Since(start)),
+		},
+	}}
+	if errReason != nil {
+		return event, errReason
+	}
+	return event, nil
+}
+
+func (s *step) run(client *http.Client, config *Config, vars *beat.Event) (common.MapStr, reason.Reason) {
+	result := common.MapStr{"name": s.name}
+
+	req, err := s.buildRequest(config, vars)
+	if err != nil {
+		errReason := reason.ValidateFailed(err)
+		result.Update(stepStatus(errReason))
+		return result, errReason
+	}
+	result["url"] = req.URL.String()
+
+	ctx, cancel := context.WithTimeout(context.Background(), config.Timeout)
+	defer cancel()
+	req = req.WithContext(ctx)
+
+	start := time.Now()
+	resp, content, fields, errReason := execStepRequest(client, req, s.validator)
+	result["rtt"] = common.MapStr{"total": look.RTT(time.Since(start))}
+	if resp != nil {
+		response := common.MapStr{"status_code": resp.StatusCode}
+		response.Update(fields)
+		result["response"] = response
+	}
+
+	if errReason == nil {
+		for name, e := range s.extract {
+			value, err := e.extract(resp, content)
+			if err != nil {
+				errReason = reason.ValidateFailed(fmt.Errorf("failed to extract '%v': %v", name, err))
+				break
+			}
+			vars.Fields.Put(name, value)
+		}
+	}
+
+	result.Update(stepStatus(errReason))
+	return result, errReason
+}
+
+func (s *step) buildRequest(config *Config, vars *beat.Event) (*http.Request, error) {
+	url, err := s.url.Run(vars)
+	if err != nil {
+		return nil, err
+	}
+
+	var body []byte
+	if s.body != nil {
+		if body, err = s.body.RunBytes(vars); err != nil {
+			return nil, err
+		}
+		if s.enc != nil {
+			buf := bytes.NewBuffer(nil)
+			if err := s.enc.Encode(buf, bytes.NewBuffer(body)); err != nil {
+				return nil, err
+			}
+			body = buf.Bytes()
+		}
+	}
+
+	req, err := http.NewRequest(s.method, url, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Close = true
+
+	if config.Username != "" {
+		req.SetBasicAuth(config.Username, config.Password)
+	}
+	for k, v := range s.headers {
+		value, err := v.Run(vars)
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Add(k, value)
+	}
+	if s.enc != nil {
+		s.enc.AddHeaders(&req.Header)
+	}
+
+	return req, nil
+}
+
+// execStepRequest executes the request and validates the response. The
+// response body is returned, so values can be extracted from it.
+func execStepRequest(client *http.Client, req *http.Request, validator ResponseValidator) (*http.Response, []byte, common.MapStr, reason.Reason) {
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, nil, nil, reason.IOFailed(err)
+	}
+	defer resp.Body.Close()
+
+	content, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return resp, nil, nil, reason.IOFailed(err)
+	}
+
+	resp.Body = ioutil.NopCloser(bytes.NewReader(content))
+	fields, err := validator(resp)
+	if err != nil {
+		return resp, content, fields, reason.ValidateFailed(err)
+	}
+	return resp, content, fields, nil
+}
+
+func (e *extractConfig) extract(resp *http.Response, content []byte) (string, error) {
+	switch {
+	case e.Header != "":
+		value := resp.Header.Get(e.Header)
+		if value == "" {
+			return "", fmt.Errorf("header %v not found", e.Header)
+		}
+		return value, nil
+
+	case e.Cookie != "":
+		for _, c := range resp.Cookies() {
+			if c.Name == e.Cookie {
+				return c.Value, nil
+			}
+		}
+		return "", fmt.Errorf("cookie %v not found", e.Cookie)
+
+	default:
+		var doc map[string]interface{}
+		dec := json.NewDecoder(bytes.NewReader(content))
+		dec.UseNumber()
+		if err := dec.Decode(&doc); err != nil {
+			return "", fmt.Errorf("response body is no JSON object: %v", err)
+		}
+
+		value, err := common.MapStr(doc).GetValue(e.JSON)
+		if err != nil {
+			return "", fmt.Errorf("%v not found in response body", e.JSON)
+		}
+
+		switch v := value.(type) {
+		case string:
+			return v, nil
+		case json.Number, bool:
+			return fmt.Sprint(v), nil
+		default:
+			return "", fmt.Errorf("%v in response body is no string, number or boolean", e.JSON)
+		}
+	}
+}
+
+func stepStatus(err error) common.MapStr {
+	status := common.MapStr{"status": look.Status(err)}
+	if err != nil {
+		status["error"] = look.Reason(err)
+	}
+	return status
+}
+
+// stepFailed prefixes the error reason with the name of the failed step,
+// keeping the type of the reason.
+func stepFailed(name string, r reason.Reason) reason.Reason {
+	err := fmt.Errorf("step '%v' failed: %v", name, r)
+	if r.Type() == "io" {
+		return reason.IOFailed(err)
+	}
+	return reason.ValidateFailed(err)
+}
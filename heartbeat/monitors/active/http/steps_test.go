@@ -0,0 +1,189 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package http
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/heartbeat/monitors"
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+)
+
+func loginServer() *httptest.Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/login", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		http.SetCookie(w, &http.Cookie{Name: "session", Value: "s1"})
+		w.Header().Set("X-Account", "42")
+		fmt.Fprint(w, `{"auth": {"token": "abc"}}`)
+	})
+	mux.HandleFunc("/accounts/42", func(w http.ResponseWriter, r *http.Request) {
+		cookie, err := r.Cookie("session")
+		if err != nil || cookie.Value != "s1" || r.Header.Get("Authorization") != "Bearer abc" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		fmt.Fprint(w, "ok")
+	})
+	return httptest.NewServer(mux)
+}
+
+func runStepsMonitor(t *testing.T, steps []map[string]interface{}) beat.Event {
+	config, err := common.NewConfigFrom(map[string]interface{}{"steps": steps})
+	require.NoError(t, err)
+
+	jobs, err := create(monitors.Info{}, config)
+	require.NoError(t, err)
+	require.Len(t, jobs, 1)
+
+	event, _, err := jobs[0].Run()
+	require.NoError(t, err)
+	return event
+}
+
+func TestSteps(t *testing.T) {
+	server := loginServer()
+	defer server.Close()
+
+	event := runStepsMonitor(t, []map[string]interface{}{
+		{
+			"name":                 "login",
+			"url":                  server.URL + "/login",
+			"check.request.method": "POST",
+			"check.request.body":   `{"user": "test"}`,
+			"extract": map[string]interface{}{
+				"token":   map[string]interface{}{"json": "auth.token"},
+				"account": map[string]interface{}{"header": "X-Account"},
+				"session": map[string]interface{}{"cookie": "session"},
+			},
+		},
+		{
+			"name": "fetch",
+			"url":  server.URL + "/accounts/%{[account]}",
+			"check.request.headers": map[string]interface{}{
+				"Authorization": "Bearer %{[token]}",
+			},
+			"check.response.status": 200,
+		},
+	})
+
+	status, _ := event.Fields.GetValue("monitor.status")
+	assert.Equal(t, "up", status)
+
+	v, _ := event.Fields.GetValue("http.steps")
+	steps := v.([]common.MapStr)
+	require.Len(t, steps, 2)
+	assert.Equal(t, "login", steps[0]["name"])
+	assert.Equal(t, "up", steps[0]["status"])
+	assert.Equal(t, "fetch", steps[1]["name"])
+	assert.Equal(t, server.URL+"/accounts/42", steps[1]["url"])
+	assert.Equal(t, common.MapStr{"status_code": 200}, steps[1]["response"])
+}
+
+func TestStepsFailure(t *testing.T) {
+	server := loginServer()
+	defer server.Close()
+
+	event := runStepsMonitor(t, []map[string]interface{}{
+		{
+			"name":                 "login",
+			"url":                  server.URL + "/login",
+			"check.request.method": "POST",
+			"extract": map[string]interface{}{
+				"token": map[string]interface{}{"json": "auth.missing"},
+			},
+		},
+		{
+			"name": "fetch",
+			"url":  server.URL + "/accounts/42",
+		},
+	})
+
+	status, _ := event.Fields.GetValue("monitor.status")
+	assert.Equal(t, "down", status)
+
+	msg, _ := event.Fields.GetValue("error.message")
+	assert.Contains(t, msg, "step 'login' failed")
+	typ, _ := event.Fields.GetValue("error.type")
+	assert.Equal(t, "validate", typ)
+
+	// steps after the failed step are not run
+	v, _ := event.Fields.GetValue("http.steps")
+	steps := v.([]common.MapStr)
+	require.Len(t, steps, 1)
+	assert.Equal(t, "down", steps[0]["status"])
+}
+
+func TestStepsUnauthorized(t *testing.T) {
+	server := loginServer()
+	defer server.Close()
+
+	event := runStepsMonitor(t, []map[string]interface{}{
+		{"url": server.URL + "/accounts/42"},
+	})
+
+	status, _ := event.Fields.GetValue("monitor.status")
+	assert.Equal(t, "down", status)
+
+	v, _ := event.Fields.GetValue("http.steps")
+	steps := v.([]common.MapStr)
+	require.Len(t, steps, 1)
+	assert.Equal(t, "step0", steps[0]["name"])
+	assert.Equal(t, common.MapStr{"status_code": 401}, steps[0]["response"])
+}
+
+func TestStepsConfig(t *testing.T) {
+	tests := map[string]map[string]interface{}{
+		"no urls or steps": {},
+		"urls and steps": {
+			"urls":  []string{"http://localhost"},
+			"steps": []map[string]interface{}{{"url": "http://localhost"}},
+		},
+		"step without url": {
+			"steps": []map[string]interface{}{{"name": "a"}},
+		},
+		"ambiguous extract": {
+			"steps": []map[string]interface{}{{
+				"url": "http://localhost",
+				"extract": map[string]interface{}{
+					"v": map[string]interface{}{"json": "a", "header": "b"},
+				},
+			}},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			config, err := common.NewConfigFrom(test)
+			require.NoError(t, err)
+
+			_, err = create(monitors.Info{}, config)
+			assert.Error(t, err)
+		})
+	}
+}
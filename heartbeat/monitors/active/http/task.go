@@ -43,7 +43,7 @@ func newHTTPMonitorHostJob(
 	transport *http.Transport,
 	enc contentEncoder,
 	body []byte,
-	validator RespCheck,
+	validator ResponseValidator,
 ) (monitors.Job, error) {
 	typ := config.Name
 	jobName := fmt.Sprintf("%v@%v", typ, addr)
@@ -90,7 +90,7 @@ func newHTTPMonitorIPsJob(
 	tls *transport.TLSConfig,
 	enc contentEncoder,
 	body []byte,
-	validator RespCheck,
+	validator ResponseValidator,
 ) (monitors.Job, error) {
 	typ := config.Name
 	jobName := fmt.Sprintf("%v@%v", typ, addr)
@@ -129,7 +129,7 @@ func createPingFactory(
 	tls *transport.TLSConfig,
 	request *http.Request,
 	body []byte,
-	validator RespCheck,
+	validator ResponseValidator,
 ) func(*net.IPAddr) monitors.TaskRunner {
 	timeout := config.Timeout
 	isTLS := request.URL.Scheme == "https"
@@ -145,7 +145,7 @@ func createPingFactory(
 		// TODO: add socks5 proxy?
 
 		if isTLS {
-			d.AddLayer(dialchain.TLSLayer(tls, timeout))
+			d.AddLayer(dialchain.TLSLayer(tls, timeout, config.Check.TLS))
 		}
 
 		dialer, err := d.Build(event)
@@ -217,22 +217,22 @@ func execPing(
 	req *http.Request,
 	body []byte,
 	timeout time.Duration,
-	validator func(*http.Response) error,
+	validator ResponseValidator,
 ) (start, end time.Time, event common.MapStr, errReason reason.Reason) {
 	ctx, cancel := context.WithTimeout(context.Background(), timeout)
 	defer cancel()
 
 	req = attachRequestBody(&ctx, req, body)
-	start, end, resp, errReason := execRequest(client, req, validator)
+	start, end, resp, fields, errReason := execRequest(client, req, validator)
 
 	if errReason != nil {
 		if resp != nil {
-			return start, end, makeEvent(end.Sub(start), resp), errReason
+			return start, end, makeEvent(end.Sub(start), resp, fields), errReason
 		}
 		return start, end, nil, errReason
 	}
 
-	event = makeEvent(end.Sub(start), resp)
+	event = makeEvent(end.Sub(start), resp, fields)
 
 	return start, end, event, nil
 }
@@ -247,7 +247,7 @@ func attachRequestBody(ctx *context.Context, req *http.Request, body []byte) *ht
 	return req
 }
 
-func execRequest(client *http.Client, req *http.Request, validator func(*http.Response) error) (start time.Time, end time.Time, resp *http.Response, errReason reason.Reason) {
+func execRequest(client *http.Client, req *http.Request, validator ResponseValidator) (start time.Time, end time.Time, resp *http.Response, fields common.MapStr, errReason reason.Reason) {
 	start = time.Now()
 	resp, err := client.Do(req)
 	if resp != nil { // If above errors, the response will be nil
@@ -256,23 +256,26 @@ func execRequest(client *http.Client, req *http.Request, validator func(*http.Re
 	end = time.Now()
 
 	if err != nil {
-		return start, end, nil, reason.IOFailed(err)
+		return start, end, nil, nil, reason.IOFailed(err)
 	}
 
-	err = validator(resp)
+	fields, err = validator(resp)
 	end = time.Now()
 	if err != nil {
-		return start, end, resp, reason.ValidateFailed(err)
+		return start, end, resp, fields, reason.ValidateFailed(err)
 	}
 
-	return start, end, resp, nil
+	return start, end, resp, fields, nil
 }
 
-func makeEvent(rtt time.Duration, resp *http.Response) common.MapStr {
+func makeEvent(rtt time.Duration, resp *http.Response, fields common.MapStr) common.MapStr {
+	response := common.MapStr{
+		"status_code": resp.StatusCode,
+	}
+	response.Update(fields)
+
 	return common.MapStr{"http": common.MapStr{
-		"response": common.MapStr{
-			"status_code": resp.StatusCode,
-		},
+		"response": response,
 		"rtt": common.MapStr{
 			"total": look.RTT(rtt),
 		},
@@ -31,6 +31,10 @@ type Config struct {
 
 	Timeout time.Duration `config:"timeout"`
 	Wait    time.Duration `config:"wait"`
+
+	// Socket selects raw (requires CAP_NET_RAW) or unprivileged datagram
+	// sockets. Auto prefers raw sockets.
+	Socket socketType `config:"socket"`
 }
 
 var DefaultConfig = Config{
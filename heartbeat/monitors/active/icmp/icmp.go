@@ -74,7 +74,7 @@ func create(
 		return nil, loopErr
 	}
 
-	if err := loop.checkNetworkMode(ipVersion); err != nil {
+	if err := loop.checkNetworkMode(ipVersion, config.Socket); err != nil {
 		return nil, err
 	}
 
@@ -99,7 +99,7 @@ func create(
 
 func createPingIPFactory(config *Config) func(*net.IPAddr) (common.MapStr, error) {
 	return func(ip *net.IPAddr) (common.MapStr, error) {
-		rtt, n, err := loop.ping(ip, config.Timeout, config.Wait)
+		rtt, n, err := loop.ping(ip, config.Socket, config.Timeout, config.Wait)
 
 		fields := common.MapStr{"requests": n}
 		if err == nil {
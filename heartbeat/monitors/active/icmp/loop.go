@@ -20,10 +20,10 @@ package icmp
 import (
 	"bytes"
 	"encoding/binary"
-	"errors"
 	"fmt"
 	"math/rand"
 	"net"
+	"strings"
 	"sync"
 	"time"
 
@@ -35,13 +35,20 @@ import (
 )
 
 type icmpLoop struct {
-	conn4, conn6 *icmp.PacketConn
-	recv         chan packet
-
 	mutex    sync.Mutex
+	conns    map[string]*icmpConn // listeners by network, created on first use
+	failed   map[string]error     // networks failed to listen on
 	requests map[requestID]*requestContext
 }
 
+// icmpConn is a raw or datagram socket used to send and receive ICMP
+// messages.
+type icmpConn struct {
+	*icmp.PacketConn
+	proto    int
+	datagram bool
+}
+
 type timeoutError struct {
 }
 
@@ -51,6 +58,23 @@ const (
 	protocolIPv6ICMP = 58
 )
 
+// socketType selects the kind of socket used to send ICMP echo requests. Raw
+// sockets require root or CAP_NET_RAW, datagram sockets must be allowed by
+// the OS (e.g. net.ipv4.ping_group_range on Linux).
+type socketType uint8
+
+const (
+	socketAuto socketType = iota
+	socketRaw
+	socketDatagram
+)
+
+var socketTypeNames = map[socketType]string{
+	socketAuto:     "auto",
+	socketRaw:      "raw",
+	socketDatagram: "datagram",
+}
+
 type packet struct {
 	ts   time.Time
 	addr net.Addr
@@ -86,31 +110,17 @@ var (
 )
 
 func newICMPLoop() (*icmpLoop, error) {
-	// Log errors at info level, as the loop is setup globally when ICMP module is loaded
-	// first (not yet configured).
-	// With multiple configurations using the icmp loop, we have to postpose
-	// IPv4/IPv6 checking
-	conn4 := createListener("IPv4", "ip4:icmp")
-	conn6 := createListener("IPv6", "ip6:ipv6-icmp")
-
+	// Listeners are created when first required by a monitor, as raw and
+	// datagram sockets for IPv4 and IPv6 might not be all available.
 	l := &icmpLoop{
-		conn4:    conn4,
-		conn6:    conn6,
-		recv:     make(chan packet, 16),
+		conns:    map[string]*icmpConn{},
+		failed:   map[string]error{},
 		requests: map[requestID]*requestContext{},
 	}
-
-	if conn4 != nil {
-		go l.runICMPRecv(conn4, protocolICMP)
-	}
-	if conn6 != nil {
-		go l.runICMPRecv(conn6, protocolIPv6ICMP)
-	}
-
 	return l, nil
 }
 
-func (l *icmpLoop) checkNetworkMode(mode string) error {
+func (l *icmpLoop) checkNetworkMode(mode string, typ socketType) error {
 	ip4, ip6 := false, false
 	switch mode {
 	case "ip4":
@@ -123,21 +133,90 @@ func (l *icmpLoop) checkNetworkMode(mode string) error {
 		return fmt.Errorf("'%v' is not supported", mode)
 	}
 
-	if ip4 && l.conn4 == nil {
-		return errors.New("failed to initiate IPv4 support")
+	if ip4 {
+		if _, err := l.conn(false, typ); err != nil {
+			return fmt.Errorf("failed to initiate IPv4 support: %v", err)
+		}
 	}
-	if ip6 && l.conn6 == nil {
-		return errors.New("failed to initiate IPv6 support")
+	if ip6 {
+		if _, err := l.conn(true, typ); err != nil {
+			return fmt.Errorf("failed to initiate IPv6 support: %v", err)
+		}
 	}
 
 	return nil
 }
 
-func (l *icmpLoop) runICMPRecv(conn *icmp.PacketConn, proto int) {
+// conn returns the listener for the IP version and socket type. With
+// socketAuto, a raw socket is preferred over a datagram socket.
+func (l *icmpLoop) conn(v6 bool, typ socketType) (*icmpConn, error) {
+	var datagram []bool
+	switch typ {
+	case socketRaw:
+		datagram = []bool{false}
+	case socketDatagram:
+		datagram = []bool{true}
+	default:
+		datagram = []bool{false, true}
+	}
+
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	var err error
+	for _, dgram := range datagram {
+		var c *icmpConn
+		if c, err = l.listen(v6, dgram); err == nil {
+			return c, nil
+		}
+	}
+	return nil, err
+}
+
+// listen creates the listener for the IP version and socket kind, if not
+// done yet. It must be called with the mutex held.
+func (l *icmpLoop) listen(v6, datagram bool) (*icmpConn, error) {
+	name, network, address, proto := "IPv4", "ip4:icmp", "", protocolICMP
+	if v6 {
+		name, network, proto = "IPv6", "ip6:ipv6-icmp", protocolIPv6ICMP
+	}
+	if datagram {
+		network, address = "udp4", "0.0.0.0"
+		if v6 {
+			network, address = "udp6", "::"
+		}
+	}
+
+	if c := l.conns[network]; c != nil {
+		return c, nil
+	}
+	if err := l.failed[network]; err != nil {
+		return nil, err
+	}
+
+	conn, err := icmp.ListenPacket(network, address)
+
+	// XXX: need to check for conn == nil, as 'err != nil' seems always to be
+	//      true, even if error value itself is `nil`. Checking for conn suppresses
+	//      misleading log message.
+	if conn == nil && err != nil {
+		logp.Info("%v ICMP not supported on %v socket: %v", name, network, err)
+		l.failed[network] = err
+		return nil, err
+	}
+
+	c := &icmpConn{PacketConn: conn, proto: proto, datagram: datagram}
+	l.conns[network] = c
+	go l.runICMPRecv(c)
+	return c, nil
+}
+
+func (l *icmpLoop) runICMPRecv(conn *icmpConn) {
+	proto := conn.proto
 	for {
 		bytes := make([]byte, 512)
 		conn.SetReadDeadline(time.Now().Add(1 * time.Second))
-		_, addr, err := conn.ReadFrom(bytes)
+		n, addr, err := conn.ReadFrom(bytes)
 		if err != nil {
 			if neterr, ok := err.(*net.OpError); ok {
 				if neterr.Timeout() {
@@ -150,7 +229,7 @@ func (l *icmpLoop) runICMPRecv(conn *icmp.PacketConn, proto int) {
 		}
 
 		ts := time.Now()
-		m, err := icmp.ParseMessage(proto, bytes)
+		m, err := icmp.ParseMessage(proto, bytes[:n])
 		if err != nil {
 			continue
 		}
@@ -165,11 +244,15 @@ func (l *icmpLoop) runICMPRecv(conn *icmp.PacketConn, proto int) {
 		}
 
 		id := requestID{
-			addr:  addr.String(),
+			addr:  addrIP(addr),
 			proto: proto,
 			id:    echo.ID,
 			seq:   echo.Seq,
 		}
+		if conn.datagram {
+			// The OS replaces the echo ID of datagram sockets.
+			id.id = 0
+		}
 
 		l.mutex.Lock()
 		ctx := l.requests[id]
@@ -199,6 +282,7 @@ func (l *icmpLoop) runICMPRecv(conn *icmp.PacketConn, proto int) {
 
 func (l *icmpLoop) ping(
 	addr *net.IPAddr,
+	typ socketType,
 	timeout time.Duration,
 	interval time.Duration,
 ) (time.Duration, int, error) {
@@ -239,7 +323,7 @@ func (l *icmpLoop) ping(
 
 	for !done {
 		var ctx *requestContext
-		ctx, err = l.sendEchoRequest(addr)
+		ctx, err = l.sendEchoRequest(addr, typ)
 		if err != nil {
 			close(doneSignal)
 			break
@@ -275,9 +359,8 @@ func (l *icmpLoop) ping(
 	return rtt, requests, nil
 }
 
-func (l *icmpLoop) sendEchoRequest(addr *net.IPAddr) (*requestContext, error) {
-	var conn *icmp.PacketConn
-	var proto int
+func (l *icmpLoop) sendEchoRequest(addr *net.IPAddr, socket socketType) (*requestContext, error) {
+	var v6 bool
 	var typ icmp.Type
 
 	if l == nil {
@@ -285,32 +368,41 @@ func (l *icmpLoop) sendEchoRequest(addr *net.IPAddr) (*requestContext, error) {
 	}
 
 	if isIPv4(addr.IP) {
-		conn = l.conn4
-		proto = protocolICMP
 		typ = ipv4.ICMPTypeEcho
 	} else if isIPv6(addr.IP) {
-		conn = l.conn6
-		proto = protocolIPv6ICMP
+		v6 = true
 		typ = ipv6.ICMPTypeEchoRequest
 	} else {
 		return nil, fmt.Errorf("%v is unknown ip address", addr)
 	}
 
+	conn, err := l.conn(v6, socket)
+	if err != nil {
+		return nil, err
+	}
+
 	id := requestID{
-		addr:  addr.String(),
-		proto: proto,
+		addr:  addr.IP.String(),
+		proto: conn.proto,
 		id:    rand.Intn(0xffff),
 		seq:   rand.Intn(0xffff),
 	}
 
+	var dst net.Addr = addr
+	key := id
+	if conn.datagram {
+		dst = &net.UDPAddr{IP: addr.IP, Zone: addr.Zone}
+		key.id = 0
+	}
+
 	ctx := &requestContext{
 		l:      l,
-		id:     id,
+		id:     key,
 		result: make(chan requestResult, 1),
 	}
 
 	l.mutex.Lock()
-	l.requests[id] = ctx
+	l.requests[key] = ctx
 	l.mutex.Unlock()
 
 	payloadBuf := make([]byte, 0, 8)
@@ -328,8 +420,8 @@ func (l *icmpLoop) sendEchoRequest(addr *net.IPAddr) (*requestContext, error) {
 	}
 	encoded, _ := msg.Marshal(nil)
 
-	_, err := conn.WriteTo(encoded, addr)
-	if err != nil {
+	if _, err := conn.WriteTo(encoded, dst); err != nil {
+		ctx.Stop()
 		return nil, err
 	}
 
@@ -337,17 +429,26 @@ func (l *icmpLoop) sendEchoRequest(addr *net.IPAddr) (*requestContext, error) {
 	return ctx, nil
 }
 
-func createListener(name, network string) *icmp.PacketConn {
-	conn, err := icmp.ListenPacket(network, "")
+// addrIP returns the IP of an address returned by a raw or datagram socket.
+func addrIP(addr net.Addr) string {
+	switch a := addr.(type) {
+	case *net.IPAddr:
+		return a.IP.String()
+	case *net.UDPAddr:
+		return a.IP.String()
+	}
+	return addr.String()
+}
 
-	// XXX: need to check for conn == nil, as 'err != nil' seems always to be
-	//      true, even if error value itself is `nil`. Checking for conn suppresses
-	//      misleading log message.
-	if conn == nil && err != nil {
-		logp.Info("%v ICMP not supported: %v", name, err)
-		return nil
+// Unpack creates a socketType from its name.
+func (t *socketType) Unpack(s string) error {
+	for k, v := range socketTypeNames {
+		if strings.ToLower(s) == v {
+			*t = k
+			return nil
+		}
 	}
-	return conn
+	return fmt.Errorf("invalid icmp socket type '%v' (must be auto, raw or datagram)", s)
 }
 
 // timeoutError implements net.Error interface
@@ -0,0 +1,71 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package icmp
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/libbeat/common"
+)
+
+func TestSocketTypeUnpack(t *testing.T) {
+	for name, expected := range map[string]socketType{
+		"auto":     socketAuto,
+		"raw":      socketRaw,
+		"datagram": socketDatagram,
+		"Datagram": socketDatagram,
+	} {
+		config := DefaultConfig
+		cfg := common.MustNewConfigFrom(map[string]interface{}{
+			"hosts":  []string{"localhost"},
+			"socket": name,
+		})
+		require.NoError(t, cfg.Unpack(&config), name)
+		assert.Equal(t, expected, config.Socket, name)
+	}
+
+	config := DefaultConfig
+	cfg := common.MustNewConfigFrom(map[string]interface{}{
+		"hosts":  []string{"localhost"},
+		"socket": "stream",
+	})
+	assert.Error(t, cfg.Unpack(&config))
+}
+
+func TestPingLocalhost(t *testing.T) {
+	l, err := newICMPLoop()
+	require.NoError(t, err)
+
+	for _, typ := range []socketType{socketRaw, socketDatagram} {
+		if err := l.checkNetworkMode("ip4", typ); err != nil {
+			t.Logf("%v socket not available: %v", socketTypeNames[typ], err)
+			continue
+		}
+
+		addr := &net.IPAddr{IP: net.IPv4(127, 0, 0, 1)}
+		rtt, n, err := l.ping(addr, typ, 2*time.Second, 100*time.Millisecond)
+		require.NoError(t, err, socketTypeNames[typ])
+		assert.Equal(t, 1, n)
+		assert.True(t, rtt > 0)
+	}
+}
@@ -25,6 +25,7 @@ import (
 	"github.com/elastic/beats/libbeat/outputs/transport"
 
 	"github.com/elastic/beats/heartbeat/monitors"
+	"github.com/elastic/beats/heartbeat/monitors/active/dialchain"
 )
 
 type Config struct {
@@ -46,6 +47,9 @@ type Config struct {
 	// validate connection
 	SendString    string `config:"check.send"`
 	ReceiveString string `config:"check.receive"`
+
+	// validate server certificate
+	TLSCheck dialchain.TLSCheck `config:"check.tls"`
 }
 
 var DefaultConfig = Config{
@@ -80,9 +80,10 @@ func create(
 		}
 
 		db, err := dialchain.NewBuilder(dialchain.BuilderSettings{
-			Timeout: timeout,
-			Socks5:  config.Socks5,
-			TLS:     schemeTLS,
+			Timeout:  timeout,
+			Socks5:   config.Socks5,
+			TLS:      schemeTLS,
+			TLSCheck: config.TLSCheck,
 		})
 		if err != nil {
 			return nil, err
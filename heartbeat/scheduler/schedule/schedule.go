@@ -18,21 +18,56 @@
 package schedule
 
 import (
+	"fmt"
+	"hash/fnv"
+	"math/rand"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/elastic/beats/heartbeat/scheduler"
 	"github.com/elastic/beats/heartbeat/scheduler/schedule/cron"
+	"github.com/elastic/beats/libbeat/common"
 )
 
 type Schedule struct {
 	scheduler.Schedule
+
+	// spread runs the jobs of an interval schedule at different offsets
+	// within the interval.
+	spread bool
+
+	// jitter delays every run by a random duration up to jitter, or up to
+	// jitterPercent of the interval.
+	jitter        time.Duration
+	jitterPercent float64
 }
 
 type intervalScheduler struct {
 	interval time.Duration
 }
 
+// spreadScheduler runs every interval, at a fixed phase within the interval.
+type spreadScheduler struct {
+	interval time.Duration
+	phase    time.Duration
+}
+
+// jitterScheduler delays the runs of a schedule by a random duration. The
+// delay of the last run is not taken into account when computing the next run,
+// so jobs don't drift.
+type jitterScheduler struct {
+	scheduler.Schedule
+	max  time.Duration
+	last time.Duration
+}
+
+type scheduleConfig struct {
+	Expression string `config:"expression" validate:"required"`
+	Jitter     string `config:"jitter"`
+	Spread     bool   `config:"spread"`
+}
+
 func Parse(in string) (*Schedule, error) {
 	every := "@every"
 
@@ -44,7 +79,7 @@ func Parse(in string) (*Schedule, error) {
 			return nil, err
 		}
 
-		return &Schedule{intervalScheduler{d}}, nil
+		return &Schedule{Schedule: intervalScheduler{d}, spread: true}, nil
 	}
 
 	// fallback on cron scheduler parsers
@@ -52,17 +87,124 @@ func Parse(in string) (*Schedule, error) {
 	if err != nil {
 		return nil, err
 	}
-	return &Schedule{s}, nil
+	return &Schedule{Schedule: s}, nil
+}
+
+// ParseJitter sets the maximum jitter of the schedule from a duration, or
+// from a percentage of the interval like `10%`.
+func (s *Schedule) ParseJitter(in string) error {
+	s.jitter, s.jitterPercent = 0, 0
+	if in == "" {
+		return nil
+	}
+
+	if strings.HasSuffix(in, "%") {
+		if _, ok := s.Schedule.(intervalScheduler); !ok {
+			return fmt.Errorf("jitter percentage '%v' requires an @every schedule", in)
+		}
+
+		p, err := strconv.ParseFloat(strings.TrimSpace(in[:len(in)-1]), 64)
+		if err != nil || p < 0 || p > 100 {
+			return fmt.Errorf("invalid jitter percentage '%v'", in)
+		}
+		s.jitterPercent = p
+		return nil
+	}
+
+	d, err := time.ParseDuration(in)
+	if err != nil || d < 0 {
+		return fmt.Errorf("invalid jitter '%v'", in)
+	}
+	s.jitter = d
+	return nil
+}
+
+// ForJob returns the schedule of a single job. Interval schedules are spread
+// by the job name, so jobs sharing an interval don't all run at once.
+func (s *Schedule) ForJob(name string) scheduler.Schedule {
+	sched := s.Schedule
+
+	interval, isInterval := sched.(intervalScheduler)
+	if isInterval && s.spread && interval.interval > 0 {
+		sched = spreadScheduler{
+			interval: interval.interval,
+			phase:    phase(name, interval.interval),
+		}
+	}
+
+	max := s.jitter
+	if isInterval && s.jitterPercent > 0 {
+		max = time.Duration(float64(interval.interval) * s.jitterPercent / 100)
+	}
+	if max > 0 {
+		sched = &jitterScheduler{Schedule: sched, max: max}
+	}
+
+	return sched
 }
 
 func (s intervalScheduler) Next(t time.Time) time.Time {
 	return t.Add(s.interval)
 }
 
-func (s *Schedule) Unpack(str string) error {
-	tmp, err := Parse(str)
-	if err == nil {
+func (s spreadScheduler) Next(t time.Time) time.Time {
+	interval := int64(s.interval)
+	offset := (t.UnixNano() - int64(s.phase)) % interval
+	if offset < 0 {
+		offset += interval
+	}
+	return t.Add(time.Duration(interval - offset))
+}
+
+func (s *jitterScheduler) Next(t time.Time) time.Time {
+	next := s.Schedule.Next(t.Add(-s.last))
+	s.last = time.Duration(rand.Int63n(int64(s.max)))
+	return next.Add(s.last)
+}
+
+// phase returns the offset within the interval a job is run at, derived from
+// the job name.
+func phase(name string, interval time.Duration) time.Duration {
+	h := fnv.New64a()
+	h.Write([]byte(name))
+	return time.Duration(h.Sum64() % uint64(interval))
+}
+
+// Unpack creates a schedule from a schedule expression, or from a schedule
+// configuration object.
+func (s *Schedule) Unpack(in interface{}) error {
+	switch v := in.(type) {
+	case string:
+		tmp, err := Parse(v)
+		if err != nil {
+			return err
+		}
+		*s = *tmp
+		return nil
+
+	case map[string]interface{}:
+		cfg, err := common.NewConfigFrom(v)
+		if err != nil {
+			return err
+		}
+
+		config := scheduleConfig{Spread: true}
+		if err := cfg.Unpack(&config); err != nil {
+			return err
+		}
+
+		tmp, err := Parse(config.Expression)
+		if err != nil {
+			return err
+		}
+		if err := tmp.ParseJitter(config.Jitter); err != nil {
+			return err
+		}
+		tmp.spread = tmp.spread && config.Spread
 		*s = *tmp
+		return nil
+
+	default:
+		return fmt.Errorf("invalid schedule '%v'", in)
 	}
-	return err
 }
@@ -0,0 +1,132 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package schedule
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/libbeat/common"
+)
+
+func unpackSchedule(t *testing.T, in interface{}) (*Schedule, error) {
+	cfg, err := common.NewConfigFrom(map[string]interface{}{"schedule": in})
+	require.NoError(t, err)
+
+	config := struct {
+		Schedule *Schedule `config:"schedule" validate:"required"`
+	}{}
+	err = cfg.Unpack(&config)
+	return config.Schedule, err
+}
+
+func TestUnpackExpression(t *testing.T) {
+	s, err := unpackSchedule(t, "@every 30s")
+	require.NoError(t, err)
+	assert.Equal(t, intervalScheduler{30 * time.Second}, s.Schedule)
+	assert.True(t, s.spread)
+
+	s, err = unpackSchedule(t, "*/5 * * * * * *")
+	require.NoError(t, err)
+	assert.False(t, s.spread)
+}
+
+func TestUnpackObject(t *testing.T) {
+	s, err := unpackSchedule(t, map[string]interface{}{
+		"expression": "@every 30s",
+		"jitter":     "10%",
+		"spread":     false,
+	})
+	require.NoError(t, err)
+	assert.False(t, s.spread)
+	assert.Equal(t, 10.0, s.jitterPercent)
+
+	s, err = unpackSchedule(t, map[string]interface{}{
+		"expression": "*/5 * * * * * *",
+		"jitter":     "2s",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 2*time.Second, s.jitter)
+}
+
+func TestUnpackInvalid(t *testing.T) {
+	tests := map[string]interface{}{
+		"bad expression":  "@every soon",
+		"no expression":   map[string]interface{}{"jitter": "1s"},
+		"bad jitter":      map[string]interface{}{"expression": "@every 1m", "jitter": "abc"},
+		"percent too big": map[string]interface{}{"expression": "@every 1m", "jitter": "150%"},
+		"percent on cron": map[string]interface{}{"expression": "*/5 * * * * * *", "jitter": "10%"},
+	}
+
+	for name, in := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := unpackSchedule(t, in)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestSpread(t *testing.T) {
+	s, err := Parse("@every 30s")
+	require.NoError(t, err)
+
+	start := time.Unix(1500000000, 0)
+	phases := map[time.Duration]bool{}
+	for _, name := range []string{"http@a", "http@b", "http@c", "tcp@d"} {
+		sched := s.ForJob(name)
+
+		first := sched.Next(start)
+		assert.True(t, first.After(start))
+		assert.False(t, first.After(start.Add(30*time.Second)))
+
+		// runs keep the interval and their phase
+		second := sched.Next(first)
+		assert.Equal(t, 30*time.Second, second.Sub(first))
+
+		// the same job always gets the same phase
+		assert.Equal(t, first, s.ForJob(name).Next(start))
+
+		phases[first.Sub(start)] = true
+	}
+	assert.True(t, len(phases) > 1, "jobs not spread")
+
+	s.spread = false
+	assert.Equal(t, start.Add(30*time.Second), s.ForJob("http@a").Next(start))
+}
+
+func TestJitter(t *testing.T) {
+	s, err := Parse("@every 10s")
+	require.NoError(t, err)
+	s.spread = false
+	require.NoError(t, s.ParseJitter("50%"))
+
+	sched := s.ForJob("job")
+	start := time.Unix(1500000000, 0)
+	next := start
+	for i := 1; i <= 100; i++ {
+		next = sched.Next(next)
+
+		// jitter never accumulates
+		base := start.Add(time.Duration(i) * 10 * time.Second)
+		assert.False(t, next.Before(base))
+		assert.True(t, next.Before(base.Add(5*time.Second)))
+	}
+}
@@ -80,6 +80,14 @@ type Schedule interface {
 	Next(time.Time) time.Time
 }
 
+// JobSchedule is implemented by schedules creating a separate schedule for
+// each job they are added with. The returned schedule is used by a single job
+// only.
+type JobSchedule interface {
+	Schedule
+	ForJob(name string) Schedule
+}
+
 var debugf = logp.MakeDebug("scheduler")
 
 func New(limit uint) *Scheduler {
@@ -128,6 +136,10 @@ func (s *Scheduler) Stop() error {
 func (s *Scheduler) Add(sched Schedule, name string, entrypoint TaskFunc) func() error {
 	debugf("Add scheduler job '%v'.", name)
 
+	if js, ok := sched.(JobSchedule); ok {
+		sched = js.ForJob(name)
+	}
+
 	j := &job{
 		name:       name,
 		fn:         entrypoint,
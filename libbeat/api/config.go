@@ -17,10 +17,26 @@
 
 package api
 
+import "github.com/elastic/beats/libbeat/common/transport/tlscommon"
+
 type Config struct {
-	Enabled bool
-	Host    string
-	Port    int
+	Enabled    bool
+	Host       string
+	Port       int
+	TLS        *tlscommon.ServerConfig `config:"ssl"`
+	Username   string                  `config:"username"`
+	Password   string                  `config:"password"`
+	Prometheus PrometheusConfig        `config:"prometheus"`
+}
+
+// PrometheusConfig configures the /metrics endpoint, serving the internal
+// metrics in Prometheus exposition format.
+type PrometheusConfig struct {
+	Enabled bool `config:"enabled"`
+
+	// Namespace is the prefix of all metric names. The name of the beat is
+	// used if empty.
+	Namespace string `config:"namespace"`
 }
 
 var (
@@ -28,5 +44,8 @@ var (
 		Enabled: false,
 		Host:    "localhost",
 		Port:    5066,
+		Prometheus: PrometheusConfig{
+			Enabled: true,
+		},
 	}
 )
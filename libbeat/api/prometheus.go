@@ -0,0 +1,139 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package api
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/elastic/beats/libbeat/monitoring"
+)
+
+// prometheusHandler serves the metrics of the stats namespace in Prometheus
+// text exposition format. Metric names are built from the flattened metric
+// names, prefixed by the namespace. String metrics are not exported, but the
+// strings of the info namespace are exported as labels of the
+// `<namespace>_beat_info` metric.
+func prometheusHandler(namespace string) func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+
+		info := monitoring.CollectFlatSnapshot(monitoring.GetNamespace("info").GetRegistry(), monitoring.Full, false)
+		stats := monitoring.CollectFlatSnapshot(monitoring.GetNamespace("stats").GetRegistry(), monitoring.Full, false)
+
+		ns := namespace
+		if ns == "" {
+			ns = info.Strings["beat"]
+		}
+
+		writePrometheus(w, ns, info, stats)
+	}
+}
+
+func writePrometheus(out io.Writer, namespace string, info, stats monitoring.FlatSnapshot) error {
+	w := bufio.NewWriter(out)
+
+	prefix := ""
+	if namespace != "" {
+		prefix = namespace + "_"
+	}
+
+	// Info metric, with the info strings as labels
+	infoName := sanitizeMetricName(prefix + "beat_info")
+	fmt.Fprintf(w, "# TYPE %s gauge\n", infoName)
+	fmt.Fprintf(w, "%s{", infoName)
+	for i, key := range sortedKeys(info.Strings) {
+		if i > 0 {
+			w.WriteString(",")
+		}
+		fmt.Fprintf(w, "%s=\"%s\"", sanitizeLabelName(key), escapeLabelValue(info.Strings[key]))
+	}
+	w.WriteString("} 1\n")
+
+	values := map[string]string{}
+	for name, v := range stats.Ints {
+		values[name] = fmt.Sprintf("%d", v)
+	}
+	for name, v := range stats.Floats {
+		values[name] = fmt.Sprintf("%g", v)
+	}
+	for name, v := range stats.Bools {
+		if v {
+			values[name] = "1"
+		} else {
+			values[name] = "0"
+		}
+	}
+
+	for _, name := range sortedKeys(values) {
+		metric := sanitizeMetricName(prefix + name)
+		fmt.Fprintf(w, "# TYPE %s untyped\n", metric)
+		fmt.Fprintf(w, "%s %s\n", metric, values[name])
+	}
+
+	return w.Flush()
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// sanitizeMetricName replaces the characters not allowed in Prometheus metric
+// names by underscores.
+func sanitizeMetricName(name string) string {
+	return sanitize(name, true)
+}
+
+// sanitizeLabelName replaces the characters not allowed in Prometheus label
+// names by underscores.
+func sanitizeLabelName(name string) string {
+	return sanitize(name, false)
+}
+
+func sanitize(name string, allowColon bool) string {
+	b := []byte(name)
+	for i, c := range b {
+		switch {
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9', c == '_':
+		case c == ':' && allowColon:
+		default:
+			b[i] = '_'
+		}
+	}
+
+	// Names cannot start with a digit
+	if len(b) > 0 && b[0] >= '0' && b[0] <= '9' {
+		return "_" + string(b)
+	}
+	return string(b)
+}
+
+var labelValueEscaper = strings.NewReplacer("\\", "\\\\", "\"", "\\\"", "\n", "\\n")
+
+func escapeLabelValue(v string) string {
+	return labelValueEscaper.Replace(v)
+}
@@ -0,0 +1,89 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package api
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elastic/beats/libbeat/monitoring"
+)
+
+func TestWritePrometheus(t *testing.T) {
+	info := monitoring.MakeFlatSnapshot()
+	info.Strings["beat"] = "testbeat"
+	info.Strings["version"] = "7.0.0"
+	info.Strings["name"] = "host \"one\""
+
+	stats := monitoring.MakeFlatSnapshot()
+	stats.Ints["libbeat.pipeline.events.total"] = 42
+	stats.Floats["system.load.1"] = 0.5
+	stats.Bools["beat.ready"] = true
+	stats.Strings["ignored"] = "value"
+
+	var buf bytes.Buffer
+	err := writePrometheus(&buf, "testbeat", info, stats)
+	assert.NoError(t, err)
+
+	expected := `# TYPE testbeat_beat_info gauge
+testbeat_beat_info{beat="testbeat",name="host \"one\"",version="7.0.0"} 1
+# TYPE testbeat_beat_ready untyped
+testbeat_beat_ready 1
+# TYPE testbeat_libbeat_pipeline_events_total untyped
+testbeat_libbeat_pipeline_events_total 42
+# TYPE testbeat_system_load_1 untyped
+testbeat_system_load_1 0.5
+`
+	assert.Equal(t, expected, buf.String())
+}
+
+func TestSanitizeMetricName(t *testing.T) {
+	assert.Equal(t, "a_b_c", sanitizeMetricName("a.b-c"))
+	assert.Equal(t, "_1m", sanitizeMetricName("1m"))
+	assert.Equal(t, "a:b", sanitizeMetricName("a:b"))
+	assert.Equal(t, "a_b", sanitizeLabelName("a:b"))
+}
+
+func TestBasicAuth(t *testing.T) {
+	handler := basicAuth("user", "secret", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	tests := []struct {
+		user, pass string
+		status     int
+	}{
+		{"user", "secret", http.StatusOK},
+		{"user", "wrong", http.StatusUnauthorized},
+		{"", "", http.StatusUnauthorized},
+	}
+
+	for _, test := range tests {
+		req := httptest.NewRequest("GET", "/metrics", nil)
+		if test.user != "" {
+			req.SetBasicAuth(test.user, test.pass)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		assert.Equal(t, test.status, rec.Code)
+	}
+}
@@ -18,6 +18,7 @@
 package api
 
 import (
+	"crypto/subtle"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -25,6 +26,7 @@ import (
 
 	"github.com/elastic/beats/libbeat/common"
 	"github.com/elastic/beats/libbeat/common/cfgwarn"
+	"github.com/elastic/beats/libbeat/common/transport/tlscommon"
 	"github.com/elastic/beats/libbeat/logp"
 	"github.com/elastic/beats/libbeat/monitoring"
 )
@@ -35,6 +37,12 @@ func Start(cfg *common.Config) {
 	config := DefaultConfig
 	cfg.Unpack(&config)
 
+	tlsConfig, err := tlscommon.LoadTLSServerConfig(config.TLS)
+	if err != nil {
+		logp.Err("Failed to load the TLS config of the stats endpoint: %v", err)
+		return
+	}
+
 	logp.Info("Starting stats endpoint")
 	go func() {
 		mux := http.NewServeMux()
@@ -44,14 +52,45 @@ func Start(cfg *common.Config) {
 		mux.HandleFunc("/state", stateHandler)
 		mux.HandleFunc("/stats", statsHandler)
 		mux.HandleFunc("/dataset", datasetHandler)
+		if config.Prometheus.Enabled {
+			mux.HandleFunc("/metrics", prometheusHandler(config.Prometheus.Namespace))
+		}
+
+		var handler http.Handler = mux
+		if config.Username != "" {
+			handler = basicAuth(config.Username, config.Password, mux)
+		}
 
 		url := config.Host + ":" + strconv.Itoa(config.Port)
 		logp.Info("Metrics endpoint listening on: %s", url)
-		endpoint := http.ListenAndServe(url, mux)
+		server := &http.Server{Addr: url, Handler: handler}
+		var endpoint error
+		if tlsConfig != nil {
+			server.TLSConfig = tlsConfig.BuildModuleConfig(config.Host)
+			endpoint = server.ListenAndServeTLS("", "")
+		} else {
+			endpoint = server.ListenAndServe()
+		}
 		logp.Info("finished starting stats endpoint: %v", endpoint)
 	}()
 }
 
+// basicAuth wraps the handler, requiring the requests to authenticate with
+// the given credentials.
+func basicAuth(username, password string, h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user, pass, ok := r.BasicAuth()
+		if !ok ||
+			subtle.ConstantTimeCompare([]byte(user), []byte(username)) != 1 ||
+			subtle.ConstantTimeCompare([]byte(pass), []byte(password)) != 1 {
+			w.Header().Set("WWW-Authenticate", `Basic realm="beat"`)
+			http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+			return
+		}
+		h.ServeHTTP(w, r)
+	})
+}
+
 func rootHandler() func(http.ResponseWriter, *http.Request) {
 	return func(w http.ResponseWriter, r *http.Request) {
 		// Return error page
@@ -26,6 +26,7 @@ import (
 
 	"github.com/elastic/beats/libbeat/common"
 	"github.com/elastic/beats/libbeat/logp"
+	"github.com/elastic/beats/libbeat/processors"
 )
 
 // GetContainerID returns the id of a container
@@ -71,13 +72,25 @@ func GetHintAsList(hints common.MapStr, key, config string) []string {
 	return nil
 }
 
-// GetProcessors gets processor definitions from the hints and returns a list of configs as a MapStr
+// GetProcessors gets processor definitions from the hints and returns a list of configs as a MapStr.
+// Processors declared for all builders (e.g. `co.elastic.processors/1.add_locale...`) come before the
+// ones declared for the given key. Processor definitions that are not valid are discarded.
 func GetProcessors(hints common.MapStr, key string) []common.MapStr {
-	rawProcs := GetHintMapStr(hints, key, "processors")
-	if rawProcs == nil {
-		return nil
+	var configs []common.MapStr
+	if rawProcs, err := hints.GetValue("processors"); err == nil {
+		if procs, ok := rawProcs.(common.MapStr); ok {
+			configs = append(configs, getProcessors(procs)...)
+		}
 	}
 
+	if rawProcs := GetHintMapStr(hints, key, "processors"); rawProcs != nil {
+		configs = append(configs, getProcessors(rawProcs)...)
+	}
+
+	return configs
+}
+
+func getProcessors(rawProcs common.MapStr) []common.MapStr {
 	var words, nums []string
 
 	for key := range rawProcs {
@@ -90,17 +103,18 @@ func GetProcessors(hints common.MapStr, key string) []common.MapStr {
 	}
 
 	sort.Strings(nums)
+	sort.Strings(words)
 
 	var configs []common.MapStr
 	for _, key := range nums {
 		rawCfg, _ := rawProcs[key]
 		if config, ok := rawCfg.(common.MapStr); ok {
-			configs = append(configs, config)
+			configs = appendProcessor(configs, config)
 		}
 	}
 
 	for _, word := range words {
-		configs = append(configs, common.MapStr{
+		configs = appendProcessor(configs, common.MapStr{
 			word: rawProcs[word],
 		})
 	}
@@ -108,6 +122,40 @@ func GetProcessors(hints common.MapStr, key string) []common.MapStr {
 	return configs
 }
 
+// appendProcessor appends the processor definition to the list if the
+// processor can be created from it. Otherwise the definition is logged and
+// discarded, so an invalid hint doesn't prevent the rest of the configuration
+// from being launched.
+func appendProcessor(configs []common.MapStr, config common.MapStr) []common.MapStr {
+	if err := checkProcessor(config); err != nil {
+		logp.Err("Invalid processor hint %v: %v", config, err)
+		return configs
+	}
+
+	return append(configs, config)
+}
+
+// checkProcessor creates the processor declared by the definition, so unknown
+// processors and invalid options are reported when the configuration is
+// generated instead of when it is launched.
+func checkProcessor(config common.MapStr) error {
+	cfg, err := common.NewConfigFrom(config)
+	if err != nil {
+		return err
+	}
+
+	var pluginConfig map[string]*common.Config
+	if err := cfg.Unpack(&pluginConfig); err != nil {
+		return err
+	}
+
+	procs, err := processors.New(processors.PluginConfig{pluginConfig})
+	if err != nil {
+		return err
+	}
+	return procs.Close()
+}
+
 func getStringAsList(input string) []string {
 	if input == "" {
 		return []string{}
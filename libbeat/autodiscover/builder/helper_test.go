@@ -23,6 +23,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/elastic/beats/libbeat/common"
+	_ "github.com/elastic/beats/libbeat/processors/actions"
 )
 
 func TestGenerateHints(t *testing.T) {
@@ -72,3 +73,108 @@ func TestGenerateHints(t *testing.T) {
 		assert.Equal(t, GenerateHints(annMap, "foobar", "co.elastic"), test.result)
 	}
 }
+
+func TestGetProcessors(t *testing.T) {
+	tests := []struct {
+		message string
+		hints   common.MapStr
+		result  []common.MapStr
+	}{
+		{
+			message: "No processors",
+			hints:   common.MapStr{},
+			result:  nil,
+		},
+		{
+			message: "Processors for all builders go before the ones of the key",
+			hints: common.MapStr{
+				"processors": common.MapStr{
+					"1": common.MapStr{
+						"drop_fields": common.MapStr{"fields": []string{"a"}},
+					},
+				},
+				"logs": common.MapStr{
+					"processors": common.MapStr{
+						"drop_event": common.MapStr{},
+					},
+				},
+				"metrics": common.MapStr{
+					"processors": common.MapStr{
+						"include_fields": common.MapStr{"fields": []string{"b"}},
+					},
+				},
+			},
+			result: []common.MapStr{
+				{"drop_fields": common.MapStr{"fields": []string{"a"}}},
+				{"drop_event": common.MapStr{}},
+			},
+		},
+		{
+			message: "Invalid processors are discarded",
+			hints: common.MapStr{
+				"logs": common.MapStr{
+					"processors": common.MapStr{
+						"1": common.MapStr{
+							"drop_fields":    common.MapStr{"fields": []string{"a"}},
+							"include_fields": common.MapStr{"fields": []string{"b"}},
+						},
+						"2": common.MapStr{
+							"drop_event": common.MapStr{},
+						},
+						"unknown": common.MapStr{},
+					},
+				},
+			},
+			result: []common.MapStr{
+				{"drop_event": common.MapStr{}},
+			},
+		},
+		{
+			message: "Processors with invalid options are discarded",
+			hints: common.MapStr{
+				"logs": common.MapStr{
+					"processors": common.MapStr{
+						"1": common.MapStr{
+							"drop_fields": common.MapStr{"fields": []string{"/[/"}},
+						},
+						"2": common.MapStr{
+							"drop_fields": common.MapStr{"fields": []string{"/a.*/"}},
+						},
+					},
+				},
+			},
+			result: []common.MapStr{
+				{"drop_fields": common.MapStr{"fields": []string{"/a.*/"}}},
+			},
+		},
+		{
+			message: "Conditional processors are supported",
+			hints: common.MapStr{
+				"logs": common.MapStr{
+					"processors": common.MapStr{
+						"1": common.MapStr{
+							"if":   common.MapStr{"equals": common.MapStr{"level": "debug"}},
+							"then": common.MapStr{"drop_event": common.MapStr{}},
+							"else": common.MapStr{"drop_fields": common.MapStr{"fields": []string{"a"}}},
+						},
+						"2": common.MapStr{
+							"if":   nil,
+							"then": common.MapStr{"drop_event": common.MapStr{}},
+						},
+					},
+				},
+			},
+			result: []common.MapStr{
+				{
+					"if":   common.MapStr{"equals": common.MapStr{"level": "debug"}},
+					"then": common.MapStr{"drop_event": common.MapStr{}},
+					"else": common.MapStr{"drop_fields": common.MapStr{"fields": []string{"a"}}},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.result, GetProcessors(test.hints, "logs"), test.message)
+	}
+}
@@ -0,0 +1,243 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package nomad
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/elastic/beats/libbeat/common/transport/tlscommon"
+	"github.com/elastic/beats/libbeat/outputs/transport"
+)
+
+// Allocation client status values, as reported by the Nomad API.
+const (
+	AllocClientStatusPending  = "pending"
+	AllocClientStatusRunning  = "running"
+	AllocClientStatusComplete = "complete"
+	AllocClientStatusFailed   = "failed"
+	AllocClientStatusLost     = "lost"
+)
+
+// AllocationListStub is the summary of an allocation returned when listing
+// allocations.
+type AllocationListStub struct {
+	ID            string
+	Namespace     string
+	Name          string
+	NodeID        string
+	JobID         string
+	TaskGroup     string
+	ClientStatus  string
+	DesiredStatus string
+	CreateIndex   uint64
+	ModifyIndex   uint64
+}
+
+// Allocation is the subset of a Nomad allocation used by the provider.
+type Allocation struct {
+	ID            string
+	Namespace     string
+	Name          string
+	NodeID        string
+	JobID         string
+	Job           *Job
+	TaskGroup     string
+	TaskResources map[string]*Resources
+	ClientStatus  string
+	DesiredStatus string
+	CreateIndex   uint64
+	ModifyIndex   uint64
+}
+
+// Job is the subset of a Nomad job used by the provider.
+type Job struct {
+	ID          string
+	Name        string
+	Type        string
+	Region      string
+	Namespace   string
+	Datacenters []string
+	Meta        map[string]string
+	TaskGroups  []*TaskGroup
+}
+
+// TaskGroup is a group of tasks that are placed together in an allocation.
+type TaskGroup struct {
+	Name  string
+	Meta  map[string]string
+	Tasks []*Task
+}
+
+// Task is a single unit of work in a task group.
+type Task struct {
+	Name   string
+	Driver string
+	Meta   map[string]string
+}
+
+// Resources holds the resources assigned to a task.
+type Resources struct {
+	Networks []*NetworkResource
+}
+
+// NetworkResource holds the address and ports assigned to a task.
+type NetworkResource struct {
+	IP            string
+	ReservedPorts []Port
+	DynamicPorts  []Port
+}
+
+// Port is a labeled port assigned to a task.
+type Port struct {
+	Label string
+	Value int
+}
+
+// client is a minimal client for the Nomad HTTP API.
+type client struct {
+	address  string
+	http     *http.Client
+	query    url.Values
+	secretID string
+}
+
+func newClient(config *Config) (*client, error) {
+	address := config.Address
+	if !strings.Contains(address, "://") {
+		address = "http://" + address
+	}
+	if _, err := url.Parse(address); err != nil {
+		return nil, fmt.Errorf("invalid nomad address '%v': %v", config.Address, err)
+	}
+
+	tlsConfig, err := tlscommon.LoadTLSConfig(config.TLS)
+	if err != nil {
+		return nil, fmt.Errorf("fail to load the TLS config: %v", err)
+	}
+
+	dialer := transport.NetDialer(config.Timeout)
+	tlsDialer, err := transport.TLSDialer(dialer, tlsConfig, config.Timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	query := url.Values{}
+	if config.Region != "" {
+		query.Set("region", config.Region)
+	}
+	if config.Namespace != "" {
+		query.Set("namespace", config.Namespace)
+	}
+	if config.AllowStale {
+		query.Set("stale", "")
+	}
+
+	return &client{
+		address: strings.TrimRight(address, "/"),
+		http: &http.Client{
+			Transport: &http.Transport{
+				Dial:    dialer.Dial,
+				DialTLS: tlsDialer.Dial,
+			},
+			// Blocking queries can take up to the wait time to return.
+			Timeout: config.WaitTime + config.Timeout,
+		},
+		query:    query,
+		secretID: config.SecretID,
+	}, nil
+}
+
+// Allocations lists the allocations known to the cluster. If index is not
+// zero, the request blocks until the allocations change after the given index
+// or the wait time elapses. The index of the returned list is also returned.
+func (c *client) Allocations(ctx context.Context, index uint64, wait string) ([]*AllocationListStub, uint64, error) {
+	params := url.Values{}
+	if index > 0 {
+		params.Set("index", strconv.FormatUint(index, 10))
+		params.Set("wait", wait)
+	}
+
+	var allocs []*AllocationListStub
+	newIndex, err := c.get(ctx, "/v1/allocations", params, &allocs)
+	return allocs, newIndex, err
+}
+
+// Allocation returns the full details of an allocation.
+func (c *client) Allocation(ctx context.Context, id string) (*Allocation, error) {
+	var alloc Allocation
+	_, err := c.get(ctx, "/v1/allocation/"+url.PathEscape(id), nil, &alloc)
+	if err != nil {
+		return nil, err
+	}
+	return &alloc, nil
+}
+
+func (c *client) get(ctx context.Context, path string, params url.Values, out interface{}) (uint64, error) {
+	query := url.Values{}
+	for k, v := range c.query {
+		query[k] = v
+	}
+	for k, v := range params {
+		query[k] = v
+	}
+
+	u := c.address + path
+	if len(query) > 0 {
+		u += "?" + query.Encode()
+	}
+
+	req, err := http.NewRequest("GET", u, nil)
+	if err != nil {
+		return 0, err
+	}
+	req = req.WithContext(ctx)
+	if c.secretID != "" {
+		req.Header.Set("X-Nomad-Token", c.secretID)
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return 0, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("HTTP error %d in %s: %s", resp.StatusCode, path, strings.TrimSpace(string(body)))
+	}
+
+	var index uint64
+	if raw := resp.Header.Get("X-Nomad-Index"); raw != "" {
+		index, err = strconv.ParseUint(raw, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid X-Nomad-Index header '%v': %v", raw, err)
+		}
+	}
+
+	return index, json.Unmarshal(body, out)
+}
@@ -0,0 +1,64 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package nomad
+
+import (
+	"time"
+
+	"github.com/elastic/beats/libbeat/autodiscover/template"
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/common/transport/tlscommon"
+)
+
+// Config for the Nomad autodiscover provider
+type Config struct {
+	Address        string            `config:"address"`
+	Region         string            `config:"region"`
+	Namespace      string            `config:"namespace"`
+	SecretID       string            `config:"secret_id"`
+	Node           string            `config:"node"`
+	AllowStale     bool              `config:"allow_stale"`
+	TLS            *tlscommon.Config `config:"ssl"`
+	Timeout        time.Duration     `config:"timeout" validate:"positive"`
+	WaitTime       time.Duration     `config:"wait_time" validate:"positive"`
+	CleanupTimeout time.Duration     `config:"cleanup_timeout"`
+
+	Prefix       string                  `config:"prefix"`
+	HintsEnabled bool                    `config:"hints.enabled"`
+	Builders     []*common.Config        `config:"builders"`
+	Appenders    []*common.Config        `config:"appenders"`
+	Templates    template.MapperSettings `config:"templates"`
+}
+
+func defaultConfig() *Config {
+	return &Config{
+		Address:        "http://127.0.0.1:4646",
+		AllowStale:     true,
+		Timeout:        10 * time.Second,
+		WaitTime:       15 * time.Second,
+		CleanupTimeout: 60 * time.Second,
+		Prefix:         "co.elastic",
+	}
+}
+
+func (c *Config) Validate() {
+	// Make sure that prefix doesn't ends with a '.'
+	if c.Prefix[len(c.Prefix)-1] == '.' && c.Prefix != "." {
+		c.Prefix = c.Prefix[:len(c.Prefix)-2]
+	}
+}
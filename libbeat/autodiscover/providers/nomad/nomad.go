@@ -0,0 +1,278 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package nomad
+
+import (
+	"time"
+
+	"github.com/elastic/beats/libbeat/autodiscover"
+	"github.com/elastic/beats/libbeat/autodiscover/builder"
+	"github.com/elastic/beats/libbeat/autodiscover/template"
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/common/bus"
+	"github.com/elastic/beats/libbeat/common/cfgwarn"
+	"github.com/elastic/beats/libbeat/common/safemapstr"
+	"github.com/elastic/beats/libbeat/logp"
+)
+
+func init() {
+	autodiscover.Registry.AddProvider("nomad", AutodiscoverBuilder)
+}
+
+// Provider implements autodiscover provider for Nomad allocations
+type Provider struct {
+	config    *Config
+	bus       bus.Bus
+	watcher   *watcher
+	templates *template.Mapper
+	builders  autodiscover.Builders
+	appenders autodiscover.Appenders
+}
+
+// AutodiscoverBuilder builds and returns an autodiscover provider
+func AutodiscoverBuilder(bus bus.Bus, c *common.Config) (autodiscover.Provider, error) {
+	cfgwarn.Experimental("The nomad autodiscover is experimental")
+	config := defaultConfig()
+	err := c.Unpack(&config)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := newClient(config)
+	if err != nil {
+		return nil, err
+	}
+
+	mapper, err := template.NewConfigMapper(config.Templates)
+	if err != nil {
+		return nil, err
+	}
+
+	builders, err := autodiscover.NewBuilders(config.Builders, config.HintsEnabled)
+	if err != nil {
+		return nil, err
+	}
+
+	appenders, err := autodiscover.NewAppenders(config.Appenders)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &Provider{
+		config:    config,
+		bus:       bus,
+		templates: mapper,
+		builders:  builders,
+		appenders: appenders,
+	}
+
+	p.watcher = newWatcher(client, config.Node, config.WaitTime, WatcherHandler{
+		StartFunc: func(alloc *Allocation) {
+			p.emit(alloc, "start")
+		},
+		StopFunc: func(alloc *Allocation) {
+			time.AfterFunc(config.CleanupTimeout, func() { p.emit(alloc, "stop") })
+		},
+	})
+
+	return p, nil
+}
+
+// Start the autodiscover provider
+func (p *Provider) Start() {
+	if err := p.watcher.Start(); err != nil {
+		logp.Err("Error starting nomad autodiscover provider: %s", err)
+	}
+}
+
+// Stop the autodiscover provider
+func (p *Provider) Stop() {
+	p.watcher.Stop()
+}
+
+func (p *Provider) String() string {
+	return "nomad"
+}
+
+// emit publishes an event per task of the allocation, and per port if the
+// task has ports assigned.
+func (p *Provider) emit(alloc *Allocation, flag string) {
+	group := findTaskGroup(alloc)
+	if group == nil {
+		logp.Err("nomad: task group %v not found in allocation %v", alloc.TaskGroup, alloc.ID)
+		return
+	}
+
+	for _, task := range group.Tasks {
+		meta := allocationMetadata(alloc, group, task)
+
+		host, ports := taskNetwork(alloc.TaskResources[task.Name])
+
+		// Without this check there would be overlapping configurations with and without ports.
+		if len(ports) == 0 {
+			event := bus.Event{
+				flag:    true,
+				"host":  host,
+				"nomad": meta,
+				"meta": common.MapStr{
+					"nomad": meta,
+				},
+			}
+			p.publish(event)
+		}
+
+		for _, port := range ports {
+			event := bus.Event{
+				flag:    true,
+				"host":  host,
+				"port":  port,
+				"nomad": meta,
+				"meta": common.MapStr{
+					"nomad": meta,
+				},
+			}
+			p.publish(event)
+		}
+	}
+}
+
+func (p *Provider) publish(event bus.Event) {
+	// Try to match a config
+	if config := p.templates.GetConfig(event); config != nil {
+		event["config"] = config
+	} else {
+		// If there isn't a default template then attempt to use builders
+		if config := p.builders.GetConfig(p.generateHints(event)); config != nil {
+			event["config"] = config
+		}
+	}
+
+	// Call all appenders to append any extra configuration
+	p.appenders.Append(event)
+	p.bus.Publish(event)
+}
+
+func (p *Provider) generateHints(event bus.Event) bus.Event {
+	// Try to build a config with enabled builders. Send a provider agnostic payload.
+	// Builders are Beat specific.
+	e := bus.Event{}
+	var nomadMeta common.MapStr
+
+	if rawMeta, ok := event["nomad"]; ok {
+		nomadMeta = rawMeta.(common.MapStr)
+		// The builder base config can configure any of the field values of nomad if need be.
+		e["nomad"] = nomadMeta
+	}
+	if host, ok := event["host"]; ok {
+		e["host"] = host
+	}
+	if port, ok := event["port"]; ok {
+		e["port"] = port
+	}
+
+	if meta, err := nomadMeta.GetValue("meta"); err == nil {
+		hints := builder.GenerateHints(meta.(common.MapStr), "", p.config.Prefix)
+		if len(hints) != 0 {
+			e["hints"] = hints
+		}
+	}
+
+	return e
+}
+
+func findTaskGroup(alloc *Allocation) *TaskGroup {
+	if alloc.Job == nil {
+		return nil
+	}
+	for _, group := range alloc.Job.TaskGroups {
+		if group.Name == alloc.TaskGroup {
+			return group
+		}
+	}
+	return nil
+}
+
+// allocationMetadata builds the metadata of a task in an allocation. Meta
+// values defined at the job, group and task level are merged, the most
+// specific level taking precedence, as Nomad does.
+func allocationMetadata(alloc *Allocation, group *TaskGroup, task *Task) common.MapStr {
+	metaMap := common.MapStr{}
+	for _, m := range []map[string]string{alloc.Job.Meta, group.Meta, task.Meta} {
+		for k, v := range m {
+			safemapstr.Put(metaMap, k, v)
+		}
+	}
+
+	namespace := alloc.Namespace
+	if namespace == "" {
+		namespace = alloc.Job.Namespace
+	}
+
+	meta := common.MapStr{
+		"allocation": common.MapStr{
+			"id":     alloc.ID,
+			"name":   alloc.Name,
+			"status": alloc.ClientStatus,
+		},
+		"job": common.MapStr{
+			"name": alloc.Job.Name,
+			"type": alloc.Job.Type,
+		},
+		"task": common.MapStr{
+			"name":   task.Name,
+			"group":  group.Name,
+			"driver": task.Driver,
+		},
+		"node": common.MapStr{
+			"id": alloc.NodeID,
+		},
+		"meta": metaMap,
+	}
+	if namespace != "" {
+		meta["namespace"] = namespace
+	}
+	if alloc.Job.Region != "" {
+		meta["region"] = alloc.Job.Region
+	}
+	if len(alloc.Job.Datacenters) > 0 {
+		meta["datacenters"] = alloc.Job.Datacenters
+	}
+	return meta
+}
+
+// taskNetwork returns the IP address and the ports assigned to a task.
+func taskNetwork(resources *Resources) (string, []int) {
+	if resources == nil {
+		return "", nil
+	}
+
+	var host string
+	var ports []int
+	for _, network := range resources.Networks {
+		if host == "" {
+			host = network.IP
+		}
+		for _, port := range network.ReservedPorts {
+			ports = append(ports, port.Value)
+		}
+		for _, port := range network.DynamicPorts {
+			ports = append(ports, port.Value)
+		}
+	}
+	return host, ports
+}
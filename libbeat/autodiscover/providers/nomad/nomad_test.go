@@ -0,0 +1,235 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package nomad
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elastic/beats/libbeat/autodiscover/template"
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/common/bus"
+)
+
+func TestGenerateHints(t *testing.T) {
+	tests := []struct {
+		event  bus.Event
+		result bus.Event
+	}{
+		// Empty events should return empty hints
+		{
+			event:  bus.Event{},
+			result: bus.Event{},
+		},
+		// Meta values with the prefix must be converted to hints
+		{
+			event: bus.Event{
+				"host": "10.0.0.1",
+				"port": 6379,
+				"nomad": common.MapStr{
+					"task": common.MapStr{"name": "redis"},
+					"meta": common.MapStr{
+						"co": common.MapStr{
+							"elastic": common.MapStr{
+								"metrics/module": "redis",
+							},
+						},
+						"version": "3",
+					},
+				},
+			},
+			result: bus.Event{
+				"host": "10.0.0.1",
+				"port": 6379,
+				"nomad": common.MapStr{
+					"task": common.MapStr{"name": "redis"},
+					"meta": common.MapStr{
+						"co": common.MapStr{
+							"elastic": common.MapStr{
+								"metrics/module": "redis",
+							},
+						},
+						"version": "3",
+					},
+				},
+				"hints": common.MapStr{
+					"metrics": common.MapStr{
+						"module": "redis",
+					},
+				},
+			},
+		},
+	}
+
+	p := Provider{
+		config: defaultConfig(),
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.result, p.generateHints(test.event))
+	}
+}
+
+func testAllocation() *Allocation {
+	return &Allocation{
+		ID:           "f6d4c4b5-fc9c-4d6e-9c2c-0a9ad3f3c1e1",
+		Name:         "cache.redis[0]",
+		Namespace:    "default",
+		NodeID:       "node-1",
+		TaskGroup:    "redis",
+		ClientStatus: AllocClientStatusRunning,
+		Job: &Job{
+			Name:        "cache",
+			Type:        "service",
+			Region:      "global",
+			Datacenters: []string{"dc1"},
+			Meta:        map[string]string{"team": "ops", "env": "dev"},
+			TaskGroups: []*TaskGroup{
+				{
+					Name: "redis",
+					Meta: map[string]string{"env": "prod"},
+					Tasks: []*Task{
+						{
+							Name:   "redis",
+							Driver: "docker",
+							Meta:   map[string]string{"co.elastic.metrics/module": "redis"},
+						},
+						{
+							Name:   "sidecar",
+							Driver: "exec",
+						},
+					},
+				},
+			},
+		},
+		TaskResources: map[string]*Resources{
+			"redis": {
+				Networks: []*NetworkResource{
+					{
+						IP:           "10.0.0.1",
+						DynamicPorts: []Port{{Label: "db", Value: 6379}},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestEmitEvent(t *testing.T) {
+	mapper, err := template.NewConfigMapper(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p := &Provider{
+		config:    defaultConfig(),
+		bus:       bus.New("test"),
+		templates: mapper,
+	}
+
+	listener := p.bus.Subscribe()
+	defer listener.Stop()
+
+	p.emit(testAllocation(), "start")
+
+	expectedMeta := func(task, driver string, meta common.MapStr) common.MapStr {
+		return common.MapStr{
+			"allocation": common.MapStr{
+				"id":     "f6d4c4b5-fc9c-4d6e-9c2c-0a9ad3f3c1e1",
+				"name":   "cache.redis[0]",
+				"status": "running",
+			},
+			"job": common.MapStr{
+				"name": "cache",
+				"type": "service",
+			},
+			"task": common.MapStr{
+				"name":   task,
+				"group":  "redis",
+				"driver": driver,
+			},
+			"node": common.MapStr{
+				"id": "node-1",
+			},
+			"meta":        meta,
+			"namespace":   "default",
+			"region":      "global",
+			"datacenters": []string{"dc1"},
+		}
+	}
+
+	redisMeta := expectedMeta("redis", "docker", common.MapStr{
+		"team": "ops",
+		"env":  "prod",
+		"co": common.MapStr{
+			"elastic": common.MapStr{
+				"metrics/module": "redis",
+			},
+		},
+	})
+	sidecarMeta := expectedMeta("sidecar", "exec", common.MapStr{
+		"team": "ops",
+		"env":  "prod",
+	})
+
+	expected := []bus.Event{
+		{
+			"start": true,
+			"host":  "10.0.0.1",
+			"port":  6379,
+			"nomad": redisMeta,
+			"meta":  common.MapStr{"nomad": redisMeta},
+		},
+		{
+			"start": true,
+			"host":  "",
+			"nomad": sidecarMeta,
+			"meta":  common.MapStr{"nomad": sidecarMeta},
+		},
+	}
+
+	for _, e := range expected {
+		select {
+		case event := <-listener.Events():
+			assert.Equal(t, e, event)
+		case <-time.After(2 * time.Second):
+			t.Fatal("Timeout while waiting for event")
+		}
+	}
+}
+
+func TestEmitEventUnknownTaskGroup(t *testing.T) {
+	p := &Provider{
+		config: defaultConfig(),
+		bus:    bus.New("test"),
+	}
+
+	listener := p.bus.Subscribe()
+	defer listener.Stop()
+
+	alloc := testAllocation()
+	alloc.TaskGroup = "unknown"
+	p.emit(alloc, "start")
+
+	select {
+	case event := <-listener.Events():
+		t.Fatalf("Unexpected event: %v", event)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
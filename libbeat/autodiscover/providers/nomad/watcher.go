@@ -0,0 +1,169 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package nomad
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/elastic/beats/libbeat/logp"
+)
+
+// retryPeriod is the time to wait before querying the API again after an error.
+const retryPeriod = 5 * time.Second
+
+// allocationAPI is the part of the Nomad API used by the watcher.
+type allocationAPI interface {
+	Allocations(ctx context.Context, index uint64, wait string) ([]*AllocationListStub, uint64, error)
+	Allocation(ctx context.Context, id string) (*Allocation, error)
+}
+
+// WatcherHandler is notified when allocations start and stop running.
+type WatcherHandler struct {
+	StartFunc func(alloc *Allocation)
+	StopFunc  func(alloc *Allocation)
+}
+
+// watcher tracks the running allocations in a Nomad cluster using blocking
+// queries on the allocations list.
+type watcher struct {
+	api      allocationAPI
+	node     string
+	waitTime time.Duration
+	handler  WatcherHandler
+	logger   *logp.Logger
+
+	index   uint64
+	running map[string]*Allocation
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func newWatcher(api allocationAPI, node string, waitTime time.Duration, handler WatcherHandler) *watcher {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &watcher{
+		api:      api,
+		node:     node,
+		waitTime: waitTime,
+		handler:  handler,
+		logger:   logp.NewLogger("nomad"),
+		running:  map[string]*Allocation{},
+		ctx:      ctx,
+		cancel:   cancel,
+	}
+}
+
+// Start does an initial synchronization of the running allocations and then
+// keeps watching for changes in background.
+func (w *watcher) Start() error {
+	if err := w.sync(); err != nil {
+		return err
+	}
+
+	w.wg.Add(1)
+	go func() {
+		defer w.wg.Done()
+		w.watch()
+	}()
+	return nil
+}
+
+// Stop stops watching for changes.
+func (w *watcher) Stop() {
+	w.cancel()
+	w.wg.Wait()
+}
+
+func (w *watcher) watch() {
+	for {
+		select {
+		case <-w.ctx.Done():
+			return
+		default:
+		}
+
+		if err := w.sync(); err != nil {
+			if w.ctx.Err() != nil {
+				return
+			}
+			w.logger.Errorf("Error watching Nomad allocations: %v", err)
+			select {
+			case <-w.ctx.Done():
+				return
+			case <-time.After(retryPeriod):
+			}
+		}
+	}
+}
+
+// sync lists the allocations and notifies the handler about the allocations
+// that started or stopped running since the last call.
+func (w *watcher) sync() error {
+	stubs, index, err := w.api.Allocations(w.ctx, w.index, w.waitTime.String())
+	if err != nil {
+		return err
+	}
+
+	// Reset the index if it goes backwards, as recommended for blocking queries.
+	if index < w.index {
+		index = 0
+	}
+	w.index = index
+
+	seen := make(map[string]struct{}, len(stubs))
+	for _, stub := range stubs {
+		if w.node != "" && stub.NodeID != w.node {
+			continue
+		}
+		if stub.ClientStatus != AllocClientStatusRunning {
+			continue
+		}
+
+		seen[stub.ID] = struct{}{}
+		if _, found := w.running[stub.ID]; found {
+			continue
+		}
+
+		alloc, err := w.api.Allocation(w.ctx, stub.ID)
+		if err != nil {
+			// Allocation will be retried on next sync
+			w.logger.Errorf("Error getting Nomad allocation %v: %v", stub.ID, err)
+			delete(seen, stub.ID)
+			continue
+		}
+
+		w.logger.Debugf("Nomad allocation started: %v (%v)", alloc.Name, alloc.ID)
+		w.running[alloc.ID] = alloc
+		w.handler.StartFunc(alloc)
+	}
+
+	for id, alloc := range w.running {
+		if _, found := seen[id]; found {
+			continue
+		}
+
+		w.logger.Debugf("Nomad allocation stopped: %v (%v)", alloc.Name, alloc.ID)
+		delete(w.running, id)
+		w.handler.StopFunc(alloc)
+	}
+
+	return nil
+}
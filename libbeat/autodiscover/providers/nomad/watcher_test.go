@@ -0,0 +1,137 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package nomad
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockAPI struct {
+	stubs  []*AllocationListStub
+	index  uint64
+	allocs map[string]*Allocation
+}
+
+func (m *mockAPI) Allocations(ctx context.Context, index uint64, wait string) ([]*AllocationListStub, uint64, error) {
+	return m.stubs, m.index, nil
+}
+
+func (m *mockAPI) Allocation(ctx context.Context, id string) (*Allocation, error) {
+	alloc, found := m.allocs[id]
+	if !found {
+		return nil, errors.New("not found")
+	}
+	return alloc, nil
+}
+
+func TestWatcherSync(t *testing.T) {
+	api := &mockAPI{
+		allocs: map[string]*Allocation{
+			"a": {ID: "a", NodeID: "node-1"},
+			"b": {ID: "b", NodeID: "node-2"},
+		},
+	}
+
+	var started, stopped []string
+	w := newWatcher(api, "node-1", time.Second, WatcherHandler{
+		StartFunc: func(alloc *Allocation) { started = append(started, alloc.ID) },
+		StopFunc:  func(alloc *Allocation) { stopped = append(stopped, alloc.ID) },
+	})
+
+	// Allocations in other nodes and not running allocations are ignored
+	api.stubs = []*AllocationListStub{
+		{ID: "a", NodeID: "node-1", ClientStatus: AllocClientStatusPending},
+		{ID: "b", NodeID: "node-2", ClientStatus: AllocClientStatusRunning},
+	}
+	api.index = 10
+	require.NoError(t, w.sync())
+	assert.Empty(t, started)
+	assert.Equal(t, uint64(10), w.index)
+
+	// Running allocation is started only once
+	api.stubs[0].ClientStatus = AllocClientStatusRunning
+	api.index = 11
+	require.NoError(t, w.sync())
+	require.NoError(t, w.sync())
+	assert.Equal(t, []string{"a"}, started)
+	assert.Empty(t, stopped)
+
+	// Completed allocation is stopped
+	api.stubs[0].ClientStatus = AllocClientStatusComplete
+	api.index = 12
+	require.NoError(t, w.sync())
+	assert.Equal(t, []string{"a"}, stopped)
+
+	// Index is reset if it goes backwards
+	api.index = 5
+	require.NoError(t, w.sync())
+	assert.Equal(t, uint64(0), w.index)
+}
+
+func TestClient(t *testing.T) {
+	alloc := testAllocation()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "secret", r.Header.Get("X-Nomad-Token"))
+		assert.Equal(t, "ops", r.URL.Query().Get("namespace"))
+
+		w.Header().Set("X-Nomad-Index", "42")
+		switch r.URL.Path {
+		case "/v1/allocations":
+			assert.Equal(t, "7", r.URL.Query().Get("index"))
+			assert.Equal(t, "1s", r.URL.Query().Get("wait"))
+			json.NewEncoder(w).Encode([]*AllocationListStub{
+				{ID: alloc.ID, ClientStatus: AllocClientStatusRunning},
+			})
+		case "/v1/allocation/" + alloc.ID:
+			json.NewEncoder(w).Encode(alloc)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	config := defaultConfig()
+	config.Address = server.URL
+	config.Namespace = "ops"
+	config.SecretID = "secret"
+	c, err := newClient(config)
+	require.NoError(t, err)
+
+	stubs, index, err := c.Allocations(context.Background(), 7, "1s")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(42), index)
+	if assert.Len(t, stubs, 1) {
+		assert.Equal(t, alloc.ID, stubs[0].ID)
+	}
+
+	result, err := c.Allocation(context.Background(), alloc.ID)
+	require.NoError(t, err)
+	assert.Equal(t, alloc, result)
+
+	_, err = c.Allocation(context.Background(), "unknown")
+	assert.Error(t, err)
+}
@@ -18,6 +18,7 @@
 package cfgfile
 
 import (
+	"fmt"
 	"sync"
 
 	"github.com/joeshaw/multierror"
@@ -26,11 +27,13 @@ import (
 
 	"github.com/elastic/beats/libbeat/beat"
 	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/control"
 	"github.com/elastic/beats/libbeat/logp"
 )
 
 // RunnerList implements a reloadable.List of Runners
 type RunnerList struct {
+	name     string
 	runners  map[uint64]Runner
 	mutex    sync.RWMutex
 	factory  RunnerFactory
@@ -50,6 +53,7 @@ type ConfigWithMeta struct {
 // NewRunnerList builds and returns a RunnerList
 func NewRunnerList(name string, factory RunnerFactory, pipeline beat.Pipeline) *RunnerList {
 	return &RunnerList{
+		name:     name,
 		runners:  map[uint64]Runner{},
 		factory:  factory,
 		pipeline: pipeline,
@@ -91,6 +95,7 @@ func (r *RunnerList) Reload(configs []*ConfigWithMeta) error {
 	for hash, runner := range stopList {
 		r.logger.Debugf("Stopping runner: %s", runner)
 		delete(r.runners, hash)
+		control.RemoveRunner(r.name, hash)
 		go runner.Stop()
 	}
 
@@ -108,6 +113,7 @@ func (r *RunnerList) Reload(configs []*ConfigWithMeta) error {
 
 		r.logger.Debugf("Starting runner: %s", runner)
 		r.runners[hash] = runner
+		control.AddRunner(r.name, hash, fmt.Sprint(runner))
 		runner.Start()
 	}
 
@@ -130,6 +136,7 @@ func (r *RunnerList) Stop() {
 		wg.Add(1)
 
 		delete(r.runners, hash)
+		control.RemoveRunner(r.name, hash)
 
 		// Stop modules in parallel
 		go func(h uint64, run Runner) {
@@ -0,0 +1,171 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package cfgfile
+
+import (
+	"fmt"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/mitchellh/hashstructure"
+
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/logp"
+	"github.com/elastic/beats/libbeat/monitoring"
+	"github.com/elastic/beats/libbeat/paths"
+)
+
+var (
+	outputReloads        = monitoring.NewInt(nil, "libbeat.config.output.reloads")
+	outputReloadFailures = monitoring.NewInt(nil, "libbeat.config.output.failures")
+)
+
+// OutputReloadable is implemented by publisher pipelines that can replace
+// their outputs at runtime.
+type OutputReloadable interface {
+	ReloadOutput(config common.ConfigNamespace) error
+}
+
+// OutputReloader watches a config file containing an output section, and
+// reloads the output of the pipeline when the file changes.
+type OutputReloader struct {
+	reloadable OutputReloadable
+	config     DynamicConfig
+	path       string
+	lastHash   uint64
+	done       chan struct{}
+	wg         sync.WaitGroup
+}
+
+// NewOutputReloader creates a new OutputReloader instance for the given config
+func NewOutputReloader(reloadable OutputReloadable, cfg *common.Config) *OutputReloader {
+	config := DefaultDynamicConfig
+	cfg.Unpack(&config)
+
+	path := config.Path
+	if !filepath.IsAbs(path) {
+		path = paths.Resolve(paths.Config, path)
+	}
+
+	return &OutputReloader{
+		reloadable: reloadable,
+		config:     config,
+		path:       path,
+		done:       make(chan struct{}),
+	}
+}
+
+// Start starts watching the output config file in background. The output in
+// the file is loaded on start, and then every time the file changes if
+// reloading is enabled.
+func (rl *OutputReloader) Start() {
+	rl.wg.Add(1)
+	go func() {
+		defer rl.wg.Done()
+		rl.run()
+	}()
+}
+
+func (rl *OutputReloader) run() {
+	logp.Info("Output config reloader started")
+
+	gw := NewGlobWatcher(rl.path)
+
+	// If reloading is disabled, the config file is loaded only once
+	period := time.Duration(0)
+
+	for {
+		select {
+		case <-rl.done:
+			logp.Info("Output config reloader stopped")
+			return
+
+		case <-time.After(period):
+			files, updated, err := gw.Scan()
+			if err != nil {
+				logp.Err("Error fetching output config file: %v", err)
+			}
+
+			if updated && len(files) > 0 {
+				if err := rl.reload(files); err != nil {
+					outputReloadFailures.Add(1)
+					logp.Err("Error reloading output: %v", err)
+				}
+			}
+		}
+
+		if !rl.config.Reload.Enabled {
+			<-rl.done
+			logp.Info("Output config reloader stopped")
+			return
+		}
+		period = rl.config.Reload.Period
+	}
+}
+
+func (rl *OutputReloader) reload(files []string) error {
+	if len(files) > 1 {
+		return fmt.Errorf("only one output config file is supported, found %v", files)
+	}
+
+	debugf("Loading output config from: %s", files[0])
+	cfg, err := common.LoadFile(files[0])
+	if err != nil {
+		return err
+	}
+
+	config := struct {
+		Output common.ConfigNamespace `config:"output"`
+	}{}
+	if err := cfg.Unpack(&config); err != nil {
+		return err
+	}
+	if !config.Output.IsSet() {
+		return fmt.Errorf("no output defined in %v", files[0])
+	}
+
+	// Skip reloading if the output config didn't change
+	var raw map[string]interface{}
+	if err := cfg.Unpack(&raw); err != nil {
+		return err
+	}
+	hash, err := hashstructure.Hash(raw["output"], nil)
+	if err != nil {
+		return err
+	}
+	if hash == rl.lastHash {
+		debugf("Output config didn't change, skipping reload")
+		return nil
+	}
+
+	if err := rl.reloadable.ReloadOutput(config.Output); err != nil {
+		return err
+	}
+
+	rl.lastHash = hash
+	outputReloads.Add(1)
+	logp.Info("Output reloaded from %v", files[0])
+	return nil
+}
+
+// Stop stops the reloader
+func (rl *OutputReloader) Stop() {
+	close(rl.done)
+	rl.wg.Wait()
+}
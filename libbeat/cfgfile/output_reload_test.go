@@ -0,0 +1,104 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package cfgfile
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/libbeat/common"
+)
+
+type mockReloadable struct {
+	sync.Mutex
+	outputs []string
+}
+
+func (m *mockReloadable) ReloadOutput(config common.ConfigNamespace) error {
+	m.Lock()
+	defer m.Unlock()
+	m.outputs = append(m.outputs, config.Name())
+	return nil
+}
+
+func (m *mockReloadable) loaded() []string {
+	m.Lock()
+	defer m.Unlock()
+	return append([]string{}, m.outputs...)
+}
+
+func TestOutputReloader(t *testing.T) {
+	dir, err := ioutil.TempDir("", "output_reload")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "output.yml")
+	require.NoError(t, ioutil.WriteFile(path, []byte("output.console.enabled: true\n"), 0600))
+
+	reloadable := &mockReloadable{}
+	reloader := NewOutputReloader(reloadable, common.MustNewConfigFrom(map[string]interface{}{
+		"path":           path,
+		"reload.enabled": true,
+		"reload.period":  "50ms",
+	}))
+	reloader.Start()
+	defer reloader.Stop()
+
+	waitFor(t, func() bool { return len(reloadable.loaded()) == 1 })
+	assert.Equal(t, []string{"console"}, reloadable.loaded())
+
+	// Make sure the modification time changes
+	time.Sleep(1100 * time.Millisecond)
+	require.NoError(t, ioutil.WriteFile(path, []byte("output.file.path: /tmp\n"), 0600))
+
+	waitFor(t, func() bool { return len(reloadable.loaded()) >= 2 })
+	assert.Equal(t, "file", reloadable.loaded()[1])
+}
+
+func TestOutputReloaderInvalidConfig(t *testing.T) {
+	dir, err := ioutil.TempDir("", "output_reload")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "output.yml")
+	require.NoError(t, ioutil.WriteFile(path, []byte("output.console.enabled: true\noutput.file.path: /tmp\n"), 0600))
+
+	reloadable := &mockReloadable{}
+	reloader := NewOutputReloader(reloadable, common.MustNewConfigFrom(map[string]interface{}{
+		"path": path,
+	}))
+	assert.Error(t, reloader.reload([]string{path}))
+	assert.Empty(t, reloadable.loaded())
+}
+
+func waitFor(t *testing.T, cond func() bool) {
+	for i := 0; i < 100; i++ {
+		if cond() {
+			return
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+	t.Fatal("Timeout waiting for condition")
+}
@@ -27,6 +27,7 @@ import (
 
 	"github.com/elastic/beats/libbeat/beat"
 	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/control"
 	"github.com/elastic/beats/libbeat/logp"
 	"github.com/elastic/beats/libbeat/monitoring"
 	"github.com/elastic/beats/libbeat/paths"
@@ -77,6 +78,7 @@ type Reloader struct {
 	config        DynamicConfig
 	path          string
 	done          chan struct{}
+	trigger       chan struct{}
 	wg            sync.WaitGroup
 }
 
@@ -95,6 +97,7 @@ func NewReloader(pipeline beat.Pipeline, cfg *common.Config) *Reloader {
 		config:   config,
 		path:     path,
 		done:     make(chan struct{}),
+		trigger:  make(chan struct{}, 1),
 	}
 }
 
@@ -152,42 +155,54 @@ func (rl *Reloader) Run(runnerFactory RunnerFactory) {
 	// If reloading is disable, config files should be loaded immediately
 	if !rl.config.Reload.Enabled {
 		rl.config.Reload.Period = 0
+	} else {
+		control.AddReloadTrigger(rl.path, rl.Trigger)
+		defer control.RemoveReloadTrigger(rl.path)
 	}
 
 	overwriteUpdate := true
 
+	scan := func() {
+		debugf("Scan for new config files")
+		configReloads.Add(1)
+
+		files, updated, err := gw.Scan()
+		if err != nil {
+			// In most cases of error, updated == false, so will continue
+			// to next iteration below
+			logp.Err("Error fetching new config files: %v", err)
+		}
+
+		// no file changes
+		if !updated && !overwriteUpdate {
+			overwriteUpdate = false
+			return
+		}
+
+		// Load all config objects
+		configs, _ := rl.loadConfigs(files)
+
+		debugf("Number of module configs found: %v", len(configs))
+
+		if err := list.Reload(configs); err != nil {
+			// Make sure the next run also updates because some runners were not properly loaded
+			overwriteUpdate = true
+		}
+	}
+
 	for {
 		select {
 		case <-rl.done:
 			logp.Info("Dynamic config reloader stopped")
 			return
 
-		case <-time.After(rl.config.Reload.Period):
-			debugf("Scan for new config files")
-			configReloads.Add(1)
-
-			files, updated, err := gw.Scan()
-			if err != nil {
-				// In most cases of error, updated == false, so will continue
-				// to next iteration below
-				logp.Err("Error fetching new config files: %v", err)
-			}
-
-			// no file changes
-			if !updated && !overwriteUpdate {
-				overwriteUpdate = false
-				continue
-			}
-
-			// Load all config objects
-			configs, _ := rl.loadConfigs(files)
-
-			debugf("Number of module configs found: %v", len(configs))
+		case <-rl.trigger:
+			debugf("Reload triggered")
+			overwriteUpdate = true
+			scan()
 
-			if err := list.Reload(configs); err != nil {
-				// Make sure the next run also updates because some runners were not properly loaded
-				overwriteUpdate = true
-			}
+		case <-time.After(rl.config.Reload.Period):
+			scan()
 		}
 
 		// Path loading is enabled but not reloading. Loads files only once and then stops.
@@ -222,6 +237,15 @@ func (rl *Reloader) loadConfigs(files []string) ([]*ConfigWithMeta, error) {
 	return result, errs.Err()
 }
 
+// Trigger forces the reloader to reload all config files, without waiting for
+// the next period.
+func (rl *Reloader) Trigger() {
+	select {
+	case rl.trigger <- struct{}{}:
+	default:
+	}
+}
+
 // Stop stops the reloader and waits for all modules to properly stop
 func (rl *Reloader) Stop() {
 	close(rl.done)
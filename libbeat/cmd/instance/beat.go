@@ -23,6 +23,7 @@ import (
 	"encoding/json"
 	"flag"
 	"fmt"
+	"io"
 	"math"
 	"math/big"
 	"math/rand"
@@ -30,6 +31,7 @@ import (
 	"path/filepath"
 	"runtime"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/satori/go.uuid"
@@ -44,7 +46,9 @@ import (
 	"github.com/elastic/beats/libbeat/common/cfgwarn"
 	"github.com/elastic/beats/libbeat/common/file"
 	"github.com/elastic/beats/libbeat/common/seccomp"
+	"github.com/elastic/beats/libbeat/control"
 	"github.com/elastic/beats/libbeat/dashboards"
+	"github.com/elastic/beats/libbeat/ilm"
 	"github.com/elastic/beats/libbeat/keystore"
 	"github.com/elastic/beats/libbeat/logp"
 	"github.com/elastic/beats/libbeat/logp/configure"
@@ -69,15 +73,24 @@ import (
 	_ "github.com/elastic/beats/libbeat/processors/actions"
 	_ "github.com/elastic/beats/libbeat/processors/add_cloud_metadata"
 	_ "github.com/elastic/beats/libbeat/processors/add_docker_metadata"
+	_ "github.com/elastic/beats/libbeat/processors/add_geoip"
 	_ "github.com/elastic/beats/libbeat/processors/add_host_metadata"
 	_ "github.com/elastic/beats/libbeat/processors/add_kubernetes_metadata"
 	_ "github.com/elastic/beats/libbeat/processors/add_locale"
+	_ "github.com/elastic/beats/libbeat/processors/add_process_metadata"
+	_ "github.com/elastic/beats/libbeat/processors/dedupe"
 	_ "github.com/elastic/beats/libbeat/processors/dissect"
+	_ "github.com/elastic/beats/libbeat/processors/dns"
+	_ "github.com/elastic/beats/libbeat/processors/fingerprint"
+	_ "github.com/elastic/beats/libbeat/processors/rate_limit"
+	_ "github.com/elastic/beats/libbeat/processors/route"
+	_ "github.com/elastic/beats/libbeat/processors/script"
 
 	// Register autodiscover providers
 	_ "github.com/elastic/beats/libbeat/autodiscover/providers/docker"
 	_ "github.com/elastic/beats/libbeat/autodiscover/providers/jolokia"
 	_ "github.com/elastic/beats/libbeat/autodiscover/providers/kubernetes"
+	_ "github.com/elastic/beats/libbeat/autodiscover/providers/nomad"
 
 	// Register default monitoring reporting
 	_ "github.com/elastic/beats/libbeat/monitoring/report/elasticsearch"
@@ -104,18 +117,21 @@ type beatConfig struct {
 
 	// beat internal components configurations
 	HTTP          *common.Config `config:"http"`
+	Control       *common.Config `config:"control"`
 	Path          paths.Path     `config:"path"`
 	Logging       *common.Config `config:"logging"`
 	MetricLogging *common.Config `config:"logging.metrics"`
 	Keystore      *common.Config `config:"keystore"`
 
 	// output/publishing related configurations
-	Pipeline   pipeline.Config `config:",inline"`
-	Monitoring *common.Config  `config:"xpack.monitoring"`
+	Pipeline     pipeline.Config `config:",inline"`
+	Monitoring   *common.Config  `config:"xpack.monitoring"`
+	OutputReload *common.Config  `config:"config.output"`
 
 	// elastic stack 'setup' configurations
 	Dashboards *common.Config `config:"setup.dashboards"`
 	Template   *common.Config `config:"setup.template"`
+	ILM        *common.Config `config:"setup.ilm"`
 	Kibana     *common.Config `config:"setup.kibana"`
 }
 
@@ -315,6 +331,12 @@ func (b *Beat) launch(bt beat.Creator) error {
 		return err
 	}
 
+	// Keystores with background tasks, like renewing credentials, are
+	// stopped when the beat exits.
+	if closer, ok := b.keystore.(io.Closer); ok {
+		defer closer.Close()
+	}
+
 	svc.BeforeRun()
 	defer svc.Cleanup()
 
@@ -350,8 +372,24 @@ func (b *Beat) launch(bt beat.Creator) error {
 		return beat.GracefulExit
 	}
 
+	if b.Config.OutputReload.Enabled() {
+		reloadable, ok := b.Publisher.(cfgfile.OutputReloadable)
+		if !ok {
+			return fmt.Errorf("output reloading is not supported by the publisher")
+		}
+		cfgwarn.Beta("Output config reloading is beta")
+		reloader := cfgfile.NewOutputReloader(reloadable, b.Config.OutputReload)
+		reloader.Start()
+		defer reloader.Stop()
+	}
+
 	ctx, cancel := context.WithCancel(context.Background())
-	svc.HandleSignals(beater.Stop, cancel)
+	var stopOnce sync.Once
+	stopBeat := func() {
+		cancel()
+		stopOnce.Do(beater.Stop)
+	}
+	svc.HandleSignals(stopBeat, cancel)
 
 	err = b.loadDashboards(ctx, false)
 	if err != nil {
@@ -370,6 +408,18 @@ func (b *Beat) launch(bt beat.Creator) error {
 		api.Start(b.Config.HTTP)
 	}
 
+	if b.Config.Control.Enabled() {
+		server, err := control.New(b.Config.Control, control.Settings{
+			Config: b.RawConfig,
+			Stop:   stopBeat,
+		})
+		if err != nil {
+			return err
+		}
+		server.Start()
+		defer server.Stop()
+	}
+
 	return beater.Run(&b.Beat)
 }
 
@@ -416,8 +466,13 @@ func (b *Beat) Setup(bt beat.Creator, template, dashboards, machineLearning, pip
 				return fmt.Errorf("Template loading requested but the Elasticsearch output is not configured/enabled")
 			}
 
+			ilmManager, err := ilm.NewManager(b.Config.ILM, b.Info)
+			if err != nil {
+				return err
+			}
+
 			esConfig := outCfg.Config()
-			if tmplCfg := b.Config.Template; tmplCfg == nil || tmplCfg.Enabled() {
+			if tmplCfg := b.Config.Template; tmplCfg == nil || tmplCfg.Enabled() || ilmManager.Enabled() {
 				loadCallback, err := b.templateLoadingCallback()
 				if err != nil {
 					return err
@@ -436,6 +491,9 @@ func (b *Beat) Setup(bt beat.Creator, template, dashboards, machineLearning, pip
 			}
 
 			fmt.Println("Loaded index template")
+			if ilmManager.Enabled() {
+				fmt.Println("Loaded index lifecycle policy and write alias")
+			}
 		}
 
 		if dashboards {
@@ -677,6 +735,11 @@ func (b *Beat) registerTemplateLoading() error {
 		}
 	}
 
+	ilmManager, err := ilm.NewManager(b.Config.ILM, b.Info)
+	if err != nil {
+		return fmt.Errorf("unpacking ilm config fails: %v", err)
+	}
+
 	// Loads template by default if esOutput is enabled
 	if b.Config.Output.Name() == "elasticsearch" {
 
@@ -689,11 +752,21 @@ func (b *Beat) registerTemplateLoading() error {
 			return err
 		}
 
-		if esCfg.Index != "" && (cfg.Name == "" || cfg.Pattern == "") && (b.Config.Template == nil || b.Config.Template.Enabled()) {
+		if ilmManager.Enabled() {
+			// With ILM, events are indexed into the rollover alias and the
+			// template name and pattern are derived from it.
+			if esCfg.Index != "" {
+				logp.Warn("output.elasticsearch.index is ignored, as index lifecycle management is enabled.")
+			}
+			err = b.Config.Output.Config().SetString("index", -1, ilmManager.Alias())
+			if err != nil {
+				return err
+			}
+		} else if esCfg.Index != "" && (cfg.Name == "" || cfg.Pattern == "") && len(cfg.Templates) == 0 && (b.Config.Template == nil || b.Config.Template.Enabled()) {
 			return fmt.Errorf("setup.template.name and setup.template.pattern have to be set if index name is modified.")
 		}
 
-		if b.Config.Template == nil || (b.Config.Template != nil && b.Config.Template.Enabled()) {
+		if b.Config.Template == nil || (b.Config.Template != nil && b.Config.Template.Enabled()) || ilmManager.Enabled() {
 
 			// load template through callback to make sure it is also loaded
 			// on reconnecting
@@ -715,14 +788,45 @@ func (b *Beat) templateLoadingCallback() (func(esClient *elasticsearch.Client) e
 			b.Config.Template = common.NewConfig()
 		}
 
-		loader, err := template.NewLoader(b.Config.Template, esClient, b.Info, b.Fields)
+		ilmManager, err := ilm.NewManager(b.Config.ILM, b.Info)
 		if err != nil {
-			return fmt.Errorf("Error creating Elasticsearch template loader: %v", err)
+			return err
 		}
 
-		err = loader.Load()
-		if err != nil {
-			return fmt.Errorf("Error loading Elasticsearch template: %v", err)
+		// The policy must exist before the template referencing it is loaded.
+		if ilmManager.Enabled() {
+			err = ilmManager.LoadPolicy(esClient)
+			if err != nil {
+				return fmt.Errorf("Error loading ILM policy: %v", err)
+			}
+		}
+
+		if b.Config.Template.Enabled() {
+			tmplCfg := b.Config.Template
+			if ilmManager.Enabled() {
+				tmplCfg, err = common.MergeConfigs(tmplCfg, ilmManager.TemplateConfig())
+				if err != nil {
+					return err
+				}
+			}
+
+			loader, err := template.NewLoader(tmplCfg, esClient, b.Info, b.Fields)
+			if err != nil {
+				return fmt.Errorf("Error creating Elasticsearch template loader: %v", err)
+			}
+
+			err = loader.Load()
+			if err != nil {
+				return fmt.Errorf("Error loading Elasticsearch template: %v", err)
+			}
+		}
+
+		// The bootstrap index is created last, so it picks up the template.
+		if ilmManager.Enabled() {
+			err = ilmManager.LoadAlias(esClient)
+			if err != nil {
+				return fmt.Errorf("Error creating ILM write alias: %v", err)
+			}
 		}
 
 		return nil
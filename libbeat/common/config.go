@@ -77,8 +77,38 @@ var debugBlacklist = MakeStringSet(
 	"urls",
 	"host",
 	"hosts",
+	"connection_string",
+	"credentials_json",
 )
 
+// debugBlacklistWords are the words of setting names holding secrets, like
+// `token` in `session_token` or `secret` in `secret_id`. Words are separated
+// by underscores.
+var debugBlacklistWords = MakeStringSet(
+	"token",
+	"secret",
+	"credentials",
+	"key",
+	"password",
+	"passphrase",
+)
+
+// isPrivateSetting returns true if the value of the setting must not be
+// logged or exposed. Names of the blacklist and names containing one of the
+// blacklisted words, like `api_key` or `secret_access_key`, are private.
+func isPrivateSetting(name string) bool {
+	name = strings.ToLower(name)
+	if debugBlacklist.Has(name) {
+		return true
+	}
+	for _, word := range strings.Split(name, "_") {
+		if debugBlacklistWords.Has(word) {
+			return true
+		}
+	}
+	return false
+}
+
 // make hasSelector and configDebugf available for unit testing
 var hasSelector = logp.HasSelector
 var configDebugf = logp.Debug
@@ -350,6 +380,17 @@ func (ns *ConfigNamespace) IsSet() bool {
 	return ns.config != nil
 }
 
+// Redacted returns the configuration as map, with private settings like
+// passwords, tokens, keys and hosts replaced by `xxxxx`.
+func (c *Config) Redacted() (map[string]interface{}, error) {
+	var content map[string]interface{}
+	if err := c.Unpack(&content); err != nil {
+		return nil, err
+	}
+	filterDebugObject(content)
+	return content, nil
+}
+
 func configDebugString(c *Config, filterPrivate bool) string {
 	var bufs []string
 
@@ -386,7 +427,7 @@ func filterDebugObject(c interface{}) {
 	switch cfg := c.(type) {
 	case map[string]interface{}:
 		for k, v := range cfg {
-			if debugBlacklist.Has(k) {
+			if isPrivateSetting(k) {
 				if arr, ok := v.([]interface{}); ok {
 					for i := range arr {
 						arr[i] = "xxxxx"
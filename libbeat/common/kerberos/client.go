@@ -0,0 +1,67 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kerberos
+
+import (
+	"fmt"
+
+	krbclient "gopkg.in/jcmturner/gokrb5.v7/client"
+	krbconfig "gopkg.in/jcmturner/gokrb5.v7/config"
+	"gopkg.in/jcmturner/gokrb5.v7/credentials"
+	"gopkg.in/jcmturner/gokrb5.v7/keytab"
+)
+
+// NewClient creates a Kerberos client from the configuration and logs in
+// to the KDC. Clients authenticating with a password or a keytab renew their
+// tickets in the background until Destroy is called. Clients created from a
+// credential cache cannot renew tickets and fail once the ticket expires.
+func NewClient(config *Config) (*krbclient.Client, error) {
+	krbConf, err := krbconfig.Load(config.ConfigPath)
+	if err != nil {
+		return nil, fmt.Errorf("error loading Kerberos config from %s: %v", config.ConfigPath, err)
+	}
+
+	var client *krbclient.Client
+	switch config.AuthType {
+	case authPassword:
+		client = krbclient.NewClientWithPassword(config.Username, config.Realm, config.Password, krbConf)
+	case authKeytab:
+		kt, err := keytab.Load(config.KeyTabPath)
+		if err != nil {
+			return nil, fmt.Errorf("error loading keytab from %s: %v", config.KeyTabPath, err)
+		}
+		client = krbclient.NewClientWithKeytab(config.Username, config.Realm, kt, krbConf)
+	case authCCache:
+		ccache, err := credentials.LoadCCache(config.CCachePath)
+		if err != nil {
+			return nil, fmt.Errorf("error loading credential cache from %s: %v", config.CCachePath, err)
+		}
+		client, err = krbclient.NewClientFromCCache(ccache, krbConf)
+		if err != nil {
+			return nil, fmt.Errorf("error creating Kerberos client from credential cache: %v", err)
+		}
+	default:
+		return nil, fmt.Errorf("unknown authentication type: %d", config.AuthType)
+	}
+
+	if err := client.Login(); err != nil {
+		client.Destroy()
+		return nil, fmt.Errorf("error logging in to the KDC: %v", err)
+	}
+	return client, nil
+}
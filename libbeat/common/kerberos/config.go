@@ -0,0 +1,90 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kerberos
+
+import (
+	"errors"
+	"fmt"
+)
+
+// AuthType is the method used to obtain the Kerberos credentials.
+type AuthType uint
+
+const (
+	authPassword = 1
+	authKeytab   = 2
+	authCCache   = 3
+
+	authPasswordStr = "password"
+	authKeytabStr   = "keytab"
+	authCCacheStr   = "ccache"
+)
+
+var authTypes = map[string]AuthType{
+	authPasswordStr: authPassword,
+	authKeytabStr:   authKeytab,
+	authCCacheStr:   authCCache,
+}
+
+// Config defines the user configurable Kerberos options in the yaml file.
+type Config struct {
+	AuthType    AuthType `config:"auth_type" validate:"required"`
+	KeyTabPath  string   `config:"keytab"`
+	ConfigPath  string   `config:"config_path" validate:"required"`
+	CCachePath  string   `config:"ccache"`
+	ServiceName string   `config:"service_name"`
+	Username    string   `config:"username"`
+	Password    string   `config:"password"`
+	Realm       string   `config:"realm"`
+}
+
+// Unpack validates and unpacks the auth_type setting.
+func (t *AuthType) Unpack(value string) error {
+	authT, ok := authTypes[value]
+	if !ok {
+		return fmt.Errorf("invalid authentication type '%s'", value)
+	}
+	*t = authT
+	return nil
+}
+
+// Validate checks that the settings required by the configured
+// authentication type are present.
+func (c *Config) Validate() error {
+	switch c.AuthType {
+	case authPassword:
+		if c.Username == "" {
+			return errors.New("username must be configured when using password authentication")
+		}
+		if c.Password == "" {
+			return errors.New("password must be configured when using password authentication")
+		}
+	case authKeytab:
+		if c.Username == "" {
+			return errors.New("username must be configured when using keytab authentication")
+		}
+		if c.KeyTabPath == "" {
+			return errors.New("keytab must be configured when using keytab authentication")
+		}
+	case authCCache:
+		if c.CCachePath == "" {
+			return errors.New("ccache must be configured when using ccache authentication")
+		}
+	}
+	return nil
+}
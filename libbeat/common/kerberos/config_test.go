@@ -0,0 +1,128 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package kerberos
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elastic/beats/libbeat/common"
+)
+
+func TestConfigValidate(t *testing.T) {
+	tests := []struct {
+		name  string
+		cfg   map[string]interface{}
+		valid bool
+	}{
+		{
+			name: "password",
+			cfg: map[string]interface{}{
+				"auth_type":   "password",
+				"config_path": "/etc/krb5.conf",
+				"username":    "beats",
+				"password":    "secret",
+			},
+			valid: true,
+		},
+		{
+			name: "password without password",
+			cfg: map[string]interface{}{
+				"auth_type":   "password",
+				"config_path": "/etc/krb5.conf",
+				"username":    "beats",
+			},
+		},
+		{
+			name: "keytab",
+			cfg: map[string]interface{}{
+				"auth_type":   "keytab",
+				"config_path": "/etc/krb5.conf",
+				"username":    "beats",
+				"keytab":      "/etc/beats.keytab",
+			},
+			valid: true,
+		},
+		{
+			name: "keytab without keytab",
+			cfg: map[string]interface{}{
+				"auth_type":   "keytab",
+				"config_path": "/etc/krb5.conf",
+				"username":    "beats",
+			},
+		},
+		{
+			name: "ccache",
+			cfg: map[string]interface{}{
+				"auth_type":   "ccache",
+				"config_path": "/etc/krb5.conf",
+				"ccache":      "/tmp/krb5cc_1000",
+			},
+			valid: true,
+		},
+		{
+			name: "ccache without ccache",
+			cfg: map[string]interface{}{
+				"auth_type":   "ccache",
+				"config_path": "/etc/krb5.conf",
+			},
+		},
+		{
+			name: "unknown auth type",
+			cfg: map[string]interface{}{
+				"auth_type":   "token",
+				"config_path": "/etc/krb5.conf",
+			},
+		},
+		{
+			name: "missing config path",
+			cfg: map[string]interface{}{
+				"auth_type": "password",
+				"username":  "beats",
+				"password":  "secret",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := common.MustNewConfigFrom(test.cfg)
+
+			var config Config
+			err := c.Unpack(&config)
+			if test.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestNewClientMissingConfig(t *testing.T) {
+	_, err := NewClient(&Config{
+		AuthType:   authPassword,
+		ConfigPath: "/nonexistent/krb5.conf",
+		Username:   "beats",
+		Password:   "secret",
+	})
+	assert.Error(t, err)
+}
@@ -0,0 +1,99 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kubernetes
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/ericchiang/k8s"
+
+	"github.com/elastic/beats/libbeat/common"
+)
+
+// CustomResource identifies a custom resource type, as defined by a
+// CustomResourceDefinition.
+type CustomResource struct {
+	Group    string
+	Version  string
+	Resource string // plural name of the resource
+}
+
+// ListCustomResources lists the objects of a custom resource in the given
+// namespace, or in all namespaces if namespace is empty. Objects are returned
+// as generic maps, as custom resources have no known schema.
+func ListCustomResources(
+	ctx context.Context,
+	client *k8s.Client,
+	resource CustomResource,
+	namespace string,
+) ([]common.MapStr, error) {
+	path := []string{strings.TrimRight(client.Endpoint, "/"), "apis", resource.Group, resource.Version}
+	if namespace != "" {
+		path = append(path, "namespaces", namespace)
+	}
+	path = append(path, resource.Resource)
+
+	req, err := http.NewRequest("GET", strings.Join(path, "/"), nil)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Accept", "application/json")
+	if client.SetHeaders != nil {
+		if err := client.SetHeaders(req.Header); err != nil {
+			return nil, err
+		}
+	}
+
+	httpClient := client.Client
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("listing %s.%s/%s failed with status %s: %s",
+			resource.Resource, resource.Group, resource.Version, resp.Status, body)
+	}
+
+	var list struct {
+		Items []map[string]interface{} `json:"items"`
+	}
+	if err := json.Unmarshal(body, &list); err != nil {
+		return nil, fmt.Errorf("decoding %s.%s/%s list: %v", resource.Resource, resource.Group, resource.Version, err)
+	}
+
+	items := make([]common.MapStr, len(list.Items))
+	for i, item := range list.Items {
+		items[i] = common.MapStr(item)
+	}
+	return items, nil
+}
@@ -0,0 +1,62 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kubernetes
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ericchiang/k8s"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListCustomResources(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/apis/example.com/v1/namespaces/default/routes" {
+			http.NotFound(w, r)
+			return
+		}
+		assert.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"items": [{"metadata": {"name": "route1", "namespace": "default"}, "spec": {"key": "team-a"}}]}`))
+	}))
+	defer server.Close()
+
+	client := &k8s.Client{
+		Endpoint: server.URL,
+		SetHeaders: func(h http.Header) error {
+			h.Set("Authorization", "Bearer secret")
+			return nil
+		},
+	}
+	resource := CustomResource{Group: "example.com", Version: "v1", Resource: "routes"}
+
+	items, err := ListCustomResources(context.Background(), client, resource, "default")
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+
+	key, err := items[0].GetValue("spec.key")
+	assert.NoError(t, err)
+	assert.Equal(t, "team-a", key)
+
+	_, err = ListCustomResources(context.Background(), client, resource, "other")
+	assert.Error(t, err)
+}
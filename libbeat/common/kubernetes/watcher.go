@@ -122,6 +122,17 @@ func NewWatcher(client *k8s.Client, resource Resource, options WatchOptions) (Wa
 			}
 			return rs
 		}
+	case *Namespace:
+		list := &v1.NamespaceList{}
+		w.resourceList = list
+		w.k8sResourceFactory = func() k8s.Resource { return &v1.Namespace{} }
+		w.items = func() []k8s.Resource {
+			rs := make([]k8s.Resource, 0, len(list.Items))
+			for _, item := range list.Items {
+				rs = append(rs, item)
+			}
+			return rs
+		}
 	case *Deployment:
 		list := &appsv1.DeploymentList{}
 		w.resourceList = list
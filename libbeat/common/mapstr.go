@@ -20,6 +20,7 @@ package common
 import (
 	"encoding/json"
 	"fmt"
+	"reflect"
 	"sort"
 	"strings"
 
@@ -38,6 +39,31 @@ const (
 var (
 	// ErrKeyNotFound indicates that the specified key was not found.
 	ErrKeyNotFound = errors.New("key not found")
+
+	// ErrMergeConflict indicates that a key has different values in the maps
+	// being merged with the MergeErrorOnConflict policy.
+	ErrMergeConflict = errors.New("conflicting values")
+)
+
+// MergePolicy defines how DeepMerge handles keys with a value in both maps,
+// if the values are not both maps. Maps are always merged recursively.
+type MergePolicy uint8
+
+const (
+	// MergeOverride replaces the existing value with the new value.
+	MergeOverride MergePolicy = iota
+
+	// MergeKeepExisting keeps the existing value.
+	MergeKeepExisting
+
+	// MergeAppend appends the new value to the existing value, converting
+	// the existing value to a slice if it is not a []interface{}. If the new
+	// value is a []interface{}, its elements are appended.
+	MergeAppend
+
+	// MergeErrorOnConflict returns ErrMergeConflict if the values are
+	// different. The map is not modified if an error is returned.
+	MergeErrorOnConflict
 )
 
 // EventMetadata contains fields and tags that can be added to an event via
@@ -137,6 +163,146 @@ func (m MapStr) Clone() MapStr {
 	return result
 }
 
+// DeepClone returns a copy of the MapStr. Unlike Clone, it also makes copies
+// of the slices, so the copy shares no mutable values with the original.
+func (m MapStr) DeepClone() MapStr {
+	result := make(MapStr, len(m))
+	for k, v := range m {
+		result[k] = deepCloneValue(v)
+	}
+	return result
+}
+
+func deepCloneValue(v interface{}) interface{} {
+	switch val := v.(type) {
+	case MapStr:
+		return val.DeepClone()
+	case map[string]interface{}:
+		return MapStr(val).DeepClone()
+	case []MapStr:
+		result := make([]MapStr, len(val))
+		for i, m := range val {
+			result[i] = m.DeepClone()
+		}
+		return result
+	case []map[string]interface{}:
+		result := make([]MapStr, len(val))
+		for i, m := range val {
+			result[i] = MapStr(m).DeepClone()
+		}
+		return result
+	case []interface{}:
+		result := make([]interface{}, len(val))
+		for i, elem := range val {
+			result[i] = deepCloneValue(elem)
+		}
+		return result
+	case []string:
+		return append([]string(nil), val...)
+	default:
+		return v
+	}
+}
+
+// DeepMerge recursively merges the key-value pairs of d into this map. Keys
+// with a map value in both maps are merged recursively. For other keys present
+// in both maps, the policy defines the resulting value. Values are deep cloned
+// before being added, so the maps share no mutable values after merging.
+func (m MapStr) DeepMerge(d MapStr, policy MergePolicy) error {
+	if policy == MergeErrorOnConflict {
+		if err := checkMergeConflicts("", m, d); err != nil {
+			return err
+		}
+	}
+
+	deepMerge(m, d, policy)
+	return nil
+}
+
+func deepMerge(m, d MapStr, policy MergePolicy) {
+	for k, v := range d {
+		old, exists := m[k]
+		if !exists {
+			m[k] = deepCloneValue(v)
+			continue
+		}
+
+		oldMap, oldIsMap := tryToMapStr(old)
+		newMap, newIsMap := tryToMapStr(v)
+		if oldIsMap && newIsMap {
+			deepMerge(oldMap, newMap, policy)
+			m[k] = oldMap
+			continue
+		}
+
+		switch policy {
+		case MergeKeepExisting:
+		case MergeAppend:
+			m[k] = appendValue(old, deepCloneValue(v))
+		default:
+			m[k] = deepCloneValue(v)
+		}
+	}
+}
+
+// appendValue appends v to old. Slices of any type are flattened into a
+// single []interface{}.
+func appendValue(old, v interface{}) interface{} {
+	list := append([]interface{}{}, toValueList(old)...)
+	return append(list, toValueList(v)...)
+}
+
+// toValueList returns the elements of a slice or array as []interface{}, or a
+// list with v as only element for other values. Byte slices are kept as
+// single values.
+func toValueList(v interface{}) []interface{} {
+	if list, ok := v.([]interface{}); ok {
+		return list
+	}
+
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Slice, reflect.Array:
+		if rv.Type().Elem().Kind() == reflect.Uint8 {
+			return []interface{}{v}
+		}
+		list := make([]interface{}, rv.Len())
+		for i := range list {
+			list[i] = rv.Index(i).Interface()
+		}
+		return list
+	}
+	return []interface{}{v}
+}
+
+func checkMergeConflicts(prefix string, m, d MapStr) error {
+	for k, v := range d {
+		old, exists := m[k]
+		if !exists {
+			continue
+		}
+
+		key := k
+		if prefix != "" {
+			key = prefix + "." + k
+		}
+
+		oldMap, oldIsMap := tryToMapStr(old)
+		newMap, newIsMap := tryToMapStr(v)
+		if oldIsMap && newIsMap {
+			if err := checkMergeConflicts(key, oldMap, newMap); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if !reflect.DeepEqual(old, v) {
+			return errors.Wrapf(ErrMergeConflict, "key '%v'", key)
+		}
+	}
+	return nil
+}
+
 // HasKey returns true if the key exist. If an error occurs then false is
 // returned with a non-nil error.
 func (m MapStr) HasKey(key string) (bool, error) {
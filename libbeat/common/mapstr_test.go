@@ -25,6 +25,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/zap/zapcore"
 
@@ -349,6 +350,138 @@ func TestClone(t *testing.T) {
 	assert.Equal(MapStr{"c31": 1, "c32": 2}, c["c3"])
 }
 
+func TestDeepClone(t *testing.T) {
+	m := MapStr{
+		"a": MapStr{"b": 1},
+		"c": []interface{}{MapStr{"d": 1}, "e"},
+		"f": []string{"g"},
+		"h": []MapStr{{"i": 1}},
+	}
+
+	c := m.DeepClone()
+	assert.Equal(t, m, c)
+
+	c["a"].(MapStr)["b"] = 2
+	c["c"].([]interface{})[0].(MapStr)["d"] = 2
+	c["f"].([]string)[0] = "x"
+	c["h"].([]MapStr)[0]["i"] = 2
+
+	assert.Equal(t, MapStr{
+		"a": MapStr{"b": 1},
+		"c": []interface{}{MapStr{"d": 1}, "e"},
+		"f": []string{"g"},
+		"h": []MapStr{{"i": 1}},
+	}, m)
+}
+
+func TestDeepMerge(t *testing.T) {
+	base := func() MapStr {
+		return MapStr{
+			"a": 1,
+			"b": MapStr{
+				"c": "x",
+				"d": []interface{}{"y"},
+			},
+			"e": map[string]interface{}{"f": 1},
+		}
+	}
+	other := MapStr{
+		"a": 2,
+		"b": MapStr{
+			"c": "z",
+			"d": "w",
+			"g": true,
+		},
+		"e": MapStr{"h": 2},
+	}
+
+	tests := map[string]struct {
+		policy   MergePolicy
+		expected MapStr
+	}{
+		"override": {
+			policy: MergeOverride,
+			expected: MapStr{
+				"a": 2,
+				"b": MapStr{"c": "z", "d": "w", "g": true},
+				"e": MapStr{"f": 1, "h": 2},
+			},
+		},
+		"keep existing": {
+			policy: MergeKeepExisting,
+			expected: MapStr{
+				"a": 1,
+				"b": MapStr{"c": "x", "d": []interface{}{"y"}, "g": true},
+				"e": MapStr{"f": 1, "h": 2},
+			},
+		},
+		"append": {
+			policy: MergeAppend,
+			expected: MapStr{
+				"a": []interface{}{1, 2},
+				"b": MapStr{"c": []interface{}{"x", "z"}, "d": []interface{}{"y", "w"}, "g": true},
+				"e": MapStr{"f": 1, "h": 2},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			m := base()
+			err := m.DeepMerge(other, test.policy)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, m)
+		})
+	}
+}
+
+func TestDeepMergeAppendTypedSlices(t *testing.T) {
+	m := MapStr{
+		"tags":  []string{"a"},
+		"ports": []int{80},
+		"raw":   []byte("x"),
+	}
+	err := m.DeepMerge(MapStr{
+		"tags":  []string{"b", "c"},
+		"ports": 443,
+		"raw":   []byte("y"),
+	}, MergeAppend)
+	assert.NoError(t, err)
+
+	assert.Equal(t, MapStr{
+		"tags":  []interface{}{"a", "b", "c"},
+		"ports": []interface{}{80, 443},
+		"raw":   []interface{}{[]byte("x"), []byte("y")},
+	}, m)
+}
+
+func TestDeepMergeErrorOnConflict(t *testing.T) {
+	m := MapStr{"a": MapStr{"b": 1, "c": 2}}
+
+	err := m.DeepMerge(MapStr{"a": MapStr{"b": 1, "d": 3}}, MergeErrorOnConflict)
+	assert.NoError(t, err)
+	assert.Equal(t, MapStr{"a": MapStr{"b": 1, "c": 2, "d": 3}}, m)
+
+	err = m.DeepMerge(MapStr{"a": MapStr{"c": 3, "e": 4}}, MergeErrorOnConflict)
+	if assert.Error(t, err) {
+		assert.Equal(t, ErrMergeConflict, errors.Cause(err))
+		assert.Contains(t, err.Error(), "a.c")
+	}
+
+	// map is not modified on conflicts
+	assert.Equal(t, MapStr{"a": MapStr{"b": 1, "c": 2, "d": 3}}, m)
+}
+
+func TestDeepMergeClonesValues(t *testing.T) {
+	m := MapStr{}
+	other := MapStr{"a": MapStr{"b": []interface{}{1}}}
+
+	assert.NoError(t, m.DeepMerge(other, MergeOverride))
+	m["a"].(MapStr)["b"].([]interface{})[0] = 2
+
+	assert.Equal(t, MapStr{"a": MapStr{"b": []interface{}{1}}}, other)
+}
+
 func TestString(t *testing.T) {
 	type io struct {
 		Input  MapStr
@@ -61,6 +61,10 @@ func MustRegisterPolicy(p *seccomp.Policy) {
 // errors interfacing with the kernel are logged (i.e. it is non-fatal if
 // seccomp cannot be setup).
 //
+// The syscalls of the policy can be either replaced entirely with a list of
+// groups in syscalls, or adjusted with the syscalls.allow and syscalls.deny
+// lists.
+//
 // Policy precedence order (highest to lowest):
 // - Policy values from config
 // - Application registered policy
@@ -122,15 +126,117 @@ func getPolicy(c *common.Config) (*seccomp.Policy, error) {
 		policy = registeredPolicy
 	}
 
+	if c != nil && hasSyscallOverrides(c) {
+		return applyOverrides(policy, c)
+	}
+
 	if c != nil && (c.HasField("default_action") || c.HasField("syscalls")) {
-		if policy == nil {
-			policy = &seccomp.Policy{}
+		// Unpack into a copy, so syscalls from config replace the groups of
+		// the built-in policy instead of being merged into them.
+		custom := &seccomp.Policy{}
+		if policy != nil {
+			custom.DefaultAction = policy.DefaultAction
+			if !c.HasField("syscalls") {
+				custom.Syscalls = policy.Syscalls
+			}
 		}
 
-		if err := c.Unpack(policy); err != nil {
+		if err := c.Unpack(custom); err != nil {
 			return nil, err
 		}
+		policy = custom
+	}
+
+	return policy, nil
+}
+
+// policyOverrides contains additions and removals to apply on top of the
+// built-in policy, instead of replacing it.
+type policyOverrides struct {
+	DefaultAction *seccomp.Action `config:"default_action"`
+	Syscalls      struct {
+		Allow []string `config:"allow"`
+		Deny  []string `config:"deny"`
+	} `config:"syscalls"`
+}
+
+// hasSyscallOverrides returns true if syscalls is configured as an object with
+// allow/deny lists, rather than as a list of groups replacing the policy.
+func hasSyscallOverrides(c *common.Config) bool {
+	if !c.HasField("syscalls") {
+		return false
+	}
+	syscalls, err := c.Child("syscalls", -1)
+	return err == nil && syscalls.IsDict()
+}
+
+// applyOverrides returns a copy of the given policy with the syscalls in the
+// allow list allowed and the syscalls in the deny list denied, removing them
+// from any other group in the policy.
+func applyOverrides(base *seccomp.Policy, c *common.Config) (*seccomp.Policy, error) {
+	var overrides policyOverrides
+	if err := c.Unpack(&overrides); err != nil {
+		return nil, err
+	}
+
+	allow, deny := overrides.Syscalls.Allow, overrides.Syscalls.Deny
+	for _, name := range allow {
+		if contains(deny, name) {
+			return nil, errors.Errorf("seccomp syscall %v cannot be both allowed and denied", name)
+		}
+	}
+
+	policy := &seccomp.Policy{DefaultAction: seccomp.ActionAllow}
+	if base != nil {
+		policy.DefaultAction = base.DefaultAction
+		for _, group := range base.Syscalls {
+			var names []string
+			for _, name := range group.Names {
+				if !contains(allow, name) && !contains(deny, name) {
+					names = append(names, name)
+				}
+			}
+			if len(names) > 0 {
+				policy.Syscalls = append(policy.Syscalls, seccomp.SyscallGroup{
+					Action: group.Action,
+					Names:  names,
+				})
+			}
+		}
+	}
+	if overrides.DefaultAction != nil {
+		policy.DefaultAction = *overrides.DefaultAction
+	}
+
+	if len(allow) > 0 {
+		policy.Syscalls = append(policy.Syscalls, seccomp.SyscallGroup{
+			Action: seccomp.ActionAllow,
+			Names:  allow,
+		})
+	}
+	if len(deny) > 0 {
+		policy.Syscalls = append(policy.Syscalls, seccomp.SyscallGroup{
+			Action: seccomp.ActionErrno,
+			Names:  deny,
+		})
+	}
+
+	// Report unknown syscalls as configuration errors, instead of failing to
+	// install the filter later.
+	if runtime.GOOS == "linux" {
+		if _, err := policy.Assemble(); err != nil {
+			return nil, errors.Wrap(err, "invalid seccomp policy overrides")
+		}
 	}
 
 	return policy, nil
 }
+
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
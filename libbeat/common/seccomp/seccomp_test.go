@@ -0,0 +1,110 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build linux,amd64 linux,386
+
+package seccomp
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/go-seccomp-bpf"
+)
+
+var testPolicy = &seccomp.Policy{
+	DefaultAction: seccomp.ActionErrno,
+	Syscalls: []seccomp.SyscallGroup{
+		{
+			Action: seccomp.ActionAllow,
+			Names:  []string{"read", "write", "execve"},
+		},
+	},
+}
+
+func TestApplyOverrides(t *testing.T) {
+	cfg := common.MustNewConfigFrom(map[string]interface{}{
+		"syscalls.allow": []string{"ptrace"},
+		"syscalls.deny":  []string{"execve"},
+	})
+	require.True(t, hasSyscallOverrides(cfg))
+
+	policy, err := applyOverrides(testPolicy, cfg)
+	require.NoError(t, err)
+
+	assert.Equal(t, seccomp.ActionErrno, policy.DefaultAction)
+	assert.Equal(t, []seccomp.SyscallGroup{
+		{Action: seccomp.ActionAllow, Names: []string{"read", "write"}},
+		{Action: seccomp.ActionAllow, Names: []string{"ptrace"}},
+		{Action: seccomp.ActionErrno, Names: []string{"execve"}},
+	}, policy.Syscalls)
+
+	// The base policy must be left unchanged.
+	assert.Equal(t, []string{"read", "write", "execve"}, testPolicy.Syscalls[0].Names)
+}
+
+func TestApplyOverridesDefaultAction(t *testing.T) {
+	cfg := common.MustNewConfigFrom(map[string]interface{}{
+		"default_action": "log",
+		"syscalls.allow": []string{"ptrace"},
+	})
+
+	policy, err := applyOverrides(testPolicy, cfg)
+	require.NoError(t, err)
+	assert.Equal(t, seccomp.ActionLog, policy.DefaultAction)
+}
+
+func TestApplyOverridesErrors(t *testing.T) {
+	for name, settings := range map[string]map[string]interface{}{
+		"allowed and denied": {
+			"syscalls.allow": []string{"ptrace"},
+			"syscalls.deny":  []string{"ptrace"},
+		},
+		"unknown syscall": {
+			"syscalls.allow": []string{"not_a_syscall"},
+		},
+		"invalid default action": {
+			"default_action": "ignore",
+			"syscalls.allow": []string{"ptrace"},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := applyOverrides(testPolicy, common.MustNewConfigFrom(settings))
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestGetPolicyReplaceSyscalls(t *testing.T) {
+	cfg := common.MustNewConfigFrom(map[string]interface{}{
+		"default_action": "allow",
+		"syscalls": []map[string]interface{}{
+			{"action": "errno", "names": []string{"execve"}},
+		},
+	})
+	assert.False(t, hasSyscallOverrides(cfg))
+
+	policy, err := getPolicy(cfg)
+	require.NoError(t, err)
+	assert.Equal(t, seccomp.ActionAllow, policy.DefaultAction)
+	assert.Equal(t, []seccomp.SyscallGroup{
+		{Action: seccomp.ActionErrno, Names: []string{"execve"}},
+	}, policy.Syscalls)
+}
@@ -33,6 +33,7 @@ type Config struct {
 	Certificate      CertificateConfig       `config:",inline"`
 	CurveTypes       []tlsCurveType          `config:"curve_types"`
 	Renegotiation    tlsRenegotiationSupport `config:"renegotiation"`
+	Reload           ReloadConfig            `config:"reload"`
 }
 
 // LoadTLSConfig will load a certificate from config with all TLS based keys
@@ -80,7 +81,7 @@ func LoadTLSConfig(config *Config) (*TLSConfig, error) {
 	}
 
 	// return config if no error occurred
-	return &TLSConfig{
+	tlsConfig := &TLSConfig{
 		Versions:         config.Versions,
 		Verification:     config.VerificationMode,
 		Certificates:     certs,
@@ -88,7 +89,11 @@ func LoadTLSConfig(config *Config) (*TLSConfig, error) {
 		CipherSuites:     cipherSuites,
 		CurvePreferences: curves,
 		Renegotiation:    tls.RenegotiationSupport(config.Renegotiation),
-	}, nil
+	}
+	if config.Reload.Enabled {
+		tlsConfig.reloader = newCertReloader(config.Reload, config.Certificate, config.CAs, cert, cas)
+	}
+	return tlsConfig, nil
 }
 
 // Validate values the TLSConfig struct making sure certificate sure we have both a certificate and
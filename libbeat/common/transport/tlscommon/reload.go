@@ -0,0 +1,175 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tlscommon
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/elastic/beats/libbeat/logp"
+)
+
+const defaultReloadPeriod = 10 * time.Second
+
+// ReloadConfig configures reloading of the certificate, key and certificate
+// authorities files when they are modified on disk.
+type ReloadConfig struct {
+	Enabled bool          `config:"enabled"`
+	Period  time.Duration `config:"period"`
+}
+
+// certReloader keeps track of the certificate, key and CA files and reloads
+// them if they have changed on disk. Files are checked lazily, at most once
+// per period, whenever a connection is established.
+type certReloader struct {
+	certificate CertificateConfig
+	cas         []string
+	period      time.Duration
+	now         func() time.Time
+
+	mu         sync.Mutex
+	lastCheck  time.Time
+	stats      map[string]fileStat
+	generation uint64
+	cert       *tls.Certificate
+	pool       *x509.CertPool
+}
+
+type fileStat struct {
+	modTime time.Time
+	size    int64
+}
+
+func newCertReloader(
+	config ReloadConfig,
+	certificate CertificateConfig,
+	cas []string,
+	cert *tls.Certificate,
+	pool *x509.CertPool,
+) *certReloader {
+	period := config.Period
+	if period <= 0 {
+		period = defaultReloadPeriod
+	}
+
+	r := &certReloader{
+		certificate: certificate,
+		cas:         cas,
+		period:      period,
+		now:         time.Now,
+		cert:        cert,
+		pool:        pool,
+	}
+	r.stats = r.statFiles()
+	r.lastCheck = r.now()
+	return r
+}
+
+func (r *certReloader) files() []string {
+	var files []string
+	if r.certificate.Certificate != "" {
+		files = append(files, r.certificate.Certificate, r.certificate.Key)
+	}
+	return append(files, r.cas...)
+}
+
+func (r *certReloader) statFiles() map[string]fileStat {
+	stats := map[string]fileStat{}
+	for _, path := range r.files() {
+		info, err := os.Stat(path)
+		if err != nil {
+			continue
+		}
+		stats[path] = fileStat{modTime: info.ModTime(), size: info.Size()}
+	}
+	return stats
+}
+
+func (r *certReloader) changed(stats map[string]fileStat) bool {
+	if len(stats) != len(r.stats) {
+		return true
+	}
+	for path, st := range stats {
+		if old, exists := r.stats[path]; !exists || old != st {
+			return true
+		}
+	}
+	return false
+}
+
+// check reloads the files if the reload period has passed and any of the
+// files has been modified. If loading the new files fails, the previously
+// loaded certificates are kept.
+func (r *certReloader) check() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := r.now()
+	if now.Sub(r.lastCheck) < r.period {
+		return
+	}
+	r.lastCheck = now
+
+	stats := r.statFiles()
+	if !r.changed(stats) {
+		return
+	}
+
+	cert, err := LoadCertificate(&r.certificate)
+	if err != nil {
+		logp.Err("Failed to reload TLS certificate, keeping previous one: %v", err)
+		return
+	}
+
+	pool, errs := LoadCertificateAuthorities(r.cas)
+	if len(errs) > 0 {
+		logp.Err("Failed to reload TLS certificate authorities, keeping previous ones: %v", errs)
+		return
+	}
+
+	logp.Info("TLS certificates reloaded")
+	r.stats = stats
+	r.cert = cert
+	r.pool = pool
+	r.generation++
+}
+
+func (r *certReloader) current() (*tls.Certificate, *x509.CertPool, uint64) {
+	r.check()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.cert, r.pool, r.generation
+}
+
+func (r *certReloader) getCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	cert, _, _ := r.current()
+	return cert, nil
+}
+
+func (r *certReloader) getClientCertificate(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+	cert, _, _ := r.current()
+	if cert == nil {
+		// no client certificate configured, continue handshake without one
+		return &tls.Certificate{}, nil
+	}
+	return cert, nil
+}
@@ -0,0 +1,181 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package tlscommon
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeCertificate(t *testing.T, dir, name string, modTime time.Time) {
+	key, err := rsa.GenerateKey(rand.Reader, 1024)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	files := map[string][]byte{
+		"cert.pem": pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		"key.pem":  pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)}),
+	}
+	for file, content := range files {
+		path := filepath.Join(dir, file)
+		require.NoError(t, ioutil.WriteFile(path, content, 0600))
+		require.NoError(t, os.Chtimes(path, modTime, modTime))
+	}
+}
+
+func reloadConfigYAML(dir string) string {
+	return fmt.Sprintf(`
+certificate: %[1]s/cert.pem
+key: %[1]s/key.pem
+certificate_authorities: [%[1]s/cert.pem]
+reload.enabled: true
+reload.period: 1m
+`, dir)
+}
+
+func commonName(t *testing.T, cert *tls.Certificate) string {
+	require.NotNil(t, cert)
+	parsed, err := x509.ParseCertificate(cert.Certificate[0])
+	require.NoError(t, err)
+	return parsed.Subject.CommonName
+}
+
+func TestReloadDisabledByDefault(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tls-reload")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	writeCertificate(t, dir, "first", time.Now())
+	cfg := mustLoad(t, fmt.Sprintf("certificate: %[1]s/cert.pem\nkey: %[1]s/key.pem\n", dir))
+	tlsC, err := LoadTLSConfig(cfg)
+	require.NoError(t, err)
+
+	assert.Nil(t, tlsC.reloader)
+	assert.Equal(t, uint64(0), tlsC.Generation())
+	assert.Len(t, tlsC.BuildModuleConfig("").Certificates, 1)
+}
+
+func TestReloadClientCertificate(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tls-reload")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	start := time.Now().Add(-time.Hour)
+	writeCertificate(t, dir, "first", start)
+
+	cfg := mustLoad(t, reloadConfigYAML(dir))
+	assert.Equal(t, time.Minute, cfg.Reload.Period)
+
+	tlsC, err := LoadTLSConfig(cfg)
+	require.NoError(t, err)
+	require.NotNil(t, tlsC.reloader)
+
+	now := time.Now()
+	tlsC.reloader.now = func() time.Time { return now }
+
+	config := tlsC.BuildModuleConfig("localhost")
+	assert.Empty(t, config.Certificates)
+	assert.NotNil(t, config.RootCAs)
+	cert, err := config.GetClientCertificate(nil)
+	require.NoError(t, err)
+	assert.Equal(t, "first", commonName(t, cert))
+
+	writeCertificate(t, dir, "second", start.Add(time.Minute))
+
+	// files are not checked before the reload period has passed
+	assert.Equal(t, uint64(0), tlsC.Generation())
+
+	now = now.Add(2 * time.Minute)
+	assert.Equal(t, uint64(1), tlsC.Generation())
+	cert, err = config.GetClientCertificate(nil)
+	require.NoError(t, err)
+	assert.Equal(t, "second", commonName(t, cert))
+
+	// invalid files keep the previously loaded certificate
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "cert.pem"), []byte("invalid"), 0600))
+	now = now.Add(2 * time.Minute)
+	assert.Equal(t, uint64(1), tlsC.Generation())
+	cert, err = config.GetClientCertificate(nil)
+	require.NoError(t, err)
+	assert.Equal(t, "second", commonName(t, cert))
+}
+
+func TestReloadServerCertificate(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tls-reload")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	start := time.Now().Add(-time.Hour)
+	writeCertificate(t, dir, "first", start)
+
+	var cfg ServerConfig
+	c := mustLoad(t, reloadConfigYAML(dir))
+	cfg.Certificate = c.Certificate
+	cfg.CAs = c.CAs
+	cfg.Reload = c.Reload
+
+	tlsC, err := LoadTLSServerConfig(&cfg)
+	require.NoError(t, err)
+
+	now := time.Now()
+	tlsC.reloader.now = func() time.Time { return now }
+
+	config := tlsC.BuildModuleConfig("localhost")
+	initial, err := config.GetConfigForClient(nil)
+	require.NoError(t, err)
+
+	writeCertificate(t, dir, "second", start.Add(time.Minute))
+	now = now.Add(2 * time.Minute)
+
+	cert, err := config.GetCertificate(nil)
+	require.NoError(t, err)
+	assert.Equal(t, "second", commonName(t, cert))
+
+	updated, err := config.GetConfigForClient(nil)
+	require.NoError(t, err)
+	assert.Nil(t, updated.GetConfigForClient)
+	assert.NotNil(t, updated.ClientCAs)
+	assert.False(t, initial.ClientCAs.Equal(updated.ClientCAs))
+}
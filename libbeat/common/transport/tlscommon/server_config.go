@@ -33,6 +33,7 @@ type ServerConfig struct {
 	Certificate      CertificateConfig   `config:",inline"`
 	CurveTypes       []tlsCurveType      `config:"curve_types"`
 	ClientAuth       tlsClientAuth       `config:"client_authentication"` //`none`, `optional` or `required`
+	Reload           ReloadConfig        `config:"reload"`
 }
 
 // LoadTLSServerConfig tranforms a ServerConfig into a `tls.Config` to be used directly with golang
@@ -78,7 +79,7 @@ func LoadTLSServerConfig(config *ServerConfig) (*TLSConfig, error) {
 	}
 
 	// return config if no error occurred
-	return &TLSConfig{
+	tlsConfig := &TLSConfig{
 		Versions:         config.Versions,
 		Verification:     config.VerificationMode,
 		Certificates:     certs,
@@ -86,7 +87,11 @@ func LoadTLSServerConfig(config *ServerConfig) (*TLSConfig, error) {
 		CipherSuites:     cipherSuites,
 		CurvePreferences: curves,
 		ClientAuth:       tls.ClientAuthType(config.ClientAuth),
-	}, nil
+	}
+	if config.Reload.Enabled {
+		tlsConfig.reloader = newCertReloader(config.Reload, config.Certificate, config.CAs, cert, cas)
+	}
+	return tlsConfig, nil
 }
 
 // Validate values the TLSConfig struct making sure certificate sure we have both a certificate and
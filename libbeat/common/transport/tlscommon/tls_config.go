@@ -64,6 +64,14 @@ type TLSConfig struct {
 	// ClientAuth controls how we want to verify certificate from a client, `none`, `optional` and
 	// `required`, default to required. Do not affect TCP client.
 	ClientAuth tls.ClientAuthType
+
+	// NextProtos is the list of application protocols offered during the
+	// ALPN negotiation, in order of preference.
+	NextProtos []string
+
+	// reloader reloads the certificates and CAs if the files are modified on
+	// disk. It is nil if reloading is disabled.
+	reloader *certReloader
 }
 
 // BuildModuleConfig takes the TLSConfig and transform it into a `tls.Config`.
@@ -79,7 +87,7 @@ func (c *TLSConfig) BuildModuleConfig(host string) *tls.Config {
 		logp.Warn("SSL/TLS verifications disabled.")
 	}
 
-	return &tls.Config{
+	config := &tls.Config{
 		ServerName:         host,
 		MinVersion:         minVersion,
 		MaxVersion:         maxVersion,
@@ -90,5 +98,47 @@ func (c *TLSConfig) BuildModuleConfig(host string) *tls.Config {
 		CipherSuites:       c.CipherSuites,
 		CurvePreferences:   c.CurvePreferences,
 		ClientAuth:         c.ClientAuth,
+		NextProtos:         c.NextProtos,
+	}
+
+	if c.reloader != nil {
+		c.applyReloader(config)
+		config.GetConfigForClient = func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			// Servers build a new config per handshake, so that updated client
+			// CAs are used for verification.
+			clientConfig := config.Clone()
+			clientConfig.GetConfigForClient = nil
+			c.applyReloader(clientConfig)
+			return clientConfig, nil
+		}
+	}
+
+	return config
+}
+
+// applyReloader replaces the static certificates and CAs in config with
+// the ones currently loaded by the reloader.
+func (c *TLSConfig) applyReloader(config *tls.Config) {
+	_, pool, _ := c.reloader.current()
+
+	config.Certificates = nil
+	config.GetCertificate = c.reloader.getCertificate
+	config.GetClientCertificate = c.reloader.getClientCertificate
+	if c.RootCAs != nil {
+		config.RootCAs = pool
+	}
+	if c.ClientCAs != nil {
+		config.ClientCAs = pool
+	}
+}
+
+// Generation returns a counter that is incremented every time the
+// certificates or CAs are reloaded. Users caching the result of
+// BuildModuleConfig must build a new config if the generation changes.
+func (c *TLSConfig) Generation() uint64 {
+	if c == nil || c.reloader == nil {
+		return 0
 	}
+	_, _, generation := c.reloader.current()
+	return generation
 }
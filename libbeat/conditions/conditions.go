@@ -26,14 +26,15 @@ import (
 
 // Config represents a configuration for a condition, as you would find it in the config files.
 type Config struct {
-	Equals    *Fields  `config:"equals"`
-	Contains  *Fields  `config:"contains"`
-	Regexp    *Fields  `config:"regexp"`
-	Range     *Fields  `config:"range"`
-	HasFields []string `config:"has_fields"`
-	OR        []Config `config:"or"`
-	AND       []Config `config:"and"`
-	NOT       *Config  `config:"not"`
+	Equals    *Fields                `config:"equals"`
+	Contains  *Fields                `config:"contains"`
+	Regexp    *Fields                `config:"regexp"`
+	Range     *Fields                `config:"range"`
+	HasFields []string               `config:"has_fields"`
+	Network   map[string]interface{} `config:"network"`
+	OR        []Config               `config:"or"`
+	AND       []Config               `config:"and"`
+	NOT       *Config                `config:"not"`
 }
 
 // Condition is the interface for all defined conditions
@@ -69,6 +70,8 @@ func NewCondition(config *Config) (Condition, error) {
 		condition, err = NewRangeCondition(config.Range.fields)
 	case config.HasFields != nil:
 		condition = NewHasFieldsCondition(config.HasFields)
+	case config.Network != nil:
+		condition, err = NewNetworkCondition(config.Network)
 	case len(config.OR) > 0:
 		var conditionsList []Condition
 		conditionsList, err = NewConditionList(config.OR)
@@ -0,0 +1,173 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package conditions
+
+import (
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/logp"
+)
+
+// namedNetworks are the ranges that can be referenced by name in a network
+// condition.
+var namedNetworks = map[string][]string{
+	"loopback":             {"127.0.0.0/8", "::1/128"},
+	"private":              {"10.0.0.0/8", "172.16.0.0/12", "192.168.0.0/16", "fc00::/7"},
+	"link_local_unicast":   {"169.254.0.0/16", "fe80::/10"},
+	"link_local_multicast": {"224.0.0.0/24", "ff02::/16"},
+	"multicast":            {"224.0.0.0/4", "ff00::/8"},
+	"unspecified":          {"0.0.0.0/32", "::/128"},
+}
+
+func init() {
+	namedNetworks["link_local"] = append(namedNetworks["link_local_unicast"], namedNetworks["link_local_multicast"]...)
+}
+
+// Network is a Condition type for checking if IP addresses are in networks.
+// Every field must contain an IP address in one of its configured networks.
+type Network map[string][]*net.IPNet
+
+// NewNetworkCondition builds a new Network from a map of fields to a network
+// or list of networks. A network is a CIDR range, an IP address or the name
+// of a range like private or loopback.
+func NewNetworkCondition(fields map[string]interface{}) (Network, error) {
+	c := Network{}
+
+	for field, value := range common.MapStr(fields).Flatten() {
+		var names []string
+		switch v := value.(type) {
+		case string:
+			names = []string{v}
+		case []interface{}:
+			for _, elem := range v {
+				s, err := ExtractString(elem)
+				if err != nil {
+					return nil, fmt.Errorf("invalid network for field '%v': %v", field, err)
+				}
+				names = append(names, s)
+			}
+		default:
+			return nil, fmt.Errorf("invalid network for field '%v': unexpected type %T", field, value)
+		}
+
+		for _, name := range names {
+			networks, err := parseNetwork(name)
+			if err != nil {
+				return nil, fmt.Errorf("invalid network for field '%v': %v", field, err)
+			}
+			c[field] = append(c[field], networks...)
+		}
+	}
+
+	return c, nil
+}
+
+func parseNetwork(name string) ([]*net.IPNet, error) {
+	if cidrs, found := namedNetworks[name]; found {
+		networks := make([]*net.IPNet, len(cidrs))
+		for i, cidr := range cidrs {
+			_, networks[i], _ = net.ParseCIDR(cidr)
+		}
+		return networks, nil
+	}
+
+	if strings.Contains(name, "/") {
+		_, network, err := net.ParseCIDR(name)
+		if err != nil {
+			return nil, err
+		}
+		return []*net.IPNet{network}, nil
+	}
+
+	ip := net.ParseIP(name)
+	if ip == nil {
+		return nil, fmt.Errorf("'%v' is not a valid IP address, CIDR range or named network", name)
+	}
+	bits := 8 * net.IPv6len
+	if ip4 := ip.To4(); ip4 != nil {
+		ip, bits = ip4, 8*net.IPv4len
+	}
+	return []*net.IPNet{{IP: ip, Mask: net.CIDRMask(bits, bits)}}, nil
+}
+
+// Check determines whether the given event matches this condition.
+func (c Network) Check(event ValuesMap) bool {
+	for field, networks := range c {
+		value, err := event.GetValue(field)
+		if err != nil {
+			return false
+		}
+
+		if !networksContain(networks, value) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// networksContain checks if the value, or any of the values if it is a list,
+// is an IP address in one of the networks.
+func networksContain(networks []*net.IPNet, value interface{}) bool {
+	switch v := value.(type) {
+	case string:
+		return networksContainIP(networks, net.ParseIP(v))
+	case net.IP:
+		return networksContainIP(networks, v)
+	case []string:
+		for _, s := range v {
+			if networksContainIP(networks, net.ParseIP(s)) {
+				return true
+			}
+		}
+	case []net.IP:
+		for _, ip := range v {
+			if networksContainIP(networks, ip) {
+				return true
+			}
+		}
+	case []interface{}:
+		for _, elem := range v {
+			if networksContain(networks, elem) {
+				return true
+			}
+		}
+	default:
+		logp.Warn("unexpected type %T in network condition", value)
+	}
+	return false
+}
+
+func networksContainIP(networks []*net.IPNet, ip net.IP) bool {
+	if ip == nil {
+		return false
+	}
+	for _, network := range networks {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c Network) String() string {
+	return fmt.Sprintf("network: %v", map[string][]*net.IPNet(c))
+}
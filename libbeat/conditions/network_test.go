@@ -0,0 +1,91 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package conditions
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+)
+
+func networkCondition(t *testing.T, config map[string]interface{}) Condition {
+	c := Config{}
+	err := common.MustNewConfigFrom(map[string]interface{}{"network": config}).Unpack(&c)
+	require.NoError(t, err)
+
+	cond, err := NewCondition(&c)
+	require.NoError(t, err)
+	return cond
+}
+
+func TestNetworkCondition(t *testing.T) {
+	event := &beat.Event{
+		Fields: common.MapStr{
+			"source": common.MapStr{
+				"ip": "10.1.2.3",
+			},
+			"destination": common.MapStr{
+				"ip": "8.8.8.8",
+			},
+			"client": common.MapStr{
+				"ip": net.ParseIP("fe80::1"),
+			},
+			"related": common.MapStr{
+				"ip": []string{"192.0.2.1", "127.0.0.1"},
+			},
+		},
+	}
+
+	tests := []struct {
+		config   map[string]interface{}
+		expected bool
+	}{
+		{map[string]interface{}{"source.ip": "private"}, true},
+		{map[string]interface{}{"source.ip": "10.0.0.0/8"}, true},
+		{map[string]interface{}{"source.ip": "10.1.2.3"}, true},
+		{map[string]interface{}{"source.ip": "10.1.2.4"}, false},
+		{map[string]interface{}{"source.ip": []interface{}{"loopback", "10.0.0.0/8"}}, true},
+		{map[string]interface{}{"destination.ip": "private"}, false},
+		{map[string]interface{}{"destination.ip": []interface{}{"8.8.0.0/16", "private"}}, true},
+		{map[string]interface{}{"client.ip": "link_local"}, true},
+		{map[string]interface{}{"client.ip": "link_local_unicast"}, true},
+		{map[string]interface{}{"client.ip": "loopback"}, false},
+		{map[string]interface{}{"related.ip": "loopback"}, true},
+		{map[string]interface{}{"related.ip": "private"}, false},
+		{map[string]interface{}{"source": map[string]interface{}{"ip": "private"}}, true},
+		{map[string]interface{}{"source.ip": "private", "destination.ip": "private"}, false},
+		{map[string]interface{}{"missing.ip": "private"}, false},
+	}
+
+	for _, test := range tests {
+		cond := networkCondition(t, test.config)
+		assert.Equal(t, test.expected, cond.Check(event), "%v", test.config)
+	}
+}
+
+func TestNetworkConditionInvalidConfig(t *testing.T) {
+	for _, network := range []interface{}{"unknown", "10.0.0.0/33", "10.0.0.300", 42} {
+		_, err := NewNetworkCondition(map[string]interface{}{"source.ip": network})
+		assert.Error(t, err, "%v", network)
+	}
+}
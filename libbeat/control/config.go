@@ -0,0 +1,64 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package control
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+)
+
+// Config configures the local control API.
+type Config struct {
+	Enabled bool `config:"enabled"`
+
+	// Host is the address to listen on. It is either a unix socket, written
+	// as `unix://<path>`, or a loopback address and port. Relative socket
+	// paths are resolved relative to the data path.
+	Host string `config:"host"`
+}
+
+const unixPrefix = "unix://"
+
+var defaultConfig = Config{
+	Enabled: false,
+	Host:    defaultHost,
+}
+
+// Validate checks the control API is only exposed locally.
+func (c *Config) Validate() error {
+	if strings.HasPrefix(c.Host, unixPrefix) {
+		if c.Host == unixPrefix {
+			return errors.New("no unix socket path configured")
+		}
+		return nil
+	}
+
+	host, _, err := net.SplitHostPort(c.Host)
+	if err != nil {
+		return fmt.Errorf("invalid host '%v': %v", c.Host, err)
+	}
+	if host == "localhost" {
+		return nil
+	}
+	if ip := net.ParseIP(host); ip == nil || !ip.IsLoopback() {
+		return fmt.Errorf("host '%v' is not a loopback address", c.Host)
+	}
+	return nil
+}
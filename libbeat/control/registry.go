@@ -0,0 +1,107 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package control
+
+import (
+	"sort"
+	"sync"
+)
+
+// RunnerInfo describes an active input or module.
+type RunnerInfo struct {
+	ID   uint64 `json:"id"`
+	Kind string `json:"kind"`
+	Name string `json:"name"`
+}
+
+type runnerKey struct {
+	kind string
+	id   uint64
+}
+
+var registry = struct {
+	sync.Mutex
+	runners  map[runnerKey]string
+	triggers map[string]func()
+}{
+	runners:  map[runnerKey]string{},
+	triggers: map[string]func(){},
+}
+
+// AddRunner registers an active input or module. Kind is the component
+// running it (e.g. `inputs` or `modules`), and id identifies it within the
+// component.
+func AddRunner(kind string, id uint64, name string) {
+	registry.Lock()
+	defer registry.Unlock()
+	registry.runners[runnerKey{kind, id}] = name
+}
+
+// RemoveRunner unregisters a stopped input or module.
+func RemoveRunner(kind string, id uint64) {
+	registry.Lock()
+	defer registry.Unlock()
+	delete(registry.runners, runnerKey{kind, id})
+}
+
+// Runners returns the active inputs and modules, sorted by kind and ID.
+func Runners() []RunnerInfo {
+	registry.Lock()
+	defer registry.Unlock()
+
+	infos := make([]RunnerInfo, 0, len(registry.runners))
+	for key, name := range registry.runners {
+		infos = append(infos, RunnerInfo{ID: key.id, Kind: key.kind, Name: name})
+	}
+	sort.Slice(infos, func(i, j int) bool {
+		if infos[i].Kind != infos[j].Kind {
+			return infos[i].Kind < infos[j].Kind
+		}
+		return infos[i].ID < infos[j].ID
+	})
+	return infos
+}
+
+// AddReloadTrigger registers a function forcing a config reload. The trigger
+// must not block.
+func AddReloadTrigger(name string, trigger func()) {
+	registry.Lock()
+	defer registry.Unlock()
+	registry.triggers[name] = trigger
+}
+
+// RemoveReloadTrigger unregisters a reload trigger.
+func RemoveReloadTrigger(name string) {
+	registry.Lock()
+	defer registry.Unlock()
+	delete(registry.triggers, name)
+}
+
+// TriggerReload runs all reload triggers and returns their names.
+func TriggerReload() []string {
+	registry.Lock()
+	defer registry.Unlock()
+
+	names := make([]string, 0, len(registry.triggers))
+	for name, trigger := range registry.triggers {
+		trigger()
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,232 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package control
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/common/cfgwarn"
+	"github.com/elastic/beats/libbeat/logp"
+	"github.com/elastic/beats/libbeat/paths"
+)
+
+// Settings provides the state and actions of the beat exposed by the control
+// API.
+type Settings struct {
+	// Config is the running configuration of the beat.
+	Config *common.Config
+
+	// Stop stops the beat gracefully, as on SIGTERM.
+	Stop func()
+}
+
+// Server serves the local control API.
+type Server struct {
+	log      *logp.Logger
+	settings Settings
+	listener net.Listener
+	server   *http.Server
+	path     string // path of the unix socket, if any
+}
+
+// New creates the control API server listening on the configured host.
+func New(cfg *common.Config, settings Settings) (*Server, error) {
+	config := defaultConfig
+	if err := cfg.Unpack(&config); err != nil {
+		return nil, err
+	}
+
+	s := &Server{
+		log:      logp.NewLogger("control"),
+		settings: settings,
+	}
+
+	var err error
+	if strings.HasPrefix(config.Host, unixPrefix) {
+		s.path = paths.Resolve(paths.Data, strings.TrimPrefix(config.Host, unixPrefix))
+		s.listener, err = listenUnix(s.path)
+	} else {
+		s.listener, err = net.Listen("tcp", config.Host)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to listen on %v: %v", config.Host, err)
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/config", s.configHandler)
+	mux.HandleFunc("/inputs", s.inputsHandler)
+	mux.HandleFunc("/reload", s.reloadHandler)
+	mux.HandleFunc("/logging/level", s.levelHandler)
+	mux.HandleFunc("/stop", s.stopHandler)
+	s.server = &http.Server{Handler: mux}
+	return s, nil
+}
+
+// listenUnix listens on a unix socket only accessible by the current user. A
+// socket left behind by a previous run is removed.
+func listenUnix(path string) (net.Listener, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0750); err != nil {
+		return nil, err
+	}
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	l, err := net.Listen("unix", path)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.Chmod(path, 0600); err != nil {
+		l.Close()
+		return nil, err
+	}
+	return l, nil
+}
+
+// Addr returns the address the server listens on.
+func (s *Server) Addr() net.Addr {
+	return s.listener.Addr()
+}
+
+// Start serves the control API in the background.
+func (s *Server) Start() {
+	cfgwarn.Experimental("The local control API is enabled.")
+	s.log.Infof("Control API listening on: %v", s.listener.Addr())
+	go func() {
+		if err := s.server.Serve(s.listener); err != nil && err != http.ErrServerClosed {
+			s.log.Errorf("Control API failed: %v", err)
+		}
+	}()
+}
+
+// Stop stops the server and removes the unix socket.
+func (s *Server) Stop() {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	s.server.Shutdown(ctx)
+	if s.path != "" {
+		os.Remove(s.path)
+	}
+}
+
+// configHandler returns the running configuration. Private settings, like
+// passwords, tokens and keys, are redacted.
+func (s *Server) configHandler(w http.ResponseWriter, r *http.Request) {
+	if !allowMethod(w, r, http.MethodGet) {
+		return
+	}
+
+	config, err := s.settings.Config.Redacted()
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, config)
+}
+
+func (s *Server) inputsHandler(w http.ResponseWriter, r *http.Request) {
+	if !allowMethod(w, r, http.MethodGet) {
+		return
+	}
+	writeJSON(w, http.StatusOK, common.MapStr{"inputs": Runners()})
+}
+
+func (s *Server) reloadHandler(w http.ResponseWriter, r *http.Request) {
+	if !allowMethod(w, r, http.MethodPost) {
+		return
+	}
+
+	reloaded := TriggerReload()
+	if len(reloaded) == 0 {
+		writeError(w, http.StatusConflict, fmt.Errorf("config reloading is not enabled"))
+		return
+	}
+	s.log.Info("Config reload triggered through the control API")
+	writeJSON(w, http.StatusAccepted, common.MapStr{"reloaded": reloaded})
+}
+
+// levelHandler returns the logging level on GET, and changes it on PUT. The
+// level is not persisted and is reset on restart.
+func (s *Server) levelHandler(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+	case http.MethodPut:
+		var body struct {
+			Level string `json:"level"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			writeError(w, http.StatusBadRequest, err)
+			return
+		}
+		var level logp.Level
+		if err := level.Unpack(body.Level); err != nil {
+			writeError(w, http.StatusBadRequest, err)
+			return
+		}
+		s.log.Infof("Logging level changed to %v through the control API", level)
+		logp.SetLevel(level)
+	default:
+		allowMethod(w, r, http.MethodGet, http.MethodPut)
+		return
+	}
+	writeJSON(w, http.StatusOK, common.MapStr{"level": logp.GetLevel().String()})
+}
+
+// stopHandler stops the beat. Events in the queue are published before the
+// beat exits, as on SIGTERM.
+func (s *Server) stopHandler(w http.ResponseWriter, r *http.Request) {
+	if !allowMethod(w, r, http.MethodPost) {
+		return
+	}
+
+	s.log.Info("Stop requested through the control API")
+	writeJSON(w, http.StatusAccepted, common.MapStr{"stopping": true})
+	go s.settings.Stop()
+}
+
+// allowMethod checks the request uses one of the given methods, and writes a
+// 405 response otherwise.
+func allowMethod(w http.ResponseWriter, r *http.Request, methods ...string) bool {
+	for _, m := range methods {
+		if r.Method == m {
+			return true
+		}
+	}
+	w.Header().Set("Allow", strings.Join(methods, ", "))
+	writeError(w, http.StatusMethodNotAllowed, fmt.Errorf("method %v not allowed", r.Method))
+	return false
+}
+
+func writeError(w http.ResponseWriter, status int, err error) {
+	writeJSON(w, status, common.MapStr{"error": err.Error()})
+}
+
+func writeJSON(w http.ResponseWriter, status int, data interface{}) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(data)
+}
@@ -0,0 +1,189 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package control
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/logp"
+)
+
+func TestConfigValidate(t *testing.T) {
+	tests := map[string]bool{
+		"unix:///var/run/beat.sock": true,
+		"unix://control.sock":       true,
+		"localhost:5067":            true,
+		"127.0.0.1:5067":            true,
+		"[::1]:5067":                true,
+		"unix://":                   false,
+		"0.0.0.0:5067":              false,
+		"10.0.0.1:5067":             false,
+		"localhost":                 false,
+	}
+
+	for host, valid := range tests {
+		t.Run(host, func(t *testing.T) {
+			config := Config{Host: host}
+			err := config.Validate()
+			if valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func newTestServer(t *testing.T, settings Settings) *Server {
+	s, err := New(common.MustNewConfigFrom(map[string]interface{}{
+		"enabled": true,
+		"host":    "localhost:0",
+	}), settings)
+	require.NoError(t, err)
+	return s
+}
+
+func do(t *testing.T, s *Server, method, path, body string) (int, common.MapStr) {
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	rec := httptest.NewRecorder()
+	s.server.Handler.ServeHTTP(rec, req)
+
+	var data common.MapStr
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &data))
+	return rec.Code, data
+}
+
+func TestConfigHandler(t *testing.T) {
+	s := newTestServer(t, Settings{
+		Config: common.MustNewConfigFrom(map[string]interface{}{
+			"output.elasticsearch.password": "secret",
+			"output.elasticsearch.index":    "beat",
+		}),
+	})
+	defer s.Stop()
+
+	status, data := do(t, s, http.MethodGet, "/config", "")
+	assert.Equal(t, http.StatusOK, status)
+	assert.Equal(t, common.MapStr{
+		"output": map[string]interface{}{
+			"elasticsearch": map[string]interface{}{
+				"password": "xxxxx",
+				"index":    "beat",
+			},
+		},
+	}, data)
+
+	status, _ = do(t, s, http.MethodPost, "/config", "")
+	assert.Equal(t, http.StatusMethodNotAllowed, status)
+}
+
+func TestInputsHandler(t *testing.T) {
+	s := newTestServer(t, Settings{})
+	defer s.Stop()
+
+	AddRunner("inputs", 2, "input [type=log]")
+	defer RemoveRunner("inputs", 2)
+
+	status, data := do(t, s, http.MethodGet, "/inputs", "")
+	assert.Equal(t, http.StatusOK, status)
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"id": float64(2), "kind": "inputs", "name": "input [type=log]"},
+	}, data["inputs"])
+}
+
+func TestReloadHandler(t *testing.T) {
+	s := newTestServer(t, Settings{})
+	defer s.Stop()
+
+	status, _ := do(t, s, http.MethodPost, "/reload", "")
+	assert.Equal(t, http.StatusConflict, status)
+
+	triggered := 0
+	AddReloadTrigger("inputs.d", func() { triggered++ })
+	defer RemoveReloadTrigger("inputs.d")
+
+	status, data := do(t, s, http.MethodPost, "/reload", "")
+	assert.Equal(t, http.StatusAccepted, status)
+	assert.Equal(t, []interface{}{"inputs.d"}, data["reloaded"])
+	assert.Equal(t, 1, triggered)
+}
+
+func TestLevelHandler(t *testing.T) {
+	require.NoError(t, logp.DevelopmentSetup(logp.WithLevel(logp.InfoLevel), logp.ToObserverOutput()))
+	s := newTestServer(t, Settings{})
+	defer s.Stop()
+
+	status, data := do(t, s, http.MethodGet, "/logging/level", "")
+	assert.Equal(t, http.StatusOK, status)
+	assert.Equal(t, "info", data["level"])
+
+	status, data = do(t, s, http.MethodPut, "/logging/level", `{"level": "debug"}`)
+	assert.Equal(t, http.StatusOK, status)
+	assert.Equal(t, "debug", data["level"])
+	assert.Equal(t, logp.DebugLevel, logp.GetLevel())
+
+	status, _ = do(t, s, http.MethodPut, "/logging/level", `{"level": "verbose"}`)
+	assert.Equal(t, http.StatusBadRequest, status)
+}
+
+func TestStopHandler(t *testing.T) {
+	stopped := make(chan struct{})
+	s := newTestServer(t, Settings{Stop: func() { close(stopped) }})
+	defer s.Stop()
+
+	status, _ := do(t, s, http.MethodPost, "/stop", "")
+	assert.Equal(t, http.StatusAccepted, status)
+	<-stopped
+}
+
+func TestUnixSocket(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("unix sockets are not supported on windows")
+	}
+
+	dir, err := ioutil.TempDir("", "control")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "control.sock")
+	s, err := New(common.MustNewConfigFrom(map[string]interface{}{
+		"enabled": true,
+		"host":    "unix://" + path,
+	}), Settings{})
+	require.NoError(t, err)
+
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+
+	s.Stop()
+	_, err = os.Stat(path)
+	assert.True(t, os.IsNotExist(err))
+}
@@ -0,0 +1,36 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package ilm
+
+// Config is used for unpacking the `setup.ilm` settings.
+type Config struct {
+	Enabled       bool   `config:"enabled"`
+	RolloverAlias string `config:"rollover_alias"`
+	Pattern       string `config:"pattern"`
+	PolicyName    string `config:"policy_name"`
+	PolicyFile    string `config:"policy_file"`
+	Overwrite     bool   `config:"overwrite"`
+}
+
+var (
+	// DefaultConfig for index lifecycle management
+	DefaultConfig = Config{
+		Enabled: false,
+		Pattern: "{now/d}-000001",
+	}
+)
@@ -0,0 +1,217 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package ilm
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/logp"
+	"github.com/elastic/beats/libbeat/paths"
+)
+
+// ESClient is a subset of the Elasticsearch client API capable of setting up
+// index lifecycle management.
+type ESClient interface {
+	LoadJSON(path string, json map[string]interface{}) ([]byte, error)
+	Request(method, path string, pipeline string, params map[string]string, body interface{}) (int, []byte, error)
+	GetVersion() string
+}
+
+// minESVersion is the first Elasticsearch version supporting index lifecycle
+// management.
+const minESVersion = "6.6.0"
+
+// ErrESVersionNotSupported is returned when the Elasticsearch instance does not
+// support index lifecycle management.
+var ErrESVersionNotSupported = errors.New("index lifecycle management requires Elasticsearch 6.6.0 or newer")
+
+// DefaultPolicy is the lifecycle policy loaded if no policy_file is configured.
+// Indices are rolled over once they reach 50GB or are 30 days old.
+var DefaultPolicy = common.MapStr{
+	"policy": common.MapStr{
+		"phases": common.MapStr{
+			"hot": common.MapStr{
+				"actions": common.MapStr{
+					"rollover": common.MapStr{
+						"max_size": "50gb",
+						"max_age":  "30d",
+					},
+				},
+			},
+		},
+	},
+}
+
+// Manager sets up the lifecycle policy, the write alias and the bootstrap
+// index in Elasticsearch.
+type Manager struct {
+	config Config
+}
+
+// NewManager creates a new index lifecycle manager. The rollover alias and
+// policy name default to `<index prefix>-<version>`.
+func NewManager(cfg *common.Config, info beat.Info) (*Manager, error) {
+	config := DefaultConfig
+	if cfg != nil {
+		if err := cfg.Unpack(&config); err != nil {
+			return nil, err
+		}
+	}
+
+	name := fmt.Sprintf("%s-%s", info.IndexPrefix, info.Version)
+	if config.RolloverAlias == "" {
+		config.RolloverAlias = name
+	}
+	if config.PolicyName == "" {
+		config.PolicyName = name
+	}
+
+	return &Manager{config: config}, nil
+}
+
+// Enabled returns true if index lifecycle management is enabled.
+func (m *Manager) Enabled() bool {
+	return m != nil && m.config.Enabled
+}
+
+// Alias returns the write alias events must be indexed into.
+func (m *Manager) Alias() string {
+	return m.config.RolloverAlias
+}
+
+// PolicyName returns the name of the lifecycle policy.
+func (m *Manager) PolicyName() string {
+	return m.config.PolicyName
+}
+
+// TemplateConfig returns the template settings required to attach the
+// lifecycle policy and the rollover alias to new indices.
+func (m *Manager) TemplateConfig() *common.Config {
+	return common.MustNewConfigFrom(map[string]interface{}{
+		"name":    m.config.RolloverAlias,
+		"pattern": m.config.RolloverAlias + "-*",
+		"settings": map[string]interface{}{
+			"index": map[string]interface{}{
+				"lifecycle": map[string]interface{}{
+					"name":           m.config.PolicyName,
+					"rollover_alias": m.config.RolloverAlias,
+				},
+			},
+		},
+	})
+}
+
+// CheckVersion returns an error if the connected Elasticsearch version does
+// not support index lifecycle management.
+func (m *Manager) CheckVersion(client ESClient) error {
+	version, err := common.NewVersion(client.GetVersion())
+	if err != nil {
+		return fmt.Errorf("error parsing Elasticsearch version: %v", err)
+	}
+	minVersion, _ := common.NewVersion(minESVersion)
+	if version.LessThan(minVersion) {
+		return ErrESVersionNotSupported
+	}
+	return nil
+}
+
+// LoadPolicy loads the lifecycle policy into Elasticsearch. An existing policy
+// is only replaced if overwrite is enabled.
+func (m *Manager) LoadPolicy(client ESClient) error {
+	if err := m.CheckVersion(client); err != nil {
+		return err
+	}
+
+	path := "/_ilm/policy/" + url.PathEscape(m.config.PolicyName)
+	if !m.config.Overwrite {
+		status, _, _ := client.Request("GET", path, "", nil, nil)
+		if status == 200 {
+			logp.Info("ILM policy %s already exists and will not be overwritten.", m.config.PolicyName)
+			return nil
+		}
+	}
+
+	policy, err := m.policy()
+	if err != nil {
+		return err
+	}
+
+	body, err := client.LoadJSON(path, policy)
+	if err != nil {
+		return fmt.Errorf("couldn't load ILM policy: %v. Response body: %s", err, body)
+	}
+	logp.Info("ILM policy %s loaded", m.config.PolicyName)
+	return nil
+}
+
+func (m *Manager) policy() (map[string]interface{}, error) {
+	if m.config.PolicyFile == "" {
+		return DefaultPolicy.Clone(), nil
+	}
+
+	policyPath := paths.Resolve(paths.Config, m.config.PolicyFile)
+	content, err := ioutil.ReadFile(policyPath)
+	if err != nil {
+		return nil, fmt.Errorf("error reading ILM policy file %s: %v", policyPath, err)
+	}
+
+	var policy map[string]interface{}
+	if err := json.Unmarshal(content, &policy); err != nil {
+		return nil, fmt.Errorf("could not unmarshal ILM policy file %s: %v", policyPath, err)
+	}
+	return policy, nil
+}
+
+// LoadAlias creates the bootstrap index with the write alias, unless the alias
+// already exists.
+func (m *Manager) LoadAlias(client ESClient) error {
+	if err := m.CheckVersion(client); err != nil {
+		return err
+	}
+
+	alias := m.config.RolloverAlias
+	status, _, _ := client.Request("HEAD", "/_alias/"+url.PathEscape(alias), "", nil, nil)
+	if status == 200 {
+		logp.Info("ILM write alias %s already exists.", alias)
+		return nil
+	}
+
+	// The bootstrap index name supports date math, which requires the name to
+	// be enclosed in angle brackets.
+	index := fmt.Sprintf("<%s-%s>", alias, m.config.Pattern)
+	body := map[string]interface{}{
+		"aliases": map[string]interface{}{
+			alias: map[string]interface{}{
+				"is_write_index": true,
+			},
+		},
+	}
+
+	resp, err := client.LoadJSON("/"+url.PathEscape(index), body)
+	if err != nil {
+		return fmt.Errorf("couldn't create ILM bootstrap index: %v. Response body: %s", err, resp)
+	}
+	logp.Info("ILM write alias %s created", alias)
+	return nil
+}
@@ -0,0 +1,194 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package ilm
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+)
+
+type request struct {
+	method string
+	path   string
+	body   map[string]interface{}
+}
+
+type mockClient struct {
+	version  string
+	existing map[string]bool
+	requests []request
+}
+
+func (c *mockClient) LoadJSON(path string, json map[string]interface{}) ([]byte, error) {
+	c.requests = append(c.requests, request{"PUT", path, json})
+	return nil, nil
+}
+
+func (c *mockClient) Request(method, path string, pipeline string, params map[string]string, body interface{}) (int, []byte, error) {
+	c.requests = append(c.requests, request{method: method, path: path})
+	if c.existing[path] {
+		return 200, nil, nil
+	}
+	return 404, nil, errors.New("404 Not Found")
+}
+
+func (c *mockClient) GetVersion() string {
+	return c.version
+}
+
+var info = beat.Info{IndexPrefix: "testbeat", Version: "6.6.0"}
+
+func newManager(t *testing.T, settings map[string]interface{}) *Manager {
+	m, err := NewManager(common.MustNewConfigFrom(settings), info)
+	require.NoError(t, err)
+	return m
+}
+
+func TestNewManagerDefaults(t *testing.T) {
+	m, err := NewManager(nil, info)
+	require.NoError(t, err)
+
+	assert.False(t, m.Enabled())
+	assert.Equal(t, "testbeat-6.6.0", m.Alias())
+	assert.Equal(t, "testbeat-6.6.0", m.PolicyName())
+
+	m = newManager(t, map[string]interface{}{
+		"enabled":        true,
+		"rollover_alias": "logs",
+		"policy_name":    "logs-policy",
+	})
+	assert.True(t, m.Enabled())
+	assert.Equal(t, "logs", m.Alias())
+	assert.Equal(t, "logs-policy", m.PolicyName())
+}
+
+func TestTemplateConfig(t *testing.T) {
+	m := newManager(t, map[string]interface{}{"enabled": true})
+
+	var tmpl struct {
+		Name     string        `config:"name"`
+		Pattern  string        `config:"pattern"`
+		Settings common.MapStr `config:"settings"`
+	}
+	require.NoError(t, m.TemplateConfig().Unpack(&tmpl))
+
+	assert.Equal(t, "testbeat-6.6.0", tmpl.Name)
+	assert.Equal(t, "testbeat-6.6.0-*", tmpl.Pattern)
+	name, _ := tmpl.Settings.GetValue("index.lifecycle.name")
+	assert.Equal(t, "testbeat-6.6.0", name)
+	alias, _ := tmpl.Settings.GetValue("index.lifecycle.rollover_alias")
+	assert.Equal(t, "testbeat-6.6.0", alias)
+}
+
+func TestUnsupportedVersion(t *testing.T) {
+	m := newManager(t, map[string]interface{}{"enabled": true})
+	client := &mockClient{version: "6.5.4"}
+
+	assert.Equal(t, ErrESVersionNotSupported, m.LoadPolicy(client))
+	assert.Equal(t, ErrESVersionNotSupported, m.LoadAlias(client))
+	assert.Empty(t, client.requests)
+}
+
+func TestLoadPolicy(t *testing.T) {
+	tests := map[string]struct {
+		overwrite bool
+		existing  bool
+		loaded    bool
+	}{
+		"new policy":                    {loaded: true},
+		"existing policy":               {existing: true},
+		"existing policy and overwrite": {existing: true, overwrite: true, loaded: true},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			m := newManager(t, map[string]interface{}{
+				"enabled":   true,
+				"overwrite": test.overwrite,
+			})
+			client := &mockClient{
+				version:  "7.0.0",
+				existing: map[string]bool{"/_ilm/policy/testbeat-6.6.0": test.existing},
+			}
+
+			require.NoError(t, m.LoadPolicy(client))
+
+			last := client.requests[len(client.requests)-1]
+			if test.loaded {
+				assert.Equal(t, request{"PUT", "/_ilm/policy/testbeat-6.6.0", DefaultPolicy}, last)
+			} else {
+				assert.Equal(t, "GET", last.method)
+			}
+		})
+	}
+}
+
+func TestLoadPolicyFile(t *testing.T) {
+	f, err := ioutil.TempFile("", "policy")
+	require.NoError(t, err)
+	defer os.Remove(f.Name())
+	_, err = f.WriteString(`{"policy": {"phases": {"delete": {"min_age": "7d"}}}}`)
+	require.NoError(t, err)
+	f.Close()
+
+	m := newManager(t, map[string]interface{}{
+		"enabled":     true,
+		"policy_file": f.Name(),
+		"overwrite":   true,
+	})
+	client := &mockClient{version: "6.6.0"}
+
+	require.NoError(t, m.LoadPolicy(client))
+	require.Len(t, client.requests, 1)
+	phases := client.requests[0].body["policy"].(map[string]interface{})["phases"]
+	assert.Contains(t, phases, "delete")
+}
+
+func TestLoadAlias(t *testing.T) {
+	m := newManager(t, map[string]interface{}{"enabled": true})
+
+	client := &mockClient{version: "6.6.0"}
+	require.NoError(t, m.LoadAlias(client))
+	require.Len(t, client.requests, 2)
+	assert.Equal(t, request{
+		method: "PUT",
+		path:   "/%3Ctestbeat-6.6.0-%7Bnow%2Fd%7D-000001%3E",
+		body: map[string]interface{}{
+			"aliases": map[string]interface{}{
+				"testbeat-6.6.0": map[string]interface{}{"is_write_index": true},
+			},
+		},
+	}, client.requests[1])
+
+	client = &mockClient{
+		version:  "6.6.0",
+		existing: map[string]bool{"/_alias/testbeat-6.6.0": true},
+	}
+	require.NoError(t, m.LoadAlias(client))
+	require.Len(t, client.requests, 1)
+}
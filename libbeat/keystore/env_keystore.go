@@ -0,0 +1,104 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package keystore
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/elastic/beats/libbeat/common"
+)
+
+func init() {
+	RegisterBackend("env", newEnvKeystoreFromConfig)
+}
+
+// EnvKeystore is a read only keystore that resolves the secrets from
+// environment variables, as injected by orchestrators and secret managers.
+// Only the variables starting with the configured prefix are visible, the
+// key of a secret is the name of the variable without the prefix.
+type EnvKeystore struct {
+	Prefix string
+}
+
+type envConfig struct {
+	Prefix string `config:"prefix"`
+}
+
+func newEnvKeystoreFromConfig(cfg *common.Config, _ string) (Keystore, error) {
+	config := envConfig{}
+	if err := cfg.Unpack(&config); err != nil {
+		return nil, fmt.Errorf("could not read env keystore configuration, err: %v", err)
+	}
+	return NewEnvKeystore(config.Prefix), nil
+}
+
+// NewEnvKeystore returns a keystore reading secrets from the environment
+// variables starting with the given prefix.
+func NewEnvKeystore(prefix string) Keystore {
+	return &EnvKeystore{Prefix: prefix}
+}
+
+// Retrieve returns the value of the environment variable of the key.
+func (k *EnvKeystore) Retrieve(key string) (*SecureString, error) {
+	value, found := os.LookupEnv(k.Prefix + key)
+	if !found {
+		return nil, ErrKeyDoesntExists
+	}
+	return NewSecureString([]byte(value)), nil
+}
+
+// List returns the keys of the environment variables starting with the prefix.
+func (k *EnvKeystore) List() ([]string, error) {
+	var keys []string
+	for _, env := range os.Environ() {
+		name := strings.SplitN(env, "=", 2)[0]
+		if strings.HasPrefix(name, k.Prefix) && len(name) > len(k.Prefix) {
+			keys = append(keys, name[len(k.Prefix):])
+		}
+	}
+	sort.Strings(keys)
+	return keys, nil
+}
+
+// GetConfig returns the secrets in the config format.
+func (k *EnvKeystore) GetConfig() (*common.Config, error) {
+	keys, _ := k.List()
+	configHash := make(map[string]interface{})
+	for _, key := range keys {
+		configHash[key] = os.Getenv(k.Prefix + key)
+	}
+	return common.NewConfigFrom(configHash)
+}
+
+// Store is not supported by the env keystore.
+func (k *EnvKeystore) Store(key string, value []byte) error { return ErrReadOnly }
+
+// Delete is not supported by the env keystore.
+func (k *EnvKeystore) Delete(key string) error { return ErrReadOnly }
+
+// Create is not supported by the env keystore.
+func (k *EnvKeystore) Create(override bool) error { return ErrReadOnly }
+
+// Save is not supported by the env keystore.
+func (k *EnvKeystore) Save() error { return ErrReadOnly }
+
+// IsPersisted always returns true, the environment is always available.
+func (k *EnvKeystore) IsPersisted() bool { return true }
@@ -0,0 +1,59 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package keystore
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/libbeat/common"
+)
+
+func TestEnvKeystore(t *testing.T) {
+	os.Setenv("TEST_BEAT_SECRET_ES_PWD", "secret")
+	defer os.Unsetenv("TEST_BEAT_SECRET_ES_PWD")
+
+	cfg := common.MustNewConfigFrom(map[string]interface{}{
+		"type":       "env",
+		"env.prefix": "TEST_BEAT_SECRET_",
+	})
+	keystore, err := Factory(cfg, "")
+	require.NoError(t, err)
+
+	secret, err := keystore.Retrieve("ES_PWD")
+	require.NoError(t, err)
+	v, _ := secret.Get()
+	assert.Equal(t, "secret", string(v))
+
+	_, err = keystore.Retrieve("NOT_SET")
+	assert.Equal(t, ErrKeyDoesntExists, err)
+
+	keys, err := keystore.List()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"ES_PWD"}, keys)
+
+	assert.Equal(t, ErrReadOnly, keystore.Store("key", []byte("value")))
+}
+
+func TestUnknownKeystoreType(t *testing.T) {
+	_, err := Factory(common.MustNewConfigFrom(map[string]interface{}{"type": "unknown"}), "")
+	assert.Error(t, err)
+}
@@ -36,6 +36,7 @@ import (
 
 	"github.com/elastic/beats/libbeat/common"
 	"github.com/elastic/beats/libbeat/common/file"
+	"github.com/elastic/beats/libbeat/logp"
 )
 
 const (
@@ -48,6 +49,10 @@ const (
 	keyLength       = 32
 )
 
+func init() {
+	RegisterBackend("file", newFileKeystoreFromConfig)
+}
+
 // Version of the keystore format, will be added at the beginning of the file.
 var version = []byte("v1")
 
@@ -66,6 +71,20 @@ type serializableSecureString struct {
 	Value []byte `json:"value"`
 }
 
+func newFileKeystoreFromConfig(cfg *common.Config, defaultPath string) (Keystore, error) {
+	config := defaultConfig
+	if err := cfg.Unpack(&config); err != nil {
+		return nil, fmt.Errorf("could not read keystore configuration, err: %v", err)
+	}
+
+	if config.Path == "" {
+		config.Path = defaultPath
+	}
+
+	logp.Debug("keystore", "Loading file keystore from %s", config.Path)
+	return NewFileKeystore(config.Path)
+}
+
 // NewFileKeystore returns an new File based keystore or an error, currently users cannot set their
 // own password on the keystore, the default password will be an empty string. When the keystore
 // is initialized the secrets are automatically loaded into memory.
@@ -32,8 +32,27 @@ var (
 
 	// ErrKeyDoesntExists is returned when the key doesn't exist in the store
 	ErrKeyDoesntExists = errors.New("cannot retrieve the key")
+
+	// ErrReadOnly is returned when trying to modify a keystore whose backend
+	// doesn't support modifications.
+	ErrReadOnly = errors.New("the keystore backend is read only")
 )
 
+// BackendFactory creates a keystore from the backend configuration. The
+// defaultPath is the path of the keystore file in the config directory.
+type BackendFactory func(cfg *common.Config, defaultPath string) (Keystore, error)
+
+var backends = map[string]BackendFactory{}
+
+// RegisterBackend registers a keystore backend, that can be selected with the
+// `keystore.type` setting.
+func RegisterBackend(name string, factory BackendFactory) {
+	if _, exists := backends[name]; exists {
+		panic(fmt.Sprintf("keystore backend '%v' already registered", name))
+	}
+	backends[name] = factory
+}
+
 // Keystore implement a way to securely saves and retrieves secrets to be used in the configuration
 // Currently all credentials are loaded upfront and are not lazy retrieved, we will eventually move
 // to that concept, so we can deal with tokens that has a limited duration or can be revoked by a
@@ -64,7 +83,7 @@ type Keystore interface {
 	Save() error
 }
 
-// Factory Create the right keystore with the configured options.
+// Factory creates the keystore using the backend selected in the configuration.
 func Factory(cfg *common.Config, defaultPath string) (Keystore, error) {
 	config := defaultConfig
 
@@ -77,13 +96,22 @@ func Factory(cfg *common.Config, defaultPath string) (Keystore, error) {
 		return nil, fmt.Errorf("could not read keystore configuration, err: %v", err)
 	}
 
-	if config.Path == "" {
-		config.Path = defaultPath
+	factory, found := backends[config.Type]
+	if !found {
+		return nil, fmt.Errorf("unknown keystore type '%v'", config.Type)
+	}
+
+	backendCfg, err := cfg.Child(config.Type, -1)
+	if err != nil {
+		backendCfg = common.NewConfig()
+	}
+
+	// The path of the file keystore is configured at the top level
+	if config.Type == "file" {
+		backendCfg = cfg
 	}
 
-	logp.Debug("keystore", "Loading file keystore from %s", config.Path)
-	keystore, err := NewFileKeystore(config.Path)
-	return keystore, err
+	return factory(backendCfg, defaultPath)
 }
 
 // ResolverFromConfig create a resolver from a configuration.
@@ -0,0 +1,427 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package keystore
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/common/transport/tlscommon"
+	"github.com/elastic/beats/libbeat/logp"
+)
+
+func init() {
+	RegisterBackend("vault", newVaultKeystoreFromConfig)
+}
+
+// minRenewPeriod is the minimum time between token renewals.
+const minRenewPeriod = time.Second
+
+type vaultConfig struct {
+	Address string            `config:"address"`
+	Token   string            `config:"token"`
+	AppRole vaultAppRole      `config:"auth.approle"`
+	Path    string            `config:"path"`
+	Keys    map[string]string `config:"keys"`
+	Renew   bool              `config:"renew"`
+	Cache   time.Duration     `config:"cache_ttl"`
+	Timeout time.Duration     `config:"timeout" validate:"positive"`
+	TLS     *tlscommon.Config `config:"ssl"`
+}
+
+type vaultAppRole struct {
+	Mount    string `config:"mount"`
+	RoleID   string `config:"role_id"`
+	SecretID string `config:"secret_id"`
+}
+
+var defaultVaultConfig = vaultConfig{
+	Address: "http://127.0.0.1:8200",
+	AppRole: vaultAppRole{
+		Mount: "approle",
+	},
+	Renew:   true,
+	Cache:   5 * time.Minute,
+	Timeout: 10 * time.Second,
+}
+
+func (c *vaultConfig) Validate() error {
+	if c.Path == "" && len(c.Keys) == 0 {
+		return errors.New("at least one of path or keys must be configured for the vault keystore")
+	}
+	if c.Token == "" && c.AppRole.RoleID == "" {
+		return errors.New("token or auth.approle.role_id must be configured for the vault keystore")
+	}
+	return nil
+}
+
+// VaultKeystore is a read only keystore that resolves the secrets from the
+// key/value secrets engine of HashiCorp Vault. Versions 1 and 2 of the engine
+// are supported.
+//
+// Keys are looked up as fields of the secret in the configured path, unless
+// they are mapped to other secrets with the `keys` setting, in the form
+// `<path>#<field>`. If the field is omitted, the name of the key is used.
+type VaultKeystore struct {
+	sync.Mutex
+	config vaultConfig
+	client *http.Client
+	token  string
+	cache  map[string]vaultCachedSecret
+
+	done      chan struct{}
+	closeOnce sync.Once
+	wg        sync.WaitGroup
+}
+
+type vaultCachedSecret struct {
+	data    map[string]string
+	expires time.Time
+}
+
+// vaultResponse is the generic response of the Vault API.
+type vaultResponse struct {
+	Data map[string]interface{} `json:"data"`
+	Auth *struct {
+		ClientToken   string `json:"client_token"`
+		LeaseDuration int    `json:"lease_duration"`
+		Renewable     bool   `json:"renewable"`
+	} `json:"auth"`
+	Errors []string `json:"errors"`
+}
+
+func newVaultKeystoreFromConfig(cfg *common.Config, _ string) (Keystore, error) {
+	config := defaultVaultConfig
+	if err := cfg.Unpack(&config); err != nil {
+		return nil, fmt.Errorf("could not read vault keystore configuration, err: %v", err)
+	}
+
+	tlsConfig, err := tlscommon.LoadTLSConfig(config.TLS)
+	if err != nil {
+		return nil, fmt.Errorf("fail to load the TLS config: %v", err)
+	}
+
+	transport := &http.Transport{Proxy: http.ProxyFromEnvironment}
+	if tlsConfig != nil {
+		transport.TLSClientConfig = tlsConfig.BuildModuleConfig("")
+	}
+
+	k := &VaultKeystore{
+		config: config,
+		client: &http.Client{
+			Transport: transport,
+			Timeout:   config.Timeout,
+		},
+		cache: map[string]vaultCachedSecret{},
+		done:  make(chan struct{}),
+	}
+
+	ttl, renewable, err := k.login()
+	if err != nil {
+		return nil, fmt.Errorf("could not authenticate in vault: %v", err)
+	}
+
+	if config.Renew && renewable && ttl > 0 {
+		k.wg.Add(1)
+		go func() {
+			defer k.wg.Done()
+			k.renewLoop(ttl)
+		}()
+	}
+
+	return k, nil
+}
+
+// login authenticates with the configured method and returns the TTL of the
+// token and if it can be renewed.
+func (k *VaultKeystore) login() (time.Duration, bool, error) {
+	if k.config.Token != "" {
+		k.setToken(k.config.Token)
+
+		var resp vaultResponse
+		if err := k.request("GET", "auth/token/lookup-self", nil, &resp); err != nil {
+			return 0, false, err
+		}
+		ttl, _ := resp.Data["ttl"].(float64)
+		renewable, _ := resp.Data["renewable"].(bool)
+		return time.Duration(ttl) * time.Second, renewable, nil
+	}
+
+	body := map[string]string{
+		"role_id":   k.config.AppRole.RoleID,
+		"secret_id": k.config.AppRole.SecretID,
+	}
+	var resp vaultResponse
+	if err := k.request("POST", "auth/"+k.config.AppRole.Mount+"/login", body, &resp); err != nil {
+		return 0, false, err
+	}
+	if resp.Auth == nil || resp.Auth.ClientToken == "" {
+		return 0, false, errors.New("no token in approle login response")
+	}
+
+	k.setToken(resp.Auth.ClientToken)
+	return time.Duration(resp.Auth.LeaseDuration) * time.Second, resp.Auth.Renewable, nil
+}
+
+// renew renews the token and returns its new TTL.
+func (k *VaultKeystore) renew() (time.Duration, error) {
+	var resp vaultResponse
+	if err := k.request("POST", "auth/token/renew-self", map[string]string{}, &resp); err != nil {
+		return 0, err
+	}
+	if resp.Auth == nil {
+		return 0, errors.New("no auth information in renew response")
+	}
+	return time.Duration(resp.Auth.LeaseDuration) * time.Second, nil
+}
+
+// renewLoop renews the token when half of its TTL has elapsed. If the
+// renewal fails, and the approle method is used, it authenticates again. It
+// returns when the keystore is closed.
+func (k *VaultKeystore) renewLoop(ttl time.Duration) {
+	for {
+		wait := ttl / 2
+		if wait < minRenewPeriod {
+			wait = minRenewPeriod
+		}
+
+		select {
+		case <-k.done:
+			return
+		case <-time.After(wait):
+		}
+
+		newTTL, err := k.renew()
+		if err != nil && k.config.Token == "" {
+			logp.Warn("Failed to renew vault token, authenticating again: %v", err)
+			newTTL, _, err = k.login()
+		}
+		if err != nil {
+			logp.Err("Failed to renew vault token: %v", err)
+			continue
+		}
+
+		logp.Debug("keystore", "vault token renewed, ttl: %v", newTTL)
+		ttl = newTTL
+	}
+}
+
+// Close stops the renewal of the token.
+func (k *VaultKeystore) Close() error {
+	k.closeOnce.Do(func() { close(k.done) })
+	k.wg.Wait()
+	return nil
+}
+
+func (k *VaultKeystore) setToken(token string) {
+	k.Lock()
+	defer k.Unlock()
+	k.token = token
+}
+
+func (k *VaultKeystore) request(method, path string, body interface{}, out *vaultResponse) error {
+	var reqBody []byte
+	if body != nil {
+		var err error
+		if reqBody, err = json.Marshal(body); err != nil {
+			return err
+		}
+	}
+
+	url := strings.TrimRight(k.config.Address, "/") + "/v1/" + strings.TrimLeft(path, "/")
+	req, err := http.NewRequest(method, url, bytes.NewReader(reqBody))
+	if err != nil {
+		return err
+	}
+
+	k.Lock()
+	token := k.token
+	k.Unlock()
+	if token != "" {
+		req.Header.Set("X-Vault-Token", token)
+	}
+
+	resp, err := k.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return ErrKeyDoesntExists
+	}
+
+	if len(data) > 0 {
+		if err := json.Unmarshal(data, out); err != nil {
+			return fmt.Errorf("invalid response from vault (status %d): %v", resp.StatusCode, err)
+		}
+	}
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("vault request to %s failed with status %d: %v", path, resp.StatusCode, strings.Join(out.Errors, ", "))
+	}
+	return nil
+}
+
+// readSecret reads the fields of a secret, caching them for the configured
+// time.
+func (k *VaultKeystore) readSecret(path string) (map[string]string, error) {
+	k.Lock()
+	cached, found := k.cache[path]
+	k.Unlock()
+	if found && time.Now().Before(cached.expires) {
+		return cached.data, nil
+	}
+
+	var resp vaultResponse
+	if err := k.request("GET", path, nil, &resp); err != nil {
+		return nil, err
+	}
+
+	fields := resp.Data
+	// Version 2 of the key/value engine returns the fields under data.data
+	if inner, ok := fields["data"].(map[string]interface{}); ok {
+		if _, ok := fields["metadata"]; ok {
+			fields = inner
+		}
+	}
+
+	data := make(map[string]string, len(fields))
+	for key, value := range fields {
+		switch v := value.(type) {
+		case string:
+			data[key] = v
+		default:
+			encoded, _ := json.Marshal(v)
+			data[key] = string(encoded)
+		}
+	}
+
+	if k.config.Cache > 0 {
+		k.Lock()
+		k.cache[path] = vaultCachedSecret{data: data, expires: time.Now().Add(k.config.Cache)}
+		k.Unlock()
+	}
+	return data, nil
+}
+
+// location returns the path of the secret and the field containing the key.
+func (k *VaultKeystore) location(key string) (string, string) {
+	if mapped, found := k.config.Keys[key]; found {
+		parts := strings.SplitN(mapped, "#", 2)
+		if len(parts) == 2 {
+			return parts[0], parts[1]
+		}
+		return mapped, key
+	}
+	return k.config.Path, key
+}
+
+// Retrieve returns the value of the key from vault.
+func (k *VaultKeystore) Retrieve(key string) (*SecureString, error) {
+	path, field := k.location(key)
+	if path == "" {
+		return nil, ErrKeyDoesntExists
+	}
+
+	data, err := k.readSecret(path)
+	if err != nil {
+		return nil, err
+	}
+
+	value, found := data[field]
+	if !found {
+		return nil, ErrKeyDoesntExists
+	}
+	return NewSecureString([]byte(value)), nil
+}
+
+// List returns the fields of the secret in the configured path and the keys
+// mapped to other secrets.
+func (k *VaultKeystore) List() ([]string, error) {
+	keys := map[string]struct{}{}
+	if k.config.Path != "" {
+		data, err := k.readSecret(k.config.Path)
+		if err != nil && err != ErrKeyDoesntExists {
+			return nil, err
+		}
+		for key := range data {
+			keys[key] = struct{}{}
+		}
+	}
+	for key := range k.config.Keys {
+		keys[key] = struct{}{}
+	}
+
+	list := make([]string, 0, len(keys))
+	for key := range keys {
+		list = append(list, key)
+	}
+	sort.Strings(list)
+	return list, nil
+}
+
+// GetConfig returns the secrets in the config format.
+func (k *VaultKeystore) GetConfig() (*common.Config, error) {
+	keys, err := k.List()
+	if err != nil {
+		return nil, err
+	}
+
+	configHash := make(map[string]interface{})
+	for _, key := range keys {
+		secret, err := k.Retrieve(key)
+		if err != nil {
+			if err == ErrKeyDoesntExists {
+				continue
+			}
+			return nil, err
+		}
+		value, _ := secret.Get()
+		configHash[key] = string(value)
+	}
+	return common.NewConfigFrom(configHash)
+}
+
+// Store is not supported by the vault keystore.
+func (k *VaultKeystore) Store(key string, value []byte) error { return ErrReadOnly }
+
+// Delete is not supported by the vault keystore.
+func (k *VaultKeystore) Delete(key string) error { return ErrReadOnly }
+
+// Create is not supported by the vault keystore.
+func (k *VaultKeystore) Create(override bool) error { return ErrReadOnly }
+
+// Save is not supported by the vault keystore.
+func (k *VaultKeystore) Save() error { return ErrReadOnly }
+
+// IsPersisted always returns true, secrets are managed in vault.
+func (k *VaultKeystore) IsPersisted() bool { return true }
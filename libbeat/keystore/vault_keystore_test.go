@@ -0,0 +1,219 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package keystore
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/libbeat/common"
+)
+
+type vaultMock struct {
+	reads    int32
+	renewals int32
+}
+
+func (m *vaultMock) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	reply := func(v interface{}) {
+		json.NewEncoder(w).Encode(v)
+	}
+
+	if r.URL.Path == "/v1/auth/approle/login" {
+		var body map[string]string
+		json.NewDecoder(r.Body).Decode(&body)
+		if body["role_id"] != "role" || body["secret_id"] != "secret" {
+			w.WriteHeader(http.StatusBadRequest)
+			reply(map[string]interface{}{"errors": []string{"invalid credentials"}})
+			return
+		}
+		reply(map[string]interface{}{
+			"auth": map[string]interface{}{
+				"client_token":   "approle-token",
+				"lease_duration": 3600,
+				"renewable":      true,
+			},
+		})
+		return
+	}
+
+	token := r.Header.Get("X-Vault-Token")
+	if token != "root" && token != "approle-token" {
+		w.WriteHeader(http.StatusForbidden)
+		reply(map[string]interface{}{"errors": []string{"permission denied"}})
+		return
+	}
+
+	switch r.URL.Path {
+	case "/v1/auth/token/lookup-self":
+		reply(map[string]interface{}{
+			"data": map[string]interface{}{"ttl": 0, "renewable": false},
+		})
+	case "/v1/auth/token/renew-self":
+		atomic.AddInt32(&m.renewals, 1)
+		reply(map[string]interface{}{
+			"auth": map[string]interface{}{"client_token": token, "lease_duration": 3600},
+		})
+	case "/v1/secret/beat":
+		// key/value version 1
+		atomic.AddInt32(&m.reads, 1)
+		reply(map[string]interface{}{
+			"data": map[string]interface{}{"ES_PWD": "changeme", "PORT": 9200},
+		})
+	case "/v1/kv/data/shared":
+		// key/value version 2
+		reply(map[string]interface{}{
+			"data": map[string]interface{}{
+				"data":     map[string]interface{}{"password": "shared-secret"},
+				"metadata": map[string]interface{}{"version": 1},
+			},
+		})
+	default:
+		w.WriteHeader(http.StatusNotFound)
+		reply(map[string]interface{}{"errors": []string{}})
+	}
+}
+
+func newTestVaultKeystore(t *testing.T, address string, settings map[string]interface{}) (Keystore, error) {
+	config := map[string]interface{}{
+		"type":          "vault",
+		"vault.address": address,
+	}
+	for k, v := range settings {
+		config["vault."+k] = v
+	}
+	return Factory(common.MustNewConfigFrom(config), "")
+}
+
+func retrieve(t *testing.T, keystore Keystore, key string) string {
+	secret, err := keystore.Retrieve(key)
+	require.NoError(t, err)
+	v, err := secret.Get()
+	require.NoError(t, err)
+	return string(v)
+}
+
+func TestVaultKeystoreToken(t *testing.T) {
+	mock := &vaultMock{}
+	server := httptest.NewServer(mock)
+	defer server.Close()
+
+	keystore, err := newTestVaultKeystore(t, server.URL, map[string]interface{}{
+		"token":       "root",
+		"path":        "secret/beat",
+		"keys.SHARED": "kv/data/shared#password",
+		"keys.OTHER":  "kv/data/missing",
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "changeme", retrieve(t, keystore, "ES_PWD"))
+	assert.Equal(t, "9200", retrieve(t, keystore, "PORT"))
+	assert.Equal(t, "shared-secret", retrieve(t, keystore, "SHARED"))
+
+	_, err = keystore.Retrieve("UNKNOWN")
+	assert.Equal(t, ErrKeyDoesntExists, err)
+	_, err = keystore.Retrieve("OTHER")
+	assert.Equal(t, ErrKeyDoesntExists, err)
+
+	// Secrets are cached
+	assert.Equal(t, int32(1), atomic.LoadInt32(&mock.reads))
+
+	keys, err := keystore.List()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"ES_PWD", "OTHER", "PORT", "SHARED"}, keys)
+
+	resolver := ResolverWrap(keystore)
+	v, err := resolver("ES_PWD")
+	assert.NoError(t, err)
+	assert.Equal(t, "changeme", v)
+
+	assert.Equal(t, ErrReadOnly, keystore.Save())
+}
+
+func TestVaultKeystoreAppRole(t *testing.T) {
+	mock := &vaultMock{}
+	server := httptest.NewServer(mock)
+	defer server.Close()
+
+	keystore, err := newTestVaultKeystore(t, server.URL, map[string]interface{}{
+		"auth.approle.role_id":   "role",
+		"auth.approle.secret_id": "secret",
+		"path":                   "secret/beat",
+		"renew":                  false,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "changeme", retrieve(t, keystore, "ES_PWD"))
+
+	ttl, err := keystore.(*VaultKeystore).renew()
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&mock.renewals))
+	assert.Equal(t, float64(3600), ttl.Seconds())
+
+	_, err = newTestVaultKeystore(t, server.URL, map[string]interface{}{
+		"auth.approle.role_id":   "role",
+		"auth.approle.secret_id": "wrong",
+		"path":                   "secret/beat",
+	})
+	assert.Error(t, err)
+}
+
+func TestVaultKeystoreRenewStopsOnClose(t *testing.T) {
+	mock := &vaultMock{}
+	server := httptest.NewServer(mock)
+	defer server.Close()
+
+	keystore, err := newTestVaultKeystore(t, server.URL, map[string]interface{}{
+		"auth.approle.role_id":   "role",
+		"auth.approle.secret_id": "secret",
+		"path":                   "secret/beat",
+	})
+	require.NoError(t, err)
+
+	// Close returns once the renewal loop has stopped.
+	done := make(chan struct{})
+	go func() {
+		keystore.(*VaultKeystore).Close()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("renewal loop did not stop")
+	}
+	assert.NoError(t, keystore.(*VaultKeystore).Close())
+	assert.Equal(t, int32(0), atomic.LoadInt32(&mock.renewals))
+}
+
+func TestVaultKeystoreInvalidConfig(t *testing.T) {
+	_, err := newTestVaultKeystore(t, "http://127.0.0.1:1", map[string]interface{}{
+		"path": "secret/beat",
+	})
+	assert.Error(t, err)
+
+	_, err = newTestVaultKeystore(t, "http://127.0.0.1:1", map[string]interface{}{
+		"token": "root",
+	})
+	assert.Error(t, err)
+}
@@ -17,6 +17,8 @@
 
 package logp
 
+import "time"
+
 // Config contains the configuration options for the logger. To create a Config
 // from a common.Config use logp/config.Build.
 type Config struct {
@@ -34,6 +36,8 @@ type Config struct {
 
 	Files FileConfig `config:"files"`
 
+	Sampling SamplingConfig `config:"sampling"` // Limits logging of identical messages.
+
 	addCaller   bool // Adds package and line number info to messages.
 	development bool // Controls how DPanic behaves.
 }
@@ -55,6 +59,9 @@ var defaultConfig = Config{
 		MaxBackups:  7,
 		Permissions: 0600,
 	},
+	Sampling: SamplingConfig{
+		SamplingRule: SamplingRule{Interval: time.Minute},
+	},
 	addCaller: true,
 }
 
@@ -45,6 +45,7 @@ func init() {
 		rootLogger:   zap.NewNop(),
 		globalLogger: zap.NewNop(),
 		logger:       newLogger(zap.NewNop(), ""),
+		level:        zap.NewAtomicLevel(),
 	})
 }
 
@@ -54,6 +55,7 @@ type coreLogger struct {
 	globalLogger *zap.Logger            // Logger used by legacy global functions (e.g. logp.Info).
 	logger       *Logger                // Logger that is the basis for all logp.Loggers.
 	observedLogs *observer.ObservedLogs // Contains events generated while in observation mode (a testing mode).
+	level        zap.AtomicLevel        // Level of the outputs, can be changed at runtime.
 }
 
 // Configure configures the logp package.
@@ -62,29 +64,34 @@ func Configure(cfg Config) error {
 		sink         zapcore.Core
 		observedLogs *observer.ObservedLogs
 		err          error
+		level        = zap.NewAtomicLevelAt(cfg.Level.zapLevel())
 	)
 
 	// Build a single output (stderr has priority if more than one are enabled).
 	switch {
 	case cfg.toObserver:
-		sink, observedLogs = observer.New(cfg.Level.zapLevel())
+		sink, observedLogs = observer.New(level)
 	case cfg.toIODiscard:
-		sink, err = makeDiscardOutput(cfg)
+		sink, err = makeDiscardOutput(cfg, level)
 	case cfg.ToStderr:
-		sink, err = makeStderrOutput(cfg)
+		sink, err = makeStderrOutput(cfg, level)
 	case cfg.ToSyslog:
-		sink, err = makeSyslogOutput(cfg)
+		sink, err = makeSyslogOutput(cfg, level)
 	case cfg.ToEventLog:
-		sink, err = makeEventLogOutput(cfg)
+		sink, err = makeEventLogOutput(cfg, level)
 	case cfg.ToFiles:
 		fallthrough
 	default:
-		sink, err = makeFileOutput(cfg)
+		sink, err = makeFileOutput(cfg, level)
 	}
 	if err != nil {
 		return errors.Wrap(err, "failed to build log output")
 	}
 
+	// Limit identical messages. The selective core wraps the sampling core, so
+	// only messages of enabled selectors are counted.
+	sink = samplingWrapper(sink, cfg.Sampling)
+
 	// Enabled selectors when debug is enabled.
 	selectors := make(map[string]struct{}, len(cfg.Selectors))
 	if cfg.Level.Enabled(DebugLevel) && len(cfg.Selectors) > 0 {
@@ -113,6 +120,7 @@ func Configure(cfg Config) error {
 		globalLogger: root.WithOptions(zap.AddCallerSkip(1)),
 		logger:       newLogger(root, ""),
 		observedLogs: observedLogs,
+		level:        level,
 	})
 	return nil
 }
@@ -166,25 +174,25 @@ func makeOptions(cfg Config) []zap.Option {
 	return options
 }
 
-func makeStderrOutput(cfg Config) (zapcore.Core, error) {
+func makeStderrOutput(cfg Config, enab zapcore.LevelEnabler) (zapcore.Core, error) {
 	stderr := zapcore.Lock(os.Stderr)
-	return zapcore.NewCore(buildEncoder(cfg), stderr, cfg.Level.zapLevel()), nil
+	return zapcore.NewCore(buildEncoder(cfg), stderr, enab), nil
 }
 
-func makeDiscardOutput(cfg Config) (zapcore.Core, error) {
+func makeDiscardOutput(cfg Config, enab zapcore.LevelEnabler) (zapcore.Core, error) {
 	discard := zapcore.AddSync(ioutil.Discard)
-	return zapcore.NewCore(buildEncoder(cfg), discard, cfg.Level.zapLevel()), nil
+	return zapcore.NewCore(buildEncoder(cfg), discard, enab), nil
 }
 
-func makeSyslogOutput(cfg Config) (zapcore.Core, error) {
-	return newSyslog(buildEncoder(cfg), cfg.Level.zapLevel())
+func makeSyslogOutput(cfg Config, enab zapcore.LevelEnabler) (zapcore.Core, error) {
+	return newSyslog(buildEncoder(cfg), enab)
 }
 
-func makeEventLogOutput(cfg Config) (zapcore.Core, error) {
-	return newEventLog(cfg.Beat, buildEncoder(cfg), cfg.Level.zapLevel())
+func makeEventLogOutput(cfg Config, enab zapcore.LevelEnabler) (zapcore.Core, error) {
+	return newEventLog(cfg.Beat, buildEncoder(cfg), enab)
 }
 
-func makeFileOutput(cfg Config) (zapcore.Core, error) {
+func makeFileOutput(cfg Config, enab zapcore.LevelEnabler) (zapcore.Core, error) {
 	name := cfg.Beat
 	if cfg.Files.Name != "" {
 		name = cfg.Files.Name
@@ -200,7 +208,7 @@ func makeFileOutput(cfg Config) (zapcore.Core, error) {
 		return nil, errors.Wrap(err, "failed to create file rotator")
 	}
 
-	return zapcore.NewCore(buildEncoder(cfg), rotator, cfg.Level.zapLevel()), nil
+	return zapcore.NewCore(buildEncoder(cfg), rotator, enab), nil
 }
 
 func globalLogger() *zap.Logger {
@@ -230,3 +230,24 @@ func TestL(t *testing.T) {
 		assert.Equal(t, "warning 1", log.Message)
 	}
 }
+
+func TestSetLevel(t *testing.T) {
+	if err := DevelopmentSetup(WithLevel(InfoLevel), ToObserverOutput()); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, InfoLevel, GetLevel())
+
+	logger := NewLogger("tester")
+	logger.Debug("not logged")
+	assert.Len(t, ObserverLogs().TakeAll(), 0)
+
+	SetLevel(DebugLevel)
+	assert.Equal(t, DebugLevel, GetLevel())
+	logger.Debug("is logged")
+	assert.Len(t, ObserverLogs().TakeAll(), 1)
+
+	SetLevel(ErrorLevel)
+	assert.Equal(t, ErrorLevel, GetLevel())
+	logger.Warn("not logged")
+	assert.Len(t, ObserverLogs().TakeAll(), 0)
+}
@@ -89,3 +89,21 @@ func (l Level) zapLevel() zapcore.Level {
 	}
 	return zapcore.InfoLevel
 }
+
+// SetLevel changes the logging level at runtime. Debug messages are only
+// filtered by selectors if selectors were configured at startup with the
+// debug level enabled.
+func SetLevel(l Level) {
+	loadLogger().level.SetLevel(l.zapLevel())
+}
+
+// GetLevel returns the current logging level.
+func GetLevel() Level {
+	z := loadLogger().level.Level()
+	for _, l := range []Level{DebugLevel, InfoLevel, WarnLevel, ErrorLevel} {
+		if zapLevels[l] == z {
+			return l
+		}
+	}
+	return InfoLevel
+}
@@ -0,0 +1,220 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package logp
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/zap/zapcore"
+)
+
+// SamplingConfig limits the number of identical messages logged per interval.
+// Messages are identical if they have the same logger name, level and
+// message. The limits can be overwritten per logger selector.
+type SamplingConfig struct {
+	SamplingRule `config:",inline"`
+
+	Selectors map[string]SamplingRule `config:"selectors"`
+}
+
+// SamplingRule sets the maximum number of identical messages logged per
+// interval. Sampling is disabled if MaxDuplicates is 0.
+type SamplingRule struct {
+	Interval      time.Duration `config:"interval" validate:"min=0"`
+	MaxDuplicates int           `config:"max_duplicates" validate:"min=0"`
+}
+
+func (c SamplingConfig) enabled() bool {
+	if c.MaxDuplicates > 0 {
+		return true
+	}
+	for _, rule := range c.Selectors {
+		if rule.MaxDuplicates > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+type samplingKey struct {
+	logger  string
+	level   zapcore.Level
+	message string
+}
+
+type samplingCounter struct {
+	start      time.Time
+	interval   time.Duration
+	count      int
+	suppressed int
+}
+
+// sampler keeps track of the messages logged per interval. It is shared by
+// all cores derived from the same root core.
+type sampler struct {
+	config SamplingConfig
+	core   zapcore.Core // core summaries are written to
+	now    func() time.Time
+
+	mutex     sync.Mutex
+	counters  map[samplingKey]*samplingCounter
+	lastSweep time.Time
+}
+
+type samplingCore struct {
+	sampler *sampler
+	core    zapcore.Core
+}
+
+func samplingWrapper(core zapcore.Core, config SamplingConfig) zapcore.Core {
+	if !config.enabled() {
+		return core
+	}
+
+	if config.Interval <= 0 {
+		config.Interval = defaultConfig.Sampling.Interval
+	}
+	return &samplingCore{
+		sampler: &sampler{
+			config:   config,
+			core:     core,
+			now:      time.Now,
+			counters: map[samplingKey]*samplingCounter{},
+		},
+		core: core,
+	}
+}
+
+// Enabled returns whether a given logging level is enabled when logging a
+// message.
+func (c *samplingCore) Enabled(level zapcore.Level) bool {
+	return c.core.Enabled(level)
+}
+
+// With adds structured context to the Core.
+func (c *samplingCore) With(fields []zapcore.Field) zapcore.Core {
+	return &samplingCore{sampler: c.sampler, core: c.core.With(fields)}
+}
+
+// Check determines whether the supplied Entry should be logged. Sampling is
+// applied on Write, so entries filtered out by the wrapping cores are not
+// counted.
+func (c *samplingCore) Check(ent zapcore.Entry, ce *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if c.Enabled(ent.Level) {
+		return ce.AddCore(ent, c)
+	}
+	return ce
+}
+
+// Write drops the entry if the maximum number of identical messages for the
+// current interval has been logged already.
+func (c *samplingCore) Write(ent zapcore.Entry, fields []zapcore.Field) error {
+	if !c.sampler.allow(ent) {
+		return nil
+	}
+	return c.core.Write(ent, fields)
+}
+
+// Sync flushes buffered logs (if any).
+func (c *samplingCore) Sync() error {
+	return c.core.Sync()
+}
+
+func (s *sampler) rule(logger string) SamplingRule {
+	rule, found := s.config.Selectors[logger]
+	if !found {
+		return s.config.SamplingRule
+	}
+	if rule.Interval <= 0 {
+		rule.Interval = s.config.Interval
+	}
+	return rule
+}
+
+// allow returns true if the entry is to be logged. Summaries of the
+// suppressed duplicates are written once the interval of a message is over.
+func (s *sampler) allow(ent zapcore.Entry) bool {
+	rule := s.rule(ent.LoggerName)
+	if rule.MaxDuplicates <= 0 {
+		return true
+	}
+
+	now := s.now()
+	key := samplingKey{logger: ent.LoggerName, level: ent.Level, message: ent.Message}
+
+	s.mutex.Lock()
+	summaries := s.sweep(now)
+
+	counter, found := s.counters[key]
+	if !found {
+		counter = &samplingCounter{start: now, interval: rule.Interval}
+		s.counters[key] = counter
+	}
+	counter.count++
+	allowed := counter.count <= rule.MaxDuplicates
+	if !allowed {
+		counter.suppressed++
+	}
+	s.mutex.Unlock()
+
+	for _, summary := range summaries {
+		s.core.Write(summary, nil)
+	}
+	return allowed
+}
+
+// sweep removes the counters whose interval is over, and returns the
+// summaries for the messages suppressed during these intervals. It must be
+// called with the mutex held.
+func (s *sampler) sweep(now time.Time) []zapcore.Entry {
+	if now.Sub(s.lastSweep) < s.minInterval() {
+		return nil
+	}
+	s.lastSweep = now
+
+	var summaries []zapcore.Entry
+	for key, counter := range s.counters {
+		if now.Sub(counter.start) < counter.interval {
+			continue
+		}
+
+		delete(s.counters, key)
+		if counter.suppressed > 0 {
+			summaries = append(summaries, zapcore.Entry{
+				LoggerName: key.logger,
+				Level:      key.level,
+				Time:       now,
+				Message: fmt.Sprintf("Suppressed %d duplicates of message in the last %v: %v",
+					counter.suppressed, counter.interval, key.message),
+			})
+		}
+	}
+	return summaries
+}
+
+func (s *sampler) minInterval() time.Duration {
+	interval := s.config.Interval
+	for _, rule := range s.config.Selectors {
+		if rule.Interval > 0 && rule.Interval < interval {
+			interval = rule.Interval
+		}
+	}
+	return interval
+}
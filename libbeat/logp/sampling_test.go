@@ -0,0 +1,107 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package logp
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+type testClock struct{ t time.Time }
+
+func (c *testClock) now() time.Time          { return c.t }
+func (c *testClock) advance(d time.Duration) { c.t = c.t.Add(d) }
+
+func newSamplingTestLogger(config SamplingConfig) (*zap.Logger, *observer.ObservedLogs, *testClock) {
+	sink, logs := observer.New(zapcore.DebugLevel)
+	core := samplingWrapper(sink, config)
+
+	clock := &testClock{t: time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)}
+	if sc, ok := core.(*samplingCore); ok {
+		sc.sampler.now = clock.now
+	}
+	return zap.New(core), logs, clock
+}
+
+func TestSamplingDisabled(t *testing.T) {
+	sink, _ := observer.New(zapcore.DebugLevel)
+	assert.Equal(t, sink, samplingWrapper(sink, SamplingConfig{}))
+}
+
+func TestSamplingDuplicates(t *testing.T) {
+	log, logs, clock := newSamplingTestLogger(SamplingConfig{
+		SamplingRule: SamplingRule{Interval: time.Minute, MaxDuplicates: 2},
+	})
+
+	for i := 0; i < 5; i++ {
+		log.Error("connection failed")
+		log.Error("other message")
+	}
+	assert.Equal(t, 2, logs.FilterMessage("connection failed").Len())
+	assert.Equal(t, 2, logs.FilterMessage("other message").Len())
+
+	// messages with other fields are still duplicates
+	log.With(zap.Int("attempt", 6)).Error("connection failed")
+	assert.Equal(t, 2, logs.FilterMessage("connection failed").Len())
+
+	clock.advance(time.Minute)
+	log.Error("connection failed")
+
+	assert.Equal(t, 3, logs.FilterMessage("connection failed").Len())
+	summaries := logs.FilterMessageSnippet("Suppressed").AllUntimed()
+	if assert.Len(t, summaries, 2) {
+		var messages []string
+		for _, s := range summaries {
+			messages = append(messages, s.Message)
+		}
+		assert.Contains(t, messages, "Suppressed 4 duplicates of message in the last 1m0s: connection failed")
+		assert.Contains(t, messages, "Suppressed 3 duplicates of message in the last 1m0s: other message")
+	}
+}
+
+func TestSamplingSelectors(t *testing.T) {
+	log, logs, _ := newSamplingTestLogger(SamplingConfig{
+		SamplingRule: SamplingRule{Interval: time.Minute},
+		Selectors: map[string]SamplingRule{
+			"publish": {MaxDuplicates: 1},
+		},
+	})
+
+	for i := 0; i < 3; i++ {
+		log.Named("publish").Error("failed to publish events")
+		log.Named("other").Error("failed to publish events")
+	}
+
+	assert.Equal(t, 1, filterLogger(logs, "publish"))
+	assert.Equal(t, 3, filterLogger(logs, "other"))
+}
+
+func filterLogger(logs *observer.ObservedLogs, name string) int {
+	n := 0
+	for _, entry := range logs.All() {
+		if entry.LoggerName == name {
+			n++
+		}
+	}
+	return n
+}
@@ -0,0 +1,97 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package protobuf
+
+import (
+	"fmt"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/outputs/codec"
+)
+
+// Encoder serializes a beat.Event to protocol buffers. Without a schema the
+// canonical Event message defined in event.proto is used.
+type Encoder struct {
+	version string
+	schema  []schemaField
+}
+
+type config struct {
+	Schema []fieldConfig `config:"schema"`
+}
+
+func init() {
+	codec.RegisterType("protobuf", func(info beat.Info, cfg *common.Config) (codec.Codec, error) {
+		config := config{}
+		if cfg != nil {
+			if err := cfg.Unpack(&config); err != nil {
+				return nil, err
+			}
+		}
+
+		return New(info.Version, config.Schema)
+	})
+}
+
+// New creates a new protobuf Encoder. If schema is empty, events are encoded
+// as canonical Event messages.
+func New(version string, schema []fieldConfig) (*Encoder, error) {
+	fields, err := compileSchema(schema)
+	if err != nil {
+		return nil, err
+	}
+	return &Encoder{version: version, schema: fields}, nil
+}
+
+// Encode serializes a beat event to protocol buffers.
+func (e *Encoder) Encode(index string, event *beat.Event) ([]byte, error) {
+	buf := proto.NewBuffer(nil)
+
+	var err error
+	if len(e.schema) > 0 {
+		err = encodeSchema(buf, e.schema, event)
+	} else {
+		err = e.encodeEvent(buf, index, event)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("protobuf encoding failed: %v", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// encodeEvent writes the canonical Event message, with the same metadata as
+// added by the json codec.
+func (e *Encoder) encodeEvent(buf *proto.Buffer, index string, event *beat.Event) error {
+	encodeTag(buf, 1, proto.WireVarint)
+	buf.EncodeVarint(uint64(event.Timestamp.UTC().UnixNano()))
+
+	meta := proto.NewBuffer(nil)
+	encodeString(meta, 1, index)
+	encodeString(meta, 2, "doc")
+	encodeString(meta, 3, e.version)
+	if err := encodeObjectFields(meta, 4, event.Meta); err != nil {
+		return err
+	}
+	encodeTag(buf, 2, proto.WireBytes)
+	buf.EncodeRawBytes(meta.Bytes())
+
+	return encodeObjectFields(buf, 3, event.Fields)
+}
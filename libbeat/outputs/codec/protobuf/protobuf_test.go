@@ -0,0 +1,205 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package protobuf
+
+import (
+	"encoding/binary"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+)
+
+type wireField struct {
+	wireType int
+	varint   uint64
+	bytes    []byte
+}
+
+// decode splits a serialized message into its fields, by field number.
+func decode(t *testing.T, b []byte) map[int][]wireField {
+	fields := map[int][]wireField{}
+	for len(b) > 0 {
+		key, n := proto.DecodeVarint(b)
+		require.NotZero(t, n)
+		b = b[n:]
+
+		f := wireField{wireType: int(key & 7)}
+		switch f.wireType {
+		case proto.WireVarint:
+			f.varint, n = proto.DecodeVarint(b)
+		case proto.WireFixed64:
+			f.varint, n = binary.LittleEndian.Uint64(b), 8
+		case proto.WireFixed32:
+			f.varint, n = uint64(binary.LittleEndian.Uint32(b)), 4
+		case proto.WireBytes:
+			var l uint64
+			l, n = proto.DecodeVarint(b)
+			f.bytes = b[n : n+int(l)]
+			n += int(l)
+		default:
+			t.Fatalf("unexpected wire type %v", f.wireType)
+		}
+		require.NotZero(t, n)
+		b = b[n:]
+		fields[int(key>>3)] = append(fields[int(key>>3)], f)
+	}
+	return fields
+}
+
+// decodeMap decodes the entries of a map<string, Value> field.
+func decodeMap(t *testing.T, entries []wireField) map[string]map[int][]wireField {
+	m := map[string]map[int][]wireField{}
+	for _, entry := range entries {
+		e := decode(t, entry.bytes)
+		m[string(e[1][0].bytes)] = decode(t, e[2][0].bytes)
+	}
+	return m
+}
+
+var ts = time.Date(2018, 4, 12, 10, 30, 0, 0, time.UTC)
+
+func TestCanonicalEvent(t *testing.T) {
+	enc, err := New("6.3.0", nil)
+	require.NoError(t, err)
+
+	b, err := enc.Encode("testbeat", &beat.Event{
+		Timestamp: ts,
+		Meta:      common.MapStr{"pipeline": "p"},
+		Fields: common.MapStr{
+			"message": "hello",
+			"count":   -3,
+			"bytes":   uint64(42),
+			"ratio":   0.5,
+			"ok":      true,
+			"none":    nil,
+			"tags":    []string{"a", "b"},
+			"host":    common.MapStr{"name": "h"},
+		},
+	})
+	require.NoError(t, err)
+
+	event := decode(t, b)
+	assert.Equal(t, uint64(ts.UnixNano()), event[1][0].varint)
+
+	meta := decode(t, event[2][0].bytes)
+	assert.Equal(t, "testbeat", string(meta[1][0].bytes))
+	assert.Equal(t, "doc", string(meta[2][0].bytes))
+	assert.Equal(t, "6.3.0", string(meta[3][0].bytes))
+	assert.Equal(t, "p", string(decodeMap(t, meta[4])["pipeline"][valueString][0].bytes))
+
+	fields := decodeMap(t, event[3])
+	require.Len(t, fields, 8)
+	assert.Equal(t, "hello", string(fields["message"][valueString][0].bytes))
+	assert.Equal(t, int64(-3), int64(fields["count"][valueInt][0].varint))
+	assert.Equal(t, uint64(42), fields["bytes"][valueUint][0].varint)
+	assert.Equal(t, 0.5, math.Float64frombits(fields["ratio"][valueDouble][0].varint))
+	assert.Equal(t, uint64(1), fields["ok"][valueBool][0].varint)
+	assert.Contains(t, fields["none"], valueNull)
+
+	tags := decode(t, fields["tags"][valueArray][0].bytes)
+	require.Len(t, tags[1], 2)
+	assert.Equal(t, "b", string(decode(t, tags[1][1].bytes)[valueString][0].bytes))
+
+	host := decode(t, fields["host"][valueObject][0].bytes)
+	assert.Equal(t, "h", string(decodeMap(t, host[1])["name"][valueString][0].bytes))
+}
+
+func TestCanonicalEventIsDeterministic(t *testing.T) {
+	enc, err := New("6.3.0", nil)
+	require.NoError(t, err)
+
+	event := &beat.Event{Timestamp: ts, Fields: common.MapStr{"a": 1, "b": 2, "c": 3, "d": 4}}
+	first, err := enc.Encode("testbeat", event)
+	require.NoError(t, err)
+	for i := 0; i < 10; i++ {
+		b, err := enc.Encode("testbeat", event)
+		require.NoError(t, err)
+		assert.Equal(t, first, b)
+	}
+}
+
+func TestSchema(t *testing.T) {
+	enc, err := New("6.3.0", []fieldConfig{
+		{Field: "@timestamp", Number: 1, Type: "timestamp"},
+		{Field: "message", Number: 2, Type: "string"},
+		{Field: "event.duration", Number: 3, Type: "int64"},
+		{Field: "offset", Number: 4, Type: "sint64"},
+		{Field: "ratio", Number: 5, Type: "float"},
+		{Field: "tags", Number: 6, Type: "string"},
+		{Field: "host", Number: 7, Type: "json"},
+		{Field: "missing", Number: 8, Type: "string"},
+		{Field: "@metadata.pipeline", Number: 9, Type: "string"},
+	})
+	require.NoError(t, err)
+
+	b, err := enc.Encode("testbeat", &beat.Event{
+		Timestamp: ts,
+		Meta:      common.MapStr{"pipeline": "p"},
+		Fields: common.MapStr{
+			"message": "hello",
+			"event":   common.MapStr{"duration": 1500},
+			"offset":  -2,
+			"ratio":   0.25,
+			"tags":    []string{"a", "b"},
+			"host":    common.MapStr{"name": "h"},
+		},
+	})
+	require.NoError(t, err)
+
+	msg := decode(t, b)
+	assert.Equal(t, uint64(ts.UnixNano()), msg[1][0].varint)
+	assert.Equal(t, "hello", string(msg[2][0].bytes))
+	assert.Equal(t, uint64(1500), msg[3][0].varint)
+	assert.Equal(t, uint64(3), msg[4][0].varint) // zigzag encoding of -2
+	assert.Equal(t, float32(0.25), math.Float32frombits(uint32(msg[5][0].varint)))
+	require.Len(t, msg[6], 2)
+	assert.Equal(t, "b", string(msg[6][1].bytes))
+	assert.Equal(t, `{"name":"h"}`, string(msg[7][0].bytes))
+	assert.NotContains(t, msg, 8)
+	assert.Equal(t, "p", string(msg[9][0].bytes))
+}
+
+func TestSchemaTypeMismatch(t *testing.T) {
+	enc, err := New("6.3.0", []fieldConfig{{Field: "count", Number: 1, Type: "int64"}})
+	require.NoError(t, err)
+
+	_, err = enc.Encode("testbeat", &beat.Event{Fields: common.MapStr{"count": "many"}})
+	assert.Error(t, err)
+}
+
+func TestInvalidSchema(t *testing.T) {
+	tests := map[string][]fieldConfig{
+		"unknown type":     {{Field: "a", Number: 1, Type: "map"}},
+		"reserved number":  {{Field: "a", Number: 19000, Type: "string"}},
+		"duplicate number": {{Field: "a", Number: 1, Type: "string"}, {Field: "b", Number: 1, Type: "string"}},
+	}
+
+	for name, schema := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := New("6.3.0", schema)
+			assert.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,291 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package protobuf
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+)
+
+// fieldConfig maps an event field to a field of the target message.
+type fieldConfig struct {
+	Field  string `config:"field" validate:"required"`
+	Number int    `config:"number" validate:"required"`
+	Type   string `config:"type" validate:"required"`
+}
+
+type schemaField struct {
+	path   string
+	number int
+	encode func(buf *proto.Buffer, number int, v interface{}) error
+}
+
+// fieldEncoders contains the supported scalar types of the schema mapping.
+var fieldEncoders = map[string]func(buf *proto.Buffer, number int, v interface{}) error{
+	"string":    encodeStringField,
+	"bytes":     encodeBytesField,
+	"bool":      encodeBoolField,
+	"int32":     encodeIntField,
+	"int64":     encodeIntField,
+	"sint32":    encodeSintField,
+	"sint64":    encodeSintField,
+	"uint32":    encodeIntField,
+	"uint64":    encodeIntField,
+	"float":     encodeFloatField,
+	"double":    encodeDoubleField,
+	"timestamp": encodeTimestampField,
+	"json":      encodeJSONField,
+	"value":     encodeValueField,
+}
+
+var errNotRepresentable = errors.New("value not representable")
+
+func compileSchema(schema []fieldConfig) ([]schemaField, error) {
+	numbers := map[int]string{}
+
+	fields := make([]schemaField, 0, len(schema))
+	for _, f := range schema {
+		encode, found := fieldEncoders[f.Type]
+		if !found {
+			return nil, fmt.Errorf("unsupported type '%v' for field %v", f.Type, f.Field)
+		}
+
+		// 19000 to 19999 are reserved by the protobuf implementation.
+		if f.Number < 1 || f.Number > 536870911 || (f.Number >= 19000 && f.Number <= 19999) {
+			return nil, fmt.Errorf("invalid field number %v for field %v", f.Number, f.Field)
+		}
+		if other, exists := numbers[f.Number]; exists {
+			return nil, fmt.Errorf("field number %v used by %v and %v", f.Number, other, f.Field)
+		}
+		numbers[f.Number] = f.Field
+
+		fields = append(fields, schemaField{path: f.Field, number: f.Number, encode: encode})
+	}
+	return fields, nil
+}
+
+// encodeSchema writes the fields of the event configured in schema. Missing
+// fields are omitted, and arrays are written as repeated fields.
+func encodeSchema(buf *proto.Buffer, schema []schemaField, event *beat.Event) error {
+	for _, f := range schema {
+		v, found := lookupField(event, f.path)
+		if !found || v == nil {
+			continue
+		}
+
+		if err := encodeRepeated(buf, f, v); err != nil {
+			return fmt.Errorf("field %v: %v", f.path, err)
+		}
+	}
+	return nil
+}
+
+func encodeRepeated(buf *proto.Buffer, f schemaField, v interface{}) error {
+	rv := reflect.ValueOf(v)
+	_, isBytes := v.([]byte)
+	if rv.Kind() != reflect.Slice || isBytes {
+		return f.encode(buf, f.number, v)
+	}
+
+	for i := 0; i < rv.Len(); i++ {
+		if err := f.encode(buf, f.number, rv.Index(i).Interface()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func lookupField(event *beat.Event, path string) (interface{}, bool) {
+	switch {
+	case path == "@timestamp":
+		return event.Timestamp, true
+	case strings.HasPrefix(path, "@metadata."):
+		v, err := event.Meta.GetValue(strings.TrimPrefix(path, "@metadata."))
+		return v, err == nil
+	default:
+		v, err := event.Fields.GetValue(path)
+		return v, err == nil
+	}
+}
+
+func encodeStringField(buf *proto.Buffer, number int, v interface{}) error {
+	var s string
+	switch val := v.(type) {
+	case string:
+		s = val
+	case fmt.Stringer:
+		s = val.String()
+	default:
+		s = fmt.Sprint(v)
+	}
+	encodeString(buf, number, s)
+	return nil
+}
+
+func encodeBytesField(buf *proto.Buffer, number int, v interface{}) error {
+	var b []byte
+	switch val := v.(type) {
+	case []byte:
+		b = val
+	case string:
+		b = []byte(val)
+	default:
+		return errNotRepresentable
+	}
+	encodeTag(buf, number, proto.WireBytes)
+	buf.EncodeRawBytes(b)
+	return nil
+}
+
+func encodeBoolField(buf *proto.Buffer, number int, v interface{}) error {
+	var b bool
+	switch val := v.(type) {
+	case bool:
+		b = val
+	case string:
+		var err error
+		if b, err = strconv.ParseBool(val); err != nil {
+			return err
+		}
+	default:
+		return errNotRepresentable
+	}
+	encodeTag(buf, number, proto.WireVarint)
+	buf.EncodeVarint(boolToUint(b))
+	return nil
+}
+
+func encodeIntField(buf *proto.Buffer, number int, v interface{}) error {
+	i, err := toInt(v)
+	if err != nil {
+		return err
+	}
+	encodeTag(buf, number, proto.WireVarint)
+	buf.EncodeVarint(uint64(i))
+	return nil
+}
+
+func encodeSintField(buf *proto.Buffer, number int, v interface{}) error {
+	i, err := toInt(v)
+	if err != nil {
+		return err
+	}
+	encodeTag(buf, number, proto.WireVarint)
+	buf.EncodeZigzag64(uint64(i))
+	return nil
+}
+
+func encodeFloatField(buf *proto.Buffer, number int, v interface{}) error {
+	f, err := toFloat(v)
+	if err != nil {
+		return err
+	}
+	encodeTag(buf, number, proto.WireFixed32)
+	buf.EncodeFixed32(uint64(math.Float32bits(float32(f))))
+	return nil
+}
+
+func encodeDoubleField(buf *proto.Buffer, number int, v interface{}) error {
+	f, err := toFloat(v)
+	if err != nil {
+		return err
+	}
+	encodeTag(buf, number, proto.WireFixed64)
+	buf.EncodeFixed64(math.Float64bits(f))
+	return nil
+}
+
+// encodeTimestampField writes the timestamp in nanoseconds since the Unix
+// epoch as int64.
+func encodeTimestampField(buf *proto.Buffer, number int, v interface{}) error {
+	var ts time.Time
+	switch val := v.(type) {
+	case time.Time:
+		ts = val
+	case common.Time:
+		ts = time.Time(val)
+	default:
+		return errNotRepresentable
+	}
+	encodeTag(buf, number, proto.WireVarint)
+	buf.EncodeVarint(uint64(ts.UTC().UnixNano()))
+	return nil
+}
+
+func encodeJSONField(buf *proto.Buffer, number int, v interface{}) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	encodeTag(buf, number, proto.WireBytes)
+	buf.EncodeRawBytes(b)
+	return nil
+}
+
+func encodeValueField(buf *proto.Buffer, number int, v interface{}) error {
+	b, err := encodeValue(v)
+	if err != nil {
+		return err
+	}
+	encodeTag(buf, number, proto.WireBytes)
+	buf.EncodeRawBytes(b)
+	return nil
+}
+
+func toInt(v interface{}) (int64, error) {
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return rv.Int(), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return int64(rv.Uint()), nil
+	case reflect.Float32, reflect.Float64:
+		return int64(rv.Float()), nil
+	case reflect.String:
+		return strconv.ParseInt(rv.String(), 10, 64)
+	default:
+		return 0, errNotRepresentable
+	}
+}
+
+func toFloat(v interface{}) (float64, error) {
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(rv.Int()), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(rv.Uint()), nil
+	case reflect.Float32, reflect.Float64:
+		return rv.Float(), nil
+	case reflect.String:
+		return strconv.ParseFloat(rv.String(), 64)
+	default:
+		return 0, errNotRepresentable
+	}
+}
@@ -0,0 +1,180 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package protobuf
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/elastic/beats/libbeat/common"
+)
+
+// Field numbers of the canonical Value message.
+const (
+	valueNull      = 1
+	valueString    = 2
+	valueInt       = 3
+	valueUint      = 4
+	valueDouble    = 5
+	valueBool      = 6
+	valueObject    = 7
+	valueArray     = 8
+	valueTimestamp = 9
+	valueBytes     = 10
+)
+
+func encodeTag(buf *proto.Buffer, number int, wireType int) {
+	buf.EncodeVarint(uint64(number)<<3 | uint64(wireType))
+}
+
+func encodeString(buf *proto.Buffer, number int, s string) {
+	encodeTag(buf, number, proto.WireBytes)
+	buf.EncodeStringBytes(s)
+}
+
+// encodeObjectFields writes the map as a protobuf map<string, Value> with the
+// given field number. Keys are sorted to produce a deterministic output.
+func encodeObjectFields(buf *proto.Buffer, number int, m map[string]interface{}) error {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		value, err := encodeValue(m[k])
+		if err != nil {
+			return fmt.Errorf("field %v: %v", k, err)
+		}
+
+		entry := proto.NewBuffer(nil)
+		encodeString(entry, 1, k)
+		encodeTag(entry, 2, proto.WireBytes)
+		entry.EncodeRawBytes(value)
+
+		encodeTag(buf, number, proto.WireBytes)
+		buf.EncodeRawBytes(entry.Bytes())
+	}
+	return nil
+}
+
+// encodeValue returns the serialized canonical Value message for v.
+func encodeValue(v interface{}) ([]byte, error) {
+	buf := proto.NewBuffer(nil)
+
+	switch val := v.(type) {
+	case nil:
+		encodeTag(buf, valueNull, proto.WireVarint)
+		buf.EncodeVarint(0)
+	case string:
+		encodeString(buf, valueString, val)
+	case bool:
+		encodeTag(buf, valueBool, proto.WireVarint)
+		buf.EncodeVarint(boolToUint(val))
+	case []byte:
+		encodeTag(buf, valueBytes, proto.WireBytes)
+		buf.EncodeRawBytes(val)
+	case time.Time:
+		encodeTag(buf, valueTimestamp, proto.WireVarint)
+		buf.EncodeVarint(uint64(val.UTC().UnixNano()))
+	case common.Time:
+		encodeTag(buf, valueTimestamp, proto.WireVarint)
+		buf.EncodeVarint(uint64(time.Time(val).UTC().UnixNano()))
+	case common.MapStr:
+		return encodeObject(val)
+	case map[string]interface{}:
+		return encodeObject(val)
+	default:
+		return encodeReflectValue(reflect.ValueOf(v))
+	}
+	return buf.Bytes(), nil
+}
+
+func encodeObject(m map[string]interface{}) ([]byte, error) {
+	object := proto.NewBuffer(nil)
+	if err := encodeObjectFields(object, 1, m); err != nil {
+		return nil, err
+	}
+
+	buf := proto.NewBuffer(nil)
+	encodeTag(buf, valueObject, proto.WireBytes)
+	buf.EncodeRawBytes(object.Bytes())
+	return buf.Bytes(), nil
+}
+
+func encodeReflectValue(v reflect.Value) ([]byte, error) {
+	buf := proto.NewBuffer(nil)
+
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return encodeValue(nil)
+		}
+		return encodeValue(v.Elem().Interface())
+	case reflect.String:
+		encodeString(buf, valueString, v.String())
+	case reflect.Bool:
+		encodeTag(buf, valueBool, proto.WireVarint)
+		buf.EncodeVarint(boolToUint(v.Bool()))
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		encodeTag(buf, valueInt, proto.WireVarint)
+		buf.EncodeVarint(uint64(v.Int()))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		encodeTag(buf, valueUint, proto.WireVarint)
+		buf.EncodeVarint(v.Uint())
+	case reflect.Float32, reflect.Float64:
+		encodeTag(buf, valueDouble, proto.WireFixed64)
+		buf.EncodeFixed64(math.Float64bits(v.Float()))
+	case reflect.Slice, reflect.Array:
+		array := proto.NewBuffer(nil)
+		for i := 0; i < v.Len(); i++ {
+			value, err := encodeValue(v.Index(i).Interface())
+			if err != nil {
+				return nil, err
+			}
+			encodeTag(array, 1, proto.WireBytes)
+			array.EncodeRawBytes(value)
+		}
+		encodeTag(buf, valueArray, proto.WireBytes)
+		buf.EncodeRawBytes(array.Bytes())
+	case reflect.Map:
+		if v.Type().Key().Kind() != reflect.String {
+			return nil, fmt.Errorf("unsupported map key type %v", v.Type().Key())
+		}
+		m := make(map[string]interface{}, v.Len())
+		for _, k := range v.MapKeys() {
+			m[k.String()] = v.MapIndex(k).Interface()
+		}
+		return encodeObject(m)
+	default:
+		return nil, fmt.Errorf("unsupported type %T", v.Interface())
+	}
+	return buf.Bytes(), nil
+}
+
+func boolToUint(b bool) uint64 {
+	if b {
+		return 1
+	}
+	return 0
+}
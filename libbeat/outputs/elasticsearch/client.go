@@ -28,7 +28,11 @@ import (
 	"net/url"
 	"time"
 
+	krbclient "gopkg.in/jcmturner/gokrb5.v7/client"
+	"gopkg.in/jcmturner/gokrb5.v7/spnego"
+
 	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common/kerberos"
 	"github.com/elastic/beats/libbeat/logp"
 	"github.com/elastic/beats/libbeat/outputs"
 	"github.com/elastic/beats/libbeat/outputs/outil"
@@ -44,6 +48,7 @@ type Client struct {
 
 	index    outil.Selector
 	pipeline *outil.Selector
+	allowed  metaAllowLists
 	params   map[string]string
 	timeout  time.Duration
 
@@ -54,8 +59,11 @@ type Client struct {
 	json jsonReader
 
 	// additional configs
-	compressionLevel int
-	proxyURL         *url.URL
+	compressionLevel  int
+	proxyURL          *url.URL
+	proxyDisable      bool
+	proxyLocalResolve bool
+	connectionPool    ConnectionPoolSettings
 
 	observer outputs.Observer
 }
@@ -64,7 +72,10 @@ type Client struct {
 type ClientSettings struct {
 	URL                string
 	Proxy              *url.URL
+	ProxyDisable       bool // do not use any proxy, including the proxy from the environment
+	ProxyLocalResolve  bool // resolve host names locally when using a SOCKS5 proxy
 	TLS                *transport.TLSConfig
+	Kerberos           *kerberos.Config
 	Username, Password string
 	EscapeHTML         bool
 	Parameters         map[string]string
@@ -74,6 +85,19 @@ type ClientSettings struct {
 	Timeout            time.Duration
 	CompressionLevel   int
 	Observer           outputs.Observer
+
+	// AllowedIndices and AllowedPipelines restrict the values events can
+	// set in @metadata.index and @metadata.pipeline.
+	AllowedIndices   outil.AllowList
+	AllowedPipelines outil.AllowList
+
+	// ConnectionPool configures the HTTP transport created for the client.
+	// It is ignored if Transport is set.
+	ConnectionPool ConnectionPoolSettings
+
+	// Transport is an optional HTTP transport shared with other clients
+	// connecting to the same host.
+	Transport *http.Transport
 }
 
 type connectCallback func(client *Client) error
@@ -85,6 +109,11 @@ type Connection struct {
 	Password string
 	Headers  map[string]string
 
+	// kerberos authenticates the requests with SPNEGO if configured. The
+	// Kerberos client is created on connect and destroyed on close.
+	kerberos       *kerberos.Config
+	kerberosClient *krbclient.Client
+
 	http              *http.Client
 	onConnectCallback func() error
 
@@ -137,11 +166,6 @@ func NewClient(
 	s ClientSettings,
 	onConnect *callbacksRegistry,
 ) (*Client, error) {
-	proxy := http.ProxyFromEnvironment
-	if s.Proxy != nil {
-		proxy = http.ProxyURL(s.Proxy)
-	}
-
 	pipeline := s.Pipeline
 	if pipeline != nil && pipeline.IsEmpty() {
 		pipeline = nil
@@ -162,18 +186,12 @@ func NewClient(
 
 	logp.Info("Elasticsearch url: %s", s.URL)
 
-	// TODO: add socks5 proxy support
-	var dialer, tlsDialer transport.Dialer
-
-	dialer = transport.NetDialer(s.Timeout)
-	tlsDialer, err = transport.TLSDialer(dialer, s.TLS, s.Timeout)
-	if err != nil {
-		return nil, err
-	}
-
-	if st := s.Observer; st != nil {
-		dialer = transport.StatsDialer(dialer, st)
-		tlsDialer = transport.StatsDialer(tlsDialer, st)
+	httpTransport := s.Transport
+	if httpTransport == nil {
+		httpTransport, err = newTransport(s)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	params := s.Parameters
@@ -199,27 +217,31 @@ func NewClient(
 			Username: s.Username,
 			Password: s.Password,
 			Headers:  s.Headers,
+			kerberos: s.Kerberos,
 			http: &http.Client{
-				Transport: &http.Transport{
-					Dial:    dialer.Dial,
-					DialTLS: tlsDialer.Dial,
-					Proxy:   proxy,
-				},
-				Timeout: s.Timeout,
+				Transport: httpTransport,
+				Timeout:   s.Timeout,
 			},
 			encoder: encoder,
 		},
 		tlsConfig: s.TLS,
 		index:     s.Index,
 		pipeline:  pipeline,
-		params:    params,
-		timeout:   s.Timeout,
+		allowed: metaAllowLists{
+			index:    s.AllowedIndices,
+			pipeline: s.AllowedPipelines,
+		},
+		params:  params,
+		timeout: s.Timeout,
 
 		bulkRequ: bulkRequ,
 
-		compressionLevel: compression,
-		proxyURL:         s.Proxy,
-		observer:         s.Observer,
+		compressionLevel:  compression,
+		proxyURL:          s.Proxy,
+		proxyDisable:      s.ProxyDisable,
+		proxyLocalResolve: s.ProxyLocalResolve,
+		connectionPool:    s.ConnectionPool,
+		observer:          s.Observer,
 	}
 
 	client.Connection.onConnectCallback = func() error {
@@ -249,17 +271,21 @@ func (client *Client) Clone() *Client {
 
 	c, _ := NewClient(
 		ClientSettings{
-			URL:              client.URL,
-			Index:            client.index,
-			Pipeline:         client.pipeline,
-			Proxy:            client.proxyURL,
-			TLS:              client.tlsConfig,
-			Username:         client.Username,
-			Password:         client.Password,
-			Parameters:       nil, // XXX: do not pass params?
-			Headers:          client.Headers,
-			Timeout:          client.http.Timeout,
-			CompressionLevel: client.compressionLevel,
+			URL:               client.URL,
+			Index:             client.index,
+			Pipeline:          client.pipeline,
+			Proxy:             client.proxyURL,
+			ProxyDisable:      client.proxyDisable,
+			ProxyLocalResolve: client.proxyLocalResolve,
+			TLS:               client.tlsConfig,
+			Kerberos:          client.kerberos,
+			Username:          client.Username,
+			Password:          client.Password,
+			Parameters:        nil, // XXX: do not pass params?
+			Headers:           client.Headers,
+			Timeout:           client.http.Timeout,
+			CompressionLevel:  client.compressionLevel,
+			ConnectionPool:    client.connectionPool,
 		},
 		nil, // XXX: do not pass connection callback?
 	)
@@ -301,7 +327,7 @@ func (client *Client) publishEvents(
 	// events slice
 
 	origCount := len(data)
-	data = bulkEncodePublishRequest(body, client.index, client.pipeline, data)
+	data = bulkEncodePublishRequest(body, client.index, client.pipeline, client.allowed, data)
 	newCount := len(data)
 	if st != nil && origCount > newCount {
 		st.Dropped(origCount - newCount)
@@ -360,12 +386,13 @@ func bulkEncodePublishRequest(
 	body bulkWriter,
 	index outil.Selector,
 	pipeline *outil.Selector,
+	allowed metaAllowLists,
 	data []publisher.Event,
 ) []publisher.Event {
 	okEvents := data[:0]
 	for i := range data {
 		event := &data[i].Content
-		meta, err := createEventBulkMeta(index, pipeline, event)
+		meta, err := createEventBulkMeta(index, pipeline, allowed, event)
 		if err != nil {
 			logp.Err("Failed to encode event meta data: %s", err)
 			continue
@@ -382,15 +409,16 @@ func bulkEncodePublishRequest(
 func createEventBulkMeta(
 	indexSel outil.Selector,
 	pipelineSel *outil.Selector,
+	allowed metaAllowLists,
 	event *beat.Event,
 ) (interface{}, error) {
-	pipeline, err := getPipeline(event, pipelineSel)
+	pipeline, err := getPipeline(event, pipelineSel, allowed.pipeline)
 	if err != nil {
 		err := fmt.Errorf("failed to select pipeline: %v", err)
 		return nil, err
 	}
 
-	index, err := getIndex(event, indexSel)
+	index, err := getIndex(event, indexSel, allowed.index)
 	if err != nil {
 		err := fmt.Errorf("failed to select event index: %v", err)
 		return nil, err
@@ -420,13 +448,20 @@ func createEventBulkMeta(
 	return bulkIndexAction{meta}, nil
 }
 
-func getPipeline(event *beat.Event, pipelineSel *outil.Selector) (string, error) {
+// metaAllowLists restricts the values events can set in @metadata.
+type metaAllowLists struct {
+	index, pipeline outil.AllowList
+}
+
+func getPipeline(event *beat.Event, pipelineSel *outil.Selector, allowed outil.AllowList) (string, error) {
 	if event.Meta != nil {
 		if pipeline, exists := event.Meta["pipeline"]; exists {
-			if p, ok := pipeline.(string); ok {
+			if _, ok := pipeline.(string); !ok {
+				return "", errors.New("pipeline metadata is no string")
+			}
+			if p, ok := outil.MetadataString(event, "pipeline", allowed); ok {
 				return p, nil
 			}
-			return "", errors.New("pipeline metadata is no string")
 		}
 	}
 
@@ -438,17 +473,12 @@ func getPipeline(event *beat.Event, pipelineSel *outil.Selector) (string, error)
 
 // getIndex returns the full index name
 // Index is either defined in the config as part of the output
-// or can be overload by the event through setting index
-func getIndex(event *beat.Event, index outil.Selector) (string, error) {
-	if event.Meta != nil {
-		if str, exists := event.Meta["index"]; exists {
-			idx, ok := str.(string)
-			if ok {
-				ts := event.Timestamp.UTC()
-				return fmt.Sprintf("%s-%d.%02d.%02d",
-					idx, ts.Year(), ts.Month(), ts.Day()), nil
-			}
-		}
+// or can be overload by the event through setting index, if allowed
+func getIndex(event *beat.Event, index outil.Selector, allowed outil.AllowList) (string, error) {
+	if idx, ok := outil.MetadataString(event, "index", allowed); ok {
+		ts := event.Timestamp.UTC()
+		return fmt.Sprintf("%s-%d.%02d.%02d",
+			idx, ts.Year(), ts.Month(), ts.Day()), nil
 	}
 
 	return index.Select(event)
@@ -666,6 +696,10 @@ func (client *Client) Test(d testing.Driver) {
 
 // Connect connects the client.
 func (conn *Connection) Connect() error {
+	if err := conn.connectKerberos(); err != nil {
+		return err
+	}
+
 	var err error
 	conn.version, err = conn.Ping()
 	if err != nil {
@@ -711,9 +745,46 @@ func (conn *Connection) Ping() (string, error) {
 
 // Close closes a connection.
 func (conn *Connection) Close() error {
+	if conn.kerberosClient != nil {
+		conn.kerberosClient.Destroy()
+		conn.kerberosClient = nil
+	}
+	return nil
+}
+
+// connectKerberos logs in to the KDC if Kerberos is configured and the
+// connection has no Kerberos client yet.
+func (conn *Connection) connectKerberos() error {
+	if conn.kerberos == nil {
+		return nil
+	}
+
+	if conn.kerberosClient != nil {
+		return nil
+	}
+
+	client, err := kerberos.NewClient(conn.kerberos)
+	if err != nil {
+		return fmt.Errorf("failed to initialize Kerberos authentication: %v", err)
+	}
+	conn.kerberosClient = client
 	return nil
 }
 
+// setSPNEGOHeader adds the SPNEGO authorization header to the request. The
+// service principal defaults to HTTP/<host> of the request URL.
+func (conn *Connection) setSPNEGOHeader(req *http.Request) error {
+	if conn.kerberosClient == nil {
+		return errors.New("kerberos client is not connected")
+	}
+
+	spn := conn.kerberos.ServiceName
+	if spn == "" {
+		spn = "HTTP/" + req.URL.Hostname()
+	}
+	return spnego.SetSPNEGOHeader(conn.kerberosClient, req, spn)
+}
+
 // Request sends a request via the connection.
 func (conn *Connection) Request(
 	method, path string,
@@ -770,6 +841,12 @@ func (conn *Connection) execHTTPRequest(req *http.Request) (int, []byte, error)
 		req.Header.Add(name, value)
 	}
 
+	if conn.kerberos != nil {
+		if err := conn.setSPNEGOHeader(req); err != nil {
+			return 0, nil, err
+		}
+	}
+
 	// The stlib will override the value in the header based on the configured `Host`
 	// on the request which default to the current machine.
 	//
@@ -28,10 +28,12 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/elastic/beats/libbeat/beat"
 	"github.com/elastic/beats/libbeat/common"
 	"github.com/elastic/beats/libbeat/common/fmtstr"
+	"github.com/elastic/beats/libbeat/common/kerberos"
 	"github.com/elastic/beats/libbeat/logp"
 	"github.com/elastic/beats/libbeat/outputs/outest"
 	"github.com/elastic/beats/libbeat/outputs/outil"
@@ -195,7 +197,7 @@ func TestGetIndexStandard(t *testing.T) {
 	indexSel := outil.MakeSelector(outil.FmtSelectorExpr(fmtstr, ""))
 
 	event := &beat.Event{Timestamp: ts, Fields: fields}
-	index, _ := getIndex(event, indexSel)
+	index, _ := getIndex(event, indexSel, nil)
 	assert.Equal(t, index, "beatname-"+extension)
 }
 
@@ -221,11 +223,52 @@ func TestGetIndexOverwrite(t *testing.T) {
 			"index": "dynamicindex",
 		},
 		Fields: fields}
-	index, _ := getIndex(event, indexSel)
+	index, _ := getIndex(event, indexSel, nil)
 	expected := "dynamicindex-" + extension
 	assert.Equal(t, expected, index)
 }
 
+func TestGetIndexOverwriteNotAllowed(t *testing.T) {
+	ts := time.Now().UTC()
+	extension := fmt.Sprintf("%d.%02d.%02d", ts.Year(), ts.Month(), ts.Day())
+	indexSel := outil.MakeSelector(outil.ConstSelectorExpr("beatname"))
+	allowed := outil.AllowList{"logs-*"}
+
+	event := &beat.Event{
+		Timestamp: ts,
+		Meta:      common.MapStr{"index": "dynamicindex"},
+		Fields:    common.MapStr{"field": 1},
+	}
+	index, _ := getIndex(event, indexSel, allowed)
+	assert.Equal(t, "beatname", index)
+
+	event.Meta["index"] = "logs-app"
+	index, _ = getIndex(event, indexSel, allowed)
+	assert.Equal(t, "logs-app-"+extension, index)
+}
+
+func TestGetPipelineOverwrite(t *testing.T) {
+	pipelineSel := outil.MakeSelector(outil.ConstSelectorExpr("default"))
+	allowed := outil.AllowList{"parse-*"}
+
+	event := &beat.Event{
+		Meta:   common.MapStr{"pipeline": "drop-all"},
+		Fields: common.MapStr{"field": 1},
+	}
+	pipeline, err := getPipeline(event, &pipelineSel, allowed)
+	assert.NoError(t, err)
+	assert.Equal(t, "default", pipeline)
+
+	event.Meta["pipeline"] = "parse-nginx"
+	pipeline, err = getPipeline(event, &pipelineSel, allowed)
+	assert.NoError(t, err)
+	assert.Equal(t, "parse-nginx", pipeline)
+
+	event.Meta["pipeline"] = 1
+	_, err = getPipeline(event, &pipelineSel, allowed)
+	assert.Error(t, err)
+}
+
 func BenchmarkCollectPublishFailsNone(b *testing.B) {
 	response := []byte(`
     { "items": [
@@ -334,6 +377,53 @@ func TestClientWithHeaders(t *testing.T) {
 	assert.Equal(t, 2, requestCount)
 }
 
+func TestClientKerberosLoginFailure(t *testing.T) {
+	requestCount := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestCount++
+	}))
+	defer ts.Close()
+
+	krbConfig := &kerberos.Config{}
+	err := common.MustNewConfigFrom(map[string]interface{}{
+		"auth_type":   "password",
+		"config_path": "/nonexistent/krb5.conf",
+		"username":    "beats",
+		"password":    "secret",
+	}).Unpack(krbConfig)
+	require.NoError(t, err)
+
+	client, err := NewClient(ClientSettings{
+		URL:      ts.URL,
+		Index:    outil.MakeSelector(outil.ConstSelectorExpr("test")),
+		Kerberos: krbConfig,
+	}, nil)
+	require.NoError(t, err)
+
+	// the connection fails before any request without Kerberos credentials
+	assert.Error(t, client.Connect())
+	_, _, err = client.Request("GET", "/", "", nil, nil)
+	assert.Error(t, err)
+	assert.Equal(t, 0, requestCount)
+	assert.NoError(t, client.Close())
+}
+
+func TestConfigKerberosWithBasicAuth(t *testing.T) {
+	cfg := common.MustNewConfigFrom(map[string]interface{}{
+		"username": "elastic",
+		"password": "changeme",
+		"kerberos": map[string]interface{}{
+			"auth_type":   "keytab",
+			"config_path": "/etc/krb5.conf",
+			"username":    "beats",
+			"keytab":      "/etc/beats.keytab",
+		},
+	})
+
+	config := defaultConfig
+	assert.Error(t, cfg.Unpack(&config))
+}
+
 func TestAddToURL(t *testing.T) {
 	type Test struct {
 		url      string
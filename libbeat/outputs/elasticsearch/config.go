@@ -18,27 +18,53 @@
 package elasticsearch
 
 import (
+	"errors"
 	"time"
 
+	"github.com/elastic/beats/libbeat/common/kerberos"
 	"github.com/elastic/beats/libbeat/common/transport/tlscommon"
+	"github.com/elastic/beats/libbeat/outputs/outil"
 )
 
 type elasticsearchConfig struct {
-	Protocol         string            `config:"protocol"`
-	Path             string            `config:"path"`
-	Params           map[string]string `config:"parameters"`
-	Headers          map[string]string `config:"headers"`
-	Username         string            `config:"username"`
-	Password         string            `config:"password"`
-	ProxyURL         string            `config:"proxy_url"`
-	LoadBalance      bool              `config:"loadbalance"`
-	CompressionLevel int               `config:"compression_level" validate:"min=0, max=9"`
-	EscapeHTML       bool              `config:"escape_html"`
-	TLS              *tlscommon.Config `config:"ssl"`
-	BulkMaxSize      int               `config:"bulk_max_size"`
-	MaxRetries       int               `config:"max_retries"`
-	Timeout          time.Duration     `config:"timeout"`
-	Backoff          Backoff           `config:"backoff"`
+	Protocol          string            `config:"protocol"`
+	Path              string            `config:"path"`
+	Params            map[string]string `config:"parameters"`
+	Headers           map[string]string `config:"headers"`
+	Username          string            `config:"username"`
+	Password          string            `config:"password"`
+	ProxyURL          string            `config:"proxy_url"`
+	ProxyBypass       []string          `config:"proxy_bypass"`
+	ProxyHosts        []hostProxy       `config:"proxy_hosts"`
+	ProxyLocalResolve bool              `config:"proxy_use_local_resolver"`
+	LoadBalance       bool              `config:"loadbalance"`
+	CompressionLevel  int               `config:"compression_level" validate:"min=0, max=9"`
+	EscapeHTML        bool              `config:"escape_html"`
+	TLS               *tlscommon.Config `config:"ssl"`
+	Kerberos          *kerberos.Config  `config:"kerberos"`
+	BulkMaxSize       int               `config:"bulk_max_size"`
+	MaxRetries        int               `config:"max_retries"`
+	Timeout           time.Duration     `config:"timeout"`
+	Backoff           Backoff           `config:"backoff"`
+	AllowedIndices    outil.AllowList   `config:"allowed_indices"`
+	AllowedPipelines  outil.AllowList   `config:"allowed_pipelines"`
+	ConnectionPool    connectionPool    `config:"connection_pool"`
+}
+
+// hostProxy configures the proxy to use for the hosts matching one of the
+// patterns.
+type hostProxy struct {
+	Hosts []string `config:"hosts" validate:"required"`
+	URL   string   `config:"url" validate:"required"`
+}
+
+// connectionPool configures the HTTP connections shared by all workers
+// publishing to the same host.
+type connectionPool struct {
+	MaxIdleConnsPerHost int           `config:"max_idle_connections_per_host" validate:"min=0"`
+	IdleConnTimeout     time.Duration `config:"idle_connection_timeout" validate:"min=0"`
+	KeepAlive           time.Duration `config:"keep_alive" validate:"min=0"`
+	HTTP2               bool          `config:"http2"`
 }
 
 type Backoff struct {
@@ -71,12 +97,49 @@ var (
 	}
 )
 
+// settings returns the connection pool settings for a pool shared by the
+// given number of clients. By default one idle connection is kept per client.
+func (c connectionPool) settings(clients int) ConnectionPoolSettings {
+	maxIdle := c.MaxIdleConnsPerHost
+	if maxIdle == 0 {
+		maxIdle = clients
+	}
+
+	return ConnectionPoolSettings{
+		MaxIdleConnsPerHost: maxIdle,
+		IdleConnTimeout:     c.IdleConnTimeout,
+		KeepAlive:           c.KeepAlive,
+		HTTP2:               c.HTTP2,
+	}
+}
+
 func (c *elasticsearchConfig) Validate() error {
+	if c.Kerberos != nil && (c.Username != "" || c.Password != "") {
+		return errors.New("username and password cannot be used together with kerberos")
+	}
+
 	if c.ProxyURL != "" {
 		if _, err := parseProxyURL(c.ProxyURL); err != nil {
 			return err
 		}
 	}
 
+	for _, pattern := range c.ProxyBypass {
+		if pattern == "" {
+			return errors.New("empty pattern in proxy_bypass")
+		}
+	}
+
+	for _, hp := range c.ProxyHosts {
+		if _, err := parseProxyURL(hp.URL); err != nil {
+			return err
+		}
+		for _, pattern := range hp.Hosts {
+			if pattern == "" {
+				return errors.New("empty pattern in proxy_hosts")
+			}
+		}
+	}
+
 	return nil
 }
@@ -20,6 +20,7 @@ package elasticsearch
 import (
 	"errors"
 	"fmt"
+	"net/http"
 	"sync"
 
 	"github.com/elastic/beats/libbeat/beat"
@@ -132,6 +133,10 @@ func makeES(
 		params = nil
 	}
 
+	// All clients publishing to the same host share one connection pool.
+	pool := config.ConnectionPool.settings(hostCount(hosts))
+	transports := map[string]*http.Transport{}
+
 	clients := make([]outputs.NetworkClient, len(hosts))
 	for i, host := range hosts {
 		esURL, err := common.MakeURL(config.Protocol, config.Path, host, 9200)
@@ -140,22 +145,43 @@ func makeES(
 			return outputs.Fail(err)
 		}
 
+		proxy, proxyDisable, err := proxyFor(esURL, proxyURL, &config)
+		if err != nil {
+			return outputs.Fail(err)
+		}
+
+		settings := ClientSettings{
+			URL:               esURL,
+			Index:             index,
+			Pipeline:          pipeline,
+			Proxy:             proxy,
+			ProxyDisable:      proxyDisable,
+			ProxyLocalResolve: config.ProxyLocalResolve,
+			TLS:               tlsConfig,
+			Kerberos:          config.Kerberos,
+			Username:          config.Username,
+			Password:          config.Password,
+			Parameters:        params,
+			Headers:           config.Headers,
+			Timeout:           config.Timeout,
+			CompressionLevel:  config.CompressionLevel,
+			Observer:          observer,
+			EscapeHTML:        config.EscapeHTML,
+			AllowedIndices:    config.AllowedIndices,
+			AllowedPipelines:  config.AllowedPipelines,
+			ConnectionPool:    pool,
+		}
+
+		if settings.Transport = transports[esURL]; settings.Transport == nil {
+			settings.Transport, err = newTransport(settings)
+			if err != nil {
+				return outputs.Fail(err)
+			}
+			transports[esURL] = settings.Transport
+		}
+
 		var client outputs.NetworkClient
-		client, err = NewClient(ClientSettings{
-			URL:              esURL,
-			Index:            index,
-			Pipeline:         pipeline,
-			Proxy:            proxyURL,
-			TLS:              tlsConfig,
-			Username:         config.Username,
-			Password:         config.Password,
-			Parameters:       params,
-			Headers:          config.Headers,
-			Timeout:          config.Timeout,
-			CompressionLevel: config.CompressionLevel,
-			Observer:         observer,
-			EscapeHTML:       config.EscapeHTML,
-		}, &connectCallbackRegistry)
+		client, err = NewClient(settings, &connectCallbackRegistry)
 		if err != nil {
 			return outputs.Fail(err)
 		}
@@ -167,6 +193,20 @@ func makeES(
 	return outputs.SuccessNet(config.LoadBalance, config.BulkMaxSize, config.MaxRetries, clients)
 }
 
+// hostCount returns the largest number of times a single host occurs in
+// hosts, that is the number of clients sharing one connection pool.
+func hostCount(hosts []string) int {
+	max := 0
+	counts := map[string]int{}
+	for _, host := range hosts {
+		counts[host]++
+		if counts[host] > max {
+			max = counts[host]
+		}
+	}
+	return max
+}
+
 // NewConnectedClient creates a new Elasticsearch client based on the given config.
 // It uses the NewElasticsearchClients to create a list of clients then returns
 // the first from the list that successfully connects.
@@ -233,16 +273,25 @@ func NewElasticsearchClients(cfg *common.Config) ([]Client, error) {
 			return nil, err
 		}
 
+		proxy, proxyDisable, err := proxyFor(esURL, proxyURL, &config)
+		if err != nil {
+			return nil, err
+		}
+
 		client, err := NewClient(ClientSettings{
-			URL:              esURL,
-			Proxy:            proxyURL,
-			TLS:              tlsConfig,
-			Username:         config.Username,
-			Password:         config.Password,
-			Parameters:       params,
-			Headers:          config.Headers,
-			Timeout:          config.Timeout,
-			CompressionLevel: config.CompressionLevel,
+			URL:               esURL,
+			Proxy:             proxy,
+			ProxyDisable:      proxyDisable,
+			ProxyLocalResolve: config.ProxyLocalResolve,
+			TLS:               tlsConfig,
+			Kerberos:          config.Kerberos,
+			Username:          config.Username,
+			Password:          config.Password,
+			Parameters:        params,
+			Headers:           config.Headers,
+			Timeout:           config.Timeout,
+			CompressionLevel:  config.CompressionLevel,
+			ConnectionPool:    config.ConnectionPool.settings(1),
 		}, nil)
 		if err != nil {
 			return clients, err
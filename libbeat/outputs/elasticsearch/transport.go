@@ -0,0 +1,139 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package elasticsearch
+
+import (
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+
+	"golang.org/x/net/http2"
+
+	"github.com/elastic/beats/libbeat/outputs/transport"
+)
+
+// ConnectionPoolSettings configures the HTTP transport of a client. Clients
+// sharing a transport share its pool of connections.
+type ConnectionPoolSettings struct {
+	MaxIdleConnsPerHost int
+	IdleConnTimeout     time.Duration
+	KeepAlive           time.Duration
+	HTTP2               bool
+}
+
+// newTransport creates the HTTP transport used to connect to the
+// Elasticsearch host in the client settings.
+func newTransport(s ClientSettings) (*http.Transport, error) {
+	var err error
+	var dialer, tlsDialer transport.Dialer
+
+	dialer = transport.NetDialer(s.Timeout)
+	if s.ConnectionPool.KeepAlive > 0 {
+		dialer = keepAliveDialer(dialer, s.ConnectionPool.KeepAlive)
+	}
+
+	// HTTP proxies are configured with the HTTP transport, SOCKS5 proxies
+	// are used when dialing.
+	var proxy func(*http.Request) (*url.URL, error)
+	switch {
+	case s.ProxyDisable:
+	case s.Proxy == nil:
+		proxy = http.ProxyFromEnvironment
+	case s.Proxy.Scheme == "socks5":
+		dialer, err = transport.ProxyDialer(&transport.ProxyConfig{
+			URL:          s.Proxy.String(),
+			LocalResolve: s.ProxyLocalResolve,
+		}, dialer)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		proxy = http.ProxyURL(s.Proxy)
+	}
+
+	if s.ConnectionPool.HTTP2 {
+		// HTTP/2 is negotiated via ALPN and only used if the TLS dialer
+		// returns a *tls.Conn, so the stats are collected below TLS.
+		if st := s.Observer; st != nil {
+			dialer = transport.StatsDialer(dialer, st)
+		}
+		tlsDialer, err = transport.TLSDialer(dialer, withALPN(s.TLS), s.Timeout)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		tlsDialer, err = transport.TLSDialer(dialer, s.TLS, s.Timeout)
+		if err != nil {
+			return nil, err
+		}
+
+		if st := s.Observer; st != nil {
+			dialer = transport.StatsDialer(dialer, st)
+			tlsDialer = transport.StatsDialer(tlsDialer, st)
+		}
+	}
+
+	t := &http.Transport{
+		Dial:                dialer.Dial,
+		DialTLS:             tlsDialer.Dial,
+		Proxy:               proxy,
+		MaxIdleConnsPerHost: s.ConnectionPool.MaxIdleConnsPerHost,
+		IdleConnTimeout:     s.ConnectionPool.IdleConnTimeout,
+	}
+	if s.ConnectionPool.HTTP2 {
+		if err := http2.ConfigureTransport(t); err != nil {
+			return nil, err
+		}
+	}
+	return t, nil
+}
+
+// withALPN returns a copy of the TLS settings offering HTTP/2 during the
+// handshake, falling back to HTTP/1.1.
+func withALPN(tls *transport.TLSConfig) *transport.TLSConfig {
+	var c transport.TLSConfig
+	if tls != nil {
+		c = *tls
+	}
+	c.NextProtos = []string{http2.NextProtoTLS, "http/1.1"}
+	return &c
+}
+
+// keepAliveDialer enables TCP keep-alives with the given period on all
+// connections created by the forward dialer.
+func keepAliveDialer(forward transport.Dialer, period time.Duration) transport.Dialer {
+	return transport.DialerFunc(func(network, address string) (net.Conn, error) {
+		conn, err := forward.Dial(network, address)
+		if err != nil {
+			return nil, err
+		}
+
+		if tcp, ok := conn.(*net.TCPConn); ok {
+			if err := tcp.SetKeepAlive(true); err != nil {
+				conn.Close()
+				return nil, err
+			}
+			if err := tcp.SetKeepAlivePeriod(period); err != nil {
+				conn.Close()
+				return nil, err
+			}
+		}
+		return conn, nil
+	})
+}
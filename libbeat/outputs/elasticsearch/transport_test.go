@@ -0,0 +1,111 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package elasticsearch
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/libbeat/outputs/transport"
+)
+
+func TestHostCount(t *testing.T) {
+	assert.Equal(t, 2, hostCount([]string{"a", "a", "b", "b"}))
+	assert.Equal(t, 1, hostCount([]string{"a"}))
+}
+
+func TestConnectionPoolSettings(t *testing.T) {
+	pool := connectionPool{KeepAlive: 30 * time.Second}
+	settings := pool.settings(4)
+	assert.Equal(t, 4, settings.MaxIdleConnsPerHost)
+	assert.Equal(t, 30*time.Second, settings.KeepAlive)
+
+	pool.MaxIdleConnsPerHost = 10
+	assert.Equal(t, 10, pool.settings(4).MaxIdleConnsPerHost)
+}
+
+func TestNewTransport(t *testing.T) {
+	var proto int
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proto = r.ProtoMajor
+	})
+
+	tests := map[string]struct {
+		http2 bool
+		proto int
+	}{
+		"http/1.1": {http2: false, proto: 1},
+		"http/2":   {http2: true, proto: 2},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			ts := httptest.NewUnstartedServer(handler)
+			ts.EnableHTTP2 = true
+			ts.StartTLS()
+			defer ts.Close()
+
+			rt, err := newTransport(ClientSettings{
+				TLS:          &transport.TLSConfig{Verification: transport.VerifyNone},
+				ProxyDisable: true,
+				Timeout:      10 * time.Second,
+				ConnectionPool: ConnectionPoolSettings{
+					MaxIdleConnsPerHost: 3,
+					IdleConnTimeout:     time.Minute,
+					KeepAlive:           time.Second,
+					HTTP2:               test.http2,
+				},
+			})
+			require.NoError(t, err)
+			assert.Equal(t, 3, rt.MaxIdleConnsPerHost)
+			assert.Equal(t, time.Minute, rt.IdleConnTimeout)
+
+			resp, err := (&http.Client{Transport: rt}).Get(ts.URL)
+			require.NoError(t, err)
+			resp.Body.Close()
+			assert.Equal(t, test.proto, proto)
+		})
+	}
+}
+
+func TestClientsShareTransport(t *testing.T) {
+	requests := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+	}))
+	defer ts.Close()
+
+	settings := ClientSettings{URL: ts.URL, ProxyDisable: true}
+	rt, err := newTransport(settings)
+	require.NoError(t, err)
+	settings.Transport = rt
+
+	for i := 0; i < 2; i++ {
+		client, err := NewClient(settings, nil)
+		require.NoError(t, err)
+		assert.Equal(t, rt, client.http.Transport)
+
+		client.Ping()
+	}
+	assert.Equal(t, 2, requests)
+}
@@ -19,6 +19,7 @@ package elasticsearch
 
 import (
 	"fmt"
+	"net"
 	"net/url"
 	"strings"
 )
@@ -81,7 +82,7 @@ func parseProxyURL(raw string) (*url.URL, error) {
 	}
 
 	url, err := url.Parse(raw)
-	if err == nil && strings.HasPrefix(url.Scheme, "http") {
+	if err == nil && (strings.HasPrefix(url.Scheme, "http") || url.Scheme == "socks5") {
 		return url, err
 	}
 
@@ -89,3 +90,58 @@ func parseProxyURL(raw string) (*url.URL, error) {
 	// see if that parses correctly.
 	return url.Parse("http://" + raw)
 }
+
+// proxyFor returns the proxy to use to connect to the Elasticsearch URL, and
+// if no proxy must be used at all. A proxy configured for the host in
+// proxy_hosts takes precedence over proxy_url and proxy_bypass.
+func proxyFor(esURL string, proxyURL *url.URL, config *elasticsearchConfig) (*url.URL, bool, error) {
+	for _, hp := range config.ProxyHosts {
+		if matchHost(esURL, hp.Hosts) {
+			proxy, err := parseProxyURL(hp.URL)
+			return proxy, false, err
+		}
+	}
+	return proxyURL, matchHost(esURL, config.ProxyBypass), nil
+}
+
+// matchHost checks if the host of the Elasticsearch URL matches one of the
+// patterns. Patterns can be host names, domain names starting with '.' or
+// '*.', IP addresses or CIDR ranges. A pattern can include a port.
+func matchHost(rawURL string, patterns []string) bool {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return false
+	}
+
+	host, port := strings.ToLower(u.Hostname()), u.Port()
+	ip := net.ParseIP(host)
+	for _, pattern := range patterns {
+		pattern = strings.ToLower(pattern)
+		if h, p, err := net.SplitHostPort(pattern); err == nil {
+			if p != port {
+				continue
+			}
+			pattern = h
+		}
+
+		if _, ipNet, err := net.ParseCIDR(pattern); err == nil {
+			if ip != nil && ipNet.Contains(ip) {
+				return true
+			}
+			continue
+		}
+
+		switch {
+		case strings.HasPrefix(pattern, "*."):
+			pattern = pattern[1:]
+			fallthrough
+		case strings.HasPrefix(pattern, "."):
+			if strings.HasSuffix(host, pattern) || host == pattern[1:] {
+				return true
+			}
+		case pattern == host:
+			return true
+		}
+	}
+	return false
+}
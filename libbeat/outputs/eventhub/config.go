@@ -0,0 +1,140 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package eventhub
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/elastic/beats/libbeat/common"
+)
+
+type eventhubConfig struct {
+	ConnectionString string         `config:"connection_string" validate:"required"`
+	EventHub         string         `config:"event_hub"`
+	PartitionKey     string         `config:"partition_key"`
+	TLS              *common.Config `config:"ssl"`
+	Timeout          time.Duration  `config:"timeout"           validate:"min=1"`
+	BulkMaxSize      int            `config:"bulk_max_size"     validate:"min=1"`
+	MaxRetries       int            `config:"max_retries"       validate:"min=-1,nonzero"`
+	MaxMessageBytes  int            `config:"max_message_bytes" validate:"min=1,max=1048576"`
+	Compression      string         `config:"compression"`
+	ClientID         string         `config:"client_id"`
+	Codec            *common.Config `config:"codec"`
+}
+
+// connectionString holds the parts of an Event Hubs connection string used to
+// connect to the Kafka endpoint of the namespace.
+type connectionString struct {
+	raw        string
+	namespace  string
+	keyName    string
+	entityPath string
+}
+
+const (
+	// kafkaPort is the port of the Kafka endpoint of Event Hubs namespaces.
+	kafkaPort = 9093
+
+	// saslUser is the SASL PLAIN user name used to authenticate with a
+	// connection string, which is passed as password.
+	saslUser = "$ConnectionString"
+)
+
+var defaultConfig = eventhubConfig{
+	Timeout:         30 * time.Second,
+	BulkMaxSize:     2048,
+	MaxRetries:      3,
+	MaxMessageBytes: 1048576,
+	Compression:     "none",
+	ClientID:        "beats",
+}
+
+func (c *eventhubConfig) Validate() error {
+	cs, err := parseConnectionString(c.ConnectionString)
+	if err != nil {
+		return err
+	}
+	if c.EventHub == "" && cs.entityPath == "" {
+		return errors.New("event_hub must be set if the connection string has no EntityPath")
+	}
+	if c.EventHub != "" && cs.entityPath != "" && c.EventHub != cs.entityPath {
+		return fmt.Errorf("event_hub '%v' does not match the EntityPath '%v' of the connection string",
+			c.EventHub, cs.entityPath)
+	}
+	// Event Hubs does not support the other compression codecs of Kafka.
+	switch strings.ToLower(c.Compression) {
+	case "none", "gzip":
+	default:
+		return fmt.Errorf("compression '%v' not supported by Event Hubs", c.Compression)
+	}
+	return nil
+}
+
+// parseConnectionString parses connection strings of the form
+// `Endpoint=sb://<namespace>.servicebus.windows.net/;SharedAccessKeyName=<name>;SharedAccessKey=<key>[;EntityPath=<event hub>]`.
+func parseConnectionString(s string) (connectionString, error) {
+	cs := connectionString{raw: s}
+
+	var endpoint, key string
+	for _, part := range strings.Split(s, ";") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		kv := strings.SplitN(part, "=", 2)
+		if len(kv) != 2 {
+			return cs, fmt.Errorf("invalid connection string element '%v'", kv[0])
+		}
+		switch strings.ToLower(kv[0]) {
+		case "endpoint":
+			endpoint = kv[1]
+		case "sharedaccesskeyname":
+			cs.keyName = kv[1]
+		case "sharedaccesskey":
+			key = kv[1]
+		case "entitypath":
+			cs.entityPath = kv[1]
+		}
+	}
+
+	if endpoint == "" {
+		return cs, errors.New("connection string has no Endpoint")
+	}
+	if cs.keyName == "" || key == "" {
+		return cs, errors.New("connection string has no SharedAccessKeyName or SharedAccessKey")
+	}
+
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return cs, fmt.Errorf("invalid connection string Endpoint: %v", err)
+	}
+	if u.Scheme != "sb" || u.Hostname() == "" {
+		return cs, fmt.Errorf("invalid connection string Endpoint '%v'", endpoint)
+	}
+	cs.namespace = u.Hostname()
+	return cs, nil
+}
+
+// kafkaHost returns the address of the Kafka endpoint of the namespace.
+func (cs connectionString) kafkaHost() string {
+	return fmt.Sprintf("%v:%d", cs.namespace, kafkaPort)
+}
@@ -0,0 +1,108 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package eventhub
+
+import (
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/logp"
+	"github.com/elastic/beats/libbeat/outputs"
+
+	// The Event Hubs output publishes through the Kafka output.
+	_ "github.com/elastic/beats/libbeat/outputs/kafka"
+)
+
+var debugf = logp.MakeDebug("eventhub")
+
+func init() {
+	outputs.RegisterType("eventhub", makeEventHub)
+}
+
+// makeEventHub creates an output publishing to the Kafka endpoint of an
+// Event Hubs namespace. Event hubs are exposed as Kafka topics, and clients
+// authenticate with SASL PLAIN using the connection string as password.
+func makeEventHub(
+	beat beat.Info,
+	observer outputs.Observer,
+	cfg *common.Config,
+) (outputs.Group, error) {
+	debugf("initialize eventhub output")
+
+	config := defaultConfig
+	if err := cfg.Unpack(&config); err != nil {
+		return outputs.Fail(err)
+	}
+
+	kafkaCfg, err := makeKafkaConfig(&config)
+	if err != nil {
+		return outputs.Fail(err)
+	}
+	return outputs.Load(beat, observer, "kafka", kafkaCfg)
+}
+
+// makeKafkaConfig translates the Event Hubs settings into the configuration
+// of the Kafka output.
+func makeKafkaConfig(config *eventhubConfig) (*common.Config, error) {
+	cs, err := parseConnectionString(config.ConnectionString)
+	if err != nil {
+		return nil, err
+	}
+
+	hub := config.EventHub
+	if hub == "" {
+		hub = cs.entityPath
+	}
+
+	settings := map[string]interface{}{
+		"hosts":             []string{cs.kafkaHost()},
+		"topic":             hub,
+		"username":          saslUser,
+		"password":          cs.raw,
+		"version":           "1.0.0",
+		"timeout":           config.Timeout.String(),
+		"bulk_max_size":     config.BulkMaxSize,
+		"max_retries":       config.MaxRetries,
+		"max_message_bytes": config.MaxMessageBytes,
+		"compression":       config.Compression,
+		"client_id":         config.ClientID,
+	}
+	if config.PartitionKey != "" {
+		// Events with the same key are sent to the same partition.
+		settings["key"] = config.PartitionKey
+	}
+
+	kafkaCfg, err := common.NewConfigFrom(settings)
+	if err != nil {
+		return nil, err
+	}
+
+	// Event Hubs requires TLS, so it is enabled even if not configured.
+	tls := config.TLS
+	if tls == nil {
+		tls = common.NewConfig()
+	}
+	if err := kafkaCfg.SetChild("ssl", -1, tls); err != nil {
+		return nil, err
+	}
+	if config.Codec != nil {
+		if err := kafkaCfg.SetChild("codec", -1, config.Codec); err != nil {
+			return nil, err
+		}
+	}
+	return kafkaCfg, nil
+}
@@ -0,0 +1,162 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package eventhub
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/outputs"
+	_ "github.com/elastic/beats/libbeat/outputs/codec/json"
+)
+
+const testConnectionString = "Endpoint=sb://beats.servicebus.windows.net/;" +
+	"SharedAccessKeyName=send;SharedAccessKey=c2VjcmV0"
+
+func TestParseConnectionString(t *testing.T) {
+	cs, err := parseConnectionString(testConnectionString + ";EntityPath=logs")
+	require.NoError(t, err)
+	assert.Equal(t, "beats.servicebus.windows.net", cs.namespace)
+	assert.Equal(t, "send", cs.keyName)
+	assert.Equal(t, "logs", cs.entityPath)
+	assert.Equal(t, "beats.servicebus.windows.net:9093", cs.kafkaHost())
+}
+
+func TestParseConnectionStringInvalid(t *testing.T) {
+	tests := map[string]string{
+		"no endpoint":  "SharedAccessKeyName=send;SharedAccessKey=c2VjcmV0",
+		"no key":       "Endpoint=sb://beats.servicebus.windows.net/;SharedAccessKeyName=send",
+		"bad scheme":   "Endpoint=https://beats.servicebus.windows.net/;SharedAccessKeyName=send;SharedAccessKey=c2VjcmV0",
+		"bad element":  "Endpoint=sb://beats.servicebus.windows.net/;send",
+		"empty string": "",
+	}
+
+	for name, s := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := parseConnectionString(s)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestConfigValidate(t *testing.T) {
+	tests := map[string]struct {
+		settings map[string]interface{}
+		valid    bool
+	}{
+		"event hub": {
+			settings: map[string]interface{}{
+				"connection_string": testConnectionString,
+				"event_hub":         "logs",
+			},
+			valid: true,
+		},
+		"entity path": {
+			settings: map[string]interface{}{
+				"connection_string": testConnectionString + ";EntityPath=logs",
+			},
+			valid: true,
+		},
+		"no event hub": {
+			settings: map[string]interface{}{
+				"connection_string": testConnectionString,
+			},
+		},
+		"event hub mismatch": {
+			settings: map[string]interface{}{
+				"connection_string": testConnectionString + ";EntityPath=logs",
+				"event_hub":         "metrics",
+			},
+		},
+		"unsupported compression": {
+			settings: map[string]interface{}{
+				"connection_string": testConnectionString,
+				"event_hub":         "logs",
+				"compression":       "snappy",
+			},
+		},
+		"message too large": {
+			settings: map[string]interface{}{
+				"connection_string": testConnectionString,
+				"event_hub":         "logs",
+				"max_message_bytes": 2 * 1024 * 1024,
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			config := defaultConfig
+			err := common.MustNewConfigFrom(test.settings).Unpack(&config)
+			if test.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestMakeKafkaConfig(t *testing.T) {
+	config := defaultConfig
+	err := common.MustNewConfigFrom(map[string]interface{}{
+		"connection_string":     testConnectionString + ";EntityPath=logs",
+		"partition_key":         "%{[host.name]}",
+		"ssl.verification_mode": "full",
+	}).Unpack(&config)
+	require.NoError(t, err)
+
+	kafkaCfg, err := makeKafkaConfig(&config)
+	require.NoError(t, err)
+
+	var settings struct {
+		Hosts    []string `config:"hosts"`
+		Topic    string   `config:"topic"`
+		Key      string   `config:"key"`
+		Username string   `config:"username"`
+		Password string   `config:"password"`
+		SSL      struct {
+			VerificationMode string `config:"verification_mode"`
+		} `config:"ssl"`
+	}
+	require.NoError(t, kafkaCfg.Unpack(&settings))
+
+	assert.Equal(t, []string{"beats.servicebus.windows.net:9093"}, settings.Hosts)
+	assert.Equal(t, "logs", settings.Topic)
+	assert.Equal(t, "%{[host.name]}", settings.Key)
+	assert.Equal(t, "$ConnectionString", settings.Username)
+	assert.Equal(t, testConnectionString+";EntityPath=logs", settings.Password)
+	assert.Equal(t, "full", settings.SSL.VerificationMode)
+}
+
+func TestMakeEventHub(t *testing.T) {
+	cfg := common.MustNewConfigFrom(map[string]interface{}{
+		"connection_string": testConnectionString,
+		"event_hub":         "logs",
+		"bulk_max_size":     100,
+	})
+
+	group, err := makeEventHub(beat.Info{Beat: "libbeat"}, outputs.NewNilObserver(), cfg)
+	require.NoError(t, err)
+	assert.Len(t, group.Clients, 1)
+	assert.Equal(t, 100, group.BatchSize)
+}
@@ -150,27 +150,21 @@ func (c *client) getEventMessage(data *publisher.Event) (*message, error) {
 				msg.partition = partition
 			}
 		}
+	}
 
-		if value, ok := event.Meta["topic"]; ok {
-			if topic, ok := value.(string); ok {
-				msg.topic = topic
-			}
-		}
+	// The topic selector uses @metadata.topic if set by the event and allowed.
+	topic, err := c.topic.Select(event)
+	if err != nil {
+		return nil, fmt.Errorf("setting kafka topic failed with %v", err)
 	}
-	if msg.topic == "" {
-		topic, err := c.topic.Select(event)
-		if err != nil {
-			return nil, fmt.Errorf("setting kafka topic failed with %v", err)
-		}
-		if topic == "" {
-			return nil, errNoTopicsSelected
-		}
-		msg.topic = topic
-		if event.Meta == nil {
-			event.Meta = map[string]interface{}{}
-		}
-		event.Meta["topic"] = topic
+	if topic == "" {
+		return nil, errNoTopicsSelected
+	}
+	msg.topic = topic
+	if event.Meta == nil {
+		event.Meta = map[string]interface{}{}
 	}
+	event.Meta["topic"] = topic
 
 	serializedEvent, err := c.codec.Encode(c.index, event)
 	if err != nil {
@@ -33,6 +33,7 @@ import (
 	"github.com/elastic/beats/libbeat/monitoring/adapter"
 	"github.com/elastic/beats/libbeat/outputs"
 	"github.com/elastic/beats/libbeat/outputs/codec"
+	"github.com/elastic/beats/libbeat/outputs/outil"
 )
 
 type kafkaConfig struct {
@@ -56,6 +57,7 @@ type kafkaConfig struct {
 	Username         string                    `config:"username"`
 	Password         string                    `config:"password"`
 	Codec            codec.Config              `config:"codec"`
+	AllowedTopics    outil.AllowList           `config:"allowed_topics"`
 }
 
 type metaConfig struct {
@@ -97,6 +97,7 @@ func makeKafka(
 	if err != nil {
 		return outputs.Fail(err)
 	}
+	topic = topic.WithMetadata("topic", config.AllowedTopics)
 
 	libCfg, err := newSaramaConfig(&config)
 	if err != nil {
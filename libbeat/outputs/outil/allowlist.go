@@ -0,0 +1,103 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package outil
+
+import (
+	"path"
+
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/logp"
+)
+
+// AllowList restricts the values events can set in @metadata to overwrite
+// output settings, like the index or topic events are published to. Entries
+// are shell patterns (e.g. `logs-*`). An empty list allows any value.
+type AllowList []string
+
+// Validate checks all patterns are well formed.
+func (l AllowList) Validate() error {
+	for _, pattern := range l {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return errors.Wrapf(err, "invalid pattern '%v'", pattern)
+		}
+	}
+	return nil
+}
+
+// Allowed returns true if the value matches any of the patterns, or if the
+// list is empty.
+func (l AllowList) Allowed(value string) bool {
+	if len(l) == 0 {
+		return true
+	}
+	for _, pattern := range l {
+		if matched, _ := path.Match(pattern, value); matched {
+			return true
+		}
+	}
+	return false
+}
+
+// MetadataString returns the string value of the given @metadata key of the
+// event, if set and allowed by the allow list. Values not allowed are
+// ignored, so the output falls back to its configured setting.
+func MetadataString(event *beat.Event, key string, allowed AllowList) (string, bool) {
+	if event.Meta == nil {
+		return "", false
+	}
+
+	v, exists := event.Meta[key]
+	if !exists {
+		return "", false
+	}
+	s, ok := v.(string)
+	if !ok || s == "" {
+		return "", false
+	}
+
+	if !allowed.Allowed(s) {
+		logp.Debug("publish", "Ignoring @metadata.%v value '%v' not allowed by the output", key, s)
+		return "", false
+	}
+	return s, true
+}
+
+type metadataSelector struct {
+	key      string
+	allowed  AllowList
+	fallback SelectorExpr
+}
+
+// WithMetadata returns a selector, that uses the value of the given @metadata
+// key of the event if set and allowed, and the selector s otherwise.
+func (s Selector) WithMetadata(key string, allowed AllowList) Selector {
+	fallback := s.sel
+	if fallback == nil {
+		fallback = nilSelector
+	}
+	return Selector{sel: &metadataSelector{key: key, allowed: allowed, fallback: fallback}}
+}
+
+func (s *metadataSelector) sel(evt *beat.Event) (string, error) {
+	if v, ok := MetadataString(evt, s.key, s.allowed); ok {
+		return v, nil
+	}
+	return s.fallback.sel(evt)
+}
@@ -0,0 +1,76 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package outil
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+)
+
+func TestAllowList(t *testing.T) {
+	var empty AllowList
+	assert.True(t, empty.Allowed("anything"))
+
+	list := AllowList{"logs-*", "metrics"}
+	assert.NoError(t, list.Validate())
+	assert.True(t, list.Allowed("logs-nginx"))
+	assert.True(t, list.Allowed("metrics"))
+	assert.False(t, list.Allowed("metrics-system"))
+	assert.False(t, list.Allowed("audit"))
+
+	assert.Error(t, AllowList{"logs-["}.Validate())
+}
+
+func TestMetadataString(t *testing.T) {
+	event := &beat.Event{Meta: common.MapStr{"topic": "logs-nginx", "partition": 3}}
+
+	v, ok := MetadataString(event, "topic", nil)
+	assert.True(t, ok)
+	assert.Equal(t, "logs-nginx", v)
+
+	_, ok = MetadataString(event, "topic", AllowList{"metrics-*"})
+	assert.False(t, ok)
+
+	_, ok = MetadataString(event, "partition", nil)
+	assert.False(t, ok)
+
+	_, ok = MetadataString(&beat.Event{}, "topic", nil)
+	assert.False(t, ok)
+}
+
+func TestSelectorWithMetadata(t *testing.T) {
+	sel := MakeSelector(ConstSelectorExpr("default")).WithMetadata("key", AllowList{"logs-*"})
+	assert.False(t, sel.IsConst())
+
+	for _, test := range []struct {
+		meta     common.MapStr
+		expected string
+	}{
+		{nil, "default"},
+		{common.MapStr{"key": "logs-nginx"}, "logs-nginx"},
+		{common.MapStr{"key": "secrets"}, "default"},
+	} {
+		v, err := sel.Select(&beat.Event{Meta: test.meta, Fields: common.MapStr{}})
+		assert.NoError(t, err)
+		assert.Equal(t, test.expected, v)
+	}
+}
@@ -0,0 +1,303 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pubsub
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common/fmtstr"
+	"github.com/elastic/beats/libbeat/logp"
+	"github.com/elastic/beats/libbeat/outputs"
+	"github.com/elastic/beats/libbeat/outputs/codec"
+	"github.com/elastic/beats/libbeat/publisher"
+)
+
+type client struct {
+	observer outputs.Observer
+	index    string
+	codec    codec.Codec
+	http     *http.Client
+	tokens   tokenSource
+	url      string
+
+	orderingKey     *fmtstr.EventFormatString
+	attributes      map[string]*fmtstr.EventFormatString
+	maxRequestBytes int
+	flow            *flowController
+}
+
+// message is a Pub/Sub message as expected by the publish API.
+type message struct {
+	Data        string            `json:"data"`
+	Attributes  map[string]string `json:"attributes,omitempty"`
+	OrderingKey string            `json:"orderingKey,omitempty"`
+}
+
+type publishRequest struct {
+	Messages []message `json:"messages"`
+}
+
+type publishResponse struct {
+	MessageIDs []string `json:"messageIds"`
+}
+
+// Size of the JSON envelope of a publish request and of each message, used
+// to estimate the size of requests.
+const (
+	requestOverhead = len(`{"messages":[]}`)
+	messageOverhead = len(`{"data":"","attributes":{},"orderingKey":""},`)
+)
+
+func newClient(
+	observer outputs.Observer,
+	httpClient *http.Client,
+	tokens tokenSource,
+	index string,
+	codec codec.Codec,
+	flow *flowController,
+	config *pubsubConfig,
+) *client {
+	return &client{
+		observer: observer,
+		index:    index,
+		codec:    codec,
+		http:     httpClient,
+		tokens:   tokens,
+		url: fmt.Sprintf("%s/v1/projects/%s/topics/%s:publish",
+			strings.TrimRight(config.Endpoint, "/"), config.Project, config.Topic),
+		orderingKey:     config.OrderingKey,
+		attributes:      config.Attributes,
+		maxRequestBytes: config.MaxRequestBytes,
+		flow:            flow,
+	}
+}
+
+// Connect checks the credentials can be used to get an access token.
+func (c *client) Connect() error {
+	_, err := c.tokens.token(c.http)
+	return err
+}
+
+func (c *client) Close() error {
+	return nil
+}
+
+func (c *client) String() string {
+	return "pubsub(" + c.url + ")"
+}
+
+func (c *client) Publish(batch publisher.Batch) error {
+	events := batch.Events()
+	c.observer.NewBatch(len(events))
+
+	rest, err := c.publishEvents(events)
+	if len(rest) == 0 {
+		batch.ACK()
+	} else {
+		c.observer.Failed(len(rest))
+		batch.RetryEvents(rest)
+	}
+	return err
+}
+
+// publishEvents publishes the events, splitting them into multiple requests
+// if needed. It returns the events that need to be retried.
+func (c *client) publishEvents(data []publisher.Event) ([]publisher.Event, error) {
+	messages := make([]message, 0, len(data))
+	okEvents := data[:0]
+	for i := range data {
+		msg, err := c.makeMessage(&data[i].Content)
+		if err != nil {
+			logp.Err("Failed to create Pub/Sub message: %v", err)
+			continue
+		}
+		messages = append(messages, msg)
+		okEvents = append(okEvents, data[i])
+	}
+	c.observer.Dropped(len(data) - len(okEvents))
+
+	for len(messages) > 0 {
+		n := c.requestLength(messages)
+		done, err := c.publishMessages(messages[:n])
+		messages = messages[done:]
+		okEvents = okEvents[done:]
+		if err != nil {
+			logp.Err("Failed to publish events to Pub/Sub: %v", err)
+			return okEvents, err
+		}
+	}
+	return nil, nil
+}
+
+// publishMessages publishes the messages in a single request. If Pub/Sub
+// rejects a request with multiple messages, the request is split in halves
+// that are published on their own, so only the messages rejected on their own
+// are dropped. It returns the number of messages published or dropped before
+// a retryable error occurred.
+func (c *client) publishMessages(messages []message) (int, error) {
+	err := c.publish(messages)
+	switch {
+	case err == nil:
+		c.observer.Acked(len(messages))
+		return len(messages), nil
+	case isRetryable(err):
+		return 0, err
+	case len(messages) == 1:
+		logp.Err("Dropping event rejected by Pub/Sub: %v", err)
+		c.observer.Dropped(1)
+		return 1, nil
+	}
+
+	debugf("Splitting request of %v messages rejected by Pub/Sub: %v", len(messages), err)
+	half := len(messages) / 2
+	n, err := c.publishMessages(messages[:half])
+	if err != nil {
+		return n, err
+	}
+	m, err := c.publishMessages(messages[half:])
+	return n + m, err
+}
+
+// requestLength returns the number of messages fitting into the next request.
+// A request contains at least one message.
+func (c *client) requestLength(messages []message) int {
+	size := requestOverhead
+	for i, msg := range messages {
+		size += messageSize(msg)
+		if i > 0 && size > c.maxRequestBytes {
+			return i
+		}
+	}
+	return len(messages)
+}
+
+func messageSize(msg message) int {
+	size := messageOverhead + len(msg.Data) + len(msg.OrderingKey)
+	for k, v := range msg.Attributes {
+		size += len(k) + len(v) + 6
+	}
+	return size
+}
+
+func (c *client) makeMessage(event *beat.Event) (message, error) {
+	serialized, err := c.codec.Encode(c.index, event)
+	if err != nil {
+		return message{}, err
+	}
+
+	msg := message{Data: base64.StdEncoding.EncodeToString(serialized)}
+
+	if c.orderingKey != nil {
+		msg.OrderingKey, err = c.orderingKey.Run(event)
+		if err != nil {
+			return message{}, fmt.Errorf("failed to format ordering key: %v", err)
+		}
+	}
+
+	for name, format := range c.attributes {
+		value, err := format.Run(event)
+		if err != nil {
+			// attributes are optional, skip attributes missing in the event
+			continue
+		}
+		if msg.Attributes == nil {
+			msg.Attributes = map[string]string{}
+		}
+		msg.Attributes[name] = value
+	}
+	return msg, nil
+}
+
+type publishError struct {
+	status int
+	body   []byte
+}
+
+func (e *publishError) Error() string {
+	return fmt.Sprintf("%v %v: %s", e.status, http.StatusText(e.status), e.body)
+}
+
+// isRetryable returns false if Pub/Sub rejected the request, as retrying the
+// same messages would fail again.
+func isRetryable(err error) bool {
+	pubErr, ok := err.(*publishError)
+	if !ok {
+		return true
+	}
+	switch pubErr.status {
+	case http.StatusBadRequest, http.StatusRequestEntityTooLarge:
+		return false
+	}
+	return true
+}
+
+func (c *client) publish(messages []message) error {
+	body, err := json.Marshal(publishRequest{Messages: messages})
+	if err != nil {
+		return err
+	}
+
+	token, err := c.tokens.token(c.http)
+	if err != nil {
+		return err
+	}
+
+	c.flow.acquire(len(messages), len(body))
+	defer c.flow.release(len(messages), len(body))
+
+	req, err := http.NewRequest("POST", c.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	start := time.Now()
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return &publishError{status: resp.StatusCode, body: respBody}
+	}
+
+	var result publishResponse
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return fmt.Errorf("failed to parse publish response: %v", err)
+	}
+	if len(result.MessageIDs) != len(messages) {
+		return fmt.Errorf("expected %v message IDs, got %v", len(messages), len(result.MessageIDs))
+	}
+
+	debugf("Published %v messages in %v", len(messages), time.Since(start))
+	return nil
+}
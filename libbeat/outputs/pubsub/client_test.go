@@ -0,0 +1,282 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pubsub
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/outputs"
+	jsoncodec "github.com/elastic/beats/libbeat/outputs/codec/json"
+	"github.com/elastic/beats/libbeat/outputs/outest"
+)
+
+type staticTokenSource string
+
+func (s staticTokenSource) token(*http.Client) (string, error) {
+	return string(s), nil
+}
+
+type testServer struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	requests []publishRequest
+	accepted []message
+	status   int
+	reject   func(message) bool // messages rejected with 400 Bad Request
+}
+
+func newTestServer(t *testing.T) *testServer {
+	s := &testServer{status: http.StatusOK}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/projects/project/topics/topic:publish", r.URL.Path)
+		assert.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+
+		var req publishRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.requests = append(s.requests, req)
+		if s.status != http.StatusOK {
+			w.WriteHeader(s.status)
+			return
+		}
+		for _, msg := range req.Messages {
+			if s.reject != nil && s.reject(msg) {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+		}
+		s.accepted = append(s.accepted, req.Messages...)
+
+		var resp publishResponse
+		for i := range req.Messages {
+			resp.MessageIDs = append(resp.MessageIDs, fmt.Sprint(i))
+		}
+		json.NewEncoder(w).Encode(resp)
+	}))
+	return s
+}
+
+func newTestClient(t *testing.T, server *testServer, settings map[string]interface{}) *client {
+	cfg := common.MustNewConfigFrom(map[string]interface{}{
+		"project_id": "project",
+		"topic":      "topic",
+		"endpoint":   server.URL,
+	})
+	if settings != nil {
+		require.NoError(t, cfg.Merge(settings))
+	}
+
+	config := defaultConfig
+	require.NoError(t, cfg.Unpack(&config))
+
+	return newClient(outputs.NewNilObserver(), &http.Client{}, staticTokenSource("secret"),
+		"testbeat", jsoncodec.New(false, true, "6.3.0"), newFlowController(config.FlowControl), &config)
+}
+
+func testEvent(message string) beat.Event {
+	return beat.Event{
+		Timestamp: time.Now(),
+		Fields: common.MapStr{
+			"message": message,
+			"host":    common.MapStr{"name": "host-" + message},
+		},
+	}
+}
+
+func TestPublish(t *testing.T) {
+	server := newTestServer(t)
+	defer server.Close()
+
+	c := newTestClient(t, server, map[string]interface{}{
+		"ordering_key":    "%{[host.name]}",
+		"attributes.host": "%{[host.name]}",
+		"attributes.zone": "%{[cloud.zone]}",
+	})
+
+	batch := outest.NewBatch(testEvent("a"), testEvent("b"))
+	require.NoError(t, c.Publish(batch))
+
+	require.Len(t, batch.Signals, 1)
+	assert.Equal(t, outest.BatchACK, batch.Signals[0].Tag)
+
+	require.Len(t, server.requests, 1)
+	messages := server.requests[0].Messages
+	require.Len(t, messages, 2)
+
+	msg := messages[1]
+	assert.Equal(t, "host-b", msg.OrderingKey)
+	assert.Equal(t, map[string]string{"host": "host-b"}, msg.Attributes)
+
+	data, err := base64.StdEncoding.DecodeString(msg.Data)
+	require.NoError(t, err)
+	var event common.MapStr
+	require.NoError(t, json.Unmarshal(data, &event))
+	assert.Equal(t, "b", event["message"])
+}
+
+func TestPublishSplitsRequests(t *testing.T) {
+	server := newTestServer(t)
+	defer server.Close()
+
+	c := newTestClient(t, server, map[string]interface{}{
+		"max_request_bytes": 500,
+	})
+
+	batch := outest.NewBatch(testEvent("a"), testEvent("b"), testEvent("c"))
+	require.NoError(t, c.Publish(batch))
+
+	assert.Equal(t, outest.BatchACK, batch.Signals[0].Tag)
+	require.True(t, len(server.requests) > 1)
+
+	total := 0
+	for _, req := range server.requests {
+		total += len(req.Messages)
+	}
+	assert.Equal(t, 3, total)
+}
+
+func TestPublishSplitsRejectedRequests(t *testing.T) {
+	server := newTestServer(t)
+	defer server.Close()
+
+	c := newTestClient(t, server, nil)
+	server.reject = func(msg message) bool {
+		return messageText(t, msg) == "invalid"
+	}
+
+	batch := outest.NewBatch(testEvent("a"), testEvent("b"), testEvent("invalid"), testEvent("c"))
+	require.NoError(t, c.Publish(batch))
+
+	require.Len(t, batch.Signals, 1)
+	assert.Equal(t, outest.BatchACK, batch.Signals[0].Tag)
+
+	// only the rejected message is dropped, the other ones are published
+	// with the split requests
+	var published []string
+	for _, msg := range server.accepted {
+		published = append(published, messageText(t, msg))
+	}
+	assert.Equal(t, []string{"a", "b", "c"}, published)
+}
+
+func messageText(t *testing.T, msg message) string {
+	data, err := base64.StdEncoding.DecodeString(msg.Data)
+	require.NoError(t, err)
+	var event common.MapStr
+	require.NoError(t, json.Unmarshal(data, &event))
+	text, _ := event["message"].(string)
+	return text
+}
+
+func TestFlowController(t *testing.T) {
+	flow := newFlowController(flowControlConfig{MaxOutstandingMessages: 10})
+
+	flow.acquire(8, 100)
+	acquired := make(chan struct{})
+	go func() {
+		flow.acquire(5, 100)
+		close(acquired)
+	}()
+
+	select {
+	case <-acquired:
+		t.Fatal("messages acquired while exceeding the limit")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	flow.release(8, 100)
+	select {
+	case <-acquired:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timeout waiting for messages to be acquired")
+	}
+
+	// a request larger than the limit is published if nothing is outstanding
+	flow.release(5, 100)
+	flow.acquire(20, 100)
+
+	assert.Nil(t, newFlowController(flowControlConfig{}))
+}
+
+func TestPublishErrors(t *testing.T) {
+	tests := []struct {
+		status int
+		signal outest.BatchSignalTag
+	}{
+		{http.StatusServiceUnavailable, outest.BatchRetryEvents},
+		{http.StatusTooManyRequests, outest.BatchRetryEvents},
+		{http.StatusBadRequest, outest.BatchACK},
+	}
+
+	for _, test := range tests {
+		t.Run(http.StatusText(test.status), func(t *testing.T) {
+			server := newTestServer(t)
+			defer server.Close()
+			server.status = test.status
+
+			c := newTestClient(t, server, nil)
+			batch := outest.NewBatch(testEvent("a"))
+			c.Publish(batch)
+
+			require.Len(t, batch.Signals, 1)
+			assert.Equal(t, test.signal, batch.Signals[0].Tag)
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := map[string]struct {
+		settings map[string]interface{}
+		valid    bool
+	}{
+		"minimal":       {map[string]interface{}{"project_id": "p", "topic": "t"}, true},
+		"missing topic": {map[string]interface{}{"project_id": "p"}, false},
+		"ordering key with workers": {map[string]interface{}{
+			"project_id": "p", "topic": "t", "ordering_key": "%{[host.name]}", "worker": 2,
+		}, false},
+		"both credentials": {map[string]interface{}{
+			"project_id": "p", "topic": "t", "credentials_file": "f", "credentials_json": "{}",
+		}, false},
+		"bulk too large": {map[string]interface{}{
+			"project_id": "p", "topic": "t", "bulk_max_size": 2000,
+		}, false},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			config := defaultConfig
+			err := common.MustNewConfigFrom(test.settings).Unpack(&config)
+			assert.Equal(t, test.valid, err == nil, "%v", err)
+		})
+	}
+}
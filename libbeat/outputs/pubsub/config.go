@@ -0,0 +1,96 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pubsub
+
+import (
+	"errors"
+	"time"
+
+	"github.com/elastic/beats/libbeat/common/fmtstr"
+	"github.com/elastic/beats/libbeat/common/transport/tlscommon"
+	"github.com/elastic/beats/libbeat/outputs/codec"
+)
+
+type pubsubConfig struct {
+	Project         string                               `config:"project_id"        validate:"required"`
+	Topic           string                               `config:"topic"             validate:"required"`
+	Endpoint        string                               `config:"endpoint"`
+	CredentialsFile string                               `config:"credentials_file"`
+	CredentialsJSON string                               `config:"credentials_json"`
+	OrderingKey     *fmtstr.EventFormatString            `config:"ordering_key"`
+	Attributes      map[string]*fmtstr.EventFormatString `config:"attributes"`
+	BulkMaxSize     int                                  `config:"bulk_max_size"     validate:"min=1,max=1000"`
+	MaxRequestBytes int                                  `config:"max_request_bytes" validate:"min=1"`
+	Workers         int                                  `config:"worker"            validate:"min=1"`
+	FlowControl     flowControlConfig                    `config:"flow_control"`
+	MaxRetries      int                                  `config:"max_retries"`
+	Timeout         time.Duration                        `config:"timeout"           validate:"min=1"`
+	Backoff         backoff                              `config:"backoff"`
+	TLS             *tlscommon.Config                    `config:"ssl"`
+	Codec           codec.Config                         `config:"codec"`
+}
+
+// flowControlConfig limits the messages and bytes being published by all
+// workers at the same time. Zero means no limit.
+type flowControlConfig struct {
+	MaxOutstandingMessages int `config:"max_outstanding_messages" validate:"min=0"`
+	MaxOutstandingBytes    int `config:"max_outstanding_bytes"    validate:"min=0"`
+}
+
+type backoff struct {
+	Init time.Duration
+	Max  time.Duration
+}
+
+const (
+	defaultEndpoint = "https://pubsub.googleapis.com"
+
+	// maxRequestBytes is the maximum size of a publish request accepted by
+	// Pub/Sub.
+	maxRequestBytes = 10 * 1024 * 1024
+)
+
+var (
+	defaultConfig = pubsubConfig{
+		Endpoint:        defaultEndpoint,
+		BulkMaxSize:     1000,
+		MaxRequestBytes: maxRequestBytes,
+		Workers:         1,
+		MaxRetries:      3,
+		Timeout:         30 * time.Second,
+		Backoff: backoff{
+			Init: 1 * time.Second,
+			Max:  60 * time.Second,
+		},
+	}
+)
+
+func (c *pubsubConfig) Validate() error {
+	if c.CredentialsFile != "" && c.CredentialsJSON != "" {
+		return errors.New("credentials_file and credentials_json can not be used at the same time")
+	}
+	if c.MaxRequestBytes > maxRequestBytes {
+		return errors.New("max_request_bytes must not be larger than 10MB")
+	}
+	// Messages with the same ordering key must be published in order, which
+	// is not guaranteed with multiple workers.
+	if c.OrderingKey != nil && c.Workers > 1 {
+		return errors.New("ordering_key can only be used with a single worker")
+	}
+	return nil
+}
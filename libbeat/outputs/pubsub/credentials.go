@@ -0,0 +1,248 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pubsub
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	pubsubScope     = "https://www.googleapis.com/auth/pubsub"
+	defaultTokenURI = "https://oauth2.googleapis.com/token"
+
+	// tokens are refreshed this long before they expire
+	tokenExpiryDelta = time.Minute
+)
+
+// tokenSource returns OAuth2 access tokens used to authenticate requests.
+type tokenSource interface {
+	token(client *http.Client) (string, error)
+}
+
+type tokenResponse struct {
+	AccessToken string `json:"access_token"`
+	ExpiresIn   int64  `json:"expires_in"`
+}
+
+// cachingTokenSource caches the tokens fetched by fetch until they are about
+// to expire.
+type cachingTokenSource struct {
+	fetch func(client *http.Client) (*tokenResponse, error)
+
+	mu      sync.Mutex
+	current string
+	expiry  time.Time
+}
+
+func (s *cachingTokenSource) token(client *http.Client) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.current != "" && time.Now().Add(tokenExpiryDelta).Before(s.expiry) {
+		return s.current, nil
+	}
+
+	resp, err := s.fetch(client)
+	if err != nil {
+		return "", err
+	}
+	if resp.AccessToken == "" {
+		return "", errors.New("no access token in response")
+	}
+
+	s.current = resp.AccessToken
+	s.expiry = time.Now().Add(time.Duration(resp.ExpiresIn) * time.Second)
+	return s.current, nil
+}
+
+// serviceAccount contains the fields of a service account key file needed to
+// request access tokens.
+type serviceAccount struct {
+	Type        string `json:"type"`
+	ClientEmail string `json:"client_email"`
+	PrivateKey  string `json:"private_key"`
+	TokenURI    string `json:"token_uri"`
+}
+
+// newTokenSource creates the token source for the configured credentials.
+// If no credentials are configured, the file in GOOGLE_APPLICATION_CREDENTIALS
+// is used. Otherwise the tokens are requested from the metadata server, as
+// it is done on GCE and with GKE workload identity.
+func newTokenSource(config *pubsubConfig) (tokenSource, error) {
+	content := []byte(config.CredentialsJSON)
+
+	file := config.CredentialsFile
+	if file == "" && len(content) == 0 {
+		file = os.Getenv("GOOGLE_APPLICATION_CREDENTIALS")
+	}
+	if file != "" {
+		var err error
+		content, err = ioutil.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read credentials file: %v", err)
+		}
+	}
+
+	if len(content) == 0 {
+		return &cachingTokenSource{fetch: fetchMetadataToken}, nil
+	}
+	return newServiceAccountTokenSource(content)
+}
+
+func newServiceAccountTokenSource(content []byte) (tokenSource, error) {
+	var account serviceAccount
+	if err := json.Unmarshal(content, &account); err != nil {
+		return nil, fmt.Errorf("failed to parse credentials: %v", err)
+	}
+	if account.Type != "service_account" {
+		return nil, fmt.Errorf("unsupported credentials type '%v'", account.Type)
+	}
+	if account.TokenURI == "" {
+		account.TokenURI = defaultTokenURI
+	}
+
+	key, err := parsePrivateKey(account.PrivateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &cachingTokenSource{
+		fetch: func(client *http.Client) (*tokenResponse, error) {
+			assertion, err := signJWT(key, account.ClientEmail, account.TokenURI, time.Now())
+			if err != nil {
+				return nil, err
+			}
+
+			form := url.Values{
+				"grant_type": {"urn:ietf:params:oauth:grant-type:jwt-bearer"},
+				"assertion":  {assertion},
+			}
+			req, err := http.NewRequest("POST", account.TokenURI, strings.NewReader(form.Encode()))
+			if err != nil {
+				return nil, err
+			}
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			return doTokenRequest(client, req)
+		},
+	}, nil
+}
+
+func parsePrivateKey(s string) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode([]byte(s))
+	if block == nil {
+		return nil, errors.New("no private key found in credentials")
+	}
+
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse private key: %v", err)
+	}
+	key, ok := parsed.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("private key is not a RSA key")
+	}
+	return key, nil
+}
+
+// signJWT creates the RS256 signed assertion exchanged for an access token.
+func signJWT(key *rsa.PrivateKey, email, audience string, now time.Time) (string, error) {
+	header, err := json.Marshal(map[string]string{"alg": "RS256", "typ": "JWT"})
+	if err != nil {
+		return "", err
+	}
+	claims, err := json.Marshal(map[string]interface{}{
+		"iss":   email,
+		"scope": pubsubScope,
+		"aud":   audience,
+		"iat":   now.Unix(),
+		"exp":   now.Add(time.Hour).Unix(),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	enc := base64.RawURLEncoding
+	unsigned := enc.EncodeToString(header) + "." + enc.EncodeToString(claims)
+	hash := sha256.Sum256([]byte(unsigned))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, hash[:])
+	if err != nil {
+		return "", err
+	}
+	return unsigned + "." + enc.EncodeToString(signature), nil
+}
+
+// metadataHost returns the address of the metadata server, which can be
+// overwritten by GCE_METADATA_HOST.
+func metadataHost() string {
+	if host := os.Getenv("GCE_METADATA_HOST"); host != "" {
+		return host
+	}
+	return "metadata.google.internal"
+}
+
+func fetchMetadataToken(client *http.Client) (*tokenResponse, error) {
+	u := "http://" + metadataHost() + "/computeMetadata/v1/instance/service-accounts/default/token?scopes=" +
+		url.QueryEscape(pubsubScope)
+	req, err := http.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Metadata-Flavor", "Google")
+	return doTokenRequest(client, req)
+}
+
+func doTokenRequest(client *http.Client, req *http.Request) (*tokenResponse, error) {
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to request access token: %v", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to request access token: %v: %s", resp.Status, body)
+	}
+
+	var token tokenResponse
+	if err := json.Unmarshal(body, &token); err != nil {
+		return nil, fmt.Errorf("failed to parse access token response: %v", err)
+	}
+	return &token, nil
+}
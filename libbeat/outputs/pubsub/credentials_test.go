@@ -0,0 +1,116 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pubsub
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServiceAccountTokenSource(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 1024)
+	require.NoError(t, err)
+
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		require.NoError(t, r.ParseForm())
+		assert.Equal(t, "urn:ietf:params:oauth:grant-type:jwt-bearer", r.Form.Get("grant_type"))
+
+		// verify the signature and claims of the assertion
+		parts := strings.Split(r.Form.Get("assertion"), ".")
+		require.Len(t, parts, 3)
+		signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+		require.NoError(t, err)
+		hash := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+		assert.NoError(t, rsa.VerifyPKCS1v15(&key.PublicKey, crypto.SHA256, hash[:], signature))
+
+		raw, err := base64.RawURLEncoding.DecodeString(parts[1])
+		require.NoError(t, err)
+		var claims map[string]interface{}
+		require.NoError(t, json.Unmarshal(raw, &claims))
+		assert.Equal(t, "beats@project.iam.gserviceaccount.com", claims["iss"])
+		assert.Equal(t, pubsubScope, claims["scope"])
+
+		w.Write([]byte(`{"access_token": "secret", "expires_in": 3600}`))
+	}))
+	defer server.Close()
+
+	credentials, err := json.Marshal(serviceAccount{
+		Type:        "service_account",
+		ClientEmail: "beats@project.iam.gserviceaccount.com",
+		PrivateKey: string(pem.EncodeToMemory(&pem.Block{
+			Type:  "RSA PRIVATE KEY",
+			Bytes: x509.MarshalPKCS1PrivateKey(key),
+		})),
+		TokenURI: server.URL,
+	})
+	require.NoError(t, err)
+
+	source, err := newTokenSource(&pubsubConfig{CredentialsJSON: string(credentials)})
+	require.NoError(t, err)
+
+	for i := 0; i < 3; i++ {
+		token, err := source.token(http.DefaultClient)
+		require.NoError(t, err)
+		assert.Equal(t, "secret", token)
+	}
+	// the token is cached until it expires
+	assert.Equal(t, 1, requests)
+}
+
+func TestMetadataTokenSource(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Google", r.Header.Get("Metadata-Flavor"))
+		assert.Equal(t, "/computeMetadata/v1/instance/service-accounts/default/token", r.URL.Path)
+		w.Write([]byte(`{"access_token": "metadata-secret", "expires_in": 3600}`))
+	}))
+	defer server.Close()
+
+	os.Setenv("GCE_METADATA_HOST", strings.TrimPrefix(server.URL, "http://"))
+	defer os.Unsetenv("GCE_METADATA_HOST")
+
+	source, err := newTokenSource(&pubsubConfig{})
+	require.NoError(t, err)
+
+	token, err := source.token(http.DefaultClient)
+	require.NoError(t, err)
+	assert.Equal(t, "metadata-secret", token)
+}
+
+func TestInvalidCredentials(t *testing.T) {
+	_, err := newTokenSource(&pubsubConfig{CredentialsJSON: `{"type": "authorized_user"}`})
+	assert.Error(t, err)
+
+	_, err = newTokenSource(&pubsubConfig{CredentialsJSON: `{"type": "service_account", "private_key": "invalid"}`})
+	assert.Error(t, err)
+}
@@ -0,0 +1,80 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pubsub
+
+import "sync"
+
+// flowController limits the messages and bytes being published by all the
+// clients of the output at the same time.
+type flowController struct {
+	maxMessages int
+	maxBytes    int
+
+	mutex    sync.Mutex
+	cond     *sync.Cond
+	messages int
+	bytes    int
+}
+
+// newFlowController returns nil if no limit is configured.
+func newFlowController(config flowControlConfig) *flowController {
+	if config.MaxOutstandingMessages == 0 && config.MaxOutstandingBytes == 0 {
+		return nil
+	}
+
+	f := &flowController{
+		maxMessages: config.MaxOutstandingMessages,
+		maxBytes:    config.MaxOutstandingBytes,
+	}
+	f.cond = sync.NewCond(&f.mutex)
+	return f
+}
+
+// acquire blocks until the messages can be published without exceeding the
+// limits. A request exceeding the limits on its own is published once no
+// other request is outstanding.
+func (f *flowController) acquire(messages, bytes int) {
+	if f == nil {
+		return
+	}
+
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	for f.messages > 0 && f.exceeded(messages, bytes) {
+		f.cond.Wait()
+	}
+	f.messages += messages
+	f.bytes += bytes
+}
+
+func (f *flowController) release(messages, bytes int) {
+	if f == nil {
+		return
+	}
+
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.messages -= messages
+	f.bytes -= bytes
+	f.cond.Broadcast()
+}
+
+func (f *flowController) exceeded(messages, bytes int) bool {
+	return (f.maxMessages > 0 && f.messages+messages > f.maxMessages) ||
+		(f.maxBytes > 0 && f.bytes+bytes > f.maxBytes)
+}
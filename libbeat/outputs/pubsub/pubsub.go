@@ -0,0 +1,103 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pubsub
+
+import (
+	"net/http"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/common/transport/tlscommon"
+	"github.com/elastic/beats/libbeat/logp"
+	"github.com/elastic/beats/libbeat/outputs"
+	"github.com/elastic/beats/libbeat/outputs/codec"
+	"github.com/elastic/beats/libbeat/outputs/transport"
+)
+
+var debugf = logp.MakeDebug("pubsub")
+
+func init() {
+	outputs.RegisterType("pubsub", makePubSub)
+}
+
+func makePubSub(
+	beat beat.Info,
+	observer outputs.Observer,
+	cfg *common.Config,
+) (outputs.Group, error) {
+	config := defaultConfig
+	if err := cfg.Unpack(&config); err != nil {
+		return outputs.Fail(err)
+	}
+
+	tls, err := tlscommon.LoadTLSConfig(config.TLS)
+	if err != nil {
+		return outputs.Fail(err)
+	}
+
+	tokens, err := newTokenSource(&config)
+	if err != nil {
+		return outputs.Fail(err)
+	}
+
+	flow := newFlowController(config.FlowControl)
+	clients := make([]outputs.NetworkClient, config.Workers)
+	for i := range clients {
+		httpClient, err := newHTTPClient(&config, tls, observer)
+		if err != nil {
+			return outputs.Fail(err)
+		}
+
+		enc, err := codec.CreateEncoder(beat, config.Codec)
+		if err != nil {
+			return outputs.Fail(err)
+		}
+
+		var client outputs.NetworkClient
+		client = newClient(observer, httpClient, tokens, beat.Beat, enc, flow, &config)
+		clients[i] = outputs.WithBackoff(client, config.Backoff.Init, config.Backoff.Max)
+	}
+
+	return outputs.SuccessNet(true, config.BulkMaxSize, config.MaxRetries, clients)
+}
+
+func newHTTPClient(
+	config *pubsubConfig,
+	tls *tlscommon.TLSConfig,
+	observer outputs.Observer,
+) (*http.Client, error) {
+	dialer := transport.NetDialer(config.Timeout)
+	tlsDialer, err := transport.TLSDialer(dialer, tls, config.Timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	if st := observer; st != nil {
+		dialer = transport.StatsDialer(dialer, st)
+		tlsDialer = transport.StatsDialer(tlsDialer, st)
+	}
+
+	return &http.Client{
+		Transport: &http.Transport{
+			Dial:    dialer.Dial,
+			DialTLS: tlsDialer.Dial,
+			Proxy:   http.ProxyFromEnvironment,
+		},
+		Timeout: config.Timeout,
+	}, nil
+}
@@ -49,6 +49,7 @@ type client struct {
 	observer outputs.Observer
 	index    string
 	dataType redisDataType
+	stream   streamConfig
 	db       int
 	key      outil.Selector
 	password string
@@ -62,6 +63,7 @@ type redisDataType uint16
 const (
 	redisListType redisDataType = iota
 	redisChannelType
+	redisStreamType
 )
 
 func newClient(
@@ -69,7 +71,7 @@ func newClient(
 	observer outputs.Observer,
 	timeout time.Duration,
 	pass string,
-	db int, key outil.Selector, dt redisDataType,
+	db int, key outil.Selector, dt redisDataType, stream streamConfig,
 	index string, codec codec.Codec,
 ) *client {
 	return &client{
@@ -80,6 +82,7 @@ func newClient(
 		index:    index,
 		db:       db,
 		dataType: dt,
+		stream:   stream,
 		key:      key,
 		codec:    codec,
 	}
@@ -155,39 +158,48 @@ func (c *client) Publish(batch publisher.Batch) error {
 func (c *client) makePublish(
 	conn redis.Conn,
 ) (publishFn, error) {
-	if c.dataType == redisChannelType {
+	switch c.dataType {
+	case redisChannelType:
 		return c.makePublishPUBLISH(conn)
+	case redisStreamType:
+		return c.makePublishXADD(conn)
 	}
 	return c.makePublishRPUSH(conn)
 }
 
-func (c *client) makePublishRPUSH(conn redis.Conn) (publishFn, error) {
-	if !c.key.IsConst() {
-		// TODO: more clever bulk handling batching events with same key
-		return c.publishEventsPipeline(conn, "RPUSH"), nil
-	}
-
-	var major, minor int
-	var versionRaw [][]byte
-
+// redisVersion returns the major and minor version of the Redis server.
+func redisVersion(conn redis.Conn) (int, int, error) {
 	respRaw, err := conn.Do("INFO")
 	resp, err := redis.Bytes(respRaw, err)
 	if err != nil {
-		return nil, err
+		return 0, 0, err
 	}
 
-	versionRaw = versionRegex.FindSubmatch(resp)
+	versionRaw := versionRegex.FindSubmatch(resp)
 	if versionRaw == nil {
-		err = errors.New("unable to read redis_version")
-		return nil, err
+		return 0, 0, errors.New("unable to read redis_version")
 	}
 
-	major, err = strconv.Atoi(string(versionRaw[1]))
+	major, err := strconv.Atoi(string(versionRaw[1]))
 	if err != nil {
-		return nil, err
+		return 0, 0, err
 	}
 
-	minor, err = strconv.Atoi(string(versionRaw[2]))
+	minor, err := strconv.Atoi(string(versionRaw[2]))
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return major, minor, nil
+}
+
+func (c *client) makePublishRPUSH(conn redis.Conn) (publishFn, error) {
+	if !c.key.IsConst() {
+		// TODO: more clever bulk handling batching events with same key
+		return c.publishEventsPipeline(conn, "RPUSH"), nil
+	}
+
+	major, minor, err := redisVersion(conn)
 	if err != nil {
 		return nil, err
 	}
@@ -212,6 +224,38 @@ func (c *client) makePublishPUBLISH(conn redis.Conn) (publishFn, error) {
 	return c.publishEventsPipeline(conn, "PUBLISH"), nil
 }
 
+func (c *client) makePublishXADD(conn redis.Conn) (publishFn, error) {
+	// Streams have been introduced with Redis 5.0.
+	// See: https://redis.io/commands/xadd
+	major, _, err := redisVersion(conn)
+	if err != nil {
+		return nil, err
+	}
+	if major < 5 {
+		return nil, errors.New("redis streams require Redis 5.0 or newer")
+	}
+	return c.publishEventsPipeline(conn, "XADD"), nil
+}
+
+// commandArgs returns the arguments of the command publishing the event to
+// the key.
+func (c *client) commandArgs(key string, event interface{}) []interface{} {
+	if c.dataType != redisStreamType {
+		return []interface{}{key, event}
+	}
+
+	args := []interface{}{key}
+	if c.stream.MaxLen > 0 {
+		args = append(args, "MAXLEN")
+		if c.stream.Approximate {
+			args = append(args, "~")
+		}
+		args = append(args, c.stream.MaxLen)
+	}
+	// let Redis generate the entry ID
+	return append(args, "*", c.stream.Field, event)
+}
+
 func (c *client) publishEventsBulk(conn redis.Conn, command string) publishFn {
 	// XXX: requires key.IsConst() == true
 	dest, _ := c.key.Select(&beat.Event{Fields: common.MapStr{}})
@@ -259,7 +303,7 @@ func (c *client) publishEventsPipeline(conn redis.Conn, command string) publishF
 			}
 
 			data = append(data, okEvents[i])
-			if err := conn.Send(command, eventKey, serializedEvent); err != nil {
+			if err := conn.Send(command, c.commandArgs(eventKey, serializedEvent)...); err != nil {
 				logp.Err("Failed to execute %v: %v", command, err)
 				return okEvents, err
 			}
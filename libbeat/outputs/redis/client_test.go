@@ -0,0 +1,49 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package redis
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCommandArgs(t *testing.T) {
+	tests := []struct {
+		Name     string
+		DataType redisDataType
+		Stream   streamConfig
+		Expected []interface{}
+	}{
+		{"List", redisListType, defaultConfig.Stream, []interface{}{"key", "event"}},
+		{"Channel", redisChannelType, defaultConfig.Stream, []interface{}{"key", "event"}},
+		{"Stream", redisStreamType, defaultConfig.Stream,
+			[]interface{}{"key", "*", "event", "event"}},
+		{"Stream with approximate trimming", redisStreamType,
+			streamConfig{Field: "message", MaxLen: 1000, Approximate: true},
+			[]interface{}{"key", "MAXLEN", "~", 1000, "*", "message", "event"}},
+		{"Stream with exact trimming", redisStreamType,
+			streamConfig{Field: "message", MaxLen: 1000},
+			[]interface{}{"key", "MAXLEN", 1000, "*", "message", "event"}},
+	}
+
+	for _, test := range tests {
+		c := &client{dataType: test.DataType, stream: test.Stream}
+		assert.Equal(t, test.Expected, c.commandArgs("key", "event"), test.Name)
+	}
+}
@@ -23,6 +23,7 @@ import (
 
 	"github.com/elastic/beats/libbeat/common/transport/tlscommon"
 	"github.com/elastic/beats/libbeat/outputs/codec"
+	"github.com/elastic/beats/libbeat/outputs/outil"
 	"github.com/elastic/beats/libbeat/outputs/transport"
 )
 
@@ -40,6 +41,22 @@ type redisConfig struct {
 	Codec       codec.Config          `config:"codec"`
 	Db          int                   `config:"db"`
 	DataType    string                `config:"datatype"`
+	Stream      streamConfig          `config:"stream"`
+	AllowedKeys outil.AllowList       `config:"allowed_keys"`
+}
+
+// streamConfig configures the XADD command used with the stream data type.
+type streamConfig struct {
+	// Field is the name of the stream entry field holding the encoded event.
+	Field string `config:"field" validate:"required"`
+
+	// MaxLen trims the stream to about this number of entries. 0 disables
+	// trimming.
+	MaxLen int `config:"max_len" validate:"min=0"`
+
+	// Approximate enables the more efficient `~` trimming, which might keep
+	// a few more entries than MaxLen.
+	Approximate bool `config:"approximate"`
 }
 
 var (
@@ -52,12 +69,16 @@ var (
 		TLS:         nil,
 		Db:          0,
 		DataType:    "list",
+		Stream: streamConfig{
+			Field:       "event",
+			Approximate: true,
+		},
 	}
 )
 
 func (c *redisConfig) Validate() error {
 	switch c.DataType {
-	case "", "list", "channel":
+	case "", "list", "channel", "stream":
 	default:
 		return fmt.Errorf("redis data type %v not supported", c.DataType)
 	}
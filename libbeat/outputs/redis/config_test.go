@@ -37,6 +37,7 @@ func TestValidate(t *testing.T) {
 		{"Invalid Datatype", redisConfig{Key: "test", DataType: "something"}, false},
 		{"List Datatype", redisConfig{Key: "test", DataType: "list"}, true},
 		{"Channel Datatype", redisConfig{Key: "test", DataType: "channel"}, true},
+		{"Stream Datatype", redisConfig{Key: "test", DataType: "stream"}, true},
 	}
 
 	for _, test := range tests {
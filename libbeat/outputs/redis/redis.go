@@ -78,6 +78,8 @@ func makeRedis(
 		dataType = redisListType
 	case "channel":
 		dataType = redisChannelType
+	case "stream":
+		dataType = redisStreamType
 	default:
 		return outputs.Fail(errors.New("Bad Redis data type"))
 	}
@@ -91,6 +93,11 @@ func makeRedis(
 	if err != nil {
 		return outputs.Fail(err)
 	}
+	if len(config.AllowedKeys) > 0 {
+		// Publishing events to the keys set in @metadata.key requires the
+		// keys to be explicitly allowed, as it disables bulk publishing.
+		key = key.WithMetadata("key", config.AllowedKeys)
+	}
 
 	hosts, err := outputs.ReadHostList(cfg)
 	if err != nil {
@@ -122,7 +129,7 @@ func makeRedis(
 		}
 
 		clients[i] = newClient(conn, observer, config.Timeout,
-			config.Password, config.Db, key, dataType, config.Index, enc)
+			config.Password, config.Db, key, dataType, config.Stream, config.Index, enc)
 	}
 
 	return outputs.SuccessNet(config.LoadBalance, config.BulkMaxSize, config.MaxRetries, clients)
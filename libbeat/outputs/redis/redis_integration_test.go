@@ -129,6 +129,51 @@ func testPublishList(t *testing.T, cfg map[string]interface{}) {
 	}
 }
 
+func TestPublishStreamTCP(t *testing.T) {
+	key := "test_pubstream_tcp"
+	db := 0
+	redisConfig := map[string]interface{}{
+		"hosts":              []string{getRedisAddr()},
+		"key":                key,
+		"db":                 db,
+		"datatype":           "stream",
+		"stream.max_len":     100000,
+		"stream.approximate": false,
+		"timeout":            "5s",
+	}
+
+	conn, err := redis.Dial("tcp", getRedisAddr(), redis.DialDatabase(db))
+	if err != nil {
+		t.Fatalf("redis.Dial failed %v", err)
+	}
+
+	// delete old key if present
+	defer conn.Close()
+	conn.Do("DEL", key)
+
+	out := newRedisTestingOutput(t, redisConfig)
+	err = sendTestEvents(out, 10, 100)
+	assert.NoError(t, err)
+
+	entries, err := redis.Values(conn.Do("XRANGE", key, "-", "+"))
+	assert.NoError(t, err)
+	assert.Len(t, entries, 1000)
+
+	for i, entry := range entries {
+		// each entry is [id, [field, value]]
+		fields, err := redis.Values(entry.([]interface{})[1], nil)
+		assert.NoError(t, err)
+		assert.Equal(t, "event", string(fields[0].([]byte)))
+
+		raw := fields[1].([]byte)
+		evt := struct{ Message int }{}
+		err = json.Unmarshal(raw, &evt)
+		assert.NoError(t, err)
+		assert.Equal(t, i+1, evt.Message)
+		validateMeta(t, raw)
+	}
+}
+
 func TestPublishChannelTCP(t *testing.T) {
 	db := 0
 	key := "test_pubchan_tcp"
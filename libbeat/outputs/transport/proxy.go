@@ -18,7 +18,11 @@
 package transport
 
 import (
+	"bufio"
+	"encoding/base64"
+	"fmt"
 	"net"
+	"net/http"
 	"net/url"
 
 	"golang.org/x/net/proxy"
@@ -27,10 +31,11 @@ import (
 )
 
 // ProxyConfig holds the configuration information required to proxy
-// connections through a SOCKS5 proxy server.
+// connections through a SOCKS5 or HTTP proxy server.
 type ProxyConfig struct {
-	// URL of the SOCKS proxy. Scheme must be socks5. Username and password can be
-	// embedded in the URL.
+	// URL of the proxy. Scheme must be socks5 or http. Username and password
+	// can be embedded in the URL. Connections through HTTP proxies are
+	// established using the CONNECT method.
 	URL string `config:"proxy_url"`
 
 	// Resolve names locally instead of on the SOCKS server.
@@ -46,7 +51,7 @@ func (c *ProxyConfig) Validate() error {
 	if err != nil {
 		return err
 	}
-	if _, err := proxy.FromURL(url, nil); err != nil {
+	if _, err := proxyFromURL(url, nil); err != nil {
 		return err
 	}
 
@@ -63,7 +68,7 @@ func ProxyDialer(config *ProxyConfig, forward Dialer) (Dialer, error) {
 		return nil, err
 	}
 
-	if _, err := proxy.FromURL(url, nil); err != nil {
+	if _, err := proxyFromURL(url, nil); err != nil {
 		return nil, err
 	}
 
@@ -90,10 +95,84 @@ func ProxyDialer(config *ProxyConfig, forward Dialer) (Dialer, error) {
 			addresses = []string{host}
 		}
 
-		dialer, err := proxy.FromURL(url, forward)
+		dialer, err := proxyFromURL(url, forward)
 		if err != nil {
 			return nil, err
 		}
 		return DialWith(dialer, network, host, addresses, port)
 	}), nil
 }
+
+// proxyFromURL returns a dialer for the proxy URL. HTTP proxies are
+// supported in addition to the schemes supported by golang.org/x/net/proxy.
+func proxyFromURL(u *url.URL, forward proxy.Dialer) (proxy.Dialer, error) {
+	if u.Scheme == "http" {
+		return &httpConnectDialer{url: u, forward: forward}, nil
+	}
+	return proxy.FromURL(u, forward)
+}
+
+// httpConnectDialer establishes connections through an HTTP proxy by
+// tunneling them using the CONNECT method.
+type httpConnectDialer struct {
+	url     *url.URL
+	forward proxy.Dialer
+}
+
+func (d *httpConnectDialer) Dial(network, address string) (net.Conn, error) {
+	proxyAddr := d.url.Host
+	if d.url.Port() == "" {
+		proxyAddr = net.JoinHostPort(d.url.Hostname(), "80")
+	}
+
+	conn, err := d.forward.Dial("tcp", proxyAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	req := &http.Request{
+		Method: "CONNECT",
+		URL:    &url.URL{Opaque: address},
+		Host:   address,
+		Header: http.Header{},
+	}
+	if user := d.url.User; user != nil {
+		password, _ := user.Password()
+		credentials := base64.StdEncoding.EncodeToString([]byte(user.Username() + ":" + password))
+		req.Header.Set("Proxy-Authorization", "Basic "+credentials)
+	}
+
+	if err := req.Write(conn); err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	reader := bufio.NewReader(conn)
+	resp, err := http.ReadResponse(reader, req)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		conn.Close()
+		return nil, fmt.Errorf("proxy refused connection to %v: %v", address, resp.Status)
+	}
+
+	if reader.Buffered() > 0 {
+		return &bufferedConn{Conn: conn, reader: reader}, nil
+	}
+	return conn, nil
+}
+
+// bufferedConn is a connection with data already read from the connection
+// into a buffer.
+type bufferedConn struct {
+	net.Conn
+	reader *bufio.Reader
+}
+
+func (c *bufferedConn) Read(b []byte) (int, error) {
+	return c.reader.Read(b)
+}
@@ -0,0 +1,135 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package transport
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newHTTPProxy starts an HTTP proxy on localhost, tunneling CONNECT requests
+// to the requested address. Requests without the expected credentials are
+// rejected.
+func newHTTPProxy(t *testing.T, credentials string) net.Listener {
+	l, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go serveConnect(conn, credentials)
+		}
+	}()
+	return l
+}
+
+func serveConnect(conn net.Conn, credentials string) {
+	defer conn.Close()
+
+	req, err := http.ReadRequest(bufio.NewReader(conn))
+	if err != nil || req.Method != "CONNECT" {
+		fmt.Fprint(conn, "HTTP/1.1 400 Bad Request\r\n\r\n")
+		return
+	}
+
+	if credentials != "" && req.Header.Get("Proxy-Authorization") != "Basic "+credentials {
+		fmt.Fprint(conn, "HTTP/1.1 407 Proxy Authentication Required\r\n\r\n")
+		return
+	}
+
+	target, err := net.Dial("tcp", req.Host)
+	if err != nil {
+		fmt.Fprint(conn, "HTTP/1.1 502 Bad Gateway\r\n\r\n")
+		return
+	}
+	defer target.Close()
+
+	fmt.Fprint(conn, "HTTP/1.1 200 Connection established\r\n\r\n")
+	go io.Copy(target, conn)
+	io.Copy(conn, target)
+}
+
+// newEchoServer starts a TCP server on localhost, writing back all data received.
+func newEchoServer(t *testing.T) net.Listener {
+	l, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				io.Copy(conn, conn)
+			}()
+		}
+	}()
+	return l
+}
+
+func TestHTTPConnectProxyDialer(t *testing.T) {
+	echo := newEchoServer(t)
+	defer echo.Close()
+
+	// "user:secret" base64 encoded
+	proxy := newHTTPProxy(t, "dXNlcjpzZWNyZXQ=")
+	defer proxy.Close()
+
+	tests := map[string]bool{
+		fmt.Sprintf("http://user:secret@%s", proxy.Addr()): true,
+		fmt.Sprintf("http://user:wrong@%s", proxy.Addr()):  false,
+		fmt.Sprintf("http://%s", proxy.Addr()):             false,
+	}
+
+	for proxyURL, ok := range tests {
+		config := &ProxyConfig{URL: proxyURL, LocalResolve: true}
+		require.NoError(t, config.Validate())
+
+		dialer, err := ProxyDialer(config, NetDialer(time.Second))
+		require.NoError(t, err)
+
+		conn, err := dialer.Dial("tcp", echo.Addr().String())
+		if !ok {
+			assert.Error(t, err, proxyURL)
+			continue
+		}
+		require.NoError(t, err, proxyURL)
+
+		_, err = conn.Write([]byte("hello"))
+		require.NoError(t, err)
+
+		buf := make([]byte, 5)
+		_, err = io.ReadFull(conn, buf)
+		require.NoError(t, err)
+		assert.Equal(t, "hello", string(buf))
+		conn.Close()
+	}
+}
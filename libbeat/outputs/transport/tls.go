@@ -62,6 +62,7 @@ func TestTLSDialer(
 	var lastTLSConfig *tls.Config
 	var lastNetwork string
 	var lastAddress string
+	var lastGeneration uint64
 	var m sync.Mutex
 
 	return DialerFunc(func(network, address string) (net.Conn, error) {
@@ -76,15 +77,19 @@ func TestTLSDialer(
 			return nil, err
 		}
 
+		// rebuild the cached config if the certificates have been reloaded
+		generation := config.Generation()
+
 		var tlsConfig *tls.Config
 		m.Lock()
-		if network == lastNetwork && address == lastAddress {
+		if network == lastNetwork && address == lastAddress && generation == lastGeneration {
 			tlsConfig = lastTLSConfig
 		}
 		if tlsConfig == nil {
 			tlsConfig = config.BuildModuleConfig(host)
 			lastNetwork = network
 			lastAddress = address
+			lastGeneration = generation
 			lastTLSConfig = tlsConfig
 		}
 		m.Unlock()
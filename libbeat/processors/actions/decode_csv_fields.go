@@ -0,0 +1,304 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package actions
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/processors"
+)
+
+type decodeCSVFields struct {
+	csvConfig
+	decoder csvDecoder
+}
+
+type csvConfig struct {
+	Fields           common.MapStr `config:"fields"`             // Mapping of source fields to target fields.
+	Separator        string        `config:"separator"`          // Field separator, a single character.
+	Quote            string        `config:"quote"`              // Quote character, a single character.
+	TrimLeadingSpace bool          `config:"trim_leading_space"` // Ignore leading white space in values.
+	Columns          []string      `config:"columns"`            // Names of the columns, decodes into an object if set.
+	Convert          common.MapStr `config:"convert"`            // Mapping of column names to types.
+	IgnoreMissing    bool          `config:"ignore_missing"`     // Ignore missing source fields.
+	OverwriteKeys    bool          `config:"overwrite_keys"`     // Overwrite existing target fields.
+	TagOnFailure     []string      `config:"tag_on_failure"`     // Tags to append when a row can't be decoded.
+
+	fieldMapping map[string]string
+	conversions  map[string]csvType
+}
+
+var defaultCSVConfig = csvConfig{
+	Separator:    ",",
+	Quote:        `"`,
+	TagOnFailure: []string{"_csv_decode_failure"},
+}
+
+func init() {
+	processors.RegisterPlugin("decode_csv_fields",
+		configChecked(newDecodeCSVFields,
+			requireFields("fields"),
+			allowedFields("fields", "separator", "quote", "trim_leading_space",
+				"columns", "convert", "ignore_missing", "overwrite_keys",
+				"tag_on_failure", "when")))
+}
+
+func newDecodeCSVFields(c *common.Config) (processors.Processor, error) {
+	config := defaultCSVConfig
+	if err := c.Unpack(&config); err != nil {
+		return nil, errors.Wrap(err, "fail to unpack the decode_csv_fields configuration")
+	}
+
+	sep, _ := utf8.DecodeRuneInString(config.Separator)
+	quote, _ := utf8.DecodeRuneInString(config.Quote)
+	return &decodeCSVFields{
+		csvConfig: config,
+		decoder: csvDecoder{
+			separator: sep,
+			quote:     quote,
+			trim:      config.TrimLeadingSpace,
+		},
+	}, nil
+}
+
+// Validate validates the data contained in the config.
+func (c *csvConfig) Validate() error {
+	if utf8.RuneCountInString(c.Separator) != 1 {
+		return errors.Errorf("separator must be a single character, got '%v'", c.Separator)
+	}
+	if utf8.RuneCountInString(c.Quote) != 1 {
+		return errors.Errorf("quote must be a single character, got '%v'", c.Quote)
+	}
+	if c.Separator == c.Quote {
+		return errors.New("separator and quote must be different characters")
+	}
+
+	// Flatten the mapping of source fields to target fields.
+	c.fieldMapping = map[string]string{}
+	for k, v := range c.Fields.Flatten() {
+		target, ok := v.(string)
+		if !ok || target == "" {
+			return errors.Errorf("target field for %v must be a non-empty string", k)
+		}
+		c.fieldMapping[k] = target
+	}
+	if len(c.fieldMapping) == 0 {
+		return errors.New("no fields were configured for decode_csv_fields")
+	}
+
+	columns := map[string]bool{}
+	for _, column := range c.Columns {
+		if column == "" {
+			return errors.New("empty column name")
+		}
+		if columns[column] {
+			return errors.Errorf("duplicate column '%v'", column)
+		}
+		columns[column] = true
+	}
+
+	c.conversions = map[string]csvType{}
+	for column, v := range c.Convert.Flatten() {
+		if !columns[column] {
+			return errors.Errorf("convert references unknown column '%v'", column)
+		}
+
+		name, _ := v.(string)
+		t, found := csvTypes[strings.ToLower(name)]
+		if !found {
+			return errors.Errorf("invalid type '%v' for column '%v' (must be "+
+				"string, integer, float or boolean)", v, column)
+		}
+		c.conversions[column] = t
+	}
+
+	return nil
+}
+
+// Run decodes the CSV rows in all configured fields present in the event.
+func (f *decodeCSVFields) Run(event *beat.Event) (*beat.Event, error) {
+	var errs []string
+	for source, target := range f.fieldMapping {
+		if err := f.decodeField(source, target, event); err != nil {
+			errs = append(errs, err.Error())
+		}
+	}
+
+	if len(errs) > 0 {
+		if len(f.TagOnFailure) > 0 {
+			if event.Fields == nil {
+				event.Fields = common.MapStr{}
+			}
+			common.AddTags(event.Fields, f.TagOnFailure)
+		}
+		return event, errors.New(strings.Join(errs, ", "))
+	}
+	return event, nil
+}
+
+func (f *decodeCSVFields) decodeField(source, target string, event *beat.Event) error {
+	v, err := event.GetValue(source)
+	if err != nil {
+		if f.IgnoreMissing && errors.Cause(err) == common.ErrKeyNotFound {
+			return nil
+		}
+		return errors.Wrapf(err, "could not fetch value for field %v", source)
+	}
+
+	text, ok := v.(string)
+	if !ok {
+		return errors.Errorf("field %v is not a string but %T", source, v)
+	}
+
+	values, err := f.decoder.decode(text)
+	if err != nil {
+		return errors.Wrapf(err, "failed to decode CSV in field %v", source)
+	}
+
+	var decoded interface{} = values
+	if len(f.Columns) > 0 {
+		if len(values) != len(f.Columns) {
+			return errors.Errorf("field %v has %d values but %d columns are "+
+				"configured", source, len(values), len(f.Columns))
+		}
+
+		row := common.MapStr{}
+		for i, column := range f.Columns {
+			value, err := f.conversions[column].convert(values[i])
+			if err != nil {
+				return errors.Wrapf(err, "failed to convert column %v of field %v", column, source)
+			}
+			row[column] = value
+		}
+		decoded = row
+	}
+
+	if target != source && !f.OverwriteKeys {
+		if _, err := event.GetValue(target); err == nil {
+			return errors.Errorf("target field %v already has a value", target)
+		}
+	}
+
+	_, err = event.PutValue(target, decoded)
+	return err
+}
+
+func (f *decodeCSVFields) String() string {
+	return fmt.Sprintf("decode_csv_fields=[fields=%v, separator=%q]",
+		f.fieldMapping, f.Separator)
+}
+
+// csvDecoder splits a single CSV row into its values.
+type csvDecoder struct {
+	separator rune
+	quote     rune
+	trim      bool
+}
+
+func (d *csvDecoder) decode(line string) ([]string, error) {
+	runes := []rune(strings.TrimRight(line, "\r\n"))
+
+	var values []string
+	for pos := 0; ; pos++ {
+		if d.trim {
+			for pos < len(runes) && runes[pos] != d.separator && unicode.IsSpace(runes[pos]) {
+				pos++
+			}
+		}
+
+		var value []rune
+		if pos < len(runes) && runes[pos] == d.quote {
+			closed := false
+			for pos++; pos < len(runes); pos++ {
+				if runes[pos] != d.quote {
+					value = append(value, runes[pos])
+					continue
+				}
+
+				// A doubled quote character is an escaped quote.
+				if pos+1 < len(runes) && runes[pos+1] == d.quote {
+					value = append(value, d.quote)
+					pos++
+					continue
+				}
+
+				closed = true
+				pos++
+				break
+			}
+
+			if !closed {
+				return nil, errors.New("unterminated quoted value")
+			}
+			if pos < len(runes) && runes[pos] != d.separator {
+				return nil, errors.Errorf("unexpected %q after quoted value %d",
+					runes[pos], len(values)+1)
+			}
+		} else {
+			for ; pos < len(runes) && runes[pos] != d.separator; pos++ {
+				if runes[pos] == d.quote {
+					return nil, errors.Errorf("bare %q in unquoted value %d",
+						d.quote, len(values)+1)
+				}
+				value = append(value, runes[pos])
+			}
+		}
+
+		values = append(values, string(value))
+		if pos >= len(runes) {
+			return values, nil
+		}
+	}
+}
+
+type csvType uint8
+
+const (
+	csvString csvType = iota
+	csvInteger
+	csvFloat
+	csvBoolean
+)
+
+var csvTypes = map[string]csvType{
+	"string":  csvString,
+	"integer": csvInteger,
+	"float":   csvFloat,
+	"boolean": csvBoolean,
+}
+
+func (t csvType) convert(value string) (interface{}, error) {
+	switch t {
+	case csvInteger:
+		return strconv.ParseInt(value, 10, 64)
+	case csvFloat:
+		return strconv.ParseFloat(value, 64)
+	case csvBoolean:
+		return strconv.ParseBool(value)
+	default:
+		return value, nil
+	}
+}
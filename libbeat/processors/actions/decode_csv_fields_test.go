@@ -0,0 +1,194 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package actions
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+)
+
+func TestDecodeCSVFields(t *testing.T) {
+	tests := map[string]struct {
+		config   common.MapStr
+		input    common.MapStr
+		expected common.MapStr
+		fail     bool
+	}{
+		"array": {
+			config: common.MapStr{"fields": common.MapStr{"message": "csv"}},
+			input:  common.MapStr{"message": `a,"b,c",,"d ""e"""`},
+			expected: common.MapStr{
+				"message": `a,"b,c",,"d ""e"""`,
+				"csv":     []string{"a", "b,c", "", `d "e"`},
+			},
+		},
+		"in place": {
+			config:   common.MapStr{"fields": common.MapStr{"message": "message"}},
+			input:    common.MapStr{"message": "a,b"},
+			expected: common.MapStr{"message": []string{"a", "b"}},
+		},
+		"separator and quote": {
+			config: common.MapStr{
+				"fields":             common.MapStr{"log": common.MapStr{"line": "log.values"}},
+				"separator":          ";",
+				"quote":              "'",
+				"trim_leading_space": true,
+			},
+			input: common.MapStr{"log": common.MapStr{"line": "a;  'b;c'; d"}},
+			expected: common.MapStr{"log": common.MapStr{
+				"line":   "a;  'b;c'; d",
+				"values": []string{"a", "b;c", "d"},
+			}},
+		},
+		"columns": {
+			config: common.MapStr{
+				"fields":  common.MapStr{"message": "row"},
+				"columns": []string{"user", "status", "took", "ok"},
+				"convert": common.MapStr{"status": "integer", "took": "float", "ok": "boolean"},
+			},
+			input: common.MapStr{"message": "bob,200,1.5,true"},
+			expected: common.MapStr{
+				"message": "bob,200,1.5,true",
+				"row": common.MapStr{
+					"user":   "bob",
+					"status": int64(200),
+					"took":   1.5,
+					"ok":     true,
+				},
+			},
+		},
+		"unterminated quote": {
+			config: common.MapStr{"fields": common.MapStr{"message": "csv"}},
+			input:  common.MapStr{"message": `a,"b`},
+			expected: common.MapStr{
+				"message": `a,"b`,
+				"tags":    []string{"_csv_decode_failure"},
+			},
+			fail: true,
+		},
+		"bare quote": {
+			config: common.MapStr{"fields": common.MapStr{"message": "csv"}},
+			input:  common.MapStr{"message": `a,b"c`},
+			expected: common.MapStr{
+				"message": `a,b"c`,
+				"tags":    []string{"_csv_decode_failure"},
+			},
+			fail: true,
+		},
+		"column count mismatch": {
+			config: common.MapStr{
+				"fields":         common.MapStr{"message": "row"},
+				"columns":        []string{"a", "b"},
+				"tag_on_failure": []string{"malformed"},
+			},
+			input: common.MapStr{"message": "1,2,3"},
+			expected: common.MapStr{
+				"message": "1,2,3",
+				"tags":    []string{"malformed"},
+			},
+			fail: true,
+		},
+		"conversion failure": {
+			config: common.MapStr{
+				"fields":  common.MapStr{"message": "row"},
+				"columns": []string{"a"},
+				"convert": common.MapStr{"a": "integer"},
+			},
+			input: common.MapStr{"message": "x"},
+			expected: common.MapStr{
+				"message": "x",
+				"tags":    []string{"_csv_decode_failure"},
+			},
+			fail: true,
+		},
+		"missing field": {
+			config:   common.MapStr{"fields": common.MapStr{"message": "csv"}},
+			input:    common.MapStr{},
+			expected: common.MapStr{"tags": []string{"_csv_decode_failure"}},
+			fail:     true,
+		},
+		"ignore missing": {
+			config: common.MapStr{
+				"fields":         common.MapStr{"message": "csv"},
+				"ignore_missing": true,
+			},
+			input:    common.MapStr{},
+			expected: common.MapStr{},
+		},
+		"existing target": {
+			config: common.MapStr{"fields": common.MapStr{"message": "csv"}},
+			input:  common.MapStr{"message": "a", "csv": "x"},
+			expected: common.MapStr{
+				"message": "a",
+				"csv":     "x",
+				"tags":    []string{"_csv_decode_failure"},
+			},
+			fail: true,
+		},
+		"overwrite keys": {
+			config: common.MapStr{
+				"fields":         common.MapStr{"message": "csv"},
+				"overwrite_keys": true,
+			},
+			input: common.MapStr{"message": "a", "csv": "x"},
+			expected: common.MapStr{
+				"message": "a",
+				"csv":     []string{"a"},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			p, err := newDecodeCSVFields(common.MustNewConfigFrom(test.config))
+			require.NoError(t, err)
+
+			event, err := p.Run(&beat.Event{Fields: test.input})
+			if test.fail {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, test.expected, event.Fields)
+		})
+	}
+}
+
+func TestDecodeCSVFieldsBadConfig(t *testing.T) {
+	tests := map[string]common.MapStr{
+		"no fields":        {"fields": common.MapStr{}},
+		"empty target":     {"fields": common.MapStr{"message": ""}},
+		"long separator":   {"fields": common.MapStr{"message": "csv"}, "separator": ";;"},
+		"same quote":       {"fields": common.MapStr{"message": "csv"}, "quote": ","},
+		"duplicate column": {"fields": common.MapStr{"message": "csv"}, "columns": []string{"a", "a"}},
+		"unknown column":   {"fields": common.MapStr{"message": "csv"}, "columns": []string{"a"}, "convert": common.MapStr{"b": "integer"}},
+		"unknown type":     {"fields": common.MapStr{"message": "csv"}, "columns": []string{"a"}, "convert": common.MapStr{"a": "date"}},
+	}
+
+	for name, config := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := newDecodeCSVFields(common.MustNewConfigFrom(config))
+			assert.Error(t, err)
+		})
+	}
+}
@@ -27,7 +27,8 @@ import (
 )
 
 type dropFields struct {
-	Fields []string
+	Fields   []string
+	Patterns *fieldSelector
 }
 
 func init() {
@@ -55,7 +56,12 @@ func newDropFields(c *common.Config) (processors.Processor, error) {
 		}
 	}
 
-	f := &dropFields{Fields: config.Fields}
+	fields, patterns, err := newFieldSelector(config.Fields)
+	if err != nil {
+		return nil, fmt.Errorf("fail to unpack the drop_fields configuration: %s", err)
+	}
+
+	f := &dropFields{Fields: fields, Patterns: patterns}
 	return f, nil
 }
 
@@ -70,6 +76,14 @@ func (f *dropFields) Run(event *beat.Event) (*beat.Event, error) {
 
 	}
 
+	if f.Patterns != nil {
+		f.Patterns.walkMatches(event.Fields, func(parent common.MapStr, key, path string, _ interface{}) {
+			if !isMandatoryField(path) {
+				delete(parent, key)
+			}
+		})
+	}
+
 	if len(errors) > 0 {
 		return event, fmt.Errorf(strings.Join(errors, ", "))
 	}
@@ -77,5 +91,18 @@ func (f *dropFields) Run(event *beat.Event) (*beat.Event, error) {
 }
 
 func (f *dropFields) String() string {
-	return "drop_fields=" + strings.Join(f.Fields, ", ")
+	fields := f.Fields
+	if f.Patterns != nil {
+		fields = append(fields[:len(fields):len(fields)], f.Patterns.names...)
+	}
+	return "drop_fields=" + strings.Join(fields, ", ")
+}
+
+func isMandatoryField(field string) bool {
+	for _, readOnly := range processors.MandatoryExportedFields {
+		if field == readOnly {
+			return true
+		}
+	}
+	return false
 }
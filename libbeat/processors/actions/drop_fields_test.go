@@ -0,0 +1,104 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package actions
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+)
+
+func TestDropFields(t *testing.T) {
+	var tests = []struct {
+		Fields []string
+		Input  common.MapStr
+		Output common.MapStr
+	}{
+		{
+			Fields: []string{"hello"},
+			Input: common.MapStr{
+				"hello": "world",
+				"test":  17,
+			},
+			Output: common.MapStr{
+				"test": 17,
+			},
+		},
+		{
+			Fields: []string{"kubernetes.labels.*"},
+			Input: common.MapStr{
+				"kubernetes": common.MapStr{
+					"labels": common.MapStr{"app": "web", "tier": "frontend"},
+					"pod":    common.MapStr{"name": "web-1"},
+				},
+			},
+			Output: common.MapStr{
+				"kubernetes": common.MapStr{
+					"labels": common.MapStr{},
+					"pod":    common.MapStr{"name": "web-1"},
+				},
+			},
+		},
+		{
+			Fields: []string{"/^temp_/", "host.?d"},
+			Input: common.MapStr{
+				"temp_a":   1,
+				"temp_b":   2,
+				"not_temp": 3,
+				"host":     common.MapStr{"id": "abc", "name": "x"},
+			},
+			Output: common.MapStr{
+				"not_temp": 3,
+				"host":     common.MapStr{"name": "x"},
+			},
+		},
+		{
+			Fields: []string{"/.*/"},
+			Input: common.MapStr{
+				"type":    "log",
+				"message": "hello",
+			},
+			Output: common.MapStr{
+				"type": "log",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		p, err := newDropFields(common.MustNewConfigFrom(map[string]interface{}{
+			"fields": test.Fields,
+		}))
+		if !assert.NoError(t, err) {
+			continue
+		}
+
+		newEvent, err := p.Run(&beat.Event{Fields: test.Input})
+		assert.NoError(t, err)
+		assert.Equal(t, test.Output, newEvent.Fields)
+	}
+}
+
+func TestDropFieldsInvalidRegexp(t *testing.T) {
+	_, err := newDropFields(common.MustNewConfigFrom(map[string]interface{}{
+		"fields": []string{"/[/"},
+	}))
+	assert.Error(t, err)
+}
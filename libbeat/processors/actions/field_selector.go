@@ -0,0 +1,121 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package actions
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/common/match"
+)
+
+// fieldSelector selects event fields by glob pattern (e.g.
+// `kubernetes.labels.*`) or by regular expression enclosed in slashes (e.g.
+// `/^temp_/`). Patterns are matched against the full dotted path of a key.
+type fieldSelector struct {
+	names    []string
+	patterns []match.Matcher
+}
+
+// newFieldSelector splits the configured fields into exact field names and a
+// selector for the patterns. The selector is nil if no patterns are given.
+func newFieldSelector(fields []string) ([]string, *fieldSelector, error) {
+	var exact []string
+	s := &fieldSelector{}
+	for _, field := range fields {
+		switch {
+		case len(field) > 2 && strings.HasPrefix(field, "/") && strings.HasSuffix(field, "/"):
+			m, err := match.Compile(field[1 : len(field)-1])
+			if err != nil {
+				return nil, nil, errors.Wrapf(err, "invalid regular expression in field '%v'", field)
+			}
+			s.names = append(s.names, field)
+			s.patterns = append(s.patterns, m)
+
+		case strings.ContainsAny(field, "*?"):
+			m, err := match.Compile(globToRegexp(field))
+			if err != nil {
+				return nil, nil, errors.Wrapf(err, "invalid pattern in field '%v'", field)
+			}
+			s.names = append(s.names, field)
+			s.patterns = append(s.patterns, m)
+
+		default:
+			exact = append(exact, field)
+		}
+	}
+
+	if len(s.patterns) == 0 {
+		return exact, nil, nil
+	}
+	return exact, s, nil
+}
+
+// globToRegexp converts a glob pattern into an anchored regular expression.
+// `*` matches any sequence of characters and `?` matches a single character.
+func globToRegexp(glob string) string {
+	var b strings.Builder
+	b.WriteString("^")
+	for _, r := range glob {
+		switch r {
+		case '*':
+			b.WriteString(".*")
+		case '?':
+			b.WriteString(".")
+		default:
+			b.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	b.WriteString("$")
+	return b.String()
+}
+
+func (s *fieldSelector) matchPattern(key string) bool {
+	for _, m := range s.patterns {
+		if m.MatchString(key) {
+			return true
+		}
+	}
+	return false
+}
+
+// walkMatches calls fn for every key in m whose full path matches one of the
+// patterns. Keys that match are not descended into.
+func (s *fieldSelector) walkMatches(m common.MapStr, fn func(parent common.MapStr, key, path string, value interface{})) {
+	s.walk(m, "", fn)
+}
+
+func (s *fieldSelector) walk(m common.MapStr, prefix string, fn func(common.MapStr, string, string, interface{})) {
+	for key, value := range m {
+		path := prefix + key
+		if s.matchPattern(path) {
+			fn(m, key, path, value)
+			continue
+		}
+
+		switch child := value.(type) {
+		case common.MapStr:
+			s.walk(child, path+".", fn)
+		case map[string]interface{}:
+			s.walk(common.MapStr(child), path+".", fn)
+		}
+	}
+}
@@ -29,7 +29,8 @@ import (
 )
 
 type includeFields struct {
-	Fields []string
+	Fields   []string
+	Patterns *fieldSelector
 }
 
 func init() {
@@ -61,7 +62,12 @@ func newIncludeFields(c *common.Config) (processors.Processor, error) {
 		}
 	}
 
-	f := &includeFields{Fields: config.Fields}
+	fields, patterns, err := newFieldSelector(config.Fields)
+	if err != nil {
+		return nil, fmt.Errorf("fail to unpack the include_fields configuration: %s", err)
+	}
+
+	f := &includeFields{Fields: fields, Patterns: patterns}
 	return f, nil
 }
 
@@ -81,6 +87,14 @@ func (f *includeFields) Run(event *beat.Event) (*beat.Event, error) {
 		}
 	}
 
+	if f.Patterns != nil {
+		f.Patterns.walkMatches(event.Fields, func(_ common.MapStr, _, path string, value interface{}) {
+			if _, err := filtered.Put(path, value); err != nil {
+				errs = append(errs, err.Error())
+			}
+		})
+	}
+
 	event.Fields = filtered
 	if len(errs) > 0 {
 		return event, fmt.Errorf(strings.Join(errs, ", "))
@@ -89,5 +103,9 @@ func (f *includeFields) Run(event *beat.Event) (*beat.Event, error) {
 }
 
 func (f *includeFields) String() string {
-	return "include_fields=" + strings.Join(f.Fields, ", ")
+	fields := f.Fields
+	if f.Patterns != nil {
+		fields = append(fields[:len(fields):len(fields)], f.Patterns.names...)
+	}
+	return "include_fields=" + strings.Join(fields, ", ")
 }
@@ -74,3 +74,56 @@ func TestIncludeFields(t *testing.T) {
 		assert.Equal(t, test.Output, newEvent.Fields)
 	}
 }
+
+func TestIncludeFieldsPatterns(t *testing.T) {
+	var tests = []struct {
+		Fields []string
+		Input  common.MapStr
+		Output common.MapStr
+	}{
+		{
+			Fields: []string{"kubernetes.labels.*"},
+			Input: common.MapStr{
+				"type": "log",
+				"kubernetes": common.MapStr{
+					"labels": common.MapStr{"app": "web", "tier": "frontend"},
+					"pod":    common.MapStr{"name": "web-1"},
+				},
+			},
+			Output: common.MapStr{
+				"type": "log",
+				"kubernetes": common.MapStr{
+					"labels": common.MapStr{"app": "web", "tier": "frontend"},
+				},
+			},
+		},
+		{
+			Fields: []string{"message", "/^temp_/"},
+			Input: common.MapStr{
+				"message":   "hello",
+				"temp_a":    1,
+				"temp_b":    2,
+				"not_temp":  3,
+				"something": 4,
+			},
+			Output: common.MapStr{
+				"message": "hello",
+				"temp_a":  1,
+				"temp_b":  2,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		p, err := newIncludeFields(common.MustNewConfigFrom(map[string]interface{}{
+			"fields": test.Fields,
+		}))
+		if !assert.NoError(t, err) {
+			continue
+		}
+
+		newEvent, err := p.Run(&beat.Event{Fields: test.Input})
+		assert.NoError(t, err)
+		assert.Equal(t, test.Output, newEvent.Fields)
+	}
+}
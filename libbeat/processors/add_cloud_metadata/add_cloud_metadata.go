@@ -141,66 +141,116 @@ func (r result) String() string {
 		r.provider, r.err, r.metadata)
 }
 
-// writeResult blocks until it can write the result r to the channel c or until
-// the context times out.
-func writeResult(ctx context.Context, c chan result, r result) error {
-	select {
-	case <-ctx.Done():
-		return ctx.Err()
-	case c <- r:
-		return nil
-	}
+// fetcher fetches the instance metadata of a hosting provider.
+type fetcher interface {
+	// name returns the name of the hosting provider.
+	name() string
+
+	fetchMetadata(ctx context.Context, client http.Client) result
 }
 
+func (f *metadataFetcher) name() string { return f.provider }
+
 // fetchMetadata attempts to fetch metadata in parallel from each of the
-// hosting providers supported by this processor. It wait for the results to
-// be returned or for a timeout to occur then returns the results that
-// completed in time.
-func fetchMetadata(metadataFetchers []*metadataFetcher, timeout time.Duration) *result {
+// hosting providers supported by this processor. By default the result of the
+// first provider that succeeds is returned. If ordered is set, it waits for
+// the results of the providers earlier in the list before returning a result,
+// so the first provider in the list that succeeded is used. On timeout, the
+// best result received so far is returned.
+func fetchMetadata(fetchers []fetcher, timeouts map[string]time.Duration, timeout time.Duration, ordered bool) *result {
 	debugf("add_cloud_metadata: starting to fetch metadata, timeout=%v", timeout)
 	start := time.Now()
 	defer func() {
 		debugf("add_cloud_metadata: fetchMetadata ran for %v", time.Since(start))
 	}()
 
+	// The client must not time out before the slowest provider.
+	maxTimeout := timeout
+	for _, t := range timeouts {
+		if t > maxTimeout {
+			maxTimeout = t
+		}
+	}
+
 	// Create HTTP client with our timeouts and keep-alive disabled.
 	client := http.Client{
-		Timeout: timeout,
+		Timeout: maxTimeout,
 		Transport: &http.Transport{
 			DisableKeepAlives: true,
 			DialContext: (&net.Dialer{
-				Timeout:   timeout,
+				Timeout:   maxTimeout,
 				KeepAlive: 0,
 			}).DialContext,
 		},
 	}
 
 	// Create context to enable explicit cancellation of the http requests.
-	ctx, cancel := context.WithTimeout(context.TODO(), timeout)
+	ctx, cancel := context.WithTimeout(context.TODO(), maxTimeout)
 	defer cancel()
 
-	c := make(chan result)
-	for _, fetcher := range metadataFetchers {
-		go func(fetcher *metadataFetcher) {
-			writeResult(ctx, c, fetcher.fetchMetadata(ctx, client))
-		}(fetcher)
+	type indexedResult struct {
+		idx int
+		result
 	}
 
-	for i := 0; i < len(metadataFetchers); i++ {
+	c := make(chan indexedResult, len(fetchers))
+	for i, f := range fetchers {
+		providerTimeout, found := timeouts[f.name()]
+		if !found {
+			providerTimeout = timeout
+		}
+
+		go func(i int, f fetcher, timeout time.Duration) {
+			fctx, cancel := context.WithTimeout(ctx, timeout)
+			defer cancel()
+			c <- indexedResult{i, f.fetchMetadata(fctx, client)}
+		}(i, f, providerTimeout)
+	}
+
+	results := make([]*result, len(fetchers))
+	for received := 0; received < len(fetchers); received++ {
 		select {
-		case result := <-c:
+		case r := <-c:
 			debugf("add_cloud_metadata: received disposition for %v after %v. %v",
-				result.provider, time.Since(start), result)
-			// Bail out on first success.
-			if result.err == nil && result.metadata != nil {
-				return &result
+				r.provider, time.Since(start), r.result)
+			results[r.idx] = &r.result
+			if !ordered && r.succeeded() {
+				return &r.result
 			}
 		case <-ctx.Done():
 			debugf("add_cloud_metadata: timed-out waiting for all responses")
-			return nil
+			return firstSucceeded(results, false)
+		}
+
+		// Return the first successful result, once all providers before it
+		// have failed.
+		if res := firstSucceeded(results, true); res != nil {
+			return res
 		}
 	}
 
+	return firstSucceeded(results, false)
+}
+
+func (r *result) succeeded() bool {
+	return r.err == nil && r.metadata != nil
+}
+
+// firstSucceeded returns the first successful result. If complete is set,
+// a result is only returned if the results of all providers before it have
+// been received.
+func firstSucceeded(results []*result, complete bool) *result {
+	for _, res := range results {
+		if res == nil {
+			if complete {
+				return nil
+			}
+			continue
+		}
+		if res.succeeded() {
+			return res
+		}
+	}
 	return nil
 }
 
@@ -255,66 +305,109 @@ func newMetadataFetcher(
 	return fetcher, nil
 }
 
-func setupFetchers(c *common.Config) ([]*metadataFetcher, error) {
-	var fetchers []*metadataFetcher
-	doFetcher, err := newDoMetadataFetcher(c)
-	if err != nil {
-		return fetchers, err
-	}
-	ec2Fetcher, err := newEc2MetadataFetcher(c)
-	if err != nil {
-		return fetchers, err
+// providers lists the supported hosting providers.
+var providers = []struct {
+	name       string
+	newFetcher func(c *common.Config) (fetcher, error)
+}{
+	{"digitalocean", wrapFetcher(newDoMetadataFetcher)},
+	{"ec2", wrapFetcher(newEc2MetadataFetcher)},
+	{"gce", wrapFetcher(newGceMetadataFetcher)},
+	{"qcloud", wrapFetcher(newQcloudMetadataFetcher)},
+	{"ecs", wrapFetcher(newAlibabaCloudMetadataFetcher)},
+	{"az", wrapFetcher(newAzureVmMetadataFetcher)},
+	{"openstack", newOpenstackConfigDriveFetcher},
+	{"openstack", wrapFetcher(newOpenstackNovaMetadataFetcher)},
+}
+
+func wrapFetcher(newFetcher func(*common.Config) (*metadataFetcher, error)) func(*common.Config) (fetcher, error) {
+	return func(c *common.Config) (fetcher, error) {
+		return newFetcher(c)
 	}
-	gceFetcher, err := newGceMetadataFetcher(c)
-	if err != nil {
-		return fetchers, err
+}
+
+func isProvider(name string) bool {
+	for _, p := range providers {
+		if p.name == name {
+			return true
+		}
 	}
-	qcloudFetcher, err := newQcloudMetadataFetcher(c)
-	if err != nil {
-		return fetchers, err
+	return false
+}
+
+type config struct {
+	Timeout          time.Duration            `config:"timeout"`           // Amount of time to wait for responses from the metadata services.
+	Providers        []string                 `config:"providers"`         // Providers to query. All providers are queried by default.
+	ProviderTimeouts map[string]time.Duration `config:"provider_timeouts"` // Timeouts of single providers, overwriting the timeout.
+}
+
+func defaultConfig() config {
+	return config{
+		Timeout: defaultTimeOut,
 	}
-	ecsFetcher, err := newAlibabaCloudMetadataFetcher(c)
-	if err != nil {
-		return fetchers, err
+}
+
+func (c *config) Validate() error {
+	for _, name := range c.Providers {
+		if !isProvider(name) {
+			return errors.Errorf("unknown provider '%v'", name)
+		}
 	}
-	azFetcher, err := newAzureVmMetadataFetcher(c)
-	if err != nil {
-		return fetchers, err
+	for name := range c.ProviderTimeouts {
+		if !isProvider(name) {
+			return errors.Errorf("unknown provider '%v' in provider_timeouts", name)
+		}
 	}
-	osFetcher, err := newOpenstackNovaMetadataFetcher(c)
-	if err != nil {
-		return fetchers, err
+	return nil
+}
+
+// setupFetchers returns the fetchers of the selected providers, in the order
+// they are selected in. All providers are returned if none is selected.
+func setupFetchers(c *common.Config, selected []string) ([]fetcher, error) {
+	if len(selected) == 0 {
+		for _, p := range providers {
+			selected = append(selected, p.name)
+		}
 	}
 
-	fetchers = []*metadataFetcher{
-		doFetcher,
-		ec2Fetcher,
-		gceFetcher,
-		qcloudFetcher,
-		ecsFetcher,
-		azFetcher,
-		osFetcher,
+	var fetchers []fetcher
+	added := common.StringSet{}
+	for _, name := range selected {
+		if added.Has(name) {
+			continue
+		}
+		added.Add(name)
+
+		for _, p := range providers {
+			if p.name != name {
+				continue
+			}
+			f, err := p.newFetcher(c)
+			if err != nil {
+				return nil, err
+			}
+			fetchers = append(fetchers, f)
+		}
 	}
 	return fetchers, nil
 }
 
 func newCloudMetadata(c *common.Config) (processors.Processor, error) {
-	config := struct {
-		Timeout time.Duration `config:"timeout"` // Amount of time to wait for responses from the metadata services.
-	}{
-		Timeout: defaultTimeOut,
-	}
+	config := defaultConfig()
 	err := c.Unpack(&config)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to unpack add_cloud_metadata config")
 	}
 
-	fetchers, err := setupFetchers(c)
+	fetchers, err := setupFetchers(c, config.Providers)
 	if err != nil {
 		return nil, err
 	}
 
-	result := fetchMetadata(fetchers, config.Timeout)
+	// The order of the providers is only respected if they are selected
+	// explicitly.
+	ordered := len(config.Providers) > 0
+	result := fetchMetadata(fetchers, config.ProviderTimeouts, config.Timeout, ordered)
 	if result == nil {
 		logp.Info("add_cloud_metadata: hosting provider type not detected.")
 		return &addCloudMetadata{}, nil
@@ -0,0 +1,170 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package add_cloud_metadata
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/logp"
+)
+
+// initEC2AndOpenstackTestServer serves the EC2 instance identity document and
+// the EC2 compatible metadata of OpenStack Nova, as done by OpenStack.
+func initEC2AndOpenstackTestServer(delay time.Duration) *httptest.Server {
+	ec2 := initEC2TestServer()
+	openstack := initOpenstackNovaTestServer()
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.RequestURI == "/2014-02-25/dynamic/instance-identity/document" {
+			time.Sleep(delay)
+			ec2.Config.Handler.ServeHTTP(w, r)
+			return
+		}
+		openstack.Config.Handler.ServeHTTP(w, r)
+	}))
+}
+
+func runCloudMetadata(t *testing.T, settings map[string]interface{}) common.MapStr {
+	p, err := newCloudMetadata(common.MustNewConfigFrom(settings))
+	require.NoError(t, err)
+
+	event, err := p.Run(&beat.Event{Fields: common.MapStr{}})
+	require.NoError(t, err)
+
+	cloud, err := event.GetValue("meta.cloud")
+	if err != nil {
+		return nil
+	}
+	return cloud.(common.MapStr)
+}
+
+func TestFirstResponseWins(t *testing.T) {
+	logp.TestingSetup()
+
+	// EC2 responds slower, OpenStack is detected first.
+	server := initEC2AndOpenstackTestServer(100 * time.Millisecond)
+	defer server.Close()
+
+	cloud := runCloudMetadata(t, map[string]interface{}{
+		"host": server.Listener.Addr().String(),
+	})
+	assert.Equal(t, "openstack", cloud["provider"])
+}
+
+func TestProviderPrecedence(t *testing.T) {
+	logp.TestingSetup()
+
+	// EC2 responds slower, but has precedence over OpenStack.
+	server := initEC2AndOpenstackTestServer(100 * time.Millisecond)
+	defer server.Close()
+
+	cloud := runCloudMetadata(t, map[string]interface{}{
+		"host":      server.Listener.Addr().String(),
+		"providers": []string{"ec2", "openstack"},
+	})
+	assert.Equal(t, "ec2", cloud["provider"])
+}
+
+func TestProviderPrecedenceTimeout(t *testing.T) {
+	logp.TestingSetup()
+
+	// EC2 has precedence but times out, the result of OpenStack received
+	// before is used.
+	server := initEC2AndOpenstackTestServer(time.Second)
+	defer server.Close()
+
+	cloud := runCloudMetadata(t, map[string]interface{}{
+		"host":      server.Listener.Addr().String(),
+		"timeout":   "300ms",
+		"providers": []string{"ec2", "openstack"},
+	})
+	assert.Equal(t, "openstack", cloud["provider"])
+}
+
+func TestProvidersSelection(t *testing.T) {
+	logp.TestingSetup()
+
+	server := initEC2AndOpenstackTestServer(0)
+	defer server.Close()
+
+	cloud := runCloudMetadata(t, map[string]interface{}{
+		"host":      server.Listener.Addr().String(),
+		"providers": []string{"openstack"},
+	})
+	assert.Equal(t, "openstack", cloud["provider"])
+
+	_, err := newCloudMetadata(common.MustNewConfigFrom(map[string]interface{}{
+		"providers": []string{"unknown"},
+	}))
+	assert.Error(t, err)
+}
+
+func TestProviderTimeouts(t *testing.T) {
+	logp.TestingSetup()
+
+	server := initEC2AndOpenstackTestServer(time.Second)
+	defer server.Close()
+
+	// EC2 times out, so OpenStack is used.
+	cloud := runCloudMetadata(t, map[string]interface{}{
+		"host":                  server.Listener.Addr().String(),
+		"timeout":               "3s",
+		"provider_timeouts.ec2": "100ms",
+	})
+	assert.Equal(t, "openstack", cloud["provider"])
+}
+
+func TestRetrieveOpenstackConfigDriveMetadata(t *testing.T) {
+	logp.TestingSetup()
+
+	dir, err := ioutil.TempDir("", "config-drive")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	metadataFile := filepath.Join(dir, osConfigDriveMetadataFile)
+	require.NoError(t, os.MkdirAll(filepath.Dir(metadataFile), 0755))
+	require.NoError(t, ioutil.WriteFile(metadataFile, []byte(`{
+  "uuid": "83679162-1378-4288-a2d4-70e13ec132aa",
+  "hostname": "test.novalocal",
+  "name": "test",
+  "availability_zone": "nova",
+  "project_id": "f7ac731cc11f40efbc03a9f9e1d1d21f"
+}`), 0644))
+
+	cloud := runCloudMetadata(t, map[string]interface{}{
+		"providers":              []string{"openstack"},
+		"openstack.config_drive": dir,
+		"host":                   "127.0.0.1:1",
+	})
+	assert.Equal(t, common.MapStr{
+		"provider":          "openstack",
+		"instance_id":       "83679162-1378-4288-a2d4-70e13ec132aa",
+		"instance_name":     "test.novalocal",
+		"availability_zone": "nova",
+	}, cloud)
+}
@@ -0,0 +1,96 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package add_cloud_metadata
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/libbeat/common"
+)
+
+const (
+	// osConfigDrivePath is the mount point of the config drive used in the
+	// OpenStack documentation.
+	osConfigDrivePath = "/mnt/config"
+
+	osConfigDriveMetadataFile = "openstack/latest/meta_data.json"
+)
+
+// configDriveFetcher reads the instance metadata from an OpenStack config
+// drive, which is available on clouds without a metadata service.
+// Document https://docs.openstack.org/nova/latest/user/config-drive.html
+type configDriveFetcher struct {
+	path string
+}
+
+func newOpenstackConfigDriveFetcher(c *common.Config) (fetcher, error) {
+	config := struct {
+		OpenStack struct {
+			ConfigDrive string `config:"config_drive"` // Mount point of the config drive.
+		} `config:"openstack"`
+	}{}
+	config.OpenStack.ConfigDrive = osConfigDrivePath
+	if err := c.Unpack(&config); err != nil {
+		return nil, errors.Wrap(err, "failed to unpack add_cloud_metadata config")
+	}
+	return &configDriveFetcher{path: config.OpenStack.ConfigDrive}, nil
+}
+
+func (f *configDriveFetcher) name() string { return "openstack" }
+
+func (f *configDriveFetcher) fetchMetadata(_ context.Context, _ http.Client) result {
+	res := result{provider: f.name()}
+
+	all, err := ioutil.ReadFile(filepath.Join(f.path, osConfigDriveMetadataFile))
+	if err != nil {
+		res.err = errors.Wrap(err, "failed reading openstack config drive")
+		return res
+	}
+
+	var metadata struct {
+		UUID             string `json:"uuid"`
+		Hostname         string `json:"hostname"`
+		AvailabilityZone string `json:"availability_zone"`
+	}
+	if err := json.Unmarshal(all, &metadata); err != nil {
+		res.err = errors.Wrapf(err, "failed to unmarshal openstack config drive JSON of '%v'", string(all))
+		return res
+	}
+	if metadata.UUID == "" {
+		res.err = errors.New("openstack config drive has no instance uuid")
+		return res
+	}
+
+	res.metadata = common.MapStr{
+		"provider":    f.name(),
+		"instance_id": metadata.UUID,
+	}
+	if metadata.Hostname != "" {
+		res.metadata["instance_name"] = metadata.Hostname
+	}
+	if metadata.AvailabilityZone != "" {
+		res.metadata["availability_zone"] = metadata.AvailabilityZone
+	}
+	return res
+}
@@ -168,7 +168,11 @@ func TestMatchSource(t *testing.T) {
 	assert.NoError(t, err, "initializing add_docker_metadata processor")
 
 	input := common.MapStr{
-		"source": "/var/lib/docker/containers/FABADA/foo.log",
+		"log": common.MapStr{
+			"file": common.MapStr{
+				"path": "/var/lib/docker/containers/FABADA/foo.log",
+			},
+		},
 	}
 	result, err := p.Run(&beat.Event{Fields: input})
 	assert.NoError(t, err, "processing an event")
@@ -185,7 +189,11 @@ func TestMatchSource(t *testing.T) {
 				"name": "name",
 			},
 		},
-		"source": "/var/lib/docker/containers/FABADA/foo.log",
+		"log": common.MapStr{
+			"file": common.MapStr{
+				"path": "/var/lib/docker/containers/FABADA/foo.log",
+			},
+		},
 	}, result.Fields)
 }
 
@@ -211,7 +219,11 @@ func TestDisableSource(t *testing.T) {
 	assert.NoError(t, err, "initializing add_docker_metadata processor")
 
 	input := common.MapStr{
-		"source": "/var/lib/docker/containers/FABADA/foo.log",
+		"log": common.MapStr{
+			"file": common.MapStr{
+				"path": "/var/lib/docker/containers/FABADA/foo.log",
+			},
+		},
 	}
 	result, err := p.Run(&beat.Event{Fields: input})
 	assert.NoError(t, err, "processing an event")
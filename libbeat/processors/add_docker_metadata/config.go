@@ -28,8 +28,8 @@ type Config struct {
 	Host         string            `config:"host"`               // Docker socket (UNIX or TCP socket).
 	TLS          *docker.TLSConfig `config:"ssl"`                // TLS settings for connecting to Docker.
 	Fields       []string          `config:"match_fields"`       // A list of fields to match a container ID.
-	MatchSource  bool              `config:"match_source"`       // Match container ID from a log path present in source field.
-	MatchShortID bool              `config:"match_short_id"`     // Match to container short ID from a log path present in source field.
+	MatchSource  bool              `config:"match_source"`       // Match container ID from a log path present in log.file.path field.
+	MatchShortID bool              `config:"match_short_id"`     // Match to container short ID from a log path present in log.file.path field.
 	SourceIndex  int               `config:"match_source_index"` // Index in the source path split by / to look for container ID.
 	MatchPIDs    []string          `config:"match_pids"`         // A list of fields containing process IDs (PIDs).
 	HostFS       string            `config:"system.hostfs"`      // Specifies the mount point of the host’s filesystem for use in monitoring a host from within a container.
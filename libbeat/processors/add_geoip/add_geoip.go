@@ -0,0 +1,207 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package add_geoip
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/joeshaw/multierror"
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/logp"
+	"github.com/elastic/beats/libbeat/paths"
+	"github.com/elastic/beats/libbeat/processors"
+)
+
+const processorName = "add_geoip"
+
+func init() {
+	processors.RegisterPlugin(processorName, New)
+}
+
+type cityRecord struct {
+	City struct {
+		Names map[string]string `maxminddb:"names"`
+	} `maxminddb:"city"`
+	Continent struct {
+		Names map[string]string `maxminddb:"names"`
+	} `maxminddb:"continent"`
+	Country struct {
+		IsoCode string            `maxminddb:"iso_code"`
+		Names   map[string]string `maxminddb:"names"`
+	} `maxminddb:"country"`
+	Location struct {
+		Latitude  float64 `maxminddb:"latitude"`
+		Longitude float64 `maxminddb:"longitude"`
+		TimeZone  string  `maxminddb:"time_zone"`
+	} `maxminddb:"location"`
+	Subdivisions []struct {
+		IsoCode string            `maxminddb:"iso_code"`
+		Names   map[string]string `maxminddb:"names"`
+	} `maxminddb:"subdivisions"`
+}
+
+type asnRecord struct {
+	Number       uint   `maxminddb:"autonomous_system_number"`
+	Organization string `maxminddb:"autonomous_system_organization"`
+}
+
+type addGeoIP struct {
+	config Config
+	city   *database
+	asn    *database
+}
+
+// New constructs a new add_geoip processor.
+func New(cfg *common.Config) (processors.Processor, error) {
+	config := defaultConfig()
+	if err := cfg.Unpack(&config); err != nil {
+		return nil, errors.Wrapf(err, "fail to unpack the %v configuration", processorName)
+	}
+
+	log := logp.NewLogger(processorName)
+	p := &addGeoIP{config: config}
+
+	var err error
+	if path := config.Database.City; path != "" {
+		p.city, err = openDatabase(paths.Resolve(paths.Config, path), config.ReloadPeriod, log)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if path := config.Database.ASN; path != "" {
+		p.asn, err = openDatabase(paths.Resolve(paths.Config, path), config.ReloadPeriod, log)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return p, nil
+}
+
+// Run enriches the event with the location and autonomous system of every
+// configured IP field.
+func (p *addGeoIP) Run(event *beat.Event) (*beat.Event, error) {
+	var errs multierror.Errors
+	for source, target := range p.config.fieldMapping {
+		if err := p.enrich(event, source, target); err != nil {
+			errs = append(errs, errors.Wrapf(err, "geoip lookup of %v failed", source))
+		}
+	}
+
+	if len(errs) > 0 {
+		if len(p.config.TagOnFailure) > 0 {
+			if event.Fields == nil {
+				event.Fields = common.MapStr{}
+			}
+			common.AddTags(event.Fields, p.config.TagOnFailure)
+		}
+		return event, errs.Err()
+	}
+	return event, nil
+}
+
+func (p *addGeoIP) enrich(event *beat.Event, source, target string) error {
+	v, err := event.GetValue(source)
+	if err != nil {
+		// Missing fields are not an error.
+		return nil
+	}
+	s, ok := v.(string)
+	if !ok {
+		return nil
+	}
+	ip := net.ParseIP(s)
+	if ip == nil {
+		return errors.Errorf("invalid IP address '%v'", s)
+	}
+
+	fields := common.MapStr{}
+	if p.city != nil {
+		var rec cityRecord
+		found, err := p.city.lookup(ip, &rec)
+		if err != nil {
+			return err
+		}
+		if found {
+			if geo := p.geoFields(&rec); len(geo) > 0 {
+				fields["geo"] = geo
+			}
+		}
+	}
+	if p.asn != nil {
+		var rec asnRecord
+		found, err := p.asn.lookup(ip, &rec)
+		if err != nil {
+			return err
+		}
+		if found && rec.Number != 0 {
+			as := common.MapStr{"number": rec.Number}
+			if rec.Organization != "" {
+				as["organization"] = common.MapStr{"name": rec.Organization}
+			}
+			fields["as"] = as
+		}
+	}
+
+	prefix := ""
+	if target != "" {
+		prefix = target + "."
+	}
+	for key, value := range fields {
+		if _, err := event.PutValue(prefix+key, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// geoFields converts a city record into ECS geo fields.
+func (p *addGeoIP) geoFields(rec *cityRecord) common.MapStr {
+	lang := p.config.Language
+	geo := common.MapStr{}
+
+	putString := func(key, value string) {
+		if value != "" {
+			geo[key] = value
+		}
+	}
+	putString("continent_name", rec.Continent.Names[lang])
+	putString("country_iso_code", rec.Country.IsoCode)
+	putString("country_name", rec.Country.Names[lang])
+	putString("city_name", rec.City.Names[lang])
+	putString("timezone", rec.Location.TimeZone)
+	if len(rec.Subdivisions) > 0 {
+		putString("region_iso_code", rec.Subdivisions[0].IsoCode)
+		putString("region_name", rec.Subdivisions[0].Names[lang])
+	}
+	if rec.Location.Latitude != 0 || rec.Location.Longitude != 0 {
+		geo["location"] = common.MapStr{
+			"lat": rec.Location.Latitude,
+			"lon": rec.Location.Longitude,
+		}
+	}
+	return geo
+}
+
+func (p *addGeoIP) String() string {
+	return fmt.Sprintf("%v=[database.city=%v, database.asn=%v, fields=%v]",
+		processorName, p.config.Database.City, p.config.Database.ASN, p.config.fieldMapping)
+}
@@ -0,0 +1,174 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package add_geoip
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+)
+
+const (
+	cityDB = "testdata/GeoLite2-City-Test.mmdb"
+	asnDB  = "testdata/GeoLite2-ASN-Test.mmdb"
+)
+
+func newTestGeoIP(t *testing.T, conf map[string]interface{}) *addGeoIP {
+	c, err := common.NewConfigFrom(conf)
+	require.NoError(t, err)
+
+	p, err := New(c)
+	require.NoError(t, err)
+	return p.(*addGeoIP)
+}
+
+func TestCityAndASN(t *testing.T) {
+	p := newTestGeoIP(t, map[string]interface{}{
+		"database.city": cityDB,
+		"database.asn":  asnDB,
+	})
+
+	evt, err := p.Run(&beat.Event{Fields: common.MapStr{
+		"source":      common.MapStr{"ip": "89.160.20.5", "port": 1234},
+		"destination": common.MapStr{"ip": "81.2.69.142"},
+	}})
+	require.NoError(t, err)
+
+	expectedSource := common.MapStr{
+		"ip":   "89.160.20.5",
+		"port": 1234,
+		"geo": common.MapStr{
+			"continent_name":   "Europe",
+			"country_iso_code": "SE",
+			"country_name":     "Sweden",
+			"region_iso_code":  "E",
+			"region_name":      "Östergötland County",
+			"city_name":        "Linköping",
+			"timezone":         "Europe/Stockholm",
+			"location": common.MapStr{
+				"lat": 58.4167,
+				"lon": 15.6167,
+			},
+		},
+		"as": common.MapStr{
+			"number":       uint(29518),
+			"organization": common.MapStr{"name": "Bredband2 AB"},
+		},
+	}
+	assert.Equal(t, expectedSource, evt.Fields["source"])
+
+	v, _ := evt.GetValue("destination.geo.city_name")
+	assert.Equal(t, "London", v)
+	v, _ = evt.GetValue("destination.as.number")
+	assert.Equal(t, uint(20712), v)
+}
+
+func TestCustomFieldsAndNotFound(t *testing.T) {
+	p := newTestGeoIP(t, map[string]interface{}{
+		"database.asn": asnDB,
+		"fields": map[string]interface{}{
+			"client_ip": "client",
+		},
+	})
+
+	evt, err := p.Run(&beat.Event{Fields: common.MapStr{"client_ip": "10.1.1.1"}})
+	require.NoError(t, err)
+	assert.Equal(t, common.MapStr{"client_ip": "10.1.1.1"}, evt.Fields)
+
+	evt, err = p.Run(&beat.Event{Fields: common.MapStr{"client_ip": "81.2.69.1"}})
+	require.NoError(t, err)
+	v, _ := evt.GetValue("client.as.organization.name")
+	assert.Equal(t, "Andrews & Arnold Ltd", v)
+
+	has, _ := evt.Fields.HasKey("client.geo")
+	assert.False(t, has)
+}
+
+func TestInvalidIP(t *testing.T) {
+	p := newTestGeoIP(t, map[string]interface{}{
+		"database.city":  cityDB,
+		"tag_on_failure": []string{"_geoip_lookup_failure"},
+	})
+
+	evt, err := p.Run(&beat.Event{Fields: common.MapStr{
+		"source": common.MapStr{"ip": "not-an-ip"},
+	}})
+	assert.Error(t, err)
+	assert.Equal(t, []string{"_geoip_lookup_failure"}, evt.Fields["tags"])
+}
+
+func TestConfigErrors(t *testing.T) {
+	for _, conf := range []map[string]interface{}{
+		{},
+		{"database.city": "testdata/missing.mmdb"},
+		{"database.city": cityDB, "fields": map[string]interface{}{"a": 1}},
+	} {
+		c, err := common.NewConfigFrom(conf)
+		require.NoError(t, err)
+
+		_, err = New(c)
+		assert.Error(t, err, "%v", conf)
+	}
+}
+
+func TestDatabaseReload(t *testing.T) {
+	dir, err := ioutil.TempDir("", "add_geoip")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "geoip.mmdb")
+	copyFile(t, asnDB, path)
+
+	p := newTestGeoIP(t, map[string]interface{}{
+		"database.city": path,
+		"reload.period": "1s",
+	})
+	now := time.Now()
+	p.city.clock = func() time.Time { return now }
+	assert.Equal(t, "GeoLite2-ASN", p.city.databaseType())
+
+	// Replace the file with a different database.
+	copyFile(t, cityDB, path)
+	require.NoError(t, os.Chtimes(path, now.Add(time.Minute), now.Add(time.Minute)))
+
+	// Not reloaded before the reload period elapsed.
+	p.Run(&beat.Event{Fields: common.MapStr{"source": common.MapStr{"ip": "81.2.69.142"}}})
+	assert.Equal(t, "GeoLite2-ASN", p.city.databaseType())
+
+	now = now.Add(2 * time.Second)
+	evt, err := p.Run(&beat.Event{Fields: common.MapStr{"source": common.MapStr{"ip": "81.2.69.142"}}})
+	require.NoError(t, err)
+	assert.Equal(t, "GeoLite2-City", p.city.databaseType())
+
+	v, _ := evt.GetValue("source.geo.city_name")
+	assert.Equal(t, "London", v)
+}
+
+func copyFile(t *testing.T, src, dst string) {
+	data, err := ioutil.ReadFile(src)
+	require.NoError(t, err)
+	require.NoError(t, ioutil.WriteFile(dst, data, 0644))
+}
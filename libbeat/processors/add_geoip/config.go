@@ -0,0 +1,72 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package add_geoip
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/libbeat/common"
+)
+
+// Config for the add_geoip processor.
+type Config struct {
+	Database struct {
+		City string `config:"city"` // Path to a GeoIP2/GeoLite2 City database.
+		ASN  string `config:"asn"`  // Path to a GeoIP2/GeoLite2 ASN database.
+	} `config:"database"`
+	Fields       common.MapStr `config:"fields"`         // Mapping of source IP fields to target fields.
+	Language     string        `config:"language"`       // Language used for the names of locations.
+	ReloadPeriod time.Duration `config:"reload.period"`  // How often database files are checked for changes.
+	TagOnFailure []string      `config:"tag_on_failure"` // Tags to append when a lookup fails.
+
+	fieldMapping map[string]string
+}
+
+func defaultConfig() Config {
+	return Config{
+		Fields: common.MapStr{
+			"source.ip":      "source",
+			"destination.ip": "destination",
+		},
+		Language:     "en",
+		ReloadPeriod: time.Minute,
+	}
+}
+
+// Validate checks that at least one database is configured and flattens the
+// field mapping.
+func (c *Config) Validate() error {
+	if c.Database.City == "" && c.Database.ASN == "" {
+		return errors.New("at least one of database.city or database.asn must be set")
+	}
+
+	c.fieldMapping = map[string]string{}
+	for k, v := range c.Fields.Flatten() {
+		target, ok := v.(string)
+		if !ok {
+			return errors.Errorf("target field for %v must be a string but got %T", k, v)
+		}
+		c.fieldMapping[k] = target
+	}
+	if len(c.fieldMapping) == 0 {
+		return errors.New("no fields were configured for the geoip lookup")
+	}
+	return nil
+}
@@ -0,0 +1,148 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package add_geoip
+
+import (
+	"net"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/oschwald/maxminddb-golang"
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/libbeat/common/atomic"
+	"github.com/elastic/beats/libbeat/logp"
+)
+
+// database is a MaxMind database file that is reopened when the file on disk
+// changes. Database updates are usually distributed by replacing the file, so
+// the modification time and size are used to detect a new version.
+type database struct {
+	path   string
+	period time.Duration
+	log    *logp.Logger
+
+	mu      sync.RWMutex
+	reader  *maxminddb.Reader
+	modTime time.Time
+	size    int64
+
+	// lastCheck is the time of the last check for changes in Unix
+	// nanoseconds. It is updated atomically so lookups don't need to take
+	// the exclusive lock.
+	lastCheck atomic.Int64
+	clock     func() time.Time
+}
+
+func openDatabase(path string, period time.Duration, log *logp.Logger) (*database, error) {
+	db := &database{
+		path:   path,
+		period: period,
+		log:    log,
+		clock:  time.Now,
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to stat geoip database")
+	}
+	if err := db.open(info); err != nil {
+		return nil, err
+	}
+	db.lastCheck.Store(db.clock().UnixNano())
+	return db, nil
+}
+
+func (db *database) open(info os.FileInfo) error {
+	reader, err := maxminddb.Open(db.path)
+	if err != nil {
+		return errors.Wrapf(err, "failed to open geoip database %v", db.path)
+	}
+
+	db.mu.Lock()
+	old := db.reader
+	db.reader = reader
+	db.modTime = info.ModTime()
+	db.size = info.Size()
+	db.mu.Unlock()
+
+	if old != nil {
+		old.Close()
+	}
+	return nil
+}
+
+// lookup decodes the record of the given IP into result. It returns false if
+// the IP is not part of the database.
+func (db *database) lookup(ip net.IP, result interface{}) (bool, error) {
+	db.reloadIfChanged()
+
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	offset, err := db.reader.LookupOffset(ip)
+	if err != nil {
+		return false, err
+	}
+	if offset == maxminddb.NotFound {
+		return false, nil
+	}
+	return true, db.reader.Decode(offset, result)
+}
+
+// reloadIfChanged reopens the database if the reload period elapsed and the
+// file changed since it was opened. If the new file can not be opened the
+// previous version is kept. Only the caller updating lastCheck checks the
+// file, concurrent lookups continue with the current version.
+func (db *database) reloadIfChanged() {
+	if db.period <= 0 {
+		return
+	}
+
+	now := db.clock().UnixNano()
+	last := db.lastCheck.Load()
+	if time.Duration(now-last) < db.period || !db.lastCheck.CAS(last, now) {
+		return
+	}
+
+	db.mu.RLock()
+	modTime, size := db.modTime, db.size
+	db.mu.RUnlock()
+
+	info, err := os.Stat(db.path)
+	if err != nil {
+		db.log.Warnf("Failed to check geoip database %v for changes: %v", db.path, err)
+		return
+	}
+	if info.ModTime().Equal(modTime) && info.Size() == size {
+		return
+	}
+
+	if err := db.open(info); err != nil {
+		db.log.Errorf("Failed to reload geoip database, keeping the previous version: %v", err)
+		return
+	}
+	db.log.Infof("Reloaded geoip database %v", db.path)
+}
+
+func (db *database) databaseType() string {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+	return db.reader.Metadata.DatabaseType
+}
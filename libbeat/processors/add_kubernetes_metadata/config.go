@@ -31,11 +31,12 @@ type kubeAnnotatorConfig struct {
 	SyncPeriod time.Duration `config:"sync_period"`
 	// Annotations are kept after pod is removed, until they haven't been accessed
 	// for a full `cleanup_timeout`:
-	CleanupTimeout  time.Duration `config:"cleanup_timeout"`
-	Indexers        PluginConfig  `config:"indexers"`
-	Matchers        PluginConfig  `config:"matchers"`
-	DefaultMatchers Enabled       `config:"default_matchers"`
-	DefaultIndexers Enabled       `config:"default_indexers"`
+	CleanupTimeout  time.Duration   `config:"cleanup_timeout"`
+	Indexers        PluginConfig    `config:"indexers"`
+	Matchers        PluginConfig    `config:"matchers"`
+	DefaultMatchers Enabled         `config:"default_matchers"`
+	DefaultIndexers Enabled         `config:"default_indexers"`
+	Resources       resourcesConfig `config:"resources"`
 }
 
 type Enabled struct {
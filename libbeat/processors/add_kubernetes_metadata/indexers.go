@@ -31,6 +31,9 @@ const (
 	PodNameIndexerName   = "pod_name"
 	PodUIDIndexerName    = "pod_uid"
 	IPPortIndexerName    = "ip_port"
+
+	PodAnnotationIndexerName = "pod_annotation"
+	PodLabelIndexerName      = "pod_label"
 )
 
 // Indexer take known pods and generate all the metadata we need to enrich
@@ -277,3 +280,74 @@ func (h *IPPortIndexer) GetIndexes(pod *kubernetes.Pod) []string {
 
 	return hostPorts
 }
+
+// PodMetadataIndexer indexes pods by the values of some of their annotations
+// or labels
+type PodMetadataIndexer struct {
+	keys    []string
+	values  func(pod *kubernetes.Pod) map[string]string
+	metaGen kubernetes.MetaGenerator
+}
+
+// NewPodAnnotationIndexer initializes and returns an indexer for the values
+// of the annotations given in the `annotations` setting
+func NewPodAnnotationIndexer(cfg common.Config, metaGen kubernetes.MetaGenerator) (Indexer, error) {
+	config := struct {
+		Annotations []string `config:"annotations" validate:"required"`
+	}{}
+
+	err := cfg.Unpack(&config)
+	if err != nil {
+		return nil, fmt.Errorf("fail to unpack the `annotations` configuration of `%s` indexer: %s", PodAnnotationIndexerName, err)
+	}
+
+	return &PodMetadataIndexer{
+		keys:    config.Annotations,
+		values:  func(pod *kubernetes.Pod) map[string]string { return pod.GetMetadata().GetAnnotations() },
+		metaGen: metaGen,
+	}, nil
+}
+
+// NewPodLabelIndexer initializes and returns an indexer for the values of
+// the labels given in the `labels` setting
+func NewPodLabelIndexer(cfg common.Config, metaGen kubernetes.MetaGenerator) (Indexer, error) {
+	config := struct {
+		Labels []string `config:"labels" validate:"required"`
+	}{}
+
+	err := cfg.Unpack(&config)
+	if err != nil {
+		return nil, fmt.Errorf("fail to unpack the `labels` configuration of `%s` indexer: %s", PodLabelIndexerName, err)
+	}
+
+	return &PodMetadataIndexer{
+		keys:    config.Labels,
+		values:  func(pod *kubernetes.Pod) map[string]string { return pod.GetMetadata().GetLabels() },
+		metaGen: metaGen,
+	}, nil
+}
+
+// GetMetadata returns metadata for the given pod, indexed by the values of
+// the configured keys
+func (p *PodMetadataIndexer) GetMetadata(pod *kubernetes.Pod) []MetadataIndex {
+	var metadata []MetadataIndex
+	for _, index := range p.GetIndexes(pod) {
+		metadata = append(metadata, MetadataIndex{
+			Index: index,
+			Data:  p.metaGen.PodMetadata(pod),
+		})
+	}
+	return metadata
+}
+
+// GetIndexes returns the values of the configured keys for the given Pod
+func (p *PodMetadataIndexer) GetIndexes(pod *kubernetes.Pod) []string {
+	var indexes []string
+	values := p.values(pod)
+	for _, key := range p.keys {
+		if value := values[key]; value != "" {
+			indexes = append(indexes, value)
+		}
+	}
+	return indexes
+}
@@ -414,3 +414,61 @@ func TestIpPortIndexer(t *testing.T) {
 	expected["container"] = common.MapStr{"name": container}
 	assert.Equal(t, expected.String(), indexers[1].Data.String())
 }
+
+func TestPodAnnotationIndexer(t *testing.T) {
+	cfg := common.MustNewConfigFrom(map[string]interface{}{
+		"annotations": []string{"example.com/routing-key", "other"},
+	})
+	indexer, err := NewPodAnnotationIndexer(*cfg, metagen)
+	assert.NoError(t, err)
+
+	podName := "testpod"
+	ns := "testns"
+	pod := kubernetes.Pod{
+		Metadata: &metav1.ObjectMeta{
+			Name:      &podName,
+			Namespace: &ns,
+			Annotations: map[string]string{
+				"example.com/routing-key": "team-a",
+			},
+		},
+		Spec: &v1.PodSpec{},
+	}
+
+	assert.Equal(t, []string{"team-a"}, indexer.GetIndexes(&pod))
+
+	metadata := indexer.GetMetadata(&pod)
+	assert.Equal(t, 1, len(metadata))
+	assert.Equal(t, "team-a", metadata[0].Index)
+	assert.Equal(t, "testpod", metadata[0].Data["pod"].(common.MapStr)["name"])
+
+	_, err = NewPodAnnotationIndexer(*common.NewConfig(), metagen)
+	assert.Error(t, err)
+}
+
+func TestPodLabelIndexer(t *testing.T) {
+	cfg := common.MustNewConfigFrom(map[string]interface{}{
+		"labels": []string{"app"},
+	})
+	indexer, err := NewPodLabelIndexer(*cfg, metagen)
+	assert.NoError(t, err)
+
+	podName := "testpod"
+	ns := "testns"
+	pod := kubernetes.Pod{
+		Metadata: &metav1.ObjectMeta{
+			Name:      &podName,
+			Namespace: &ns,
+			Labels: map[string]string{
+				"app": "frontend",
+			},
+		},
+		Spec: &v1.PodSpec{},
+	}
+
+	assert.Equal(t, []string{"frontend"}, indexer.GetIndexes(&pod))
+
+	pod.Metadata.Labels = nil
+	assert.Empty(t, indexer.GetIndexes(&pod))
+	assert.Empty(t, indexer.GetMetadata(&pod))
+}
@@ -20,6 +20,7 @@ package add_kubernetes_metadata
 import (
 	"errors"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/elastic/beats/libbeat/beat"
@@ -34,10 +35,12 @@ const (
 )
 
 type kubernetesAnnotator struct {
-	watcher  kubernetes.Watcher
-	indexers *Indexers
-	matchers *Matchers
-	cache    *cache
+	watcher   kubernetes.Watcher
+	indexers  *Indexers
+	matchers  *Matchers
+	cache     *cache
+	resources *resourceMetadata
+	closeOnce sync.Once
 }
 
 func init() {
@@ -48,6 +51,8 @@ func init() {
 	Indexing.AddIndexer(PodUIDIndexerName, NewPodUIDIndexer)
 	Indexing.AddIndexer(ContainerIndexerName, NewContainerIndexer)
 	Indexing.AddIndexer(IPPortIndexerName, NewIPPortIndexer)
+	Indexing.AddIndexer(PodAnnotationIndexerName, NewPodAnnotationIndexer)
+	Indexing.AddIndexer(PodLabelIndexerName, NewPodLabelIndexer)
 	Indexing.AddMatcher(FieldMatcherName, NewFieldMatcher)
 	Indexing.AddMatcher(FieldFormatMatcherName, NewFieldFormatMatcher)
 }
@@ -117,10 +122,11 @@ func newKubernetesAnnotator(cfg *common.Config) (processors.Processor, error) {
 	}
 
 	processor := &kubernetesAnnotator{
-		watcher:  watcher,
-		indexers: indexers,
-		matchers: matchers,
-		cache:    newCache(config.CleanupTimeout),
+		watcher:   watcher,
+		indexers:  indexers,
+		matchers:  matchers,
+		cache:     newCache(config.CleanupTimeout),
+		resources: newResourceMetadata(config.Resources),
 	}
 
 	watcher.AddEventHandler(kubernetes.ResourceEventHandlerFuncs{
@@ -140,6 +146,11 @@ func newKubernetesAnnotator(cfg *common.Config) (processors.Processor, error) {
 		return nil, err
 	}
 
+	if err := processor.resources.start(client, config.Namespace, config.SyncPeriod); err != nil {
+		processor.Close()
+		return nil, err
+	}
+
 	return processor, nil
 }
 
@@ -158,6 +169,14 @@ func (k *kubernetesAnnotator) Run(event *beat.Event) (*beat.Event, error) {
 		"kubernetes": metadata,
 	})
 
+	if k.resources != nil {
+		if meta := k.resources.metadata(metadata); len(meta) > 0 {
+			event.Fields.DeepUpdate(common.MapStr{
+				"kubernetes": meta,
+			})
+		}
+	}
+
 	return event, nil
 }
 
@@ -175,6 +194,15 @@ func (k *kubernetesAnnotator) removePod(pod *kubernetes.Pod) {
 	}
 }
 
+// Close stops the watchers and the pollers of the processor.
+func (k *kubernetesAnnotator) Close() error {
+	k.closeOnce.Do(func() {
+		k.watcher.Stop()
+		k.resources.stop()
+	})
+	return nil
+}
+
 func (*kubernetesAnnotator) String() string {
 	return "add_kubernetes_metadata"
 }
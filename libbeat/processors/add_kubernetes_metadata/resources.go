@@ -0,0 +1,278 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package add_kubernetes_metadata
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ericchiang/k8s"
+
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/common/kubernetes"
+	"github.com/elastic/beats/libbeat/common/safemapstr"
+	"github.com/elastic/beats/libbeat/logp"
+)
+
+const defaultRefreshInterval = 60 * time.Second
+
+// resourcesConfig configures the additional resources used to enrich events
+type resourcesConfig struct {
+	Namespace Enabled                `config:"namespace"`
+	Node      Enabled                `config:"node"`
+	Custom    []customResourceConfig `config:"custom"`
+}
+
+// customResourceConfig configures a custom resource whose objects are added
+// to the events of the pods in the same namespace
+type customResourceConfig struct {
+	Name            string        `config:"name"`
+	Group           string        `config:"group" validate:"required"`
+	Version         string        `config:"version" validate:"required"`
+	Resource        string        `config:"resource" validate:"required"`
+	MatchLabel      string        `config:"match_label"`
+	Fields          []string      `config:"fields"`
+	RefreshInterval time.Duration `config:"refresh_interval" validate:"min=0"`
+}
+
+// customObject is an object of a custom resource, with the data to add to events
+type customObject struct {
+	name string
+	data common.MapStr
+}
+
+// resourceMetadata keeps the metadata of other resources than pods, to
+// enrich the events with the labels of their namespace and node, and with
+// the custom resources in their namespace
+type resourceMetadata struct {
+	sync.RWMutex
+	namespaces map[string]common.MapStr // labels by namespace name
+	nodes      map[string]common.MapStr // labels by node name
+
+	custom []customResourceConfig
+	// objects of each custom resource, by namespace
+	objects []map[string][]customObject
+
+	watchers []kubernetes.Watcher
+	done     chan struct{}
+	wg       sync.WaitGroup
+}
+
+func newResourceMetadata(config resourcesConfig) *resourceMetadata {
+	r := &resourceMetadata{
+		custom:  config.Custom,
+		objects: make([]map[string][]customObject, len(config.Custom)),
+		done:    make(chan struct{}),
+	}
+	if config.Namespace.Enabled {
+		r.namespaces = map[string]common.MapStr{}
+	}
+	if config.Node.Enabled {
+		r.nodes = map[string]common.MapStr{}
+	}
+	for i := range r.custom {
+		if r.custom[i].Name == "" {
+			r.custom[i].Name = r.custom[i].Resource
+		}
+		if r.custom[i].RefreshInterval == 0 {
+			r.custom[i].RefreshInterval = defaultRefreshInterval
+		}
+	}
+	return r
+}
+
+// start starts the watchers and pollers of the configured resources
+func (r *resourceMetadata) start(client *k8s.Client, namespace string, syncTimeout time.Duration) error {
+	if r.namespaces != nil {
+		err := r.watch(client, &kubernetes.Namespace{}, syncTimeout, r.namespaces)
+		if err != nil {
+			return err
+		}
+	}
+
+	if r.nodes != nil {
+		err := r.watch(client, &kubernetes.Node{}, syncTimeout, r.nodes)
+		if err != nil {
+			return err
+		}
+	}
+
+	for i := range r.custom {
+		r.wg.Add(1)
+		go func(i int) {
+			defer r.wg.Done()
+			r.poll(client, namespace, i)
+		}(i)
+	}
+	return nil
+}
+
+// stop stops the watchers and pollers started by start
+func (r *resourceMetadata) stop() {
+	close(r.done)
+	for _, watcher := range r.watchers {
+		watcher.Stop()
+	}
+	r.wg.Wait()
+}
+
+// watch keeps the labels of the objects of a resource updated in labels
+func (r *resourceMetadata) watch(
+	client *k8s.Client,
+	resource kubernetes.Resource,
+	syncTimeout time.Duration,
+	labels map[string]common.MapStr,
+) error {
+	watcher, err := kubernetes.NewWatcher(client, resource, kubernetes.WatchOptions{
+		SyncTimeout: syncTimeout,
+	})
+	if err != nil {
+		return err
+	}
+
+	update := func(obj kubernetes.Resource) {
+		r.Lock()
+		defer r.Unlock()
+		labels[obj.GetMetadata().GetName()] = toMapStr(obj.GetMetadata().GetLabels())
+	}
+	watcher.AddEventHandler(kubernetes.ResourceEventHandlerFuncs{
+		AddFunc:    update,
+		UpdateFunc: update,
+		DeleteFunc: func(obj kubernetes.Resource) {
+			r.Lock()
+			defer r.Unlock()
+			delete(labels, obj.GetMetadata().GetName())
+		},
+	})
+
+	if err := watcher.Start(); err != nil {
+		return err
+	}
+	r.watchers = append(r.watchers, watcher)
+	return nil
+}
+
+// poll periodically lists the objects of a custom resource
+func (r *resourceMetadata) poll(client *k8s.Client, namespace string, i int) {
+	config := r.custom[i]
+	resource := kubernetes.CustomResource{
+		Group:    config.Group,
+		Version:  config.Version,
+		Resource: config.Resource,
+	}
+
+	ticker := time.NewTicker(config.RefreshInterval)
+	defer ticker.Stop()
+
+	for {
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		items, err := kubernetes.ListCustomResources(ctx, client, resource, namespace)
+		cancel()
+		if err != nil {
+			logp.Err("kubernetes: Failed to list custom resource %s: %v", config.Name, err)
+		} else {
+			r.setObjects(i, items)
+		}
+
+		select {
+		case <-r.done:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// setObjects updates the objects of a custom resource
+func (r *resourceMetadata) setObjects(i int, items []common.MapStr) {
+	config := r.custom[i]
+	objects := map[string][]customObject{}
+	for _, item := range items {
+		name, _ := item.GetValue("metadata.name")
+		namespace, _ := item.GetValue("metadata.namespace")
+		nameStr, _ := name.(string)
+		namespaceStr, _ := namespace.(string)
+
+		data := common.MapStr{}
+		if len(config.Fields) == 0 {
+			if spec, err := item.GetValue("spec"); err == nil {
+				data["spec"] = spec
+			}
+		}
+		for _, field := range config.Fields {
+			if value, err := item.GetValue(field); err == nil {
+				data.Put(field, value)
+			}
+		}
+		data["name"] = nameStr
+
+		objects[namespaceStr] = append(objects[namespaceStr], customObject{name: nameStr, data: data})
+	}
+
+	for _, list := range objects {
+		sort.Slice(list, func(i, j int) bool { return list[i].name < list[j].name })
+	}
+
+	r.Lock()
+	defer r.Unlock()
+	r.objects[i] = objects
+}
+
+// metadata returns the metadata of the other resources related to the
+// pod metadata
+func (r *resourceMetadata) metadata(pod common.MapStr) common.MapStr {
+	namespace, _ := pod["namespace"].(string)
+	node, _ := pod.GetValue("node.name")
+	nodeName, _ := node.(string)
+
+	r.RLock()
+	defer r.RUnlock()
+
+	meta := common.MapStr{}
+	if labels := r.namespaces[namespace]; len(labels) > 0 {
+		meta["namespace_labels"] = labels.Clone()
+	}
+	if labels := r.nodes[nodeName]; len(labels) > 0 {
+		meta.Put("node.labels", labels.Clone())
+	}
+
+	for i, config := range r.custom {
+		var label interface{}
+		if config.MatchLabel != "" {
+			label, _ = pod.GetValue("labels." + config.MatchLabel)
+		}
+
+		for _, obj := range r.objects[i][namespace] {
+			if config.MatchLabel == "" || obj.name == label {
+				meta[config.Name] = obj.data.Clone()
+				break
+			}
+		}
+	}
+
+	return meta
+}
+
+func toMapStr(labels map[string]string) common.MapStr {
+	m := common.MapStr{}
+	for k, v := range labels {
+		safemapstr.Put(m, k, v)
+	}
+	return m
+}
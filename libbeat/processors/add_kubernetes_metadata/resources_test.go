@@ -0,0 +1,122 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package add_kubernetes_metadata
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ericchiang/k8s"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/common/atomic"
+)
+
+func TestResourceMetadata(t *testing.T) {
+	resources := newResourceMetadata(resourcesConfig{
+		Namespace: Enabled{true},
+		Node:      Enabled{true},
+		Custom: []customResourceConfig{
+			{Group: "example.com", Version: "v1", Resource: "routes", Fields: []string{"spec.key"}},
+			{Name: "team", Group: "example.com", Version: "v1", Resource: "teams", MatchLabel: "team"},
+		},
+	})
+
+	resources.namespaces["testns"] = common.MapStr{"env": "prod"}
+	resources.nodes["testnode"] = common.MapStr{"zone": "a"}
+	resources.setObjects(0, []common.MapStr{
+		{
+			"metadata": map[string]interface{}{"name": "route1", "namespace": "testns"},
+			"spec":     map[string]interface{}{"key": "k1", "other": "ignored"},
+		},
+		{
+			"metadata": map[string]interface{}{"name": "route2", "namespace": "otherns"},
+			"spec":     map[string]interface{}{"key": "k2"},
+		},
+	})
+	resources.setObjects(1, []common.MapStr{
+		{
+			"metadata": map[string]interface{}{"name": "a", "namespace": "testns"},
+			"spec":     map[string]interface{}{"owner": "alice"},
+		},
+		{
+			"metadata": map[string]interface{}{"name": "b", "namespace": "testns"},
+			"spec":     map[string]interface{}{"owner": "bob"},
+		},
+	})
+
+	pod := common.MapStr{
+		"namespace": "testns",
+		"node":      common.MapStr{"name": "testnode"},
+		"labels":    common.MapStr{"team": "b"},
+	}
+
+	assert.Equal(t, common.MapStr{
+		"namespace_labels": common.MapStr{"env": "prod"},
+		"node": common.MapStr{
+			"labels": common.MapStr{"zone": "a"},
+		},
+		"routes": common.MapStr{
+			"name": "route1",
+			"spec": common.MapStr{"key": "k1"},
+		},
+		"team": common.MapStr{
+			"name": "b",
+			"spec": common.MapStr{"owner": "bob"},
+		},
+	}, resources.metadata(pod))
+
+	// no matching objects for pods without the label, or in other namespaces
+	assert.Equal(t, common.MapStr{
+		"routes": common.MapStr{
+			"name": "route2",
+			"spec": common.MapStr{"key": "k2"},
+		},
+	}, resources.metadata(common.MapStr{"namespace": "otherns"}))
+}
+
+func TestResourceMetadataStop(t *testing.T) {
+	var requests atomic.Int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Inc()
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"items": []}`))
+	}))
+	defer server.Close()
+
+	resources := newResourceMetadata(resourcesConfig{
+		Custom: []customResourceConfig{
+			{Group: "example.com", Version: "v1", Resource: "routes", RefreshInterval: 10 * time.Millisecond},
+		},
+	})
+	require.NoError(t, resources.start(&k8s.Client{Endpoint: server.URL}, "", time.Second))
+
+	for requests.Load() < 2 {
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	// no more requests once the poller is stopped
+	resources.stop()
+	count := requests.Load()
+	time.Sleep(50 * time.Millisecond)
+	assert.Equal(t, count, requests.Load())
+}
@@ -0,0 +1,176 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package add_process_metadata
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/logp"
+	"github.com/elastic/beats/libbeat/processors"
+)
+
+const (
+	processorName = "add_process_metadata"
+)
+
+var (
+	// ErrNoMatch is returned when the event doesn't contain any of the fields
+	// specified in match_pids.
+	ErrNoMatch = errors.New("none of the fields in match_pids found in the event")
+
+	// ErrNoProcess is returned when metadata for a process can't be collected.
+	ErrNoProcess = errors.New("process not found")
+)
+
+func init() {
+	processors.RegisterPlugin(processorName, newProcessMetadataProcessor)
+}
+
+type addProcessMetadata struct {
+	config   config
+	provider processMetadataProvider
+	cache    *common.Cache
+	pods     *podResolver
+	log      *logp.Logger
+}
+
+func newProcessMetadataProcessor(cfg *common.Config) (processors.Processor, error) {
+	config := defaultConfig()
+	if err := cfg.Unpack(&config); err != nil {
+		return nil, errors.Wrapf(err, "fail to unpack the %v configuration", processorName)
+	}
+
+	var pods *podResolver
+	if config.Kubernetes.Enabled {
+		var err error
+		if pods, err = startPodResolver(config.Kubernetes); err != nil {
+			return nil, errors.Wrapf(err, "fail to start the %v pods resolver", processorName)
+		}
+	}
+
+	p := newProcessMetadataProcessorWithProvider(config, sysinfoProvider{
+		hostFS:     config.HostFS,
+		includeEnv: config.IncludeEnv,
+	})
+	p.pods = pods
+	return p, nil
+}
+
+func newProcessMetadataProcessorWithProvider(config config, provider processMetadataProvider) *addProcessMetadata {
+	cache := common.NewCache(config.CacheExpiration, 100)
+	cache.StartJanitor(config.CacheExpiration)
+
+	return &addProcessMetadata{
+		config:   config,
+		provider: provider,
+		cache:    cache,
+		log:      logp.NewLogger(processorName),
+	}
+}
+
+// Run enriches the given event with the metadata of the process whose PID
+// is found in the first available match_pids field.
+func (p *addProcessMetadata) Run(event *beat.Event) (*beat.Event, error) {
+	for _, field := range p.config.MatchPIDs {
+		v, err := event.GetValue(field)
+		if err != nil {
+			continue
+		}
+
+		pid, ok := common.TryToInt(v)
+		if !ok {
+			p.log.Debugf("field %v is not a PID (type=%T, value=%v)", field, v, v)
+			continue
+		}
+
+		meta, err := p.getMetadata(pid)
+		if err != nil {
+			if p.config.IgnoreMissing {
+				return event, nil
+			}
+			return event, errors.Wrapf(ErrNoProcess, "pid %v: %v", pid, err)
+		}
+
+		return event, p.enrich(event, meta)
+	}
+
+	if p.config.IgnoreMissing {
+		return event, nil
+	}
+	return event, ErrNoMatch
+}
+
+func (p *addProcessMetadata) getMetadata(pid int) (*processMetadata, error) {
+	if meta, ok := p.cache.Get(pid).(*processMetadata); ok {
+		return meta, nil
+	}
+
+	meta, err := p.provider.GetProcessMetadata(pid)
+	if err != nil {
+		return nil, err
+	}
+	p.cache.Put(pid, meta)
+	return meta, nil
+}
+
+func (p *addProcessMetadata) enrich(event *beat.Event, meta *processMetadata) error {
+	fields := meta.fields()
+	// pods are resolved on every event, as the pod can be known to the
+	// watcher after the process metadata is cached
+	if p.pods != nil && meta.podUID != "" {
+		if pod, found := p.pods.get(meta.podUID); found {
+			fields.Put("kubernetes.pod.name", pod.name)
+			fields.Put("kubernetes.namespace", pod.namespace)
+		}
+	}
+	if p.config.Target != "" {
+		fields = common.MapStr{p.config.Target: fields}
+	}
+
+	for key, value := range fields.Flatten() {
+		if !p.config.OverwriteKeys {
+			if _, err := event.GetValue(key); err == nil {
+				continue
+			}
+		}
+		if _, err := event.PutValue(key, value); err != nil {
+			return errors.Wrapf(err, "failed to set field %v", key)
+		}
+	}
+	return nil
+}
+
+// Close stops the cache janitor and the pods watcher.
+func (p *addProcessMetadata) Close() error {
+	p.cache.StopJanitor()
+	if p.pods != nil {
+		p.pods.stop()
+	}
+	return nil
+}
+
+func (p *addProcessMetadata) String() string {
+	return fmt.Sprintf("%v=[match_pids=[%v] target=%v include_env=[%v]]",
+		processorName, strings.Join(p.config.MatchPIDs, ", "), p.config.Target,
+		strings.Join(p.config.IncludeEnv, ", "))
+}
@@ -0,0 +1,329 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package add_process_metadata
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	metav1 "github.com/ericchiang/k8s/apis/meta/v1"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/common/kubernetes"
+)
+
+type testProvider map[int]*processMetadata
+
+func (p testProvider) GetProcessMetadata(pid int) (*processMetadata, error) {
+	meta, found := p[pid]
+	if !found {
+		return nil, errors.New("no such process")
+	}
+	return meta, nil
+}
+
+var (
+	startTime = time.Date(2019, 1, 1, 0, 0, 1, 0, time.UTC)
+
+	testProcs = testProvider{
+		1: {
+			name:      "systemd",
+			title:     "/usr/lib/systemd/systemd --switched-root --system",
+			exe:       "/usr/lib/systemd/systemd",
+			args:      []string{"/usr/lib/systemd/systemd", "--switched-root", "--system"},
+			pid:       1,
+			ppid:      0,
+			startTime: startTime,
+		},
+		3: {
+			name:        "nginx",
+			title:       "nginx -g daemon off;",
+			exe:         "/usr/sbin/nginx",
+			cwd:         "/",
+			args:        []string{"nginx", "-g", "daemon off;"},
+			env:         map[string]string{"KUBERNETES_SERVICE_HOST": "10.96.0.1"},
+			pid:         3,
+			ppid:        1,
+			startTime:   startTime,
+			containerID: "b5285682fba7449c86452b89a800609440ecc88a7ba5f2d38bedfb85409b30b1",
+			podUID:      "90d81341-0b9d-11e9-b89e-025000000001",
+		},
+	}
+)
+
+func newTestProcessor(t *testing.T, settings map[string]interface{}) *addProcessMetadata {
+	config := defaultConfig()
+	if err := common.MustNewConfigFrom(settings).Unpack(&config); err != nil {
+		t.Fatal(err)
+	}
+	return newProcessMetadataProcessorWithProvider(config, testProcs)
+}
+
+func TestAddProcessMetadata(t *testing.T) {
+	for _, test := range []struct {
+		description     string
+		config          map[string]interface{}
+		event, expected common.MapStr
+		err             error
+	}{
+		{
+			description: "default fields",
+			config: map[string]interface{}{
+				"match_pids": []string{"system.process.ppid"},
+			},
+			event: common.MapStr{
+				"system": common.MapStr{"process": common.MapStr{"ppid": "1"}},
+			},
+			expected: common.MapStr{
+				"system": common.MapStr{"process": common.MapStr{"ppid": "1"}},
+				"process": common.MapStr{
+					"name":       "systemd",
+					"title":      "/usr/lib/systemd/systemd --switched-root --system",
+					"executable": "/usr/lib/systemd/systemd",
+					"args":       []string{"/usr/lib/systemd/systemd", "--switched-root", "--system"},
+					"pid":        1,
+					"ppid":       0,
+					"start_time": startTime,
+				},
+			},
+		},
+		{
+			description: "container and environment",
+			config: map[string]interface{}{
+				"match_pids": []string{"ppid"},
+				"target":     "parent",
+			},
+			event: common.MapStr{"ppid": 3},
+			expected: common.MapStr{
+				"ppid": 3,
+				"parent": common.MapStr{
+					"process": common.MapStr{
+						"name":              "nginx",
+						"title":             "nginx -g daemon off;",
+						"executable":        "/usr/sbin/nginx",
+						"working_directory": "/",
+						"args":              []string{"nginx", "-g", "daemon off;"},
+						"env":               common.MapStr{"KUBERNETES_SERVICE_HOST": "10.96.0.1"},
+						"pid":               3,
+						"ppid":              1,
+						"start_time":        startTime,
+					},
+					"container":  common.MapStr{"id": "b5285682fba7449c86452b89a800609440ecc88a7ba5f2d38bedfb85409b30b1"},
+					"kubernetes": common.MapStr{"pod": common.MapStr{"uid": "90d81341-0b9d-11e9-b89e-025000000001"}},
+				},
+			},
+		},
+		{
+			description: "keep existing fields",
+			config: map[string]interface{}{
+				"match_pids": []string{"pid"},
+			},
+			event: common.MapStr{
+				"pid":     1,
+				"process": common.MapStr{"name": "init"},
+			},
+			expected: common.MapStr{
+				"pid": 1,
+				"process": common.MapStr{
+					"name":       "init",
+					"title":      "/usr/lib/systemd/systemd --switched-root --system",
+					"executable": "/usr/lib/systemd/systemd",
+					"args":       []string{"/usr/lib/systemd/systemd", "--switched-root", "--system"},
+					"pid":        1,
+					"ppid":       0,
+					"start_time": startTime,
+				},
+			},
+		},
+		{
+			description: "overwrite existing fields",
+			config: map[string]interface{}{
+				"match_pids":     []string{"pid"},
+				"overwrite_keys": true,
+			},
+			event: common.MapStr{
+				"pid":     1,
+				"process": common.MapStr{"name": "init"},
+			},
+			expected: common.MapStr{
+				"pid": 1,
+				"process": common.MapStr{
+					"name":       "systemd",
+					"title":      "/usr/lib/systemd/systemd --switched-root --system",
+					"executable": "/usr/lib/systemd/systemd",
+					"args":       []string{"/usr/lib/systemd/systemd", "--switched-root", "--system"},
+					"pid":        1,
+					"ppid":       0,
+					"start_time": startTime,
+				},
+			},
+		},
+		{
+			description: "missing process ignored",
+			config: map[string]interface{}{
+				"match_pids": []string{"pid"},
+			},
+			event:    common.MapStr{"pid": 42},
+			expected: common.MapStr{"pid": 42},
+		},
+		{
+			description: "missing process",
+			config: map[string]interface{}{
+				"match_pids":     []string{"pid"},
+				"ignore_missing": false,
+			},
+			event:    common.MapStr{"pid": 42},
+			expected: common.MapStr{"pid": 42},
+			err:      ErrNoProcess,
+		},
+		{
+			description: "no match",
+			config: map[string]interface{}{
+				"match_pids":     []string{"pid"},
+				"ignore_missing": false,
+			},
+			event:    common.MapStr{"ppid": 1},
+			expected: common.MapStr{"ppid": 1},
+			err:      ErrNoMatch,
+		},
+	} {
+		t.Run(test.description, func(t *testing.T) {
+			proc := newTestProcessor(t, test.config)
+			defer proc.Close()
+
+			result, err := proc.Run(&beat.Event{Fields: test.event})
+			if test.err != nil {
+				if assert.Error(t, err) {
+					assert.Contains(t, err.Error(), test.err.Error())
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, test.expected, result.Fields)
+		})
+	}
+}
+
+func TestAddProcessMetadataPods(t *testing.T) {
+	proc := newTestProcessor(t, map[string]interface{}{
+		"match_pids": []string{"pid"},
+	})
+	proc.pods = newPodResolver()
+	defer proc.Close()
+
+	uid, name, namespace := "90d81341-0b9d-11e9-b89e-025000000001", "nginx-6db489d4b7-7n2gt", "default"
+	pod := &kubernetes.Pod{
+		Metadata: &metav1.ObjectMeta{
+			Uid:       &uid,
+			Name:      &name,
+			Namespace: &namespace,
+		},
+	}
+
+	// the pod is not known yet
+	result, err := proc.Run(&beat.Event{Fields: common.MapStr{"pid": 3}})
+	assert.NoError(t, err)
+	assert.Equal(t, common.MapStr{"uid": uid}, result.Fields["kubernetes"].(common.MapStr)["pod"])
+
+	proc.pods.addPod(pod)
+	result, err = proc.Run(&beat.Event{Fields: common.MapStr{"pid": 3}})
+	assert.NoError(t, err)
+	assert.Equal(t, common.MapStr{
+		"pod":       common.MapStr{"uid": uid, "name": name},
+		"namespace": namespace,
+	}, result.Fields["kubernetes"])
+
+	proc.pods.deletePod(pod)
+	result, err = proc.Run(&beat.Event{Fields: common.MapStr{"pid": 3}})
+	assert.NoError(t, err)
+	assert.Equal(t, common.MapStr{"uid": uid}, result.Fields["kubernetes"].(common.MapStr)["pod"])
+	assert.NotContains(t, result.Fields["kubernetes"], "namespace")
+}
+
+func TestContainerFromCgroups(t *testing.T) {
+	for _, test := range []struct {
+		description         string
+		cgroups             map[string]string
+		containerID, podUID string
+	}{
+		{
+			description: "not in a container",
+			cgroups: map[string]string{
+				"cpu":     "/user.slice",
+				"memory":  "/user.slice/user-1000.slice",
+				"systemd": "/user.slice/user-1000.slice/session-2.scope",
+			},
+		},
+		{
+			description: "docker",
+			cgroups: map[string]string{
+				"cpu": "/docker/b5285682fba7449c86452b89a800609440ecc88a7ba5f2d38bedfb85409b30b1",
+			},
+			containerID: "b5285682fba7449c86452b89a800609440ecc88a7ba5f2d38bedfb85409b30b1",
+		},
+		{
+			description: "docker with systemd",
+			cgroups: map[string]string{
+				"cpu": "/system.slice/docker-b5285682fba7449c86452b89a800609440ecc88a7ba5f2d38bedfb85409b30b1.scope",
+			},
+			containerID: "b5285682fba7449c86452b89a800609440ecc88a7ba5f2d38bedfb85409b30b1",
+		},
+		{
+			description: "kubernetes",
+			cgroups: map[string]string{
+				"cpu": "/kubepods/besteffort/pod90d81341-0b9d-11e9-b89e-025000000001/b5285682fba7449c86452b89a800609440ecc88a7ba5f2d38bedfb85409b30b1",
+			},
+			containerID: "b5285682fba7449c86452b89a800609440ecc88a7ba5f2d38bedfb85409b30b1",
+			podUID:      "90d81341-0b9d-11e9-b89e-025000000001",
+		},
+		{
+			description: "kubernetes with systemd and containerd",
+			cgroups: map[string]string{
+				"cpu": "/kubepods.slice/kubepods-burstable.slice/kubepods-burstable-pod90d81341_0b9d_11e9_b89e_025000000001.slice/cri-containerd-b5285682fba7449c86452b89a800609440ecc88a7ba5f2d38bedfb85409b30b1.scope",
+			},
+			containerID: "b5285682fba7449c86452b89a800609440ecc88a7ba5f2d38bedfb85409b30b1",
+			podUID:      "90d81341-0b9d-11e9-b89e-025000000001",
+		},
+	} {
+		t.Run(test.description, func(t *testing.T) {
+			containerID, podUID := containerFromCgroups(test.cgroups)
+			assert.Equal(t, test.containerID, containerID)
+			assert.Equal(t, test.podUID, podUID)
+		})
+	}
+}
+
+func TestFilterEnv(t *testing.T) {
+	env := map[string]string{
+		"HOME":                    "/root",
+		"PATH":                    "/usr/bin",
+		"KUBERNETES_SERVICE_HOST": "10.96.0.1",
+		"KUBERNETES_SERVICE_PORT": "443",
+	}
+
+	assert.Equal(t, map[string]string{
+		"PATH":                    "/usr/bin",
+		"KUBERNETES_SERVICE_HOST": "10.96.0.1",
+		"KUBERNETES_SERVICE_PORT": "443",
+	}, filterEnv(env, []string{"PATH", "KUBERNETES_*"}))
+
+	assert.Empty(t, filterEnv(env, []string{"SECRET"}))
+}
@@ -0,0 +1,59 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package add_process_metadata
+
+import (
+	"regexp"
+	"strings"
+)
+
+var (
+	// Matches the container ID in the last element of a cgroup path, like
+	// `<id>`, `docker-<id>.scope`, `cri-containerd-<id>.scope` or
+	// `crio-<id>.scope`.
+	containerIDRegex = regexp.MustCompile(`^(?:[a-z-]+-)?([0-9a-f]{64})(?:\.scope)?$`)
+
+	// Matches the pod UID in a kubepods cgroup path. Paths created by the
+	// systemd cgroup driver use underscores instead of dashes in the UID.
+	podUIDRegex = regexp.MustCompile(`pod([0-9a-f]{8}[-_][0-9a-f]{4}[-_][0-9a-f]{4}[-_][0-9a-f]{4}[-_][0-9a-f]{12})`)
+)
+
+// containerFromCgroups returns the container ID and, for Kubernetes pods, the
+// pod UID found in the cgroup paths of a process. Empty strings are returned
+// if the process is not running in a container.
+func containerFromCgroups(cgroups map[string]string) (containerID, podUID string) {
+	for _, path := range cgroups {
+		if containerID == "" {
+			last := path[strings.LastIndex(path, "/")+1:]
+			if m := containerIDRegex.FindStringSubmatch(last); m != nil {
+				containerID = m[1]
+			}
+		}
+
+		if podUID == "" && strings.Contains(path, "kubepods") {
+			if m := podUIDRegex.FindStringSubmatch(path); m != nil {
+				podUID = strings.Replace(m[1], "_", "-", -1)
+			}
+		}
+
+		if containerID != "" && podUID != "" {
+			break
+		}
+	}
+	return containerID, podUID
+}
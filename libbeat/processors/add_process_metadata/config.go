@@ -0,0 +1,72 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package add_process_metadata
+
+import (
+	"time"
+)
+
+// config for add_process_metadata processor.
+type config struct {
+	// Fields containing the PID of the process to enrich the event with.
+	MatchPIDs []string `config:"match_pids" validate:"nonzero,required"`
+
+	// Prefix for the fields added to the event.
+	Target string `config:"target"`
+
+	// Overwrite existing fields in the event.
+	OverwriteKeys bool `config:"overwrite_keys"`
+
+	// Do not return an error if the process is not found.
+	IgnoreMissing bool `config:"ignore_missing"`
+
+	// Names of the environment variables to add to the event. Shell patterns
+	// like `KUBERNETES_*` are supported. Environment variables are not added
+	// by default, as they can contain secrets.
+	IncludeEnv []string `config:"include_env"`
+
+	// Mount point of the host's filesystem, used to read the process cgroups
+	// when running in a container.
+	HostFS string `config:"system.hostfs"`
+
+	// Time the metadata of a process is cached.
+	CacheExpiration time.Duration `config:"cache.expiration" validate:"min=1"`
+
+	// Kubernetes client settings, used to resolve the name and namespace of
+	// the pod a process belongs to.
+	Kubernetes kubernetesConfig `config:"kubernetes"`
+}
+
+type kubernetesConfig struct {
+	Enabled    bool          `config:"enabled"`
+	InCluster  bool          `config:"in_cluster"`
+	KubeConfig string        `config:"kube_config"`
+	Host       string        `config:"host"`
+	SyncPeriod time.Duration `config:"sync_period"`
+}
+
+func defaultConfig() config {
+	return config{
+		IgnoreMissing:   true,
+		CacheExpiration: time.Minute,
+		Kubernetes: kubernetesConfig{
+			InCluster:  true,
+			SyncPeriod: 10 * time.Minute,
+		},
+	}
+}
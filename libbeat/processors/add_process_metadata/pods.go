@@ -0,0 +1,107 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package add_process_metadata
+
+import (
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/libbeat/common/kubernetes"
+)
+
+type podMetadata struct {
+	name, namespace string
+}
+
+// podResolver keeps the name and namespace of the pods running in the node,
+// indexed by the pod UID found in the process cgroups.
+type podResolver struct {
+	watcher kubernetes.Watcher
+
+	mutex sync.RWMutex
+	pods  map[string]podMetadata
+}
+
+func newPodResolver() *podResolver {
+	return &podResolver{pods: map[string]podMetadata{}}
+}
+
+// startPodResolver watches the pods scheduled in the node.
+func startPodResolver(config kubernetesConfig) (*podResolver, error) {
+	client, err := kubernetes.GetKubernetesClient(config.InCluster, config.KubeConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	watcher, err := kubernetes.NewWatcher(client, &kubernetes.Pod{}, kubernetes.WatchOptions{
+		SyncTimeout: config.SyncPeriod,
+		Node:        kubernetes.DiscoverKubernetesNode(config.Host, config.InCluster, client),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create the pods watcher")
+	}
+
+	r := newPodResolver()
+	r.watcher = watcher
+	watcher.AddEventHandler(kubernetes.ResourceEventHandlerFuncs{
+		AddFunc: func(obj kubernetes.Resource) {
+			r.addPod(obj.(*kubernetes.Pod))
+		},
+		UpdateFunc: func(obj kubernetes.Resource) {
+			r.addPod(obj.(*kubernetes.Pod))
+		},
+		DeleteFunc: func(obj kubernetes.Resource) {
+			r.deletePod(obj.(*kubernetes.Pod))
+		},
+	})
+
+	if err := watcher.Start(); err != nil {
+		return nil, errors.Wrap(err, "failed to start the pods watcher")
+	}
+	return r, nil
+}
+
+func (r *podResolver) addPod(pod *kubernetes.Pod) {
+	meta := pod.GetMetadata()
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.pods[meta.GetUid()] = podMetadata{
+		name:      meta.GetName(),
+		namespace: meta.GetNamespace(),
+	}
+}
+
+func (r *podResolver) deletePod(pod *kubernetes.Pod) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	delete(r.pods, pod.GetMetadata().GetUid())
+}
+
+func (r *podResolver) get(uid string) (podMetadata, bool) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	pod, found := r.pods[uid]
+	return pod, found
+}
+
+func (r *podResolver) stop() {
+	if r.watcher != nil {
+		r.watcher.Stop()
+	}
+}
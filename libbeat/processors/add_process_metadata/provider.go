@@ -0,0 +1,135 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package add_process_metadata
+
+import (
+	"path"
+	"strings"
+	"time"
+
+	"github.com/elastic/go-sysinfo"
+	"github.com/elastic/go-sysinfo/types"
+	"github.com/elastic/gosigar/cgroup"
+
+	"github.com/elastic/beats/libbeat/common"
+)
+
+type processMetadata struct {
+	name, title, exe, cwd string
+	args                  []string
+	env                   map[string]string
+	pid, ppid             int
+	startTime             time.Time
+	containerID, podUID   string
+}
+
+type processMetadataProvider interface {
+	GetProcessMetadata(pid int) (*processMetadata, error)
+}
+
+// sysinfoProvider reads the process metadata from the host, using go-sysinfo
+// for the process information and the cgroups to find the container.
+type sysinfoProvider struct {
+	hostFS     string
+	includeEnv []string
+}
+
+func (p sysinfoProvider) GetProcessMetadata(pid int) (*processMetadata, error) {
+	proc, err := sysinfo.Process(pid)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := proc.Info()
+	if err != nil {
+		return nil, err
+	}
+
+	meta := &processMetadata{
+		name:      info.Name,
+		title:     strings.Join(info.Args, " "),
+		exe:       info.Exe,
+		cwd:       info.CWD,
+		args:      info.Args,
+		pid:       info.PID,
+		ppid:      info.PPID,
+		startTime: info.StartTime,
+	}
+
+	if len(p.includeEnv) > 0 {
+		if envProc, ok := proc.(types.Environment); ok {
+			// missing permissions to read the environment are not fatal
+			if env, err := envProc.Environment(); err == nil {
+				meta.env = filterEnv(env, p.includeEnv)
+			}
+		}
+	}
+
+	// processes might not be in any cgroup, e.g. on non-Linux systems
+	if cgroups, err := cgroup.ProcessCgroupPaths(p.hostFS, pid); err == nil {
+		meta.containerID, meta.podUID = containerFromCgroups(cgroups)
+	}
+
+	return meta, nil
+}
+
+// filterEnv returns the environment variables matching any of the patterns.
+func filterEnv(env map[string]string, patterns []string) map[string]string {
+	filtered := map[string]string{}
+	for name, value := range env {
+		for _, pattern := range patterns {
+			if matched, _ := path.Match(pattern, name); matched {
+				filtered[name] = value
+				break
+			}
+		}
+	}
+	return filtered
+}
+
+// fields returns the metadata as event fields.
+func (m *processMetadata) fields() common.MapStr {
+	process := common.MapStr{
+		"name":       m.name,
+		"title":      m.title,
+		"executable": m.exe,
+		"args":       m.args,
+		"pid":        m.pid,
+		"ppid":       m.ppid,
+		"start_time": m.startTime,
+	}
+	if m.cwd != "" {
+		process["working_directory"] = m.cwd
+	}
+	if len(m.env) > 0 {
+		env := common.MapStr{}
+		for k, v := range m.env {
+			env[k] = v
+		}
+		process["env"] = env
+	}
+
+	fields := common.MapStr{"process": process}
+	if m.containerID != "" {
+		fields.Put("container.id", m.containerID)
+	}
+	if m.podUID != "" {
+		fields.Put("kubernetes.pod.uid", m.podUID)
+	}
+	return fields
+}
@@ -20,6 +20,8 @@ package processors
 import (
 	"fmt"
 
+	"github.com/joeshaw/multierror"
+
 	"github.com/elastic/beats/libbeat/beat"
 	"github.com/elastic/beats/libbeat/common"
 	"github.com/elastic/beats/libbeat/conditions"
@@ -85,6 +87,11 @@ func (r *WhenProcessor) Run(event *beat.Event) (*beat.Event, error) {
 	return r.p.Run(event)
 }
 
+// Close closes the wrapped processor.
+func (r *WhenProcessor) Close() error {
+	return Close(r.p)
+}
+
 func (r *WhenProcessor) String() string {
 	return fmt.Sprintf("%v, condition=%v", r.p.String(), r.condition.String())
 }
@@ -108,3 +115,105 @@ func addCondition(
 
 	return NewConditionRule(condConfig, p)
 }
+
+// IfThenElseProcessor executes the `then` processors if the condition is
+// true, and the `else` processors otherwise.
+type IfThenElseProcessor struct {
+	cond conditions.Condition
+	then *Processors
+	els  *Processors
+}
+
+// NewIfElseThenProcessor creates a processor from an `if`, `then` and
+// optional `else` configuration. `then` and `else` are lists of processors,
+// or a single processor, and can contain further if/then/else processors.
+func NewIfElseThenProcessor(cfg map[string]*common.Config) (*IfThenElseProcessor, error) {
+	for key := range cfg {
+		switch key {
+		case "if", "then", "else":
+		default:
+			return nil, fmt.Errorf("unexpected '%v' in if/then/else processor", key)
+		}
+	}
+	if cfg["if"] == nil {
+		return nil, fmt.Errorf("if/then/else processor requires a condition in 'if'")
+	}
+	if cfg["then"] == nil {
+		return nil, fmt.Errorf("if/then/else processor requires 'then'")
+	}
+
+	condConfig := conditions.Config{}
+	if err := cfg["if"].Unpack(&condConfig); err != nil {
+		return nil, err
+	}
+	cond, err := conditions.NewCondition(&condConfig)
+	if err != nil {
+		return nil, err
+	}
+	if cond == nil {
+		return nil, fmt.Errorf("if/then/else processor requires a condition in 'if'")
+	}
+
+	then, err := newProcessorsFrom(cfg["then"])
+	if err != nil {
+		return nil, fmt.Errorf("failed to create 'then' processors: %v", err)
+	}
+
+	var els *Processors
+	if cfg["else"] != nil {
+		els, err = newProcessorsFrom(cfg["else"])
+		if err != nil {
+			return nil, fmt.Errorf("failed to create 'else' processors: %v", err)
+		}
+	}
+
+	return &IfThenElseProcessor{cond, then, els}, nil
+}
+
+// newProcessorsFrom creates processors from a list of processors or a single
+// processor.
+func newProcessorsFrom(cfg *common.Config) (*Processors, error) {
+	var config PluginConfig
+	if cfg.IsArray() {
+		if err := cfg.Unpack(&config); err != nil {
+			return nil, err
+		}
+	} else {
+		var single map[string]*common.Config
+		if err := cfg.Unpack(&single); err != nil {
+			return nil, err
+		}
+		config = PluginConfig{single}
+	}
+	return New(config)
+}
+
+// Run executes the processors of the branch selected by the condition.
+func (p *IfThenElseProcessor) Run(event *beat.Event) (*beat.Event, error) {
+	if p.cond.Check(event) {
+		return p.then.Run(event), nil
+	}
+	if p.els != nil {
+		return p.els.Run(event), nil
+	}
+	return event, nil
+}
+
+// Close closes the processors of both branches.
+func (p *IfThenElseProcessor) Close() error {
+	var errs multierror.Errors
+	for _, procs := range []*Processors{p.then, p.els} {
+		if err := procs.Close(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errs.Err()
+}
+
+func (p *IfThenElseProcessor) String() string {
+	s := fmt.Sprintf("if %v then %v", p.cond.String(), p.then)
+	if p.els != nil {
+		s += fmt.Sprintf(" else %v", p.els)
+	}
+	return s
+}
@@ -0,0 +1,201 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package dedupe
+
+import (
+	"bufio"
+	"container/list"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/libbeat/common/file"
+)
+
+type fingerprint [32]byte
+
+type entry struct {
+	fp       fingerprint
+	lastSeen time.Time
+}
+
+// cache keeps the fingerprints seen within the window, up to maxEntries.
+// Entries are kept in a list ordered by the time they were last seen, so
+// expired and least recently seen entries are removed from the front.
+type cache struct {
+	sync.Mutex
+	window     time.Duration
+	maxEntries int
+	entries    map[fingerprint]*list.Element
+	order      *list.List
+	clock      func() time.Time
+
+	onEvict func(n int)
+}
+
+func newCache(window time.Duration, maxEntries int) *cache {
+	return &cache{
+		window:     window,
+		maxEntries: maxEntries,
+		entries:    map[fingerprint]*list.Element{},
+		order:      list.New(),
+		clock:      time.Now,
+	}
+}
+
+// seen adds the fingerprint to the cache, and reports whether it was already
+// seen within the window. It also reports if a new entry was created.
+func (c *cache) seen(fp fingerprint) (duplicate, created bool) {
+	c.Lock()
+	defer c.Unlock()
+
+	now := c.clock()
+	c.expire(now)
+
+	if elem, found := c.entries[fp]; found {
+		elem.Value.(*entry).lastSeen = now
+		c.order.MoveToBack(elem)
+		return true, false
+	}
+
+	if c.order.Len() >= c.maxEntries {
+		c.remove(c.order.Front())
+		if c.onEvict != nil {
+			c.onEvict(1)
+		}
+	}
+	c.entries[fp] = c.order.PushBack(&entry{fp: fp, lastSeen: now})
+	return false, true
+}
+
+// expire removes the entries not seen within the window.
+func (c *cache) expire(now time.Time) {
+	for elem := c.order.Front(); elem != nil; elem = c.order.Front() {
+		if now.Sub(elem.Value.(*entry).lastSeen) < c.window {
+			return
+		}
+		c.remove(elem)
+	}
+}
+
+func (c *cache) remove(elem *list.Element) {
+	delete(c.entries, elem.Value.(*entry).fp)
+	c.order.Remove(elem)
+}
+
+func (c *cache) len() int {
+	c.Lock()
+	defer c.Unlock()
+	return c.order.Len()
+}
+
+type persistedEntry struct {
+	Fingerprint string    `json:"fingerprint"`
+	LastSeen    time.Time `json:"last_seen"`
+}
+
+// save writes the entries to path, one JSON object per line, ordered by the
+// time they were last seen.
+func (c *cache) save(path string) error {
+	tmp := path + ".new"
+	f, err := os.OpenFile(tmp, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return errors.Wrap(err, "failed to create fingerprints file")
+	}
+
+	w := bufio.NewWriter(f)
+	enc := json.NewEncoder(w)
+
+	c.Lock()
+	for elem := c.order.Front(); elem != nil; elem = elem.Next() {
+		e := elem.Value.(*entry)
+		err = enc.Encode(persistedEntry{
+			Fingerprint: hex.EncodeToString(e.fp[:]),
+			LastSeen:    e.lastSeen,
+		})
+		if err != nil {
+			break
+		}
+	}
+	c.Unlock()
+
+	if err == nil {
+		err = w.Flush()
+	}
+	if err == nil {
+		err = f.Sync()
+	}
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(tmp)
+		return errors.Wrap(err, "failed to write fingerprints file")
+	}
+
+	return file.SafeFileRotate(path, tmp)
+}
+
+// load reads the entries stored by save. Expired entries are skipped. A
+// missing file is not an error.
+func (c *cache) load(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return errors.Wrap(err, "failed to open fingerprints file")
+	}
+	defer f.Close()
+
+	c.Lock()
+	defer c.Unlock()
+
+	now := c.clock()
+	dec := json.NewDecoder(f)
+	for {
+		var pe persistedEntry
+		if err := dec.Decode(&pe); err != nil {
+			if err == io.EOF {
+				break
+			}
+			return errors.Wrap(err, "failed to read fingerprints file")
+		}
+
+		var fp fingerprint
+		b, err := hex.DecodeString(pe.Fingerprint)
+		if err != nil || len(b) != len(fp) {
+			return errors.Errorf("invalid fingerprint '%v' in fingerprints file", pe.Fingerprint)
+		}
+		copy(fp[:], b)
+
+		if now.Sub(pe.LastSeen) >= c.window || c.entries[fp] != nil {
+			continue
+		}
+		if c.order.Len() >= c.maxEntries {
+			c.remove(c.order.Front())
+		}
+		c.entries[fp] = c.order.PushBack(&entry{fp: fp, lastSeen: pe.LastSeen})
+	}
+	return nil
+}
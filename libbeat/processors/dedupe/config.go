@@ -0,0 +1,60 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package dedupe
+
+import (
+	"time"
+)
+
+// Config for the dedupe processor.
+type Config struct {
+	// Fields are the event fields the fingerprint is computed from.
+	Fields []string `config:"fields" validate:"required"`
+
+	// IgnoreMissing computes the fingerprint from the available fields only,
+	// instead of returning an error if a field is missing.
+	IgnoreMissing bool `config:"ignore_missing"`
+
+	// Window is the time events with the same fingerprint are considered
+	// duplicates. Every duplicate extends the window.
+	Window time.Duration `config:"window" validate:"min=1"`
+
+	// MaxEntries is the maximum number of fingerprints kept. The least
+	// recently seen fingerprints are removed first.
+	MaxEntries int `config:"max_entries" validate:"min=1"`
+
+	// Persist configures storing the fingerprints on disk, so duplicates are
+	// detected across restarts.
+	Persist PersistConfig `config:"persist"`
+}
+
+// PersistConfig configures the file the fingerprints are stored in.
+type PersistConfig struct {
+	Path     string        `config:"path"`
+	Interval time.Duration `config:"interval" validate:"min=1"`
+}
+
+func defaultConfig() Config {
+	return Config{
+		Window:     10 * time.Minute,
+		MaxEntries: 100000,
+		Persist: PersistConfig{
+			Interval: 30 * time.Second,
+		},
+	}
+}
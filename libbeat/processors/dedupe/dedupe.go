@@ -0,0 +1,225 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package dedupe
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/common/atomic"
+	"github.com/elastic/beats/libbeat/logp"
+	"github.com/elastic/beats/libbeat/monitoring"
+	"github.com/elastic/beats/libbeat/paths"
+	"github.com/elastic/beats/libbeat/processors"
+)
+
+const processorName = "dedupe"
+
+func init() {
+	processors.RegisterPlugin(processorName, newDedupe)
+}
+
+type dedupe struct {
+	config Config
+	fields []string
+	cache  *cache
+	log    *logp.Logger
+
+	// dirty is set when the fingerprints changed since they were last
+	// persisted.
+	dirty     atomic.Bool
+	done      chan struct{}
+	closeOnce sync.Once
+	wg        sync.WaitGroup
+
+	dropped    *monitoring.Int
+	evicted    *monitoring.Int
+	entries    *monitoring.Int
+	unregister func()
+}
+
+func newDedupe(cfg *common.Config) (processors.Processor, error) {
+	config := defaultConfig()
+	if err := cfg.Unpack(&config); err != nil {
+		return nil, errors.Wrapf(err, "fail to unpack the %v configuration", processorName)
+	}
+
+	reg, unregister := processors.NewInstanceRegistry(processorName)
+
+	// The fields are sorted so the fingerprint does not depend on the order
+	// they are configured in.
+	set := common.MakeStringSet(config.Fields...)
+	fields := make([]string, 0, len(set))
+	for field := range set {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	if config.Persist.Path != "" {
+		// Relative paths are resolved relative to the data path.
+		config.Persist.Path = paths.Resolve(paths.Data, config.Persist.Path)
+	}
+
+	p := &dedupe{
+		config:     config,
+		fields:     fields,
+		cache:      newCache(config.Window, config.MaxEntries),
+		log:        logp.NewLogger(processorName),
+		dropped:    monitoring.NewInt(reg, "dropped"),
+		evicted:    monitoring.NewInt(reg, "evicted"),
+		entries:    monitoring.NewInt(reg, "entries"),
+		unregister: unregister,
+	}
+	p.cache.onEvict = func(n int) { p.evicted.Add(int64(n)) }
+
+	if config.Persist.Path != "" {
+		if err := p.cache.load(config.Persist.Path); err != nil {
+			unregister()
+			return nil, err
+		}
+		p.entries.Set(int64(p.cache.len()))
+
+		p.done = make(chan struct{})
+		p.wg.Add(1)
+		go p.persistLoop()
+	}
+	return p, nil
+}
+
+// Run drops the event if an event with the same fingerprint has been seen
+// within the window.
+func (p *dedupe) Run(event *beat.Event) (*beat.Event, error) {
+	fp, err := p.fingerprint(event)
+	if err != nil {
+		return event, errors.Wrap(err, "failed to compute fingerprint")
+	}
+
+	duplicate, _ := p.cache.seen(fp)
+	p.entries.Set(int64(p.cache.len()))
+	p.dirty.Store(true)
+
+	if duplicate {
+		p.dropped.Inc()
+		return nil, nil
+	}
+	return event, nil
+}
+
+// fingerprint computes the SHA-256 hash of the configured fields. Every
+// field is written as `|name|value|`.
+func (p *dedupe) fingerprint(event *beat.Event) (fingerprint, error) {
+	var fp fingerprint
+
+	h := sha256.New()
+	for _, field := range p.fields {
+		v, err := event.GetValue(field)
+		if err != nil {
+			if p.config.IgnoreMissing && errors.Cause(err) == common.ErrKeyNotFound {
+				continue
+			}
+			return fp, errors.Wrapf(err, "failed to get field '%v'", field)
+		}
+
+		s, err := encodeValue(v)
+		if err != nil {
+			return fp, errors.Wrapf(err, "failed to encode field '%v'", field)
+		}
+		fmt.Fprintf(h, "|%v|%v", field, s)
+	}
+	io.WriteString(h, "|")
+
+	copy(fp[:], h.Sum(nil))
+	return fp, nil
+}
+
+func encodeValue(v interface{}) (string, error) {
+	switch t := v.(type) {
+	case string:
+		return t, nil
+	case time.Time:
+		return t.UTC().Format(time.RFC3339Nano), nil
+	case common.Time:
+		return time.Time(t).UTC().Format(time.RFC3339Nano), nil
+	}
+
+	// encoding/json writes map keys in sorted order.
+	b, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// persistLoop stores the fingerprints on disk every persist interval, so
+// events are not slowed down by writing the file.
+func (p *dedupe) persistLoop() {
+	defer p.wg.Done()
+
+	ticker := time.NewTicker(p.config.Persist.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-p.done:
+			return
+		case <-ticker.C:
+			p.persist()
+		}
+	}
+}
+
+// persist stores the fingerprints on disk if they changed since they were
+// last stored.
+func (p *dedupe) persist() {
+	if !p.dirty.CAS(true, false) {
+		return
+	}
+
+	path := p.config.Persist.Path
+	if err := p.cache.save(path); err != nil {
+		p.log.Errorf("Failed to persist fingerprints to %v: %v", path, err)
+	}
+}
+
+// Close stops persisting the fingerprints in the background and stores them
+// a last time, then removes the metrics of the processor.
+func (p *dedupe) Close() error {
+	p.closeOnce.Do(func() {
+		if p.done != nil {
+			close(p.done)
+			p.wg.Wait()
+			p.persist()
+		}
+		p.unregister()
+	})
+	return nil
+}
+
+func (p *dedupe) String() string {
+	return fmt.Sprintf("%v=[fields=%v, window=%v, max_entries=%v]",
+		processorName, p.fields, p.config.Window, p.config.MaxEntries)
+}
@@ -0,0 +1,217 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package dedupe
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/monitoring"
+)
+
+type testClock struct{ t time.Time }
+
+func (c *testClock) now() time.Time          { return c.t }
+func (c *testClock) advance(d time.Duration) { c.t = c.t.Add(d) }
+
+func newTestDedupe(t *testing.T, settings map[string]interface{}) (*dedupe, *testClock) {
+	p, err := newDedupe(common.MustNewConfigFrom(settings))
+	require.NoError(t, err)
+
+	clock := &testClock{t: time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)}
+	d := p.(*dedupe)
+	d.cache.clock = clock.now
+	return d, clock
+}
+
+func testEvent(fields common.MapStr) *beat.Event {
+	return &beat.Event{Fields: fields}
+}
+
+func TestDedupe(t *testing.T) {
+	p, clock := newTestDedupe(t, map[string]interface{}{
+		"fields": []string{"message", "host.name"},
+		"window": "1m",
+	})
+
+	event := common.MapStr{"message": "hello", "host": common.MapStr{"name": "a"}}
+
+	out, err := p.Run(testEvent(event.Clone()))
+	require.NoError(t, err)
+	assert.NotNil(t, out)
+
+	// duplicate within the window
+	clock.advance(30 * time.Second)
+	out, err = p.Run(testEvent(event.Clone()))
+	require.NoError(t, err)
+	assert.Nil(t, out)
+
+	// other fields are not part of the fingerprint
+	other := event.Clone()
+	other["offset"] = 10
+	out, _ = p.Run(testEvent(other))
+	assert.Nil(t, out)
+
+	// different value
+	other = event.Clone()
+	other.Put("host.name", "b")
+	out, _ = p.Run(testEvent(other))
+	assert.NotNil(t, out)
+
+	// duplicates extend the window
+	clock.advance(50 * time.Second)
+	out, _ = p.Run(testEvent(event.Clone()))
+	assert.Nil(t, out)
+
+	clock.advance(time.Minute)
+	out, _ = p.Run(testEvent(event.Clone()))
+	assert.NotNil(t, out)
+
+	assert.Equal(t, int64(3), p.dropped.Get())
+}
+
+func TestDedupeMissingFields(t *testing.T) {
+	p, _ := newTestDedupe(t, map[string]interface{}{
+		"fields": []string{"message", "missing"},
+	})
+	_, err := p.Run(testEvent(common.MapStr{"message": "hello"}))
+	assert.Error(t, err)
+
+	p, _ = newTestDedupe(t, map[string]interface{}{
+		"fields":         []string{"message", "missing"},
+		"ignore_missing": true,
+	})
+	out, err := p.Run(testEvent(common.MapStr{"message": "hello"}))
+	assert.NoError(t, err)
+	assert.NotNil(t, out)
+}
+
+func TestDedupeMaxEntries(t *testing.T) {
+	p, _ := newTestDedupe(t, map[string]interface{}{
+		"fields":      []string{"message"},
+		"max_entries": 2,
+	})
+
+	for _, msg := range []string{"a", "b", "c"} {
+		out, _ := p.Run(testEvent(common.MapStr{"message": msg}))
+		assert.NotNil(t, out)
+	}
+	assert.Equal(t, int64(1), p.evicted.Get())
+	assert.Equal(t, int64(2), p.entries.Get())
+
+	// "a" has been evicted
+	out, _ := p.Run(testEvent(common.MapStr{"message": "a"}))
+	assert.NotNil(t, out)
+	out, _ = p.Run(testEvent(common.MapStr{"message": "c"}))
+	assert.Nil(t, out)
+}
+
+func TestDedupePersist(t *testing.T) {
+	dir, err := ioutil.TempDir("", "dedupe")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	settings := common.MustNewConfigFrom(map[string]interface{}{
+		"fields":           []string{"message"},
+		"window":           "10m",
+		"persist.path":     filepath.Join(dir, "fingerprints.json"),
+		"persist.interval": "1h",
+	})
+
+	p, err := newDedupe(settings)
+	require.NoError(t, err)
+	p.Run(testEvent(common.MapStr{"message": "a"}))
+	p.Run(testEvent(common.MapStr{"message": "b"}))
+
+	// closing the processor persists the fingerprints before the interval
+	// has passed
+	require.NoError(t, p.(*dedupe).Close())
+
+	// a restarted processor detects the duplicates
+	p, err = newDedupe(settings)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), p.(*dedupe).entries.Get())
+
+	out, _ := p.Run(testEvent(common.MapStr{"message": "a"}))
+	assert.Nil(t, out)
+	out, _ = p.Run(testEvent(common.MapStr{"message": "b"}))
+	assert.Nil(t, out)
+	out, _ = p.Run(testEvent(common.MapStr{"message": "c"}))
+	assert.NotNil(t, out)
+	assert.NoError(t, p.(*dedupe).Close())
+}
+
+func TestDedupePersistInBackground(t *testing.T) {
+	dir, err := ioutil.TempDir("", "dedupe")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "fingerprints.json")
+	p, err := newDedupe(common.MustNewConfigFrom(map[string]interface{}{
+		"fields":           []string{"message"},
+		"persist.path":     path,
+		"persist.interval": "1s",
+	}))
+	require.NoError(t, err)
+	defer p.(*dedupe).Close()
+
+	p.Run(testEvent(common.MapStr{"message": "a"}))
+
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		if _, err = os.Stat(path); err == nil {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	assert.NoError(t, err)
+}
+
+func TestDedupeCloseRemovesMetrics(t *testing.T) {
+	instances := func() int {
+		reg := monitoring.Default.GetRegistry("processor." + processorName)
+		if reg == nil {
+			return 0
+		}
+		n := 0
+		for name := range monitoring.CollectFlatSnapshot(reg, monitoring.Full, false).Ints {
+			if strings.HasSuffix(name, ".entries") {
+				n++
+			}
+		}
+		return n
+	}
+
+	before := instances()
+	p, _ := newTestDedupe(t, map[string]interface{}{
+		"fields": []string{"message"},
+	})
+	assert.Equal(t, before+1, instances())
+
+	require.NoError(t, p.Close())
+	assert.Equal(t, before, instances())
+}
@@ -0,0 +1,136 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package dns
+
+import (
+	"sync"
+	"time"
+)
+
+type cacheEntry struct {
+	result  *result
+	err     error
+	expires time.Time
+}
+
+// ttlCache is a bounded cache whose entries expire after a fixed point in
+// time. When the cache is full a random entry is evicted.
+type ttlCache struct {
+	data map[string]cacheEntry
+	max  int
+}
+
+func newTTLCache(settings CacheSettings) *ttlCache {
+	return &ttlCache{
+		data: make(map[string]cacheEntry, settings.InitialCapacity),
+		max:  settings.MaxCapacity,
+	}
+}
+
+func (c *ttlCache) get(key string, now time.Time) (cacheEntry, bool) {
+	e, found := c.data[key]
+	if !found {
+		return e, false
+	}
+	if now.After(e.expires) {
+		delete(c.data, key)
+		return e, false
+	}
+	return e, true
+}
+
+func (c *ttlCache) set(key string, e cacheEntry) {
+	if _, found := c.data[key]; !found && len(c.data) >= c.max {
+		// Go map iteration order is random, so this evicts a random entry.
+		for k := range c.data {
+			delete(c.data, k)
+			break
+		}
+	}
+	c.data[key] = e
+}
+
+// cachedResolver caches successful lookups for the TTL of the records and
+// failed lookups for the configured failure TTL.
+type cachedResolver struct {
+	sync.Mutex
+	resolver
+	success    *ttlCache
+	failure    *ttlCache
+	failureTTL time.Duration
+	clock      func() time.Time
+}
+
+func newCachedResolver(r resolver, config CacheConfig) *cachedResolver {
+	return &cachedResolver{
+		resolver:   r,
+		success:    newTTLCache(config.SuccessCache),
+		failure:    newTTLCache(config.FailureCache),
+		failureTTL: config.FailureCache.TTL,
+		clock:      time.Now,
+	}
+}
+
+// LookupPTR performs a cached reverse lookup.
+func (c *cachedResolver) LookupPTR(ip string) (*result, bool, error) {
+	return c.lookup("ptr:"+ip, func() (*result, error) {
+		return c.resolver.LookupPTR(ip)
+	})
+}
+
+// LookupHost performs a cached forward lookup.
+func (c *cachedResolver) LookupHost(host string) (*result, bool, error) {
+	return c.lookup("host:"+host, func() (*result, error) {
+		return c.resolver.LookupHost(host)
+	})
+}
+
+// lookup returns the cached result for key or calls fn. The returned bool
+// reports whether the result came from the cache.
+func (c *cachedResolver) lookup(key string, fn func() (*result, error)) (*result, bool, error) {
+	c.Lock()
+	now := c.clock()
+	if e, found := c.success.get(key, now); found {
+		c.Unlock()
+		return e.result, true, nil
+	}
+	if e, found := c.failure.get(key, now); found {
+		c.Unlock()
+		return nil, true, e.err
+	}
+	c.Unlock()
+
+	// The lock is not held during the query so slow lookups do not block
+	// events having cached results.
+	res, err := fn()
+
+	c.Lock()
+	defer c.Unlock()
+	now = c.clock()
+	if err != nil {
+		if c.failureTTL > 0 {
+			c.failure.set(key, cacheEntry{err: err, expires: now.Add(c.failureTTL)})
+		}
+		return nil, false, err
+	}
+	c.success.set(key, cacheEntry{
+		result:  res,
+		expires: now.Add(time.Duration(res.TTL) * time.Second),
+	})
+	return res, false, nil
+}
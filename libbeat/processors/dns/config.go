@@ -0,0 +1,155 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package dns
+
+import (
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/libbeat/common"
+)
+
+// Config defines the configuration options for the DNS processor.
+type Config struct {
+	CacheConfig  `config:",inline"`
+	Nameservers  []string      `config:"nameservers"`              // Required on Windows. /etc/resolv.conf is used if none are given.
+	Timeout      time.Duration `config:"timeout" validate:"min=0"` // Per request timeout (with 2 nameservers the total timeout would be 2x).
+	Type         lookupType    `config:"type" validate:"required"` // One of reverse or forward.
+	Action       fieldAction   `config:"action"`                   // Append or replace (defaults to append) when target exists.
+	TagOnFailure []string      `config:"tag_on_failure"`           // Tags to append when a failure occurs.
+	Fields       common.MapStr `config:"fields"`                   // Mapping of source fields to target fields.
+	Transport    string        `config:"transport"`                // udp or tcp.
+	fieldMapping map[string]string
+}
+
+// CacheConfig defines the success and failure caching parameters.
+type CacheConfig struct {
+	SuccessCache CacheSettings `config:"success_cache"`
+	FailureCache CacheSettings `config:"failure_cache"`
+}
+
+// CacheSettings define the caching behavior for an individual cache.
+type CacheSettings struct {
+	// TTL value for items in cache. Not used for success because we use TTL
+	// from the DNS record.
+	TTL time.Duration `config:"ttl"`
+
+	// Initial capacity. How much space is allocated at initialization.
+	InitialCapacity int `config:"capacity.initial" validate:"min=0"`
+
+	// Max capacity of the cache. When capacity is reached a random item is
+	// evicted.
+	MaxCapacity int `config:"capacity.max" validate:"min=1"`
+}
+
+type lookupType uint8
+
+const (
+	typeReverse lookupType = iota
+	typeForward
+)
+
+var lookupTypeNames = map[lookupType]string{
+	typeReverse: "reverse",
+	typeForward: "forward",
+}
+
+func (t lookupType) String() string {
+	return lookupTypeNames[t]
+}
+
+// Unpack creates a lookupType from its name.
+func (t *lookupType) Unpack(s string) error {
+	for k, v := range lookupTypeNames {
+		if strings.ToLower(s) == v {
+			*t = k
+			return nil
+		}
+	}
+	return errors.Errorf("invalid dns lookup type '%v'", s)
+}
+
+type fieldAction uint8
+
+const (
+	actionAppend fieldAction = iota
+	actionReplace
+)
+
+var fieldActionNames = map[fieldAction]string{
+	actionAppend:  "append",
+	actionReplace: "replace",
+}
+
+func (a fieldAction) String() string {
+	return fieldActionNames[a]
+}
+
+// Unpack creates a fieldAction from its name.
+func (a *fieldAction) Unpack(s string) error {
+	for k, v := range fieldActionNames {
+		if strings.ToLower(s) == v {
+			*a = k
+			return nil
+		}
+	}
+	return errors.Errorf("invalid dns field action value '%v'", s)
+}
+
+// Validate validates the data contained in the config.
+func (c *Config) Validate() error {
+	// Flatten the mapping of source fields to target fields.
+	c.fieldMapping = map[string]string{}
+	for k, v := range c.Fields.Flatten() {
+		target, ok := v.(string)
+		if !ok {
+			return errors.Errorf("target field for dns %v lookup of %v "+
+				"must be a string but got %T", c.Type, k, v)
+		}
+		c.fieldMapping[k] = target
+	}
+	if len(c.fieldMapping) == 0 {
+		return errors.New("no fields were configured for the dns lookup")
+	}
+
+	switch c.Transport {
+	case "udp", "tcp":
+	default:
+		return errors.Errorf("invalid dns transport '%v' (must be udp or tcp)", c.Transport)
+	}
+
+	return nil
+}
+
+var defaultConfig = Config{
+	CacheConfig: CacheConfig{
+		SuccessCache: CacheSettings{
+			InitialCapacity: 1000,
+			MaxCapacity:     10000,
+		},
+		FailureCache: CacheSettings{
+			TTL:             time.Minute,
+			InitialCapacity: 1000,
+			MaxCapacity:     10000,
+		},
+	},
+	Transport: "udp",
+	Timeout:   500 * time.Millisecond,
+}
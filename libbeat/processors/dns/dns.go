@@ -0,0 +1,183 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package dns
+
+import (
+	"fmt"
+
+	"github.com/joeshaw/multierror"
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/monitoring"
+	"github.com/elastic/beats/libbeat/processors"
+)
+
+const processorName = "dns"
+
+func init() {
+	processors.RegisterPlugin(processorName, New)
+}
+
+type processor struct {
+	Config
+	resolver *cachedResolver
+
+	success    *monitoring.Int
+	failure    *monitoring.Int
+	cacheHits  *monitoring.Int
+	unregister func()
+}
+
+// New constructs a new DNS processor.
+func New(cfg *common.Config) (processors.Processor, error) {
+	c := defaultConfig
+	if err := cfg.Unpack(&c); err != nil {
+		return nil, errors.Wrap(err, "fail to unpack the dns configuration")
+	}
+
+	r, err := newMiekgResolver(c.Timeout, c.Transport, c.Nameservers...)
+	if err != nil {
+		return nil, err
+	}
+
+	return newProcessor(c, r), nil
+}
+
+func newProcessor(c Config, r resolver) *processor {
+	reg, unregister := processors.NewInstanceRegistry(processorName)
+
+	return &processor{
+		Config:     c,
+		resolver:   newCachedResolver(r, c.CacheConfig),
+		success:    monitoring.NewInt(reg, "success"),
+		failure:    monitoring.NewInt(reg, "failure"),
+		cacheHits:  monitoring.NewInt(reg, "cache_hits"),
+		unregister: unregister,
+	}
+}
+
+// Run performs the lookups for all configured fields present in the event.
+func (p *processor) Run(event *beat.Event) (*beat.Event, error) {
+	var errs multierror.Errors
+	for source, target := range p.fieldMapping {
+		if err := p.processField(source, target, event); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if len(errs) > 0 {
+		if len(p.TagOnFailure) > 0 {
+			if event.Fields == nil {
+				event.Fields = common.MapStr{}
+			}
+			common.AddTags(event.Fields, p.TagOnFailure)
+		}
+		return event, errs.Err()
+	}
+	return event, nil
+}
+
+func (p *processor) processField(source, target string, event *beat.Event) error {
+	v, err := event.GetValue(source)
+	if err != nil {
+		// Missing fields are not an error.
+		return nil
+	}
+
+	value, ok := v.(string)
+	if !ok {
+		return nil
+	}
+
+	var (
+		res    *result
+		cached bool
+	)
+	switch p.Type {
+	case typeReverse:
+		res, cached, err = p.resolver.LookupPTR(value)
+	case typeForward:
+		res, cached, err = p.resolver.LookupHost(value)
+	}
+	if cached {
+		p.cacheHits.Inc()
+	}
+	if err != nil {
+		p.failure.Inc()
+		return errors.Wrapf(err, "dns %v lookup of %v (%v) failed", p.Type, source, value)
+	}
+	p.success.Inc()
+
+	return p.setFieldValue(event, target, res.Values)
+}
+
+func (p *processor) setFieldValue(event *beat.Event, target string, values []string) error {
+	var value interface{} = values
+	if len(values) == 1 {
+		value = values[0]
+	}
+
+	if p.Action == actionAppend {
+		if old, err := event.GetValue(target); err == nil {
+			switch v := old.(type) {
+			case string:
+				value = appendUnique([]string{v}, values)
+			case []string:
+				value = appendUnique(v, values)
+			default:
+				return errors.Errorf("cannot append dns result to field %v of type %T", target, old)
+			}
+		}
+	}
+
+	_, err := event.PutValue(target, value)
+	return err
+}
+
+func appendUnique(to []string, values []string) []string {
+	out := make([]string, len(to), len(to)+len(values))
+	copy(out, to)
+	for _, v := range values {
+		if !contains(out, v) {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
+// Close removes the metrics of the processor.
+func (p *processor) Close() error {
+	p.unregister()
+	return nil
+}
+
+func (p *processor) String() string {
+	return fmt.Sprintf("%v=[timeout=%v, nameservers=%v, action=%v, type=%v, fields=%v]",
+		processorName, p.Timeout, p.Nameservers, p.Action, p.Type, p.fieldMapping)
+}
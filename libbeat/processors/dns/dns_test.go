@@ -0,0 +1,256 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package dns
+
+import (
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+)
+
+// stubResolver answers lookups from static data.
+type stubResolver struct {
+	calls int
+}
+
+func (r *stubResolver) LookupPTR(ip string) (*result, error) {
+	r.calls++
+	switch ip {
+	case "192.0.2.1":
+		return &result{Values: []string{"host1.example.com"}, TTL: 60}, nil
+	case "192.0.2.2":
+		return &result{Values: []string{"host2.example.com"}, TTL: 1}, nil
+	}
+	return nil, &dnsError{"dns query failed with response code NXDOMAIN"}
+}
+
+func (r *stubResolver) LookupHost(host string) (*result, error) {
+	r.calls++
+	if strings.HasSuffix(host, "example.com") {
+		return &result{Values: []string{"192.0.2.1", "2001:db8::1"}, TTL: 60}, nil
+	}
+	return nil, &dnsError{"dns query failed with response code NXDOMAIN"}
+}
+
+func newTestProcessor(t *testing.T, conf map[string]interface{}) (*processor, *stubResolver) {
+	c, err := common.NewConfigFrom(conf)
+	require.NoError(t, err)
+
+	config := defaultConfig
+	require.NoError(t, c.Unpack(&config))
+
+	r := &stubResolver{}
+	return newProcessor(config, r), r
+}
+
+func TestReverseLookup(t *testing.T) {
+	p, r := newTestProcessor(t, map[string]interface{}{
+		"type": "reverse",
+		"fields": map[string]interface{}{
+			"source.ip":      "source.domain",
+			"destination.ip": "destination.domain",
+		},
+		"tag_on_failure": []string{"_dns_reverse_lookup_failed"},
+	})
+
+	evt, err := p.Run(&beat.Event{Fields: common.MapStr{
+		"source":      common.MapStr{"ip": "192.0.2.1"},
+		"destination": common.MapStr{"ip": "192.0.2.1"},
+	}})
+	require.NoError(t, err)
+
+	v, _ := evt.GetValue("source.domain")
+	assert.Equal(t, "host1.example.com", v)
+	v, _ = evt.GetValue("destination.domain")
+	assert.Equal(t, "host1.example.com", v)
+
+	// The second lookup of the same IP is served from the cache.
+	assert.Equal(t, 1, r.calls)
+	assert.EqualValues(t, 1, p.cacheHits.Get())
+	assert.EqualValues(t, 2, p.success.Get())
+}
+
+func TestReverseLookupFailure(t *testing.T) {
+	p, _ := newTestProcessor(t, map[string]interface{}{
+		"type":           "reverse",
+		"fields":         map[string]interface{}{"source.ip": "source.domain"},
+		"tag_on_failure": []string{"_dns_reverse_lookup_failed"},
+	})
+
+	evt, err := p.Run(&beat.Event{Fields: common.MapStr{
+		"source": common.MapStr{"ip": "198.51.100.1"},
+	}})
+	assert.Error(t, err)
+	assert.Equal(t, []string{"_dns_reverse_lookup_failed"}, evt.Fields["tags"])
+	assert.EqualValues(t, 1, p.failure.Get())
+
+	has, _ := evt.Fields.HasKey("source.domain")
+	assert.False(t, has)
+}
+
+func TestForwardLookup(t *testing.T) {
+	p, _ := newTestProcessor(t, map[string]interface{}{
+		"type":   "forward",
+		"fields": map[string]interface{}{"url.domain": "destination.ip"},
+	})
+
+	evt, err := p.Run(&beat.Event{Fields: common.MapStr{
+		"url": common.MapStr{"domain": "www.example.com"},
+	}})
+	require.NoError(t, err)
+
+	v, _ := evt.GetValue("destination.ip")
+	assert.Equal(t, []string{"192.0.2.1", "2001:db8::1"}, v)
+}
+
+func TestFieldActions(t *testing.T) {
+	fields := func() common.MapStr {
+		return common.MapStr{
+			"source": common.MapStr{"ip": "192.0.2.1", "domain": "existing.example.com"},
+		}
+	}
+
+	p, _ := newTestProcessor(t, map[string]interface{}{
+		"type":   "reverse",
+		"fields": map[string]interface{}{"source.ip": "source.domain"},
+	})
+	evt, err := p.Run(&beat.Event{Fields: fields()})
+	require.NoError(t, err)
+	v, _ := evt.GetValue("source.domain")
+	assert.Equal(t, []string{"existing.example.com", "host1.example.com"}, v)
+
+	p, _ = newTestProcessor(t, map[string]interface{}{
+		"type":   "reverse",
+		"action": "replace",
+		"fields": map[string]interface{}{"source.ip": "source.domain"},
+	})
+	evt, err = p.Run(&beat.Event{Fields: fields()})
+	require.NoError(t, err)
+	v, _ = evt.GetValue("source.domain")
+	assert.Equal(t, "host1.example.com", v)
+}
+
+func TestMissingFieldIsIgnored(t *testing.T) {
+	p, r := newTestProcessor(t, map[string]interface{}{
+		"type":   "reverse",
+		"fields": map[string]interface{}{"source.ip": "source.domain"},
+	})
+
+	evt, err := p.Run(&beat.Event{Fields: common.MapStr{"message": "hello"}})
+	assert.NoError(t, err)
+	assert.Equal(t, common.MapStr{"message": "hello"}, evt.Fields)
+	assert.Equal(t, 0, r.calls)
+}
+
+func TestConfigValidation(t *testing.T) {
+	for _, conf := range []map[string]interface{}{
+		{"type": "reverse"},
+		{"type": "lookup", "fields": map[string]interface{}{"a": "b"}},
+		{"type": "reverse", "fields": map[string]interface{}{"a": "b"}, "action": "prepend"},
+		{"type": "reverse", "fields": map[string]interface{}{"a": "b"}, "transport": "http"},
+		{"type": "reverse", "fields": map[string]interface{}{"a": 1}},
+	} {
+		c, err := common.NewConfigFrom(conf)
+		require.NoError(t, err)
+
+		config := defaultConfig
+		assert.Error(t, c.Unpack(&config), "%v", conf)
+	}
+}
+
+func TestCachedResolverTTL(t *testing.T) {
+	now := time.Now()
+	stub := &stubResolver{}
+	r := newCachedResolver(stub, defaultConfig.CacheConfig)
+	r.clock = func() time.Time { return now }
+
+	// Success entries expire with the record TTL (1s for 192.0.2.2).
+	_, cached, err := r.LookupPTR("192.0.2.2")
+	require.NoError(t, err)
+	assert.False(t, cached)
+	_, cached, _ = r.LookupPTR("192.0.2.2")
+	assert.True(t, cached)
+
+	now = now.Add(2 * time.Second)
+	_, cached, _ = r.LookupPTR("192.0.2.2")
+	assert.False(t, cached)
+
+	// Failure entries expire with the failure_cache.ttl (1m by default).
+	_, _, err = r.LookupPTR("198.51.100.1")
+	assert.Error(t, err)
+	_, cached, err = r.LookupPTR("198.51.100.1")
+	assert.Error(t, err)
+	assert.True(t, cached)
+
+	now = now.Add(2 * time.Minute)
+	_, cached, _ = r.LookupPTR("198.51.100.1")
+	assert.False(t, cached)
+
+	assert.Equal(t, 4, stub.calls)
+}
+
+func TestCacheEviction(t *testing.T) {
+	c := newTTLCache(CacheSettings{MaxCapacity: 2})
+	expires := time.Now().Add(time.Hour)
+
+	c.set("a", cacheEntry{expires: expires})
+	c.set("b", cacheEntry{expires: expires})
+	c.set("c", cacheEntry{expires: expires})
+	assert.Len(t, c.data, 2)
+
+	_, found := c.get("c", time.Now())
+	assert.True(t, found)
+}
+
+func TestMiekgResolver(t *testing.T) {
+	addr, stop := startTestServer(t)
+	defer stop()
+
+	r, err := newMiekgResolver(time.Second, "udp", addr)
+	require.NoError(t, err)
+
+	res, err := r.LookupPTR("192.0.2.1")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"host1.example.com"}, res.Values)
+	assert.EqualValues(t, 300, res.TTL)
+
+	res, err = r.LookupHost("host1.example.com")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"192.0.2.1"}, res.Values)
+
+	_, err = r.LookupPTR("192.0.2.200")
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "NXDOMAIN")
+	}
+
+	_, err = r.LookupPTR("not-an-ip")
+	assert.Error(t, err)
+}
+
+func TestMiekgResolverAddsPort(t *testing.T) {
+	r, err := newMiekgResolver(time.Second, "udp", "127.0.0.1", "[::1]:5353")
+	require.NoError(t, err)
+	assert.Equal(t, []string{net.JoinHostPort("127.0.0.1", "53"), "[::1]:5353"}, r.servers)
+}
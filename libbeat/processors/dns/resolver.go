@@ -0,0 +1,177 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package dns
+
+import (
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/miekg/dns"
+	"github.com/pkg/errors"
+)
+
+const resolvConf = "/etc/resolv.conf"
+
+// result holds the answers of a successful lookup.
+type result struct {
+	Values []string
+	TTL    uint32
+}
+
+// resolver performs DNS lookups.
+type resolver interface {
+	LookupPTR(ip string) (*result, error)
+	LookupHost(host string) (*result, error)
+}
+
+// dnsError is returned for lookups that received a response indicating a
+// failure (e.g. NXDOMAIN).
+type dnsError struct {
+	err string
+}
+
+func (e *dnsError) Error() string {
+	return e.err
+}
+
+// miekgResolver is a resolver that queries the configured nameservers using
+// github.com/miekg/dns. It is used instead of net.Resolver because it makes
+// the TTL of the records available.
+type miekgResolver struct {
+	client  *dns.Client
+	servers []string
+}
+
+func newMiekgResolver(timeout time.Duration, transport string, servers ...string) (*miekgResolver, error) {
+	if len(servers) == 0 {
+		config, err := dns.ClientConfigFromFile(resolvConf)
+		if err != nil || len(config.Servers) == 0 {
+			return nil, errors.New("no dns nameservers configured and none " +
+				"found in " + resolvConf)
+		}
+		for _, s := range config.Servers {
+			servers = append(servers, net.JoinHostPort(s, config.Port))
+		}
+	}
+
+	// Add port if one was not specified.
+	for i, s := range servers {
+		if _, _, err := net.SplitHostPort(s); err != nil {
+			servers[i] = net.JoinHostPort(s, "53")
+		}
+	}
+
+	return &miekgResolver{
+		client: &dns.Client{
+			Net:     transport,
+			Timeout: timeout,
+		},
+		servers: servers,
+	}, nil
+}
+
+// LookupPTR performs a reverse lookup on the given IP address.
+func (r *miekgResolver) LookupPTR(ip string) (*result, error) {
+	arpa, err := dns.ReverseAddr(ip)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid IP address '%v'", ip)
+	}
+
+	answers, err := r.query(arpa, dns.TypePTR)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &result{}
+	for _, rr := range answers {
+		if ptr, ok := rr.(*dns.PTR); ok {
+			res.add(strings.TrimSuffix(ptr.Ptr, "."), ptr.Hdr.Ttl)
+		}
+	}
+	if len(res.Values) == 0 {
+		return nil, &dnsError{"no PTR record was found in the response"}
+	}
+	return res, nil
+}
+
+// LookupHost performs a forward lookup of the A and AAAA records of the
+// given host name.
+func (r *miekgResolver) LookupHost(host string) (*result, error) {
+	res := &result{}
+	var lastErr error
+	for _, qtype := range []uint16{dns.TypeA, dns.TypeAAAA} {
+		answers, err := r.query(dns.Fqdn(host), qtype)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+
+		for _, rr := range answers {
+			switch v := rr.(type) {
+			case *dns.A:
+				res.add(v.A.String(), v.Hdr.Ttl)
+			case *dns.AAAA:
+				res.add(v.AAAA.String(), v.Hdr.Ttl)
+			}
+		}
+	}
+
+	if len(res.Values) == 0 {
+		if lastErr != nil {
+			return nil, lastErr
+		}
+		return nil, &dnsError{"no A or AAAA record was found in the response"}
+	}
+	return res, nil
+}
+
+// query sends the question to each nameserver until one answers.
+func (r *miekgResolver) query(name string, qtype uint16) ([]dns.RR, error) {
+	m := new(dns.Msg)
+	m.SetQuestion(name, qtype)
+	m.RecursionDesired = true
+
+	var lastErr error
+	for _, server := range r.servers {
+		resp, _, err := r.client.Exchange(m, server)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+
+		if resp.Rcode != dns.RcodeSuccess {
+			rcode, found := dns.RcodeToString[resp.Rcode]
+			if !found {
+				rcode = strconv.Itoa(resp.Rcode)
+			}
+			return nil, &dnsError{"dns query failed with response code " + rcode}
+		}
+		return resp.Answer, nil
+	}
+	return nil, errors.Wrap(lastErr, "dns query failed")
+}
+
+// add appends a value and keeps the lowest TTL of all answers.
+func (r *result) add(value string, ttl uint32) {
+	if len(r.Values) == 0 || ttl < r.TTL {
+		r.TTL = ttl
+	}
+	r.Values = append(r.Values, value)
+}
@@ -0,0 +1,64 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package dns
+
+import (
+	"net"
+	"testing"
+
+	"github.com/miekg/dns"
+	"github.com/stretchr/testify/require"
+)
+
+// startTestServer starts a DNS server on localhost that knows a single PTR
+// and A record.
+func startTestServer(t *testing.T) (string, func()) {
+	mux := dns.NewServeMux()
+	mux.HandleFunc(".", func(w dns.ResponseWriter, req *dns.Msg) {
+		m := new(dns.Msg)
+		m.SetReply(req)
+
+		q := req.Question[0]
+		switch {
+		case q.Qtype == dns.TypePTR && q.Name == "1.2.0.192.in-addr.arpa.":
+			rr, _ := dns.NewRR(q.Name + " 300 IN PTR host1.example.com.")
+			m.Answer = append(m.Answer, rr)
+		case q.Qtype == dns.TypeA && q.Name == "host1.example.com.":
+			rr, _ := dns.NewRR(q.Name + " 300 IN A 192.0.2.1")
+			m.Answer = append(m.Answer, rr)
+		case q.Name == "host1.example.com.":
+		default:
+			m.Rcode = dns.RcodeNameError
+		}
+		w.WriteMsg(m)
+	})
+
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	started := make(chan struct{})
+	server := &dns.Server{
+		PacketConn:        conn,
+		Handler:           mux,
+		NotifyStartedFunc: func() { close(started) },
+	}
+	go server.ActivateAndServe()
+	<-started
+
+	return conn.LocalAddr().String(), func() { server.Shutdown() }
+}
@@ -0,0 +1,97 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package fingerprint
+
+import (
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base32"
+	"encoding/base64"
+	"encoding/hex"
+	"hash"
+	"strings"
+
+	"github.com/OneOfOne/xxhash"
+	"github.com/pkg/errors"
+)
+
+// Config for fingerprint processor.
+type Config struct {
+	Method          hashMethod     `config:"method"`                     // Hash function to use for fingerprinting
+	Fields          []string       `config:"fields" validate:"required"` // Source fields to compute fingerprint from
+	TargetField     string         `config:"target_field"`               // Target field for the fingerprint
+	Encoding        encodingMethod `config:"encoding"`                   // Encoding to use for target field value
+	IgnoreMissing   bool           `config:"ignore_missing"`             // Ignore missing fields?
+	UseAsDocumentID bool           `config:"use_as_document_id"`         // Set the fingerprint as event ID used by Elasticsearch?
+}
+
+func defaultConfig() Config {
+	return Config{
+		Method:        hashMethods["sha256"],
+		TargetField:   "fingerprint",
+		Encoding:      encodings["hex"],
+		IgnoreMissing: false,
+	}
+}
+
+type hashMethod struct {
+	name string
+	new  func() hash.Hash
+}
+
+var hashMethods = map[string]hashMethod{
+	"md5":    {"md5", md5.New},
+	"sha1":   {"sha1", sha1.New},
+	"sha256": {"sha256", sha256.New},
+	"sha384": {"sha384", sha512.New384},
+	"sha512": {"sha512", sha512.New},
+	"xxhash": {"xxhash", func() hash.Hash { return xxhash.New64() }},
+}
+
+// Unpack creates the hashMethod from its name.
+func (m *hashMethod) Unpack(s string) error {
+	method, found := hashMethods[strings.ToLower(s)]
+	if !found {
+		return errors.Errorf("invalid fingerprint method '%v'", s)
+	}
+	*m = method
+	return nil
+}
+
+type encodingMethod struct {
+	name   string
+	encode func([]byte) string
+}
+
+var encodings = map[string]encodingMethod{
+	"hex":    {"hex", hex.EncodeToString},
+	"base32": {"base32", base32.StdEncoding.EncodeToString},
+	"base64": {"base64", base64.StdEncoding.EncodeToString},
+}
+
+// Unpack creates the encodingMethod from its name.
+func (e *encodingMethod) Unpack(s string) error {
+	enc, found := encodings[strings.ToLower(s)]
+	if !found {
+		return errors.Errorf("invalid fingerprint encoding '%v'", s)
+	}
+	*e = enc
+	return nil
+}
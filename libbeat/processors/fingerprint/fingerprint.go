@@ -0,0 +1,131 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package fingerprint
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/processors"
+)
+
+const processorName = "fingerprint"
+
+func init() {
+	processors.RegisterPlugin(processorName, New)
+}
+
+type fingerprint struct {
+	config Config
+	fields []string
+}
+
+// New constructs a new fingerprint processor.
+func New(cfg *common.Config) (processors.Processor, error) {
+	config := defaultConfig()
+	if err := cfg.Unpack(&config); err != nil {
+		return nil, errors.Wrapf(err, "fail to unpack the %v configuration", processorName)
+	}
+
+	// The fields are sorted so the fingerprint does not depend on the order
+	// they are configured in.
+	set := common.MakeStringSet(config.Fields...)
+	fields := make([]string, 0, len(set))
+	for field := range set {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	p := &fingerprint{
+		config: config,
+		fields: fields,
+	}
+	return p, nil
+}
+
+// Run computes the fingerprint of the configured fields and writes it to the
+// target field.
+func (p *fingerprint) Run(event *beat.Event) (*beat.Event, error) {
+	h := p.config.Method.new()
+
+	if err := p.writeFields(h, event); err != nil {
+		return event, errors.Wrap(err, "failed to compute fingerprint")
+	}
+
+	fp := p.config.Encoding.encode(h.Sum(nil))
+	if _, err := event.PutValue(p.config.TargetField, fp); err != nil {
+		return event, errors.Wrap(err, "failed to set fingerprint field")
+	}
+	if p.config.UseAsDocumentID {
+		event.SetID(fp)
+	}
+	return event, nil
+}
+
+// writeFields writes a deterministic representation of the configured fields
+// to w. Every field is written as `|name|value|`.
+func (p *fingerprint) writeFields(w io.Writer, event *beat.Event) error {
+	for _, field := range p.fields {
+		v, err := event.GetValue(field)
+		if err != nil {
+			if p.config.IgnoreMissing && errors.Cause(err) == common.ErrKeyNotFound {
+				continue
+			}
+			return errors.Wrapf(err, "failed to get field '%v'", field)
+		}
+
+		s, err := encodeValue(v)
+		if err != nil {
+			return errors.Wrapf(err, "failed to encode field '%v'", field)
+		}
+		fmt.Fprintf(w, "|%v|%v", field, s)
+	}
+	io.WriteString(w, "|")
+	return nil
+}
+
+func encodeValue(v interface{}) (string, error) {
+	switch t := v.(type) {
+	case string:
+		return t, nil
+	case time.Time:
+		return t.UTC().Format(time.RFC3339Nano), nil
+	case common.Time:
+		return time.Time(t).UTC().Format(time.RFC3339Nano), nil
+	}
+
+	// encoding/json writes map keys in sorted order.
+	b, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+func (p *fingerprint) String() string {
+	return fmt.Sprintf("%v=[method=%v, fields=%v, target_field=%v, use_as_document_id=%v]",
+		processorName, p.config.Method.name, p.fields, p.config.TargetField,
+		p.config.UseAsDocumentID)
+}
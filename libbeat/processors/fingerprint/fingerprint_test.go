@@ -0,0 +1,169 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package fingerprint
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+)
+
+func newTestFingerprint(t *testing.T, conf map[string]interface{}) *fingerprint {
+	c, err := common.NewConfigFrom(conf)
+	require.NoError(t, err)
+
+	p, err := New(c)
+	require.NoError(t, err)
+	return p.(*fingerprint)
+}
+
+func testFields() common.MapStr {
+	return common.MapStr{
+		"message": "hello world",
+		"source": common.MapStr{
+			"ip":   "10.0.0.1",
+			"port": 5000,
+		},
+	}
+}
+
+func TestFingerprintMethods(t *testing.T) {
+	expectedLengths := map[string]int{
+		"md5":    32,
+		"sha1":   40,
+		"sha256": 64,
+		"sha384": 96,
+		"sha512": 128,
+		"xxhash": 16,
+	}
+
+	for method, length := range expectedLengths {
+		t.Run(method, func(t *testing.T) {
+			p := newTestFingerprint(t, map[string]interface{}{
+				"fields": []string{"message", "source.ip"},
+				"method": method,
+			})
+
+			evt, err := p.Run(&beat.Event{Fields: testFields()})
+			require.NoError(t, err)
+
+			v, err := evt.GetValue("fingerprint")
+			require.NoError(t, err)
+			assert.Len(t, v, length)
+		})
+	}
+}
+
+func TestFingerprintIsStable(t *testing.T) {
+	a := newTestFingerprint(t, map[string]interface{}{
+		"fields": []string{"message", "source"},
+	})
+	b := newTestFingerprint(t, map[string]interface{}{
+		"fields": []string{"source", "message", "message"},
+	})
+
+	evtA, err := a.Run(&beat.Event{Fields: testFields()})
+	require.NoError(t, err)
+	evtB, err := b.Run(&beat.Event{Fields: testFields()})
+	require.NoError(t, err)
+	assert.Equal(t, evtA.Fields["fingerprint"], evtB.Fields["fingerprint"])
+
+	fields := testFields()
+	fields.Put("source.port", 5001)
+	evtC, err := a.Run(&beat.Event{Fields: fields})
+	require.NoError(t, err)
+	assert.NotEqual(t, evtA.Fields["fingerprint"], evtC.Fields["fingerprint"])
+}
+
+func TestFieldBoundaries(t *testing.T) {
+	p := newTestFingerprint(t, map[string]interface{}{
+		"fields": []string{"a", "b"},
+	})
+
+	evt1, err := p.Run(&beat.Event{Fields: common.MapStr{"a": "xy", "b": "z"}})
+	require.NoError(t, err)
+	evt2, err := p.Run(&beat.Event{Fields: common.MapStr{"a": "x", "b": "yz"}})
+	require.NoError(t, err)
+	assert.NotEqual(t, evt1.Fields["fingerprint"], evt2.Fields["fingerprint"])
+}
+
+func TestTimestamp(t *testing.T) {
+	p := newTestFingerprint(t, map[string]interface{}{
+		"fields": []string{"@timestamp", "message"},
+	})
+
+	ts := time.Date(2018, 7, 1, 12, 0, 0, 0, time.UTC)
+	evt1, err := p.Run(&beat.Event{Timestamp: ts, Fields: testFields()})
+	require.NoError(t, err)
+	evt2, err := p.Run(&beat.Event{Timestamp: ts.In(time.FixedZone("X", 3600)), Fields: testFields()})
+	require.NoError(t, err)
+	assert.Equal(t, evt1.Fields["fingerprint"], evt2.Fields["fingerprint"])
+}
+
+func TestMissingFields(t *testing.T) {
+	p := newTestFingerprint(t, map[string]interface{}{
+		"fields": []string{"message", "missing"},
+	})
+	evt, err := p.Run(&beat.Event{Fields: testFields()})
+	assert.Error(t, err)
+	assert.Nil(t, evt.Fields["fingerprint"])
+
+	p = newTestFingerprint(t, map[string]interface{}{
+		"fields":         []string{"message", "missing"},
+		"ignore_missing": true,
+	})
+	evt, err = p.Run(&beat.Event{Fields: testFields()})
+	assert.NoError(t, err)
+	assert.NotNil(t, evt.Fields["fingerprint"])
+}
+
+func TestTargetAndEncoding(t *testing.T) {
+	p := newTestFingerprint(t, map[string]interface{}{
+		"fields":             []string{"message"},
+		"target_field":       "event.hash",
+		"encoding":           "base64",
+		"use_as_document_id": true,
+	})
+
+	evt, err := p.Run(&beat.Event{Fields: testFields()})
+	require.NoError(t, err)
+
+	v, err := evt.GetValue("event.hash")
+	require.NoError(t, err)
+	assert.Len(t, v, 44)
+	assert.Equal(t, v, evt.Meta["id"])
+}
+
+func TestInvalidConfig(t *testing.T) {
+	for _, conf := range []map[string]interface{}{
+		{},
+		{"fields": []string{"message"}, "method": "crc32"},
+		{"fields": []string{"message"}, "encoding": "base58"},
+	} {
+		c, err := common.NewConfigFrom(conf)
+		require.NoError(t, err)
+
+		_, err = New(c)
+		assert.Error(t, err, "%v", conf)
+	}
+}
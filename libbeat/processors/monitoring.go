@@ -0,0 +1,48 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package processors
+
+import (
+	"strconv"
+	"sync"
+
+	"github.com/elastic/beats/libbeat/monitoring"
+)
+
+var (
+	instancesMu sync.Mutex
+	instances   = map[string]int{}
+)
+
+// NewInstanceRegistry creates the registry holding the metrics of a single
+// instance of the named processor, registered as processor.<name>.<id>. The
+// returned function removes the registry once the instance is closed.
+func NewInstanceRegistry(name string) (*monitoring.Registry, func()) {
+	instancesMu.Lock()
+	defer instancesMu.Unlock()
+
+	parent := monitoring.Default.GetRegistry("processor." + name)
+	if parent == nil {
+		parent = monitoring.Default.NewRegistry("processor." + name)
+	}
+
+	instances[name]++
+	id := strconv.Itoa(instances[name])
+	reg := parent.NewRegistry(id)
+	return reg, func() { parent.Remove(id) }
+}
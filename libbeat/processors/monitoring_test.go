@@ -0,0 +1,54 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package processors
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elastic/beats/libbeat/monitoring"
+)
+
+func TestNewInstanceRegistry(t *testing.T) {
+	const name = "test_instance_registry"
+
+	var wg sync.WaitGroup
+	regs := make([]*monitoring.Registry, 10)
+	for i := range regs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			regs[i], _ = NewInstanceRegistry(name)
+		}(i)
+	}
+	wg.Wait()
+
+	seen := map[*monitoring.Registry]bool{}
+	for _, reg := range regs {
+		assert.NotNil(t, reg)
+		assert.False(t, seen[reg])
+		seen[reg] = true
+	}
+
+	reg, unregister := NewInstanceRegistry(name)
+	assert.Equal(t, reg, monitoring.Default.GetRegistry("processor."+name+".11"))
+	unregister()
+	assert.Nil(t, monitoring.Default.GetRegistry("processor."+name+".11"))
+}
@@ -21,11 +21,13 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"sync"
 
 	"github.com/elastic/beats/libbeat/common"
 )
 
 type Namespace struct {
+	mu  sync.RWMutex
 	reg map[string]pluginer
 }
 
@@ -53,6 +55,9 @@ func (ns *Namespace) Register(name string, factory Constructor) error {
 }
 
 func (ns *Namespace) add(names []string, p pluginer) error {
+	ns.mu.Lock()
+	defer ns.mu.Unlock()
+
 	name := names[0]
 
 	// register plugin if intermediate node in path being processed
@@ -105,7 +110,7 @@ func (ns *Namespace) Plugin() Constructor {
 			return nil, errors.New("No lookup module configured")
 		}
 
-		backend, found := ns.reg[section]
+		backend, found := ns.lookup(section)
 		if !found {
 			return nil, fmt.Errorf("Unknown lookup module: %v", section)
 		}
@@ -120,4 +125,13 @@ func (ns *Namespace) Plugin() Constructor {
 	})
 }
 
+// lookup returns the plugin or namespace registered with the given name.
+func (ns *Namespace) lookup(name string) (pluginer, bool) {
+	ns.mu.RLock()
+	defer ns.mu.RUnlock()
+
+	p, found := ns.reg[name]
+	return p, found
+}
+
 func (p plugin) Plugin() Constructor { return p.c }
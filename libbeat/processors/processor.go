@@ -19,8 +19,11 @@ package processors
 
 import (
 	"fmt"
+	"io"
 	"strings"
 
+	"github.com/joeshaw/multierror"
+
 	"github.com/elastic/beats/libbeat/beat"
 	"github.com/elastic/beats/libbeat/common"
 	"github.com/elastic/beats/libbeat/logp"
@@ -40,6 +43,16 @@ func New(config PluginConfig) (*Processors, error) {
 
 	for _, processor := range config {
 
+		// The if/then/else processor is the only one with multiple keys.
+		if _, found := processor["if"]; found {
+			p, err := NewIfElseThenProcessor(processor)
+			if err != nil {
+				return nil, err
+			}
+			procs.add(p)
+			continue
+		}
+
 		if len(processor) != 1 {
 			return nil, fmt.Errorf("each processor needs to have exactly one action, but found %d actions",
 				len(processor))
@@ -47,7 +60,7 @@ func New(config PluginConfig) (*Processors, error) {
 
 		for processorName, cfg := range processor {
 
-			gen, exists := registry.reg[processorName]
+			gen, exists := registry.lookup(processorName)
 			if !exists {
 				return nil, fmt.Errorf("the processor %s doesn't exist", processorName)
 			}
@@ -71,6 +84,30 @@ func (procs *Processors) add(p Processor) {
 	procs.List = append(procs.List, p)
 }
 
+// Close closes the processors that implement io.Closer, like processors
+// running background tasks.
+func (procs *Processors) Close() error {
+	if procs == nil {
+		return nil
+	}
+
+	var errs multierror.Errors
+	for _, p := range procs.List {
+		if err := Close(p); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errs.Err()
+}
+
+// Close closes the processor if it implements io.Closer.
+func Close(p Processor) error {
+	if closer, ok := p.(io.Closer); ok {
+		return closer.Close()
+	}
+	return nil
+}
+
 // RunBC (run backwards-compatible) applies the processors, by providing the
 // old interface based on common.MapStr.
 // The event us temporarily converted to beat.Event. By this 'conversion' the
@@ -618,3 +618,76 @@ func TestDropMissingFields(t *testing.T) {
 
 	assert.Equal(t, expectedEvent, processedEvent.Fields)
 }
+
+func TestIfThenElse(t *testing.T) {
+	logp.TestingSetup()
+
+	yml := []map[string]interface{}{
+		{
+			"if": map[string]interface{}{
+				"equals.type": "process",
+			},
+			"then": []map[string]interface{}{
+				{"drop_fields": map[string]interface{}{"fields": []string{"a"}}},
+			},
+			"else": []map[string]interface{}{
+				{
+					"if": map[string]interface{}{
+						"equals.type": "drop",
+					},
+					"then": map[string]interface{}{"drop_event": nil},
+					"else": []map[string]interface{}{
+						{"drop_fields": map[string]interface{}{"fields": []string{"b"}}},
+					},
+				},
+			},
+		},
+	}
+
+	processors := GetProcessors(t, yml)
+
+	event := processors.Run(&beat.Event{Fields: common.MapStr{"type": "process", "a": 1, "b": 2}})
+	assert.Equal(t, common.MapStr{"type": "process", "b": 2}, event.Fields)
+
+	event = processors.Run(&beat.Event{Fields: common.MapStr{"type": "other", "a": 1, "b": 2}})
+	assert.Equal(t, common.MapStr{"type": "other", "a": 1}, event.Fields)
+
+	event = processors.Run(&beat.Event{Fields: common.MapStr{"type": "drop", "a": 1, "b": 2}})
+	assert.Nil(t, event)
+}
+
+func TestIfThenElseBadConfig(t *testing.T) {
+	tests := map[string]map[string]interface{}{
+		"missing then": {
+			"if": map[string]interface{}{"equals.type": "process"},
+		},
+		"unknown key": {
+			"if":    map[string]interface{}{"equals.type": "process"},
+			"then":  map[string]interface{}{"drop_event": nil},
+			"other": map[string]interface{}{"drop_event": nil},
+		},
+		"empty condition": {
+			"if":   map[string]interface{}{},
+			"then": map[string]interface{}{"drop_event": nil},
+		},
+		"null condition": {
+			"if":   nil,
+			"then": map[string]interface{}{"drop_event": nil},
+		},
+	}
+
+	for name, yml := range tests {
+		t.Run(name, func(t *testing.T) {
+			c := map[string]*common.Config{}
+			for key, v := range yml {
+				if v == nil {
+					c[key] = nil
+					continue
+				}
+				c[key] = common.MustNewConfigFrom(v)
+			}
+			_, err := processors.New(processors.PluginConfig{c})
+			assert.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,125 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package rate_limit
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Config for the rate_limit processor.
+type Config struct {
+	// Limit is the maximum rate of events, given as `<count>/<unit>` where
+	// unit is one of s, m or h (e.g. `100/s`).
+	Limit rate `config:"limit" validate:"required"`
+
+	// Burst is the number of events allowed to pass in excess of the limit
+	// after a quiet period. Defaults to the per second rate.
+	Burst int `config:"burst" validate:"min=0"`
+
+	// Fields are the event fields whose values are used to build the key
+	// rate limits are tracked by. If empty, a single limit applies to all
+	// events.
+	Fields []string `config:"fields"`
+
+	// Action taken for events exceeding the limit: drop, tag or throttle.
+	Action action `config:"action"`
+
+	// Tag added to events exceeding the limit when action is tag.
+	Tag string `config:"tag"`
+
+	// GCInterval is the interval at which idle keys are removed.
+	GCInterval time.Duration `config:"gc_interval" validate:"min=0"`
+}
+
+func defaultConfig() Config {
+	return Config{
+		Action:     actionDrop,
+		Tag:        "_rate_limited",
+		GCInterval: time.Minute,
+	}
+}
+
+func (c *Config) Validate() error {
+	if c.Action == actionTag && c.Tag == "" {
+		return errors.New("a tag must be set when action is 'tag'")
+	}
+	return nil
+}
+
+type action uint8
+
+const (
+	actionDrop action = iota
+	actionTag
+	actionThrottle
+)
+
+var actionNames = map[action]string{
+	actionDrop:     "drop",
+	actionTag:      "tag",
+	actionThrottle: "throttle",
+}
+
+func (a action) String() string {
+	return actionNames[a]
+}
+
+// Unpack creates an action from its name.
+func (a *action) Unpack(s string) error {
+	for k, v := range actionNames {
+		if strings.ToLower(s) == v {
+			*a = k
+			return nil
+		}
+	}
+	return errors.Errorf("invalid rate_limit action '%v' (must be drop, tag or throttle)", s)
+}
+
+// rate is a number of events per second.
+type rate float64
+
+var rateUnits = map[string]time.Duration{
+	"s": time.Second,
+	"m": time.Minute,
+	"h": time.Hour,
+}
+
+// Unpack parses a rate given as `<count>/<unit>`.
+func (r *rate) Unpack(s string) error {
+	parts := strings.Split(strings.TrimSpace(s), "/")
+	if len(parts) != 2 {
+		return errors.Errorf("invalid rate limit '%v' (expected format <count>/<unit>)", s)
+	}
+
+	count, err := strconv.ParseFloat(strings.TrimSpace(parts[0]), 64)
+	if err != nil || count <= 0 {
+		return errors.Errorf("invalid rate limit count in '%v'", s)
+	}
+
+	unit, found := rateUnits[strings.TrimSpace(parts[1])]
+	if !found {
+		return errors.Errorf("invalid rate limit unit in '%v' (must be s, m or h)", s)
+	}
+
+	*r = rate(count / unit.Seconds())
+	return nil
+}
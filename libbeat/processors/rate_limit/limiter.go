@@ -0,0 +1,147 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package rate_limit
+
+import (
+	"sync"
+	"time"
+)
+
+// bucket is a token bucket refilled at a fixed rate.
+type bucket struct {
+	tokens     float64
+	lastRefill time.Time
+}
+
+// limiter tracks one token bucket per key.
+type limiter struct {
+	sync.Mutex
+	rate     float64
+	burst    float64
+	buckets  map[string]*bucket
+	clock    func() time.Time
+	sleep    func(time.Duration)
+	gcEvery  time.Duration
+	lastGC   time.Time
+	onRemove func(n int)
+}
+
+func newLimiter(r rate, burst int, gcEvery time.Duration) *limiter {
+	b := float64(burst)
+	if b == 0 {
+		b = float64(r)
+	}
+	if b < 1 {
+		b = 1
+	}
+
+	return &limiter{
+		rate:    float64(r),
+		burst:   b,
+		buckets: map[string]*bucket{},
+		clock:   time.Now,
+		sleep:   time.Sleep,
+		gcEvery: gcEvery,
+	}
+}
+
+// allow consumes a token for the given key and reports whether the event is
+// within the limit. It also reports if a new key was created.
+func (l *limiter) allow(key string) (allowed, created bool) {
+	l.Lock()
+	defer l.Unlock()
+
+	now := l.clock()
+	l.gc(now)
+
+	b, found := l.buckets[key]
+	if !found {
+		b = &bucket{tokens: l.burst, lastRefill: now}
+		l.buckets[key] = b
+		created = true
+	} else {
+		b.refill(now, l.rate, l.burst)
+	}
+
+	if b.tokens < 1 {
+		return false, created
+	}
+	b.tokens--
+	return true, created
+}
+
+// wait consumes a token for the given key, blocking until the token is
+// available. Tokens are reserved before waiting, so concurrent callers are
+// released one after another at the configured rate. It reports if a new key
+// was created and whether the caller had to wait.
+func (l *limiter) wait(key string) (created, waited bool) {
+	l.Lock()
+	now := l.clock()
+	l.gc(now)
+
+	b, found := l.buckets[key]
+	if !found {
+		b = &bucket{tokens: l.burst, lastRefill: now}
+		l.buckets[key] = b
+		created = true
+	} else {
+		b.refill(now, l.rate, l.burst)
+	}
+	b.tokens--
+	missing := -b.tokens
+	l.Unlock()
+
+	if missing <= 0 {
+		return created, false
+	}
+	l.sleep(time.Duration(missing / l.rate * float64(time.Second)))
+	return created, true
+}
+
+func (b *bucket) refill(now time.Time, rate, burst float64) {
+	elapsed := now.Sub(b.lastRefill).Seconds()
+	if elapsed <= 0 {
+		return
+	}
+	b.tokens += elapsed * rate
+	if b.tokens > burst {
+		b.tokens = burst
+	}
+	b.lastRefill = now
+}
+
+// gc removes the buckets that have been refilled completely, as they hold no
+// state different from a new bucket.
+func (l *limiter) gc(now time.Time) {
+	if l.gcEvery <= 0 || now.Sub(l.lastGC) < l.gcEvery {
+		return
+	}
+	l.lastGC = now
+
+	removed := 0
+	for key, b := range l.buckets {
+		b.refill(now, l.rate, l.burst)
+		if b.tokens >= l.burst {
+			delete(l.buckets, key)
+			removed++
+		}
+	}
+	if removed > 0 && l.onRemove != nil {
+		l.onRemove(removed)
+	}
+}
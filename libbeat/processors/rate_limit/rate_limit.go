@@ -0,0 +1,133 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package rate_limit
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/monitoring"
+	"github.com/elastic/beats/libbeat/processors"
+)
+
+const processorName = "rate_limit"
+
+func init() {
+	processors.RegisterPlugin(processorName, newRateLimit)
+}
+
+type rateLimit struct {
+	config  Config
+	limiter *limiter
+
+	dropped    *monitoring.Int
+	tagged     *monitoring.Int
+	throttled  *monitoring.Int
+	keys       *monitoring.Int
+	unregister func()
+}
+
+func newRateLimit(cfg *common.Config) (processors.Processor, error) {
+	config := defaultConfig()
+	if err := cfg.Unpack(&config); err != nil {
+		return nil, errors.Wrapf(err, "fail to unpack the %v configuration", processorName)
+	}
+
+	reg, unregister := processors.NewInstanceRegistry(processorName)
+
+	p := &rateLimit{
+		config:     config,
+		limiter:    newLimiter(config.Limit, config.Burst, config.GCInterval),
+		dropped:    monitoring.NewInt(reg, "dropped"),
+		tagged:     monitoring.NewInt(reg, "tagged"),
+		throttled:  monitoring.NewInt(reg, "throttled"),
+		keys:       monitoring.NewInt(reg, "keys"),
+		unregister: unregister,
+	}
+	p.limiter.onRemove = func(n int) { p.keys.Sub(int64(n)) }
+	return p, nil
+}
+
+// Run drops or tags the event if the rate for its key exceeds the limit. With
+// the throttle action, Run blocks until the event is within the limit, slowing
+// down the publishing pipeline.
+func (p *rateLimit) Run(event *beat.Event) (*beat.Event, error) {
+	if p.config.Action == actionThrottle {
+		created, waited := p.limiter.wait(p.key(event))
+		if created {
+			p.keys.Inc()
+		}
+		if waited {
+			p.throttled.Inc()
+		}
+		return event, nil
+	}
+
+	allowed, created := p.limiter.allow(p.key(event))
+	if created {
+		p.keys.Inc()
+	}
+	if allowed {
+		return event, nil
+	}
+
+	if p.config.Action == actionDrop {
+		p.dropped.Inc()
+		return nil, nil
+	}
+
+	p.tagged.Inc()
+	if event.Fields == nil {
+		event.Fields = common.MapStr{}
+	}
+	if err := common.AddTags(event.Fields, []string{p.config.Tag}); err != nil {
+		return event, errors.Wrap(err, "failed to tag rate limited event")
+	}
+	return event, nil
+}
+
+// key builds the bucket key from the configured fields. Missing fields are
+// treated as empty values.
+func (p *rateLimit) key(event *beat.Event) string {
+	if len(p.config.Fields) == 0 {
+		return ""
+	}
+
+	values := make([]string, len(p.config.Fields))
+	for i, field := range p.config.Fields {
+		if v, err := event.GetValue(field); err == nil {
+			values[i] = fmt.Sprint(v)
+		}
+	}
+	return strings.Join(values, "\x00")
+}
+
+// Close removes the metrics of the processor.
+func (p *rateLimit) Close() error {
+	p.unregister()
+	return nil
+}
+
+func (p *rateLimit) String() string {
+	return fmt.Sprintf("%v=[limit=%v/s, fields=%v, action=%v]",
+		processorName, float64(p.config.Limit), p.config.Fields, p.config.Action)
+}
@@ -0,0 +1,175 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package rate_limit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+)
+
+func newTestRateLimit(t *testing.T, conf map[string]interface{}, clock *time.Time) *rateLimit {
+	c, err := common.NewConfigFrom(conf)
+	require.NoError(t, err)
+
+	p, err := newRateLimit(c)
+	require.NoError(t, err)
+
+	r := p.(*rateLimit)
+	r.limiter.clock = func() time.Time { return *clock }
+	return r
+}
+
+func runEvents(p *rateLimit, fields common.MapStr, n int) (passed int) {
+	for i := 0; i < n; i++ {
+		out, _ := p.Run(&beat.Event{Fields: fields.Clone()})
+		if out != nil {
+			passed++
+		}
+	}
+	return passed
+}
+
+func TestRateParsing(t *testing.T) {
+	cases := map[string]float64{
+		"10/s":   10,
+		"120/m":  2,
+		"3600/h": 1,
+	}
+	for s, expected := range cases {
+		var r rate
+		if assert.NoError(t, r.Unpack(s), s) {
+			assert.Equal(t, expected, float64(r), s)
+		}
+	}
+
+	for _, s := range []string{"", "10", "10/d", "-1/s", "abc/s"} {
+		var r rate
+		assert.Error(t, r.Unpack(s), s)
+	}
+}
+
+func TestDropOverLimit(t *testing.T) {
+	now := time.Now()
+	p := newTestRateLimit(t, map[string]interface{}{"limit": "5/s"}, &now)
+
+	assert.Equal(t, 5, runEvents(p, common.MapStr{"message": "a"}, 10))
+	assert.EqualValues(t, 5, p.dropped.Get())
+
+	// Half a second later, half the tokens are refilled.
+	now = now.Add(500 * time.Millisecond)
+	assert.Equal(t, 2, runEvents(p, common.MapStr{"message": "a"}, 10))
+}
+
+func TestKeyedLimits(t *testing.T) {
+	now := time.Now()
+	p := newTestRateLimit(t, map[string]interface{}{
+		"limit":  "2/s",
+		"fields": []string{"container.id"},
+	}, &now)
+
+	a := common.MapStr{"container": common.MapStr{"id": "a"}}
+	b := common.MapStr{"container": common.MapStr{"id": "b"}}
+
+	assert.Equal(t, 2, runEvents(p, a, 5))
+	assert.Equal(t, 2, runEvents(p, b, 5))
+	assert.EqualValues(t, 2, p.keys.Get())
+}
+
+func TestTagAction(t *testing.T) {
+	now := time.Now()
+	p := newTestRateLimit(t, map[string]interface{}{
+		"limit":  "1/s",
+		"action": "tag",
+	}, &now)
+
+	first, err := p.Run(&beat.Event{Fields: common.MapStr{}})
+	require.NoError(t, err)
+	assert.Nil(t, first.Fields["tags"])
+
+	second, err := p.Run(&beat.Event{Fields: common.MapStr{}})
+	require.NoError(t, err)
+	require.NotNil(t, second)
+	assert.Equal(t, []string{"_rate_limited"}, second.Fields["tags"])
+	assert.EqualValues(t, 1, p.tagged.Get())
+	assert.EqualValues(t, 0, p.dropped.Get())
+}
+
+func TestThrottleAction(t *testing.T) {
+	now := time.Now()
+	p := newTestRateLimit(t, map[string]interface{}{
+		"limit":  "2/s",
+		"action": "throttle",
+	}, &now)
+
+	var waits []time.Duration
+	p.limiter.sleep = func(d time.Duration) { waits = append(waits, d) }
+
+	// All events pass, events exceeding the burst wait for their token.
+	assert.Equal(t, 5, runEvents(p, common.MapStr{"message": "a"}, 5))
+	assert.Equal(t, []time.Duration{
+		500 * time.Millisecond,
+		time.Second,
+		1500 * time.Millisecond,
+	}, waits)
+	assert.EqualValues(t, 3, p.throttled.Get())
+	assert.EqualValues(t, 0, p.dropped.Get())
+
+	// Once the reserved tokens are refilled, events pass without waiting.
+	waits = nil
+	now = now.Add(2500 * time.Millisecond)
+	assert.Equal(t, 2, runEvents(p, common.MapStr{"message": "a"}, 2))
+	assert.Empty(t, waits)
+}
+
+func TestIdleKeysAreCollected(t *testing.T) {
+	now := time.Now()
+	p := newTestRateLimit(t, map[string]interface{}{
+		"limit":       "1/s",
+		"fields":      []string{"id"},
+		"gc_interval": "10s",
+	}, &now)
+
+	runEvents(p, common.MapStr{"id": 1}, 1)
+	runEvents(p, common.MapStr{"id": 2}, 1)
+	assert.EqualValues(t, 2, p.keys.Get())
+
+	now = now.Add(time.Minute)
+	runEvents(p, common.MapStr{"id": 3}, 1)
+	assert.EqualValues(t, 1, p.keys.Get())
+	assert.Len(t, p.limiter.buckets, 1)
+}
+
+func TestInvalidConfig(t *testing.T) {
+	for _, conf := range []map[string]interface{}{
+		{},
+		{"limit": "10/s", "action": "delay"},
+		{"limit": "10/s", "action": "tag", "tag": ""},
+	} {
+		c, err := common.NewConfigFrom(conf)
+		require.NoError(t, err)
+
+		_, err = newRateLimit(c)
+		assert.Error(t, err, "%v", conf)
+	}
+}
@@ -59,3 +59,9 @@ func RegisterPlugin(name string, constructor Constructor) {
 		panic(err)
 	}
 }
+
+// IsRegistered returns true if a processor with the given name is registered.
+func IsRegistered(name string) bool {
+	_, found := registry.lookup(name)
+	return found
+}
@@ -0,0 +1,39 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package route
+
+import (
+	"errors"
+
+	"github.com/elastic/beats/libbeat/common/fmtstr"
+)
+
+type config struct {
+	Index         *fmtstr.EventFormatString `config:"index"`
+	Pipeline      *fmtstr.EventFormatString `config:"pipeline"`
+	Topic         *fmtstr.EventFormatString `config:"topic"`
+	Key           *fmtstr.EventFormatString `config:"key"`
+	IgnoreMissing bool                      `config:"ignore_missing"`
+}
+
+func (c *config) Validate() error {
+	if c.Index == nil && c.Pipeline == nil && c.Topic == nil && c.Key == nil {
+		return errors.New("at least one of index, pipeline, topic or key must be set")
+	}
+	return nil
+}
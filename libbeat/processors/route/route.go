@@ -0,0 +1,109 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package route
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/common/fmtstr"
+	"github.com/elastic/beats/libbeat/processors"
+)
+
+const processorName = "route"
+
+func init() {
+	processors.RegisterPlugin(processorName, New)
+}
+
+// route sets the reserved @metadata fields outputs use to select the
+// destination of an event, like the Elasticsearch index or the Kafka topic.
+type route struct {
+	config config
+	keys   []routeKey
+}
+
+type routeKey struct {
+	name string
+	fmt  *fmtstr.EventFormatString
+}
+
+// New constructs a new route processor.
+func New(cfg *common.Config) (processors.Processor, error) {
+	var config config
+	if err := cfg.Unpack(&config); err != nil {
+		return nil, errors.Wrapf(err, "fail to unpack the %v configuration", processorName)
+	}
+
+	p := &route{config: config}
+	for _, k := range []routeKey{
+		{"index", config.Index},
+		{"pipeline", config.Pipeline},
+		{"topic", config.Topic},
+		{"key", config.Key},
+	} {
+		if k.fmt != nil {
+			p.keys = append(p.keys, k)
+		}
+	}
+	return p, nil
+}
+
+// Run evaluates the configured format strings and writes the results to
+// @metadata. If any format string can not be evaluated the event is left
+// unchanged, unless ignore_missing is set.
+func (p *route) Run(event *beat.Event) (*beat.Event, error) {
+	values := make(common.MapStr, len(p.keys))
+	for _, k := range p.keys {
+		v, err := k.fmt.Run(event)
+		if err != nil {
+			if p.config.IgnoreMissing {
+				continue
+			}
+			return event, errors.Wrapf(err, "failed to evaluate %v", k.name)
+		}
+		if v == "" {
+			continue
+		}
+		values[k.name] = v
+	}
+
+	if len(values) == 0 {
+		return event, nil
+	}
+	if event.Meta == nil {
+		event.Meta = common.MapStr{}
+	}
+	for k, v := range values {
+		event.Meta[k] = v
+	}
+	return event, nil
+}
+
+func (p *route) String() string {
+	names := make([]string, len(p.keys))
+	for i, k := range p.keys {
+		names[i] = k.name
+	}
+	return fmt.Sprintf("%v=[keys=%v, ignore_missing=%v]",
+		processorName, strings.Join(names, ","), p.config.IgnoreMissing)
+}
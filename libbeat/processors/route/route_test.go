@@ -0,0 +1,101 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package route
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+)
+
+func newTestRoute(t *testing.T, cfg map[string]interface{}) *route {
+	p, err := New(common.MustNewConfigFrom(cfg))
+	require.NoError(t, err)
+	return p.(*route)
+}
+
+func TestNewRequiresDestination(t *testing.T) {
+	_, err := New(common.MustNewConfigFrom(map[string]interface{}{
+		"ignore_missing": true,
+	}))
+	assert.Error(t, err)
+}
+
+func TestRun(t *testing.T) {
+	p := newTestRoute(t, map[string]interface{}{
+		"index":    "logs-%{[service.name]}",
+		"pipeline": "parse-%{[service.type]}",
+		"topic":    "%{[service.name]}",
+		"key":      "events",
+	})
+
+	event := &beat.Event{
+		Fields: common.MapStr{
+			"service": common.MapStr{"name": "billing", "type": "nginx"},
+		},
+	}
+	event, err := p.Run(event)
+	require.NoError(t, err)
+
+	assert.Equal(t, common.MapStr{
+		"index":    "logs-billing",
+		"pipeline": "parse-nginx",
+		"topic":    "billing",
+		"key":      "events",
+	}, event.Meta)
+}
+
+func TestRunKeepsExistingMetadata(t *testing.T) {
+	p := newTestRoute(t, map[string]interface{}{
+		"topic": "%{[service.name]}",
+	})
+
+	event := &beat.Event{
+		Meta:   common.MapStr{"index": "custom", "topic": "old"},
+		Fields: common.MapStr{"service": common.MapStr{"name": "billing"}},
+	}
+	event, err := p.Run(event)
+	require.NoError(t, err)
+	assert.Equal(t, common.MapStr{"index": "custom", "topic": "billing"}, event.Meta)
+}
+
+func TestRunMissingField(t *testing.T) {
+	cfg := map[string]interface{}{
+		"index": "logs-%{[service.name]}",
+		"topic": "events",
+	}
+
+	t.Run("error", func(t *testing.T) {
+		p := newTestRoute(t, cfg)
+		event, err := p.Run(&beat.Event{Fields: common.MapStr{}})
+		assert.Error(t, err)
+		assert.Nil(t, event.Meta)
+	})
+
+	t.Run("ignore_missing", func(t *testing.T) {
+		cfg["ignore_missing"] = true
+		p := newTestRoute(t, cfg)
+		event, err := p.Run(&beat.Event{Fields: common.MapStr{}})
+		assert.NoError(t, err)
+		assert.Equal(t, common.MapStr{"topic": "events"}, event.Meta)
+	})
+}
@@ -0,0 +1,209 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package javascript
+
+import (
+	"github.com/dop251/goja"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+)
+
+// event is the object passed to the script's process function. It exposes a
+// restricted API for reading and modifying the underlying beat.Event so that
+// scripts can never get hold of references to the event's internal maps.
+type event struct {
+	vm        *goja.Runtime
+	obj       *goja.Object
+	inner     *beat.Event
+	cancelled bool
+}
+
+func newEvent(vm *goja.Runtime) *event {
+	e := &event{
+		vm:  vm,
+		obj: vm.NewObject(),
+	}
+	e.obj.Set("Get", e.get)
+	e.obj.Set("Put", e.put)
+	e.obj.Set("Delete", e.delete)
+	e.obj.Set("Rename", e.rename)
+	e.obj.Set("Tag", e.tag)
+	e.obj.Set("Cancel", e.cancel)
+	e.obj.Set("IsCancelled", e.isCancelled)
+	return e
+}
+
+// reset binds the wrapper to a new beat.Event.
+func (e *event) reset(b *beat.Event) {
+	e.inner = b
+	e.cancelled = false
+}
+
+// get returns a copy of the value stored at the given key or null if the
+// key does not exist.
+func (e *event) get(call goja.FunctionCall) goja.Value {
+	key := e.stringArg(call, 0)
+
+	v, err := e.inner.GetValue(key)
+	if err != nil {
+		return goja.Null()
+	}
+	return e.vm.ToValue(exportValue(v))
+}
+
+// put stores a value at the given key and returns the previous value or null.
+func (e *event) put(call goja.FunctionCall) goja.Value {
+	if len(call.Arguments) != 2 {
+		panic(e.vm.NewTypeError("Put requires two arguments (key and value)"))
+	}
+	key := e.stringArg(call, 0)
+	value := importValue(call.Argument(1).Export())
+
+	old, err := e.inner.PutValue(key, value)
+	if err != nil {
+		panic(e.vm.NewGoError(err))
+	}
+	if old == nil {
+		return goja.Null()
+	}
+	return e.vm.ToValue(exportValue(old))
+}
+
+// delete removes the given key and returns true if it existed.
+func (e *event) delete(call goja.FunctionCall) goja.Value {
+	key := e.stringArg(call, 0)
+
+	if err := e.inner.Delete(key); err != nil {
+		return e.vm.ToValue(false)
+	}
+	return e.vm.ToValue(true)
+}
+
+// rename moves the value from one key to another. It returns false if the
+// source key does not exist or the target key is already set.
+func (e *event) rename(call goja.FunctionCall) goja.Value {
+	from := e.stringArg(call, 0)
+	to := e.stringArg(call, 1)
+
+	if has, _ := e.inner.Fields.HasKey(to); has {
+		return e.vm.ToValue(false)
+	}
+
+	v, err := e.inner.GetValue(from)
+	if err != nil {
+		return e.vm.ToValue(false)
+	}
+	if _, err = e.inner.PutValue(to, v); err != nil {
+		return e.vm.ToValue(false)
+	}
+	e.inner.Delete(from)
+	return e.vm.ToValue(true)
+}
+
+// tag appends a tag to the event's tags field.
+func (e *event) tag(call goja.FunctionCall) goja.Value {
+	tag := e.stringArg(call, 0)
+
+	if err := common.AddTags(e.inner.Fields, []string{tag}); err != nil {
+		panic(e.vm.NewGoError(err))
+	}
+	return goja.Undefined()
+}
+
+// cancel marks the event to be dropped.
+func (e *event) cancel(call goja.FunctionCall) goja.Value {
+	e.cancelled = true
+	return goja.Undefined()
+}
+
+func (e *event) isCancelled(call goja.FunctionCall) goja.Value {
+	return e.vm.ToValue(e.cancelled)
+}
+
+func (e *event) stringArg(call goja.FunctionCall, idx int) string {
+	v := call.Argument(idx)
+	if goja.IsUndefined(v) || goja.IsNull(v) {
+		panic(e.vm.NewTypeError("argument %d must be a string", idx))
+	}
+	s, ok := v.Export().(string)
+	if !ok {
+		panic(e.vm.NewTypeError("argument %d must be a string", idx))
+	}
+	return s
+}
+
+// exportValue deep copies a value read from the event into plain Go types
+// that goja can convert into native Javascript values.
+func exportValue(v interface{}) interface{} {
+	switch t := v.(type) {
+	case common.MapStr:
+		return exportMap(t)
+	case map[string]interface{}:
+		return exportMap(t)
+	case []common.MapStr:
+		out := make([]interface{}, len(t))
+		for i, m := range t {
+			out[i] = exportMap(m)
+		}
+		return out
+	case []interface{}:
+		out := make([]interface{}, len(t))
+		for i, item := range t {
+			out[i] = exportValue(item)
+		}
+		return out
+	case []string:
+		out := make([]interface{}, len(t))
+		for i, s := range t {
+			out[i] = s
+		}
+		return out
+	default:
+		return v
+	}
+}
+
+func exportMap(m map[string]interface{}) map[string]interface{} {
+	out := make(map[string]interface{}, len(m))
+	for k, v := range m {
+		out[k] = exportValue(v)
+	}
+	return out
+}
+
+// importValue converts a value exported from Javascript into the types used
+// by beat events.
+func importValue(v interface{}) interface{} {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		out := make(common.MapStr, len(t))
+		for k, item := range t {
+			out[k] = importValue(item)
+		}
+		return out
+	case []interface{}:
+		out := make([]interface{}, len(t))
+		for i, item := range t {
+			out[i] = importValue(item)
+		}
+		return out
+	default:
+		return v
+	}
+}
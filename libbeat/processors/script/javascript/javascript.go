@@ -0,0 +1,117 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package javascript
+
+import (
+	"fmt"
+	"io/ioutil"
+	"sync"
+
+	"github.com/dop251/goja"
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/logp"
+	"github.com/elastic/beats/libbeat/paths"
+	"github.com/elastic/beats/libbeat/processors"
+)
+
+const logName = "processor.javascript"
+
+type jsProcessor struct {
+	Config
+	prog       *goja.Program
+	sessions   sync.Pool
+	sourceName string
+	log        *logp.Logger
+}
+
+// New constructs a new Javascript processor. The script is compiled and
+// executed once at configuration time so that syntax errors and a missing
+// process function are reported before any event is handled.
+func New(c *common.Config) (processors.Processor, error) {
+	conf := defaultConfig()
+	if err := c.Unpack(&conf); err != nil {
+		return nil, errors.Wrap(err, "fail to unpack the javascript configuration")
+	}
+
+	return newProcessor(conf)
+}
+
+func newProcessor(conf Config) (*jsProcessor, error) {
+	sourceName, source := "inline.js", conf.Source
+	if conf.File != "" {
+		sourceName = paths.Resolve(paths.Config, conf.File)
+		content, err := ioutil.ReadFile(sourceName)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read javascript file")
+		}
+		source = string(content)
+	}
+
+	prog, err := goja.Compile(sourceName, source, true)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to compile javascript")
+	}
+
+	p := &jsProcessor{
+		Config:     conf,
+		prog:       prog,
+		sourceName: sourceName,
+		log:        logp.NewLogger(logName),
+	}
+	if conf.Tag != "" {
+		p.log = p.log.With("instance_id", conf.Tag)
+	}
+
+	s, err := newSession(prog, conf)
+	if err != nil {
+		return nil, err
+	}
+	p.sessions.Put(s)
+
+	return p, nil
+}
+
+// Run executes the script's process function on the given event.
+func (p *jsProcessor) Run(event *beat.Event) (*beat.Event, error) {
+	s, err := p.getSession()
+	if err != nil {
+		return event, err
+	}
+
+	out, err := s.runProcessFunc(event)
+	if s.tainted {
+		p.log.Debug("Discarding javascript session after timeout or panic.")
+	} else {
+		p.sessions.Put(s)
+	}
+	return out, err
+}
+
+func (p *jsProcessor) getSession() (*session, error) {
+	if s, ok := p.sessions.Get().(*session); ok {
+		return s, nil
+	}
+	return newSession(p.prog, p.Config)
+}
+
+func (p *jsProcessor) String() string {
+	return fmt.Sprintf("script=[type=javascript, id=%v, sources=%v]", p.Tag, p.sourceName)
+}
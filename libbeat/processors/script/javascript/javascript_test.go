@@ -0,0 +1,247 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package javascript
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+)
+
+func newTestProcessor(t testing.TB, source string, extra map[string]interface{}) (*jsProcessor, error) {
+	conf := map[string]interface{}{"source": source}
+	for k, v := range extra {
+		conf[k] = v
+	}
+
+	c, err := common.NewConfigFrom(conf)
+	require.NoError(t, err)
+
+	p, err := New(c)
+	if err != nil {
+		return nil, err
+	}
+	return p.(*jsProcessor), nil
+}
+
+func testEvent() *beat.Event {
+	return &beat.Event{
+		Timestamp: time.Now(),
+		Fields: common.MapStr{
+			"message": "hello world",
+			"source": common.MapStr{
+				"ip": "10.0.0.1",
+			},
+		},
+	}
+}
+
+func TestSessionAPI(t *testing.T) {
+	const script = `
+function process(event) {
+	var msg = event.Get("message");
+	event.Put("message_upper", msg.toUpperCase());
+	event.Put("nested", {"a": 1, "b": ["x", "y"]});
+	event.Rename("source.ip", "client.ip");
+	event.Delete("message");
+	event.Tag("js");
+}
+`
+	p, err := newTestProcessor(t, script, nil)
+	require.NoError(t, err)
+
+	evt, err := p.Run(testEvent())
+	require.NoError(t, err)
+	require.NotNil(t, evt)
+
+	v, _ := evt.GetValue("message_upper")
+	assert.Equal(t, "HELLO WORLD", v)
+
+	v, _ = evt.GetValue("nested.a")
+	assert.EqualValues(t, 1, v)
+
+	v, _ = evt.GetValue("client.ip")
+	assert.Equal(t, "10.0.0.1", v)
+
+	has, _ := evt.Fields.HasKey("message")
+	assert.False(t, has)
+
+	has, _ = evt.Fields.HasKey("source.ip")
+	assert.False(t, has)
+
+	assert.Equal(t, []string{"js"}, evt.Fields["tags"])
+}
+
+func TestGetReturnsCopy(t *testing.T) {
+	const script = `
+function process(event) {
+	var src = event.Get("source");
+	src.ip = "changed";
+	event.Put("missing_is_null", event.Get("not.there") === null);
+}
+`
+	p, err := newTestProcessor(t, script, nil)
+	require.NoError(t, err)
+
+	evt, err := p.Run(testEvent())
+	require.NoError(t, err)
+
+	v, _ := evt.GetValue("source.ip")
+	assert.Equal(t, "10.0.0.1", v)
+
+	v, _ = evt.GetValue("missing_is_null")
+	assert.Equal(t, true, v)
+}
+
+func TestCancel(t *testing.T) {
+	const script = `
+function process(event) {
+	if (event.Get("message") === "hello world") {
+		event.Cancel();
+	}
+}
+`
+	p, err := newTestProcessor(t, script, nil)
+	require.NoError(t, err)
+
+	evt, err := p.Run(testEvent())
+	assert.NoError(t, err)
+	assert.Nil(t, evt)
+}
+
+func TestRegisterParams(t *testing.T) {
+	const script = `
+var threshold;
+
+function register(params) {
+	threshold = params.threshold;
+}
+
+function process(event) {
+	event.Put("threshold", threshold);
+}
+`
+	p, err := newTestProcessor(t, script, map[string]interface{}{
+		"params": map[string]interface{}{"threshold": 15},
+	})
+	require.NoError(t, err)
+
+	evt, err := p.Run(testEvent())
+	require.NoError(t, err)
+
+	v, _ := evt.GetValue("threshold")
+	assert.EqualValues(t, 15, v)
+}
+
+func TestConfigErrors(t *testing.T) {
+	cases := map[string]struct {
+		source string
+		extra  map[string]interface{}
+	}{
+		"syntax error": {
+			source: `function process(event) {`,
+		},
+		"missing process function": {
+			source: `function proc(event) {}`,
+		},
+		"params without register": {
+			source: `function process(event) {}`,
+			extra:  map[string]interface{}{"params": map[string]interface{}{"a": 1}},
+		},
+		"register throws": {
+			source: `function register(params) { throw "bad params"; }
+function process(event) {}`,
+		},
+		"source and file": {
+			source: `function process(event) {}`,
+			extra:  map[string]interface{}{"file": "processor.js"},
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := newTestProcessor(t, c.source, c.extra)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestExceptionTagging(t *testing.T) {
+	const script = `
+function process(event) {
+	throw "boom";
+}
+`
+	p, err := newTestProcessor(t, script, nil)
+	require.NoError(t, err)
+
+	evt, err := p.Run(testEvent())
+	assert.Error(t, err)
+	require.NotNil(t, evt)
+	assert.Equal(t, []string{"_js_exception"}, evt.Fields["tags"])
+}
+
+func TestTimeout(t *testing.T) {
+	const script = `
+function process(event) {
+	if (event.Get("loop")) {
+		while (true) {}
+	}
+	event.Put("ok", true);
+}
+`
+	p, err := newTestProcessor(t, script, map[string]interface{}{
+		"timeout": "50ms",
+	})
+	require.NoError(t, err)
+
+	evt := testEvent()
+	evt.Fields["loop"] = true
+	out, err := p.Run(evt)
+	assert.Error(t, err)
+	require.NotNil(t, out)
+	assert.Equal(t, []string{"_js_exception"}, out.Fields["tags"])
+
+	// Subsequent events must not be affected by the interrupted session.
+	out, err = p.Run(testEvent())
+	require.NoError(t, err)
+	v, _ := out.GetValue("ok")
+	assert.Equal(t, true, v)
+}
+
+func BenchmarkProcess(b *testing.B) {
+	const script = `
+function process(event) {
+	event.Put("message_length", event.Get("message").length);
+}
+`
+	p, err := newTestProcessor(b, script, nil)
+	require.NoError(b, err)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := p.Run(testEvent()); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
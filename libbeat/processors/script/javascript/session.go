@@ -0,0 +1,147 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package javascript
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/dop251/goja"
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+)
+
+const (
+	entryFunction    = "process"
+	registerFunction = "register"
+)
+
+var errTimeout = errors.New("javascript processor execution timed out")
+
+// session is a Javascript runtime with the compiled script loaded. A goja
+// runtime is not safe for concurrent use, so every session must only be used
+// by one goroutine at a time.
+type session struct {
+	vm             *goja.Runtime
+	process        goja.Callable
+	evt            *event
+	timeout        time.Duration
+	tagOnException string
+
+	// tainted is set when the runtime was interrupted by a timeout that could
+	// not be cleanly cancelled. Tainted sessions must not be reused.
+	tainted bool
+}
+
+func newSession(p *goja.Program, conf Config) (*session, error) {
+	s := &session{
+		vm:             goja.New(),
+		timeout:        conf.Timeout,
+		tagOnException: conf.TagOnException,
+	}
+	s.evt = newEvent(s.vm)
+
+	if _, err := s.vm.RunProgram(p); err != nil {
+		return nil, errors.Wrap(err, "failed to run javascript source")
+	}
+
+	processFunc, ok := goja.AssertFunction(s.vm.Get(entryFunction))
+	if !ok {
+		return nil, errors.Errorf("javascript must define a %v function", entryFunction)
+	}
+	s.process = processFunc
+
+	if err := s.register(conf.Params); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// register calls the optional register function of the script, passing the
+// user defined params.
+func (s *session) register(params map[string]interface{}) error {
+	registerFunc, ok := goja.AssertFunction(s.vm.Get(registerFunction))
+	if !ok {
+		if len(params) > 0 {
+			return errors.Errorf("params were given, but the javascript does not "+
+				"define a %v function", registerFunction)
+		}
+		return nil
+	}
+
+	if params == nil {
+		params = map[string]interface{}{}
+	}
+	if _, err := registerFunc(goja.Undefined(), s.vm.ToValue(params)); err != nil {
+		return errors.Wrapf(err, "failed to call javascript %v function", registerFunction)
+	}
+	return nil
+}
+
+// runProcessFunc executes the process function of the script on the given
+// event. It returns nil if the script cancelled the event.
+func (s *session) runProcessFunc(b *beat.Event) (out *beat.Event, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("unexpected panic in javascript processor: %v", r)
+			s.tainted = true
+			s.tagException(b)
+			out = b
+		}
+	}()
+
+	if b.Fields == nil {
+		b.Fields = common.MapStr{}
+	}
+	s.evt.reset(b)
+	defer s.evt.reset(nil)
+
+	if s.timeout > 0 {
+		timer := time.AfterFunc(s.timeout, func() {
+			s.vm.Interrupt(errTimeout)
+		})
+		defer func() {
+			if !timer.Stop() {
+				// The interrupt may still be pending on the runtime.
+				s.tainted = true
+			}
+		}()
+	}
+
+	if _, err = s.process(goja.Undefined(), s.evt.obj); err != nil {
+		if intr, ok := err.(*goja.InterruptedError); ok && intr.Value() == errTimeout {
+			err = errors.Wrapf(errTimeout, "processing took longer than %v", s.timeout)
+		}
+		s.tagException(b)
+		return b, err
+	}
+
+	if s.evt.cancelled {
+		return nil, nil
+	}
+	return b, nil
+}
+
+func (s *session) tagException(b *beat.Event) {
+	if s.tagOnException == "" {
+		return
+	}
+	common.AddTags(b.Fields, []string{s.tagOnException})
+}
@@ -18,6 +18,8 @@
 package publisher
 
 import (
+	"time"
+
 	"github.com/elastic/beats/libbeat/beat"
 )
 
@@ -41,6 +43,16 @@ type Batch interface {
 type Event struct {
 	Content beat.Event
 	Flags   EventFlags
+
+	// Trace is set if the event has been sampled for tracing its way through
+	// the publisher pipeline.
+	Trace *Trace
+}
+
+// Trace identifies an event sampled for tracing.
+type Trace struct {
+	ID        uint64
+	Published time.Time
 }
 
 // EventFlags provides additional flags/option types  for used with the outputs.
@@ -19,6 +19,7 @@ package pipeline
 
 import (
 	"sync"
+	"time"
 
 	"github.com/elastic/beats/libbeat/publisher"
 	"github.com/elastic/beats/libbeat/publisher/queue"
@@ -29,11 +30,21 @@ type Batch struct {
 	ctx      *batchContext
 	ttl      int
 	events   []publisher.Event
+	sendTime time.Time
+
+	// traces of the traced events in the batch, collected before the events
+	// are handed to the output.
+	traces []*publisher.Trace
+
+	// finished is called once the output client ACKed or dropped the batch,
+	// or returned it to the retryer.
+	finished func()
 }
 
 type batchContext struct {
 	observer outputObserver
 	retryer  *retryer
+	tracer   *tracer
 }
 
 var batchPool = sync.Pool{
@@ -54,6 +65,8 @@ func newBatch(ctx *batchContext, original queue.Batch, ttl int) *Batch {
 		ttl:      ttl,
 		events:   original.Events(),
 	}
+	ctx.tracer.stage(traceStageQueue, b.events)
+	b.traces = ctx.tracer.traces(b.events)
 	return b
 }
 
@@ -66,22 +79,49 @@ func (b *Batch) Events() []publisher.Event {
 	return b.events
 }
 
+// sent must be called by the output workers right before the batch is
+// passed to the output client. finished is called once the output client is
+// done with the batch.
+func (b *Batch) sent(finished func()) {
+	b.ctx.observer.outBatchSend(len(b.events))
+	b.ctx.tracer.stage(traceStageOutput, b.events)
+	b.sendTime = time.Now()
+	b.finished = finished
+}
+
+func (b *Batch) finish() {
+	if b.finished != nil {
+		finished := b.finished
+		b.finished = nil
+		finished()
+	}
+}
+
 func (b *Batch) ACK() {
 	b.ctx.observer.outBatchACKed(len(b.events))
+	if !b.sendTime.IsZero() {
+		b.ctx.observer.outBatchLatency(time.Since(b.sendTime))
+	}
+	b.ctx.tracer.stage(traceStageACK, b.events)
 	b.original.ACK()
+	b.finish()
 	releaseBatch(b)
 }
 
 func (b *Batch) Drop() {
+	b.ctx.tracer.stage(traceStageDropped, b.events)
 	b.original.ACK()
+	b.finish()
 	releaseBatch(b)
 }
 
 func (b *Batch) Retry() {
+	b.finish()
 	b.ctx.retryer.retry(b)
 }
 
 func (b *Batch) Cancelled() {
+	b.finish()
 	b.ctx.retryer.cancelled(b)
 }
 
@@ -102,6 +142,9 @@ func (b *Batch) updEvents(events []publisher.Event) {
 		// report subset of events not to be retried as ACKed
 		b.ctx.observer.outBatchACKed(l1 - l2)
 	}
+	if len(b.traces) > 0 {
+		b.traces = b.ctx.tracer.stageRemoved(traceStageACK, b.traces, events)
+	}
 
 	b.events = events
 }
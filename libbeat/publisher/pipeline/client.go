@@ -19,13 +19,19 @@ package pipeline
 
 import (
 	"sync"
+	"time"
 
 	"github.com/elastic/beats/libbeat/beat"
 	"github.com/elastic/beats/libbeat/common/atomic"
+	"github.com/elastic/beats/libbeat/processors"
 	"github.com/elastic/beats/libbeat/publisher"
 	"github.com/elastic/beats/libbeat/publisher/queue"
 )
 
+// producerBlockedThreshold is the minimum time publishing an event must take
+// to be reported as the client being blocked by a full queue.
+const producerBlockedThreshold = time.Millisecond
+
 // client connects a beat with the processors and pipeline queue.
 //
 // TODO: All ackers currently drop any late incoming ACK. Some beats still might
@@ -35,6 +41,9 @@ type client struct {
 	pipeline   *Pipeline
 	processors beat.Processor
 	producer   queue.Producer
+
+	// processors configured with the client, closed with the client
+	clientProcessors beat.ProcessorList
 	mutex      sync.Mutex
 	acker      acker
 
@@ -116,16 +125,23 @@ func (c *client) publish(e beat.Event) {
 		c.pipeline.waitCloser.inc()
 	}
 
+	c.pipeline.tracer.sample(&pubEvent)
+
 	var published bool
 	if c.canDrop {
 		published = c.producer.TryPublish(pubEvent)
 	} else {
+		start := time.Now()
 		published = c.producer.Publish(pubEvent)
+		if blocked := time.Since(start); blocked >= producerBlockedThreshold {
+			c.pipeline.observer.producerBlocked(blocked)
+		}
 	}
 
 	if published {
 		c.onPublished()
 	} else {
+		c.pipeline.tracer.stage(traceStageDropped, []publisher.Event{pubEvent})
 		c.onDroppedOnPublish(e)
 		if c.reportEvents {
 			c.pipeline.waitCloser.dec(1)
@@ -160,10 +176,25 @@ func (c *client) Close() error {
 		}
 	}
 
+	c.closeProcessors()
 	c.onClosed()
 	return nil
 }
 
+// closeProcessors closes the client processors, like processors running
+// background tasks.
+func (c *client) closeProcessors() {
+	if c.clientProcessors == nil {
+		return
+	}
+
+	for _, p := range c.clientProcessors.All() {
+		if err := processors.Close(p); err != nil {
+			c.pipeline.logger.Errorf("client: failed to close processor %v: %v", p, err)
+		}
+	}
+}
+
 func (c *client) onClosing() {
 	c.pipeline.observer.clientClosing()
 	if c.eventer != nil {
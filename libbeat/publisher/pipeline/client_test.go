@@ -0,0 +1,73 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pipeline
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/outputs"
+	"github.com/elastic/beats/libbeat/processors"
+	_ "github.com/elastic/beats/libbeat/processors/dedupe"
+	"github.com/elastic/beats/libbeat/publisher/queue"
+	"github.com/elastic/beats/libbeat/publisher/queue/memqueue"
+)
+
+func TestClientCloseClosesProcessors(t *testing.T) {
+	dir, err := ioutil.TempDir("", "client")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "fingerprints")
+
+	procs, err := processors.New(processors.PluginConfig{
+		{"dedupe": common.MustNewConfigFrom(map[string]interface{}{
+			"fields":           []string{"message"},
+			"persist.path":     path,
+			"persist.interval": "1h",
+		})},
+	})
+	require.NoError(t, err)
+
+	p, err := New(beat.Info{}, nil, func(e queue.Eventer) (queue.Queue, error) {
+		return memqueue.NewBroker(memqueue.Settings{Eventer: e, Events: 10}), nil
+	}, outputs.Group{}, Settings{})
+	require.NoError(t, err)
+	defer p.Close()
+
+	client, err := p.ConnectWith(beat.ClientConfig{Processor: procs})
+	require.NoError(t, err)
+	client.Publish(beat.Event{Fields: common.MapStr{"message": "hello"}})
+
+	// the fingerprints are only persisted every hour, or once the processor
+	// is closed
+	_, err = os.Stat(path)
+	assert.True(t, os.IsNotExist(err))
+
+	require.NoError(t, client.Close())
+	info, err := os.Stat(path)
+	if assert.NoError(t, err) {
+		assert.NotZero(t, info.Size())
+	}
+}
@@ -34,6 +34,13 @@ type Config struct {
 
 	// Event queue
 	Queue common.ConfigNamespace `config:"queue"`
+
+	// Outputs configures multiple outputs to publish events to. Outputs can
+	// not be used together with the output setting.
+	Outputs []common.ConfigNamespace `config:"outputs"`
+
+	// Trace configures the tracing of sampled events through the pipeline.
+	Trace TraceConfig `config:"pipeline.trace"`
 }
 
 // validateClientConfig checks a ClientConfig can be used with (*Pipeline).ConnectWith.
@@ -18,6 +18,9 @@
 package pipeline
 
 import (
+	"sync"
+	"time"
+
 	"github.com/elastic/beats/libbeat/logp"
 	"github.com/elastic/beats/libbeat/outputs"
 	"github.com/elastic/beats/libbeat/publisher/queue"
@@ -36,8 +39,14 @@ type outputController struct {
 	retryer  *retryer
 	consumer *eventConsumer
 	out      *outputGroup
+
+	// drainTimeout is the time the clients of a replaced output group have
+	// to publish the batches they are handling, before they are closed.
+	drainTimeout time.Duration
 }
 
+const defaultDrainTimeout = 30 * time.Second
+
 // outputGroup configures a group of load balanced outputs with shared work queue.
 type outputGroup struct {
 	workQueue workQueue
@@ -53,17 +62,24 @@ type workQueue chan *Batch
 // instances.
 type outputWorker interface {
 	Close() error
+
+	// Drain stops the worker from reading the work queue and waits for the
+	// batches passed to the output client to be ACKed, dropped or returned to
+	// the retryer. It returns false if the timeout expires first.
+	Drain(timeout time.Duration) bool
 }
 
 func newOutputController(
 	log *logp.Logger,
 	observer outputObserver,
+	tracer *tracer,
 	b queue.Queue,
 ) *outputController {
 	c := &outputController{
-		logger:   log,
-		observer: observer,
-		queue:    b,
+		logger:       log,
+		observer:     observer,
+		queue:        b,
+		drainTimeout: defaultDrainTimeout,
 	}
 
 	ctx := &batchContext{}
@@ -71,6 +87,7 @@ func newOutputController(
 	c.retryer = newRetryer(log, observer, nil, c.consumer)
 	ctx.observer = observer
 	ctx.retryer = c.retryer
+	ctx.tracer = tracer
 
 	c.consumer.sigContinue()
 
@@ -79,17 +96,17 @@ func newOutputController(
 
 func (c *outputController) Close() error {
 	c.consumer.sigPause()
+	c.consumer.close()
+	c.retryer.close()
 
+	// workers stop reading the work queue when closed, so the work queue is
+	// not closed, as the consumer might still be sending to it.
 	if c.out != nil {
 		for _, out := range c.out.outputs {
 			out.Close()
 		}
-		close(c.out.workQueue)
 	}
 
-	c.consumer.close()
-	c.retryer.close()
-
 	return nil
 }
 
@@ -109,9 +126,10 @@ func (c *outputController) Set(outGrp outputs.Group) {
 	}
 
 	// update consumer and retryer
+	old := c.out
 	c.consumer.sigPause()
-	if c.out != nil {
-		for range c.out.outputs {
+	if old != nil {
+		for range old.outputs {
 			c.retryer.sigOutputRemoved()
 		}
 	}
@@ -120,18 +138,34 @@ func (c *outputController) Set(outGrp outputs.Group) {
 		c.retryer.sigOutputAdded()
 	}
 	c.consumer.updOutput(grp)
-
-	// close old group, so events are send to new workQueue via retryer
-	if c.out != nil {
-		for _, w := range c.out.outputs {
-			w.Close()
-		}
-	}
+	c.out = grp
 
 	// restart consumer (potentially blocked by retryer)
 	c.consumer.sigContinue()
 
 	c.observer.updateOutputGroup()
+
+	// drain the old group before closing it, so batches already passed to
+	// its clients are published instead of being cancelled and retried
+	// with the new outputs.
+	if old != nil {
+		c.drain(old)
+	}
+}
+
+func (c *outputController) drain(grp *outputGroup) {
+	var wg sync.WaitGroup
+	for _, w := range grp.outputs {
+		wg.Add(1)
+		go func(w outputWorker) {
+			defer wg.Done()
+			if !w.Drain(c.drainTimeout) {
+				c.logger.Warnf("Timeout draining the replaced output after %v, pending events will be retried", c.drainTimeout)
+			}
+			w.Close()
+		}(w)
+	}
+	wg.Wait()
 }
 
 func makeWorkQueue() workQueue {
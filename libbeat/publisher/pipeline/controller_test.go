@@ -0,0 +1,138 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pipeline
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/common/atomic"
+	"github.com/elastic/beats/libbeat/logp"
+	"github.com/elastic/beats/libbeat/outputs"
+	"github.com/elastic/beats/libbeat/publisher"
+	"github.com/elastic/beats/libbeat/publisher/queue"
+	"github.com/elastic/beats/libbeat/publisher/queue/memqueue"
+)
+
+// pendingClient keeps the published batches until the test ACKs them. Closing
+// the client cancels the batches not ACKed yet.
+type pendingClient struct {
+	batches chan publisher.Batch
+	closed  atomic.Bool
+}
+
+func newPendingClient() *pendingClient {
+	return &pendingClient{batches: make(chan publisher.Batch, 10)}
+}
+
+func (c *pendingClient) Publish(batch publisher.Batch) error {
+	c.batches <- batch
+	return nil
+}
+
+func (c *pendingClient) Close() error {
+	c.closed.Store(true)
+	for {
+		select {
+		case batch := <-c.batches:
+			batch.Cancelled()
+		default:
+			return nil
+		}
+	}
+}
+
+func (c *pendingClient) next(t *testing.T) publisher.Batch {
+	select {
+	case batch := <-c.batches:
+		return batch
+	case <-time.After(5 * time.Second):
+		t.Fatal("timeout waiting for batch")
+	}
+	return nil
+}
+
+func newTestController(t *testing.T, client outputs.Client) *outputController {
+	qu := memqueue.NewBroker(memqueue.Settings{Events: 10})
+	ctrl := newOutputController(logp.NewLogger("test"), nilObserver, nil, qu)
+	out, _ := outputs.Success(0, 0, client)
+	ctrl.Set(out)
+
+	producer := qu.Producer(queue.ProducerConfig{})
+	require.True(t, producer.Publish(publisher.Event{
+		Content: beat.Event{Fields: common.MapStr{"message": "test"}},
+	}))
+	return ctrl
+}
+
+func TestOutputControllerSetDrainsOldOutput(t *testing.T) {
+	old, client := newPendingClient(), newPendingClient()
+	ctrl := newTestController(t, old)
+	defer ctrl.Close()
+
+	batch := old.next(t)
+
+	done := make(chan struct{})
+	go func() {
+		out, _ := outputs.Success(0, 0, client)
+		ctrl.Set(out)
+		close(done)
+	}()
+
+	// the old client is not closed while it is publishing a batch
+	select {
+	case <-done:
+		t.Fatal("output replaced before the old output was drained")
+	case <-time.After(100 * time.Millisecond):
+	}
+	assert.False(t, old.closed.Load())
+
+	batch.ACK()
+	<-done
+	assert.True(t, old.closed.Load())
+
+	// the batch ACKed by the old output is not published again
+	select {
+	case <-client.batches:
+		t.Fatal("ACKed batch published by the new output")
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestOutputControllerSetDrainTimeout(t *testing.T) {
+	old, client := newPendingClient(), newPendingClient()
+	ctrl := newTestController(t, old)
+	ctrl.drainTimeout = 50 * time.Millisecond
+	defer ctrl.Close()
+
+	// keep the batch pending, for the old client to cancel it on close
+	old.batches <- old.next(t)
+
+	out, _ := outputs.Success(0, 0, client)
+	ctrl.Set(out)
+	assert.True(t, old.closed.Load())
+
+	// the batch cancelled by the old output is retried with the new one
+	batch := client.next(t)
+	assert.Len(t, batch.Events(), 1)
+}
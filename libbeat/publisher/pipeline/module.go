@@ -21,6 +21,7 @@ import (
 	"errors"
 	"flag"
 	"fmt"
+	"strings"
 
 	"github.com/elastic/beats/libbeat/beat"
 	"github.com/elastic/beats/libbeat/common"
@@ -65,6 +66,7 @@ func Load(
 		WaitCloseMode: NoWaitOnClose,
 		Disabled:      publishDisabled,
 		Processors:    processors,
+		Trace:         config.Trace,
 		Annotations: Annotations{
 			Event: config.EventMetadata,
 			Builtin: common.MapStr{
@@ -85,7 +87,16 @@ func Load(
 		return nil, err
 	}
 
-	out, err := loadOutput(beatInfo, reg, outcfg)
+	loader := newOutputLoader(beatInfo, reg)
+	var out outputs.Group
+	if len(config.Outputs) > 0 {
+		if outcfg.IsSet() {
+			return nil, errors.New("output and outputs can not be configured at the same time")
+		}
+		out, err = loader.loadRoutes(config.Outputs)
+	} else {
+		out, err = loader.load(outcfg)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -94,16 +105,30 @@ func Load(
 	if err != nil {
 		return nil, err
 	}
+	p.outputLoader = loader
 
 	logp.Info("Beat name: %s", name)
 	return p, err
 }
 
-func loadOutput(
-	beatInfo beat.Info,
-	reg *monitoring.Registry,
-	outcfg common.ConfigNamespace,
-) (outputs.Group, error) {
+// outputLoader creates the outputs from the output configuration. The
+// monitoring registries are created on first load, and reused when the
+// outputs are reloaded.
+type outputLoader struct {
+	beatInfo beat.Info
+	reg      *monitoring.Registry
+
+	outReg   *monitoring.Registry
+	outStats outputs.Observer
+	outType  *monitoring.String
+	outName  *monitoring.String
+}
+
+func newOutputLoader(beatInfo beat.Info, reg *monitoring.Registry) *outputLoader {
+	return &outputLoader{beatInfo: beatInfo, reg: reg}
+}
+
+func (l *outputLoader) load(outcfg common.ConfigNamespace) (outputs.Group, error) {
 	if publishDisabled {
 		return outputs.Group{}, nil
 	}
@@ -114,31 +139,105 @@ func loadOutput(
 		return outputs.Fail(errors.New(msg))
 	}
 
-	// TODO: add support to unload/reassign outStats on output reloading
-
-	var (
-		outReg   *monitoring.Registry
-		outStats outputs.Observer
-	)
-	if reg != nil {
-		outReg = reg.NewRegistry("output")
-		outStats = outputs.NewStats(outReg)
+	l.initRegistry()
+	if l.outReg != nil {
+		l.outReg.Remove("routes")
 	}
 
-	out, err := outputs.Load(beatInfo, outStats, outcfg.Name(), outcfg.Config())
+	out, err := outputs.Load(l.beatInfo, l.outStats, outcfg.Name(), outcfg.Config())
 	if err != nil {
 		return outputs.Fail(err)
 	}
 
-	if outReg != nil {
-		monitoring.NewString(outReg, "type").Set(outcfg.Name())
+	l.setName(outcfg.Name())
+	return out, nil
+}
+
+// loadRoutes creates an output router publishing events to all configured
+// outputs. Events are only published to an output if they match its `when`
+// condition.
+func (l *outputLoader) loadRoutes(cfgs []common.ConfigNamespace) (outputs.Group, error) {
+	if publishDisabled {
+		return outputs.Group{}, nil
 	}
 
-	stateRegistry := monitoring.GetNamespace("state").GetRegistry()
-	outputRegistry := stateRegistry.NewRegistry("output")
-	monitoring.NewString(outputRegistry, "name").Set(outcfg.Name())
+	l.initRegistry()
+	var routesReg *monitoring.Registry
+	if l.outReg != nil {
+		l.outReg.Remove("routes")
+		routesReg = l.outReg.NewRegistry("routes")
+	}
 
-	return out, nil
+	router := newOutputRouter(logp.NewLogger("publish"))
+	names := make([]string, 0, len(cfgs))
+	seen := map[string]int{}
+	for _, outcfg := range cfgs {
+		if !outcfg.IsSet() {
+			router.Close()
+			return outputs.Fail(errors.New("empty output in outputs"))
+		}
+
+		// routes of the same type are named <type>, <type>-1, <type>-2, ...
+		typ := outcfg.Name()
+		name := typ
+		if n := seen[typ]; n > 0 {
+			name = fmt.Sprintf("%v-%v", typ, n)
+		}
+		seen[typ]++
+
+		config := routeConfig{}
+		if err := outcfg.Config().Unpack(&config); err != nil {
+			router.Close()
+			return outputs.Fail(fmt.Errorf("invalid routing settings in output %v: %v", name, err))
+		}
+
+		out, err := outputs.Load(l.beatInfo, l.outStats, typ, outcfg.Config())
+		if err != nil {
+			router.Close()
+			return outputs.Fail(fmt.Errorf("failed to load output %v: %v", name, err))
+		}
+
+		var reg *monitoring.Registry
+		if routesReg != nil {
+			reg = routesReg.NewRegistry(name)
+			monitoring.NewString(reg, "type").Set(typ)
+		}
+
+		if err := router.addRoute(name, config, out, reg); err != nil {
+			router.Close()
+			return outputs.Fail(fmt.Errorf("failed to configure output %v: %v", name, err))
+		}
+		names = append(names, name)
+	}
+
+	l.setName(strings.Join(names, ","))
+
+	// The routes retry failed events on their own, batches are never
+	// retried by the pipeline.
+	return outputs.Success(0, -1, router)
+}
+
+func (l *outputLoader) initRegistry() {
+	if l.reg != nil && l.outReg == nil {
+		l.outReg = l.reg.NewRegistry("output")
+		l.outStats = outputs.NewStats(l.outReg)
+	}
+}
+
+func (l *outputLoader) setName(name string) {
+	if l.outReg != nil {
+		if l.outType == nil {
+			l.outType = monitoring.NewString(l.outReg, "type")
+		}
+		l.outType.Set(name)
+	}
+
+	if l.outName == nil {
+		stateRegistry := monitoring.GetNamespace("state").GetRegistry()
+		outputRegistry := stateRegistry.NewRegistry("output")
+		l.outName = monitoring.NewString(outputRegistry, "name")
+	}
+	l.outName.Set(name)
 }
 
 func createQueueBuilder(config common.ConfigNamespace) (func(queue.Eventer) (queue.Queue, error), error) {
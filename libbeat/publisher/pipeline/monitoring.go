@@ -17,7 +17,14 @@
 
 package pipeline
 
-import "github.com/elastic/beats/libbeat/monitoring"
+import (
+	"sync"
+	"time"
+
+	"github.com/elastic/beats/libbeat/common/atomic"
+	"github.com/elastic/beats/libbeat/monitoring"
+	"github.com/elastic/beats/libbeat/publisher/queue"
+)
 
 type observer interface {
 	pipelineObserver
@@ -39,9 +46,12 @@ type clientObserver interface {
 	filteredEvent()
 	publishedEvent()
 	failedPublishEvent()
+	producerBlocked(time.Duration)
 }
 
 type queueObserver interface {
+	queueCreated(q queue.Queue)
+	queueMaxEvents(n int)
 	queueACKed(n int)
 }
 
@@ -52,6 +62,7 @@ type outputObserver interface {
 	eventsRetry(int)
 	outBatchSend(int)
 	outBatchACKed(int)
+	outBatchLatency(time.Duration)
 }
 
 // metricsObserver is used by many component in the publisher pipeline, to report
@@ -72,7 +83,16 @@ type metricsObserver struct {
 	activeEvents                        *monitoring.Uint
 
 	// queue metrics
-	ackedQueue *monitoring.Uint
+	ackedQueue                  *monitoring.Uint
+	maxEvents                   int
+	queueFilled                 atomic.Int64 // may turn negative temporarily
+	queueFilledEvents, queueMax *monitoring.Uint
+	queueFilledPct              *monitoring.Float
+	blocked, blockedMS          *monitoring.Uint
+	queueEvents                 *queueMetrics
+
+	// output metrics
+	outACKLatency ackLatencyMetrics
 }
 
 func newMetricsObserver(metrics *monitoring.Registry) *metricsObserver {
@@ -92,7 +112,15 @@ func newMetricsObserver(metrics *monitoring.Registry) *metricsObserver {
 		dropped:   monitoring.NewUint(reg, "events.dropped"),
 		retry:     monitoring.NewUint(reg, "events.retry"),
 
-		ackedQueue: monitoring.NewUint(reg, "queue.acked"),
+		ackedQueue:        monitoring.NewUint(reg, "queue.acked"),
+		queueFilledEvents: monitoring.NewUint(reg, "queue.filled.events"),
+		queueFilledPct:    monitoring.NewFloat(reg, "queue.filled.pct"),
+		queueMax:          monitoring.NewUint(reg, "queue.max_events"),
+		blocked:           monitoring.NewUint(reg, "queue.producer.blocked.count"),
+		blockedMS:         monitoring.NewUint(reg, "queue.producer.blocked.ms"),
+		queueEvents:       newQueueMetrics(reg, "queue.events"),
+
+		outACKLatency: newACKLatencyMetrics(reg, "output."),
 
 		activeEvents: monitoring.NewUint(reg, "events.active"),
 	}
@@ -136,6 +164,7 @@ func (o *metricsObserver) filteredEvent() {
 // (client) managed to push an event into the publisher pipeline
 func (o *metricsObserver) publishedEvent() {
 	o.published.Inc()
+	o.updateQueueFilled(o.queueFilled.Add(1))
 }
 
 // (client) client closing down or DropIfFull is set
@@ -144,14 +173,43 @@ func (o *metricsObserver) failedPublishEvent() {
 	o.activeEvents.Dec()
 }
 
+// (client) client has been blocked by the queue being full when publishing
+// an event
+func (o *metricsObserver) producerBlocked(d time.Duration) {
+	o.blocked.Inc()
+	o.blockedMS.Add(uint64(d / time.Millisecond))
+}
+
 //
 // queue events
 //
 
+// (pipeline) queue has been created
+func (o *metricsObserver) queueCreated(q queue.Queue) {
+	o.queueEvents.set(q)
+}
+
+// (pipeline) maximum number of events the queue can hold, if limited
+func (o *metricsObserver) queueMaxEvents(n int) {
+	o.maxEvents = n
+	o.queueMax.Set(uint64(n))
+}
+
 // (queue) number of events ACKed by the queue/broker in use
 func (o *metricsObserver) queueACKed(n int) {
 	o.ackedQueue.Add(uint64(n))
 	o.activeEvents.Sub(uint64(n))
+	o.updateQueueFilled(o.queueFilled.Add(-int64(n)))
+}
+
+func (o *metricsObserver) updateQueueFilled(filled int64) {
+	if filled < 0 {
+		filled = 0
+	}
+	o.queueFilledEvents.Set(uint64(filled))
+	if o.maxEvents > 0 {
+		o.queueFilledPct.Set(float64(filled) / float64(o.maxEvents))
+	}
 }
 
 //
@@ -180,22 +238,109 @@ func (o *metricsObserver) outBatchSend(int) {}
 // (output) number of events acked by the output batch
 func (o *metricsObserver) outBatchACKed(int) {}
 
+// (output) time between a batch being sent to the output and it being ACKed
+func (o *metricsObserver) outBatchLatency(d time.Duration) {
+	o.outACKLatency.update(d)
+}
+
+// ackLatencyMetrics reports the time it takes outputs to ACK batches. The
+// average latency over an interval can be derived from the deltas of
+// ack_latency.total_ms and batches.acked.
+type ackLatencyMetrics struct {
+	batches, last, total *monitoring.Uint
+}
+
+func newACKLatencyMetrics(reg *monitoring.Registry, prefix string) ackLatencyMetrics {
+	return ackLatencyMetrics{
+		batches: monitoring.NewUint(reg, prefix+"batches.acked"),
+		last:    monitoring.NewUint(reg, prefix+"ack_latency.last_ms"),
+		total:   monitoring.NewUint(reg, prefix+"ack_latency.total_ms"),
+	}
+}
+
+func (m ackLatencyMetrics) update(d time.Duration) {
+	ms := uint64(d / time.Millisecond)
+	m.batches.Inc()
+	m.last.Set(ms)
+	m.total.Add(ms)
+}
+
+// queueMetrics reports the events held by queues implementing queue.Monitored,
+// and the rates the queue is filled and drained at, in events per second,
+// since the metrics were last collected.
+type queueMetrics struct {
+	mu       sync.Mutex
+	queue    queue.Monitored
+	last     queue.Metrics
+	lastTime time.Time
+}
+
+func newQueueMetrics(reg *monitoring.Registry, name string) *queueMetrics {
+	m := &queueMetrics{}
+	monitoring.NewFunc(reg, name, m.report, monitoring.Report)
+	return m
+}
+
+func (m *queueMetrics) set(q queue.Queue) {
+	monitored, _ := q.(queue.Monitored)
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.queue = monitored
+	m.last, m.lastTime = queue.Metrics{}, time.Time{}
+}
+
+func (m *queueMetrics) report(_ monitoring.Mode, V monitoring.Visitor) {
+	V.OnRegistryStart()
+	defer V.OnRegistryFinished()
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.queue == nil {
+		return
+	}
+
+	now, current := time.Now(), m.queue.Metrics()
+	monitoring.ReportInt(V, "count", int64(current.Events))
+	if current.EventsLimit > 0 {
+		monitoring.ReportInt(V, "limit", int64(current.EventsLimit))
+		monitoring.ReportFloat(V, "pct", float64(current.Events)/float64(current.EventsLimit))
+	}
+	monitoring.ReportInt(V, "added", int64(current.Added))
+	monitoring.ReportInt(V, "removed", int64(current.Removed))
+
+	var fillRate, drainRate float64
+	if !m.lastTime.IsZero() {
+		if secs := now.Sub(m.lastTime).Seconds(); secs > 0 {
+			fillRate = float64(current.Added-m.last.Added) / secs
+			drainRate = float64(current.Removed-m.last.Removed) / secs
+		}
+	}
+	monitoring.ReportFloat(V, "fill_rate", fillRate)
+	monitoring.ReportFloat(V, "drain_rate", drainRate)
+	m.last, m.lastTime = current, now
+}
+
 type emptyObserver struct{}
 
 var nilObserver observer = (*emptyObserver)(nil)
 
-func (*emptyObserver) cleanup()            {}
-func (*emptyObserver) clientConnected()    {}
-func (*emptyObserver) clientClosing()      {}
-func (*emptyObserver) clientClosed()       {}
-func (*emptyObserver) newEvent()           {}
-func (*emptyObserver) filteredEvent()      {}
-func (*emptyObserver) publishedEvent()     {}
-func (*emptyObserver) failedPublishEvent() {}
-func (*emptyObserver) queueACKed(n int)    {}
-func (*emptyObserver) updateOutputGroup()  {}
-func (*emptyObserver) eventsFailed(int)    {}
-func (*emptyObserver) eventsDropped(int)   {}
-func (*emptyObserver) eventsRetry(int)     {}
-func (*emptyObserver) outBatchSend(int)    {}
-func (*emptyObserver) outBatchACKed(int)   {}
+func (*emptyObserver) cleanup()                      {}
+func (*emptyObserver) clientConnected()              {}
+func (*emptyObserver) clientClosing()                {}
+func (*emptyObserver) clientClosed()                 {}
+func (*emptyObserver) newEvent()                     {}
+func (*emptyObserver) filteredEvent()                {}
+func (*emptyObserver) publishedEvent()               {}
+func (*emptyObserver) failedPublishEvent()           {}
+func (*emptyObserver) producerBlocked(time.Duration) {}
+func (*emptyObserver) queueCreated(queue.Queue)      {}
+func (*emptyObserver) queueMaxEvents(n int)          {}
+func (*emptyObserver) queueACKed(n int)              {}
+func (*emptyObserver) updateOutputGroup()            {}
+func (*emptyObserver) eventsFailed(int)              {}
+func (*emptyObserver) eventsDropped(int)             {}
+func (*emptyObserver) eventsRetry(int)               {}
+func (*emptyObserver) outBatchSend(int)              {}
+func (*emptyObserver) outBatchACKed(int)             {}
+func (*emptyObserver) outBatchLatency(time.Duration) {}
@@ -0,0 +1,100 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pipeline
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elastic/beats/libbeat/monitoring"
+	"github.com/elastic/beats/libbeat/publisher/queue"
+)
+
+type monitoredQueue struct {
+	queue.Queue
+	metrics queue.Metrics
+}
+
+func (q *monitoredQueue) Metrics() queue.Metrics { return q.metrics }
+
+func TestMetricsObserverQueue(t *testing.T) {
+	reg := monitoring.NewRegistry()
+	o := newMetricsObserver(reg)
+	o.queueMaxEvents(10)
+
+	for i := 0; i < 4; i++ {
+		o.newEvent()
+		o.publishedEvent()
+	}
+	o.queueACKed(2)
+	o.producerBlocked(1500 * time.Millisecond)
+
+	snapshot := monitoring.CollectFlatSnapshot(reg, monitoring.Full, false)
+	assert.Equal(t, int64(10), snapshot.Ints["pipeline.queue.max_events"])
+	assert.Equal(t, int64(2), snapshot.Ints["pipeline.queue.filled.events"])
+	assert.Equal(t, 0.2, snapshot.Floats["pipeline.queue.filled.pct"])
+	assert.Equal(t, int64(1), snapshot.Ints["pipeline.queue.producer.blocked.count"])
+	assert.Equal(t, int64(1500), snapshot.Ints["pipeline.queue.producer.blocked.ms"])
+}
+
+func TestMetricsObserverOutputLatency(t *testing.T) {
+	reg := monitoring.NewRegistry()
+	o := newMetricsObserver(reg)
+
+	o.outBatchLatency(20 * time.Millisecond)
+	o.outBatchLatency(40 * time.Millisecond)
+
+	snapshot := monitoring.CollectFlatSnapshot(reg, monitoring.Full, false)
+	assert.Equal(t, int64(2), snapshot.Ints["pipeline.output.batches.acked"])
+	assert.Equal(t, int64(40), snapshot.Ints["pipeline.output.ack_latency.last_ms"])
+	assert.Equal(t, int64(60), snapshot.Ints["pipeline.output.ack_latency.total_ms"])
+}
+
+func TestMetricsObserverQueueEvents(t *testing.T) {
+	reg := monitoring.NewRegistry()
+	o := newMetricsObserver(reg)
+
+	q := &monitoredQueue{metrics: queue.Metrics{Events: 5, EventsLimit: 10, Added: 5}}
+	o.queueCreated(q)
+
+	snapshot := monitoring.CollectFlatSnapshot(reg, monitoring.Full, false)
+	assert.Equal(t, int64(5), snapshot.Ints["pipeline.queue.events.count"])
+	assert.Equal(t, int64(10), snapshot.Ints["pipeline.queue.events.limit"])
+	assert.Equal(t, 0.5, snapshot.Floats["pipeline.queue.events.pct"])
+	assert.Equal(t, int64(5), snapshot.Ints["pipeline.queue.events.added"])
+	assert.Equal(t, 0.0, snapshot.Floats["pipeline.queue.events.fill_rate"])
+
+	// rates are computed since the previous collection
+	q.metrics = queue.Metrics{Events: 3, EventsLimit: 10, Added: 1005, Removed: 1002}
+	snapshot = monitoring.CollectFlatSnapshot(reg, monitoring.Full, false)
+	assert.Equal(t, int64(3), snapshot.Ints["pipeline.queue.events.count"])
+	assert.Equal(t, int64(1002), snapshot.Ints["pipeline.queue.events.removed"])
+	assert.True(t, snapshot.Floats["pipeline.queue.events.fill_rate"] > 0)
+	assert.True(t, snapshot.Floats["pipeline.queue.events.drain_rate"] > 0)
+}
+
+func TestMetricsObserverQueueNotMonitored(t *testing.T) {
+	reg := monitoring.NewRegistry()
+	o := newMetricsObserver(reg)
+	o.queueCreated(struct{ queue.Queue }{})
+
+	snapshot := monitoring.CollectFlatSnapshot(reg, monitoring.Full, false)
+	assert.NotContains(t, snapshot.Ints, "pipeline.queue.events.count")
+}
@@ -18,6 +18,9 @@
 package pipeline
 
 import (
+	"sync"
+	"time"
+
 	"github.com/elastic/beats/libbeat/common/atomic"
 	"github.com/elastic/beats/libbeat/logp"
 	"github.com/elastic/beats/libbeat/outputs"
@@ -29,6 +32,10 @@ type clientWorker struct {
 	qu       workQueue
 	client   outputs.Client
 	closed   atomic.Bool
+	done     chan struct{}
+	stopOnce sync.Once
+	stopped  chan struct{}
+	inflight sync.WaitGroup
 }
 
 // netClientWorker manages reconnectable output clients of type outputs.NetworkClient.
@@ -37,6 +44,10 @@ type netClientWorker struct {
 	qu       workQueue
 	client   outputs.NetworkClient
 	closed   atomic.Bool
+	done     chan struct{}
+	stopOnce sync.Once
+	stopped  chan struct{}
+	inflight sync.WaitGroup
 
 	batchSize  int
 	batchSizer func() int
@@ -44,42 +55,133 @@ type netClientWorker struct {
 
 func makeClientWorker(observer outputObserver, qu workQueue, client outputs.Client) outputWorker {
 	if nc, ok := client.(outputs.NetworkClient); ok {
-		c := &netClientWorker{observer: observer, qu: qu, client: nc}
+		c := &netClientWorker{
+			observer: observer,
+			qu:       qu,
+			client:   nc,
+			done:     make(chan struct{}),
+			stopped:  make(chan struct{}),
+		}
 		go c.run()
 		return c
 	}
-	c := &clientWorker{observer: observer, qu: qu, client: client}
+	c := &clientWorker{
+		observer: observer,
+		qu:       qu,
+		client:   client,
+		done:     make(chan struct{}),
+		stopped:  make(chan struct{}),
+	}
 	go c.run()
 	return c
 }
 
 func (w *clientWorker) Close() error {
-	w.closed.Store(true)
+	w.stop()
 	return w.client.Close()
 }
 
+func (w *clientWorker) Drain(timeout time.Duration) bool {
+	w.stop()
+	return waitDrained(w.stopped, &w.inflight, timeout)
+}
+
+func (w *clientWorker) stop() {
+	w.stopOnce.Do(func() {
+		w.closed.Store(true)
+		close(w.done)
+	})
+}
+
 func (w *clientWorker) run() {
+	defer close(w.stopped)
+
 	for !w.closed.Load() {
-		for batch := range w.qu {
-			w.observer.outBatchSend(len(batch.events))
+		batch, ok := w.next()
+		if !ok {
+			return
+		}
+		if w.closed.Load() {
+			batch.Cancelled()
+			return
+		}
 
-			if err := w.client.Publish(batch); err != nil {
-				return
-			}
+		w.inflight.Add(1)
+		batch.sent(w.inflight.Done)
+
+		if err := w.client.Publish(batch); err != nil {
+			return
 		}
 	}
 }
 
+// next returns the next batch from the work queue. It returns false if the
+// worker is closed, so workers of outputs replaced on reload don't block
+// forever on the old work queue.
+func (w *clientWorker) next() (*Batch, bool) {
+	return nextBatch(w.qu, w.done)
+}
+
 func (w *netClientWorker) Close() error {
-	w.closed.Store(true)
+	w.stop()
 	return w.client.Close()
 }
 
+func (w *netClientWorker) Drain(timeout time.Duration) bool {
+	w.stop()
+	return waitDrained(w.stopped, &w.inflight, timeout)
+}
+
+func (w *netClientWorker) stop() {
+	w.stopOnce.Do(func() {
+		w.closed.Store(true)
+		close(w.done)
+	})
+}
+
+func (w *netClientWorker) next() (*Batch, bool) {
+	return nextBatch(w.qu, w.done)
+}
+
+func nextBatch(qu workQueue, done <-chan struct{}) (*Batch, bool) {
+	select {
+	case <-done:
+		return nil, false
+	case batch, ok := <-qu:
+		return batch, ok
+	}
+}
+
+// waitDrained waits for a stopped worker to return and for the batches it
+// passed to the output client to be finished. It returns false if the timeout
+// expires first.
+func waitDrained(stopped <-chan struct{}, inflight *sync.WaitGroup, timeout time.Duration) bool {
+	drained := make(chan struct{})
+	go func() {
+		<-stopped
+		inflight.Wait()
+		close(drained)
+	}()
+
+	select {
+	case <-drained:
+		return true
+	case <-time.After(timeout):
+		return false
+	}
+}
+
 func (w *netClientWorker) run() {
+	defer close(w.stopped)
+
 	for !w.closed.Load() {
 		// start initial connect loop from first batch, but return
 		// batch to pipeline for other outputs to catch up while we're trying to connect
-		for batch := range w.qu {
+		for {
+			batch, ok := w.next()
+			if !ok {
+				return
+			}
 			batch.Cancelled()
 
 			if w.closed.Load() {
@@ -96,7 +198,11 @@ func (w *netClientWorker) run() {
 		}
 
 		// send loop
-		for batch := range w.qu {
+		for {
+			batch, ok := w.next()
+			if !ok {
+				return
+			}
 			if w.closed.Load() {
 				if batch != nil {
 					batch.Cancelled()
@@ -104,6 +210,8 @@ func (w *netClientWorker) run() {
 				return
 			}
 
+			w.inflight.Add(1)
+			batch.sent(w.inflight.Done)
 			err := w.client.Publish(batch)
 			if err != nil {
 				logp.Err("Failed to publish events: %v", err)
@@ -56,7 +56,13 @@ type Pipeline struct {
 	queue  queue.Queue
 	output *outputController
 
+	// outputLoader creates new outputs when reloading the output configuration.
+	// Reloading is not supported if nil.
+	outputLoader *outputLoader
+	outputMutex  sync.Mutex
+
 	observer observer
+	tracer   *tracer
 
 	eventer pipelineEventer
 
@@ -73,6 +79,9 @@ type Pipeline struct {
 	eventSema  *sema
 
 	processors pipelineProcessors
+
+	// globalProcessors are closed when the pipeline is closed.
+	globalProcessors *processors.Processors
 }
 
 type pipelineProcessors struct {
@@ -101,6 +110,9 @@ type Settings struct {
 	Processors  *processors.Processors
 
 	Disabled bool
+
+	// Trace configures the tracing of sampled events through the pipeline.
+	Trace TraceConfig
 }
 
 // Annotations configures additional metadata to be adde to every single event
@@ -169,6 +181,8 @@ func New(
 		waitCloseMode:    settings.WaitCloseMode,
 		waitCloseTimeout: settings.WaitClose,
 		processors:       makePipelineProcessors(annotations, processors, disabledOutput),
+		globalProcessors: processors,
+		tracer:           newTracer(settings.Trace),
 	}
 	p.ackBuilder = &pipelineEmptyACK{p}
 	p.ackActive = atomic.MakeBool(true)
@@ -191,8 +205,10 @@ func New(
 		return nil, err
 	}
 
+	p.observer.queueCreated(p.queue)
 	if count := p.queue.BufferConfig().Events; count > 0 {
 		p.eventSema = newSema(count)
+		p.observer.queueMaxEvents(count)
 	}
 
 	maxEvents := p.queue.BufferConfig().Events
@@ -203,12 +219,34 @@ func New(
 	}
 	p.eventSema = newSema(maxEvents)
 
-	p.output = newOutputController(log, p.observer, p.queue)
+	p.output = newOutputController(log, p.observer, p.tracer, p.queue)
 	p.output.Set(out)
 
 	return p, nil
 }
 
+// ReloadOutput replaces the outputs of the pipeline with the outputs in the
+// given configuration. The old output clients are drained before they are
+// closed. Batches not acknowledged by them before the drain timeout and the
+// events in the queue are retried with the new outputs, so no events are lost.
+func (p *Pipeline) ReloadOutput(outcfg common.ConfigNamespace) error {
+	if p.outputLoader == nil {
+		return errors.New("output reloading is not supported by this pipeline")
+	}
+
+	p.outputMutex.Lock()
+	defer p.outputMutex.Unlock()
+
+	out, err := p.outputLoader.load(outcfg)
+	if err != nil {
+		return err
+	}
+
+	p.logger.Infof("Reloading output of type %v", outcfg.Name())
+	p.output.Set(out)
+	return nil
+}
+
 // SetACKHandler sets a global ACK handler on all events published to the pipeline.
 // SetACKHandler must be called before any connection is made.
 func (p *Pipeline) SetACKHandler(handler beat.PipelineACKHandler) error {
@@ -285,6 +323,10 @@ func (p *Pipeline) Close() error {
 		log.Error("pipeline queue shutdown error: ", err)
 	}
 
+	if err := p.globalProcessors.Close(); err != nil {
+		log.Error("failed to close processors: ", err)
+	}
+
 	p.observer.cleanup()
 	return nil
 }
@@ -360,15 +402,16 @@ func (p *Pipeline) ConnectWith(cfg beat.ClientConfig) (beat.Client, error) {
 
 	producer := p.queue.Producer(producerCfg)
 	client := &client{
-		pipeline:     p,
-		isOpen:       atomic.MakeBool(true),
-		eventer:      cfg.Events,
-		processors:   processors,
-		producer:     producer,
-		acker:        acker,
-		eventFlags:   eventFlags,
-		canDrop:      canDrop,
-		reportEvents: reportEvents,
+		pipeline:         p,
+		isOpen:           atomic.MakeBool(true),
+		eventer:          cfg.Events,
+		processors:       processors,
+		clientProcessors: cfg.Processor,
+		producer:         producer,
+		acker:            acker,
+		eventFlags:       eventFlags,
+		canDrop:          canDrop,
+		reportEvents:     reportEvents,
 	}
 
 	p.observer.clientConnected()
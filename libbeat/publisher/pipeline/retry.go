@@ -196,6 +196,10 @@ func (r *retryer) loop() {
 			switch sig.tag {
 			case sigRetryerUpdateOutput:
 				r.out = sig.channel
+				// forward pending batches to the new output
+				if out != nil {
+					out = r.out
+				}
 			case sigRetryerOutputAdded:
 				numOutputs++
 			case sigRetryerOutputRemoved:
@@ -0,0 +1,321 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pipeline
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/common/atomic"
+	"github.com/elastic/beats/libbeat/conditions"
+	"github.com/elastic/beats/libbeat/logp"
+	"github.com/elastic/beats/libbeat/monitoring"
+	"github.com/elastic/beats/libbeat/outputs"
+	"github.com/elastic/beats/libbeat/publisher"
+	"github.com/elastic/beats/libbeat/publisher/queue"
+)
+
+// outputRouter is an outputs.Client forwarding the events of the pipeline
+// to multiple outputs. Every output (route) has its own queue, output
+// controller and retryer, so an output being slow or unavailable does not
+// stop the others from publishing events, until its queue is full.
+// Events are only forwarded to the routes whose condition matches the event.
+// A batch from the pipeline queue is ACKed once its events have been accepted
+// by the queues of all matching routes. From then on every route publishes and
+// ACKs its share of the events on its own.
+type outputRouter struct {
+	log    *logp.Logger
+	routes []*outputRoute
+
+	// drainTimeout is the time the routes have on close to publish the
+	// events in their queues.
+	drainTimeout time.Duration
+
+	closed atomic.Bool
+}
+
+// outputRoute is a single output of the router, with its own queue.
+type outputRoute struct {
+	router    *outputRouter
+	name      string
+	condition conditions.Condition
+
+	queue    queue.Queue
+	producer queue.Producer
+	output   *outputController
+
+	mutex   sync.Mutex
+	pending int           // events published to the queue, not yet ACKed
+	empty   chan struct{} // closed once all pending events are ACKed
+}
+
+// routeConfig holds the routing settings found in the output configuration.
+type routeConfig struct {
+	When  *conditions.Config     `config:"when"`
+	Queue common.ConfigNamespace `config:"queue"`
+}
+
+// routeEventer counts the events ACKed by the queue of a route.
+type routeEventer struct {
+	route *outputRoute
+	acked *monitoring.Uint
+}
+
+var errRouterClosed = errors.New("output router closed")
+
+// defaultRouteQueue configures the memory queue of a route to forward events
+// to the output without waiting for a minimum number of events, as events
+// are already buffered by the pipeline queue.
+var defaultRouteQueue = map[string]interface{}{
+	"flush.min_events": 0,
+}
+
+func newOutputRouter(log *logp.Logger) *outputRouter {
+	return &outputRouter{
+		log:          log,
+		drainTimeout: defaultDrainTimeout,
+	}
+}
+
+// addRoute creates the queue and output controller for an output group and
+// adds it to the router.
+func (r *outputRouter) addRoute(
+	name string,
+	config routeConfig,
+	out outputs.Group,
+	reg *monitoring.Registry,
+) error {
+	var cond conditions.Condition
+	if config.When != nil {
+		var err error
+		cond, err = conditions.NewCondition(config.When)
+		if err != nil {
+			return err
+		}
+	}
+
+	eventer := &routeEventer{}
+	var observer outputObserver = nilObserver
+	var queueEvents *queueMetrics
+	if reg != nil {
+		eventer.acked = monitoring.NewUint(reg, "queue.acked")
+		queueEvents = newQueueMetrics(reg, "queue.events")
+		observer = &routeObserver{latency: newACKLatencyMetrics(reg, "")}
+	}
+
+	qu, err := createRouteQueue(config.Queue, eventer)
+	if err != nil {
+		return err
+	}
+	if queueEvents != nil {
+		queueEvents.set(qu)
+	}
+
+	route := &outputRoute{
+		router:    r,
+		name:      name,
+		condition: cond,
+		queue:     qu,
+		output:    newOutputController(r.log, observer, nil, qu),
+	}
+	eventer.route = route
+	route.producer = qu.Producer(queue.ProducerConfig{})
+	route.output.Set(out)
+
+	r.routes = append(r.routes, route)
+	return nil
+}
+
+// routeObserver reports the ACK latency of the output of a route.
+type routeObserver struct {
+	*emptyObserver
+	latency ackLatencyMetrics
+}
+
+func (o *routeObserver) outBatchLatency(d time.Duration) { o.latency.update(d) }
+
+func createRouteQueue(config common.ConfigNamespace, eventer queue.Eventer) (queue.Queue, error) {
+	if config.IsSet() {
+		builder, err := createQueueBuilder(config)
+		if err != nil {
+			return nil, err
+		}
+		return builder(eventer)
+	}
+
+	factory := queue.FindFactory(defaultQueueType)
+	if factory == nil {
+		return nil, errors.New("default queue type not available")
+	}
+	return factory(eventer, common.MustNewConfigFrom(defaultRouteQueue))
+}
+
+// Publish forwards the events of the batch to the matching routes. Publish
+// blocks if the queue of a matching route is full. The batch is ACKed once all
+// events have been accepted by the queues of their routes.
+func (r *outputRouter) Publish(batch publisher.Batch) error {
+	if r.closed.Load() {
+		batch.Cancelled()
+		return errRouterClosed
+	}
+
+	events := batch.Events()
+	routed := make([][]int, len(r.routes))
+
+	unrouted := 0
+	for i := range events {
+		matched := false
+		for j, route := range r.routes {
+			if route.matches(&events[i].Content) {
+				routed[j] = append(routed[j], i)
+				matched = true
+			}
+		}
+		if !matched {
+			unrouted++
+		}
+	}
+	if unrouted > 0 {
+		r.log.Debugf("Dropping %v events not matching any output", unrouted)
+	}
+
+	forwarded := make([]bool, len(events))
+	for i, route := range r.routes {
+		for _, idx := range routed[i] {
+			if !route.publish(events[idx]) {
+				r.cancelUnforwarded(batch, events, forwarded)
+				return errRouterClosed
+			}
+			forwarded[idx] = true
+		}
+	}
+
+	batch.ACK()
+	return nil
+}
+
+// cancelUnforwarded returns the events of the batch that have not been
+// forwarded to any route to the pipeline. Events already forwarded to a route
+// are not retried, so they are not published twice by that route.
+func (r *outputRouter) cancelUnforwarded(batch publisher.Batch, events []publisher.Event, forwarded []bool) {
+	var cancelled []publisher.Event
+	for i, event := range events {
+		if !forwarded[i] {
+			cancelled = append(cancelled, event)
+		}
+	}
+
+	if len(cancelled) < len(events) {
+		r.log.Debugf("Router closed, %v events were only forwarded to some of their outputs",
+			len(events)-len(cancelled))
+	}
+	if len(cancelled) == 0 {
+		batch.ACK()
+		return
+	}
+	batch.CancelledEvents(cancelled)
+}
+
+// Close stops forwarding events and closes the routes. Every route has up to
+// drainTimeout to publish the events already in its queue.
+func (r *outputRouter) Close() error {
+	r.closed.Store(true)
+
+	var wg sync.WaitGroup
+	for _, route := range r.routes {
+		wg.Add(1)
+		go func(route *outputRoute) {
+			defer wg.Done()
+			route.close(r.drainTimeout)
+		}(route)
+	}
+	wg.Wait()
+	return nil
+}
+
+func (rt *outputRoute) matches(event *beat.Event) bool {
+	return rt.condition == nil || rt.condition.Check(event)
+}
+
+func (rt *outputRoute) publish(event publisher.Event) bool {
+	rt.addPending(1)
+	if !rt.producer.Publish(event) {
+		rt.addPending(-1)
+		return false
+	}
+	return true
+}
+
+// addPending updates the number of events in the queue of the route, that
+// have not been ACKed by its output yet.
+func (rt *outputRoute) addPending(n int) {
+	rt.mutex.Lock()
+	defer rt.mutex.Unlock()
+
+	// events of a persistent queue might have been published by a
+	// previous run
+	rt.pending += n
+	if rt.pending < 0 {
+		rt.pending = 0
+	}
+
+	switch {
+	case rt.pending > 0 && rt.empty == nil:
+		rt.empty = make(chan struct{})
+	case rt.pending == 0 && rt.empty != nil:
+		close(rt.empty)
+		rt.empty = nil
+	}
+}
+
+// close waits for the events in the queue of the route to be published, then
+// closes its output and queue.
+func (rt *outputRoute) close(timeout time.Duration) {
+	rt.mutex.Lock()
+	empty := rt.empty
+	rt.mutex.Unlock()
+
+	if empty != nil {
+		select {
+		case <-empty:
+		case <-time.After(timeout):
+			rt.mutex.Lock()
+			pending := rt.pending
+			rt.mutex.Unlock()
+			rt.router.log.Errorf("Timeout publishing the events of output %v, %v events are dropped",
+				rt.name, pending)
+		}
+	}
+
+	rt.output.Close()
+	if err := rt.queue.Close(); err != nil {
+		rt.router.log.Errorf("Failed to close queue of output %v: %v", rt.name, err)
+	}
+}
+
+func (e *routeEventer) OnACK(n int) {
+	if e.route != nil {
+		e.route.addPending(-n)
+	}
+	if e.acked != nil {
+		e.acked.Add(uint64(n))
+	}
+}
@@ -0,0 +1,230 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pipeline
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/conditions"
+	"github.com/elastic/beats/libbeat/logp"
+	"github.com/elastic/beats/libbeat/outputs"
+	"github.com/elastic/beats/libbeat/outputs/outest"
+	"github.com/elastic/beats/libbeat/publisher"
+	_ "github.com/elastic/beats/libbeat/publisher/queue/memqueue"
+)
+
+type mockClient struct {
+	mutex  sync.Mutex
+	events []publisher.Event
+	ack    bool
+}
+
+func (c *mockClient) Close() error { return nil }
+
+func (c *mockClient) Publish(batch publisher.Batch) error {
+	c.mutex.Lock()
+	c.events = append(c.events, batch.Events()...)
+	c.mutex.Unlock()
+
+	if c.ack {
+		batch.ACK()
+	}
+	return nil
+}
+
+func (c *mockClient) count() int {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return len(c.events)
+}
+
+// waitCount waits for the client to receive the given number of events.
+func (c *mockClient) waitCount(t *testing.T, n int) {
+	for start := time.Now(); c.count() < n; time.Sleep(10 * time.Millisecond) {
+		if time.Since(start) > 5*time.Second {
+			t.Fatalf("timeout waiting for %v events, got %v", n, c.count())
+		}
+	}
+	assert.Equal(t, n, c.count())
+}
+
+func newTestRouter(t *testing.T, routes map[string]*conditions.Config, clients map[string]*mockClient) *outputRouter {
+	router := newOutputRouter(logp.NewLogger("test"))
+	for name, cond := range routes {
+		out, _ := outputs.Success(0, 0, clients[name])
+		err := router.addRoute(name, routeConfig{When: cond}, out, nil)
+		require.NoError(t, err)
+	}
+	return router
+}
+
+func signalBatch(events ...beat.Event) (*outest.Batch, chan outest.BatchSignal) {
+	ch := make(chan outest.BatchSignal, 1)
+	batch := outest.NewBatch(events...)
+	batch.OnSignal = func(sig outest.BatchSignal) { ch <- sig }
+	return batch, ch
+}
+
+func waitSignal(t *testing.T, ch chan outest.BatchSignal) outest.BatchSignal {
+	select {
+	case sig := <-ch:
+		return sig
+	case <-time.After(5 * time.Second):
+		t.Fatal("timeout waiting for batch signal")
+	}
+	return outest.BatchSignal{}
+}
+
+func TestOutputRouterRoutesByCondition(t *testing.T) {
+	cond := &conditions.Config{}
+	err := common.MustNewConfigFrom(map[string]interface{}{
+		"equals.type": "audit",
+	}).Unpack(cond)
+	require.NoError(t, err)
+
+	clients := map[string]*mockClient{
+		"all":   {ack: true},
+		"audit": {ack: true},
+	}
+	router := newTestRouter(t, map[string]*conditions.Config{
+		"all":   nil,
+		"audit": cond,
+	}, clients)
+	defer router.Close()
+
+	batch, signals := signalBatch(
+		beat.Event{Fields: common.MapStr{"type": "audit"}},
+		beat.Event{Fields: common.MapStr{"type": "log"}},
+		beat.Event{Fields: common.MapStr{"type": "log"}},
+	)
+	require.NoError(t, router.Publish(batch))
+
+	sig := waitSignal(t, signals)
+	assert.Equal(t, outest.BatchACK, sig.Tag)
+	clients["all"].waitCount(t, 3)
+	clients["audit"].waitCount(t, 1)
+}
+
+func TestOutputRouterACKsUnroutedEvents(t *testing.T) {
+	cond := &conditions.Config{}
+	err := common.MustNewConfigFrom(map[string]interface{}{
+		"equals.type": "audit",
+	}).Unpack(cond)
+	require.NoError(t, err)
+
+	clients := map[string]*mockClient{"audit": {ack: true}}
+	router := newTestRouter(t, map[string]*conditions.Config{"audit": cond}, clients)
+	defer router.Close()
+
+	batch, signals := signalBatch(beat.Event{Fields: common.MapStr{"type": "log"}})
+	require.NoError(t, router.Publish(batch))
+
+	sig := waitSignal(t, signals)
+	assert.Equal(t, outest.BatchACK, sig.Tag)
+	assert.Equal(t, 0, clients["audit"].count())
+}
+
+func TestOutputRouterRoutesIndependently(t *testing.T) {
+	fast := &mockClient{ack: true}
+	slow := newPendingClient()
+
+	router := newOutputRouter(logp.NewLogger("test"))
+	router.drainTimeout = 50 * time.Millisecond
+	defer router.Close()
+	for name, client := range map[string]outputs.Client{"fast": fast, "slow": slow} {
+		out, _ := outputs.Success(0, 0, client)
+		require.NoError(t, router.addRoute(name, routeConfig{}, out, nil))
+	}
+
+	// batches are ACKed once they are in the queues of the routes, while the
+	// slow route did not ACK any of them
+	for i := 0; i < 3; i++ {
+		batch, signals := signalBatch(beat.Event{Fields: common.MapStr{"message": "test"}})
+		require.NoError(t, router.Publish(batch))
+
+		sig := waitSignal(t, signals)
+		assert.Equal(t, outest.BatchACK, sig.Tag)
+	}
+
+	slow.next(t)
+	fast.waitCount(t, 3)
+}
+
+func TestOutputRouterCloseDrainsRoutes(t *testing.T) {
+	client := newPendingClient()
+	router := newOutputRouter(logp.NewLogger("test"))
+	out, _ := outputs.Success(0, 0, client)
+	require.NoError(t, router.addRoute("slow", routeConfig{}, out, nil))
+
+	batch, signals := signalBatch(beat.Event{Fields: common.MapStr{"message": "test"}})
+	require.NoError(t, router.Publish(batch))
+	assert.Equal(t, outest.BatchACK, waitSignal(t, signals).Tag)
+
+	pending := client.next(t)
+	done := make(chan struct{})
+	go func() {
+		router.Close()
+		close(done)
+	}()
+
+	// the route is not closed while it has events to publish
+	select {
+	case <-done:
+		t.Fatal("router closed before the route was drained")
+	case <-time.After(100 * time.Millisecond):
+	}
+	assert.False(t, client.closed.Load())
+
+	pending.ACK()
+	<-done
+	assert.True(t, client.closed.Load())
+
+	// batches published after close are returned to the pipeline
+	batch, signals = signalBatch(beat.Event{Fields: common.MapStr{"message": "test"}})
+	assert.Error(t, router.Publish(batch))
+	assert.Equal(t, outest.BatchCancelled, waitSignal(t, signals).Tag)
+}
+
+func TestOutputRouterCloseTimeout(t *testing.T) {
+	router := newTestRouter(t, map[string]*conditions.Config{"slow": nil},
+		map[string]*mockClient{"slow": {ack: false}})
+	router.drainTimeout = 50 * time.Millisecond
+
+	batch, signals := signalBatch(beat.Event{Fields: common.MapStr{"message": "test"}})
+	require.NoError(t, router.Publish(batch))
+	assert.Equal(t, outest.BatchACK, waitSignal(t, signals).Tag)
+
+	done := make(chan struct{})
+	go func() {
+		router.Close()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timeout closing the router")
+	}
+}
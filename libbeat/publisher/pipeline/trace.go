@@ -0,0 +1,157 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pipeline
+
+import (
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/elastic/beats/libbeat/common/atomic"
+	"github.com/elastic/beats/libbeat/logp"
+	"github.com/elastic/beats/libbeat/publisher"
+)
+
+// TraceConfig configures the tracing of events through the pipeline stages.
+type TraceConfig struct {
+	Enabled bool `config:"enabled"`
+
+	// Fraction of the published events to trace, between 0 and 1. Defaults
+	// to defaultTraceSampleRate if not set.
+	SampleRate float64 `config:"sample_rate" validate:"min=0, max=1"`
+}
+
+const defaultTraceSampleRate = 0.001
+
+const (
+	traceStagePublish = "publish"
+	traceStageQueue   = "queue"
+	traceStageOutput  = "output"
+	traceStageACK     = "ack"
+	traceStageDropped = "dropped"
+)
+
+// tracer samples events on publish and logs the pipeline stages the sampled
+// events pass: publish -> queue -> output -> ack. All methods can be called
+// on a nil tracer, in which case no event is traced.
+type tracer struct {
+	log  *logp.Logger
+	rate float64
+
+	ids atomic.Uint64
+
+	mutex sync.Mutex
+	rand  *rand.Rand
+}
+
+func newTracer(config TraceConfig) *tracer {
+	if !config.Enabled {
+		return nil
+	}
+
+	rate := config.SampleRate
+	if rate == 0 {
+		rate = defaultTraceSampleRate
+	}
+
+	return &tracer{
+		log:  logp.NewLogger("publish_trace"),
+		rate: rate,
+		rand: rand.New(rand.NewSource(time.Now().UnixNano())),
+	}
+}
+
+// sample decides if the event is traced, and logs the publish stage if so.
+func (t *tracer) sample(event *publisher.Event) {
+	if t == nil {
+		return
+	}
+
+	t.mutex.Lock()
+	sampled := t.rand.Float64() < t.rate
+	t.mutex.Unlock()
+	if !sampled {
+		return
+	}
+
+	event.Trace = &publisher.Trace{
+		ID:        t.ids.Inc(),
+		Published: time.Now(),
+	}
+	t.logStage(traceStagePublish, event.Trace)
+}
+
+// stage logs the given stage for all traced events.
+func (t *tracer) stage(stage string, events []publisher.Event) {
+	if t == nil {
+		return
+	}
+
+	for i := range events {
+		if trace := events[i].Trace; trace != nil {
+			t.logStage(stage, trace)
+		}
+	}
+}
+
+// traces returns the traces of the traced events.
+func (t *tracer) traces(events []publisher.Event) []*publisher.Trace {
+	if t == nil {
+		return nil
+	}
+
+	var traces []*publisher.Trace
+	for i := range events {
+		if trace := events[i].Trace; trace != nil {
+			traces = append(traces, trace)
+		}
+	}
+	return traces
+}
+
+// stageRemoved logs the given stage for the traces that are not part of the
+// remaining events anymore, and returns the traces of the remaining events.
+// Outputs may reuse the events slice for the remaining events, so the traces
+// must be collected before the batch is passed to the output.
+func (t *tracer) stageRemoved(stage string, traces []*publisher.Trace, remaining []publisher.Event) []*publisher.Trace {
+	if t == nil {
+		return nil
+	}
+
+	left := t.traces(remaining)
+	for _, trace := range traces {
+		found := false
+		for _, other := range left {
+			if other == trace {
+				found = true
+				break
+			}
+		}
+		if !found {
+			t.logStage(stage, trace)
+		}
+	}
+	return left
+}
+
+func (t *tracer) logStage(stage string, trace *publisher.Trace) {
+	t.log.Infow("Event trace",
+		"trace.id", trace.ID,
+		"trace.stage", stage,
+		"trace.elapsed", time.Since(trace.Published))
+}
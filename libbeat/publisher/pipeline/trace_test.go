@@ -0,0 +1,80 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pipeline
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+
+	"github.com/elastic/beats/libbeat/logp"
+	"github.com/elastic/beats/libbeat/publisher"
+)
+
+func TestTracerDisabled(t *testing.T) {
+	tracer := newTracer(TraceConfig{Enabled: false, SampleRate: 1})
+	assert.Nil(t, tracer)
+
+	// all methods must be usable on a nil tracer
+	event := publisher.Event{}
+	tracer.sample(&event)
+	tracer.stage(traceStageQueue, []publisher.Event{event})
+	tracer.stageRemoved(traceStageACK, tracer.traces([]publisher.Event{event}), nil)
+	assert.Nil(t, event.Trace)
+}
+
+func TestTracerSample(t *testing.T) {
+	tracer := newTracer(TraceConfig{Enabled: true, SampleRate: 1})
+
+	events := make([]publisher.Event, 3)
+	for i := range events {
+		tracer.sample(&events[i])
+		if assert.NotNil(t, events[i].Trace) {
+			assert.Equal(t, uint64(i+1), events[i].Trace.ID)
+		}
+	}
+}
+
+func TestTracerDefaultSampleRate(t *testing.T) {
+	tracer := newTracer(TraceConfig{Enabled: true})
+	assert.Equal(t, defaultTraceSampleRate, tracer.rate)
+}
+
+func TestTracerStageRemovedCompactedEvents(t *testing.T) {
+	logp.DevelopmentSetup(logp.ToObserverOutput())
+	tracer := newTracer(TraceConfig{Enabled: true, SampleRate: 1})
+
+	events := make([]publisher.Event, 3)
+	for i := range events {
+		tracer.sample(&events[i])
+	}
+	traces := tracer.traces(events)
+
+	// Outputs compact the events to retry in place, here the second and
+	// third events are retried, the first one was acknowledged.
+	remaining := append(events[:0], events[1:]...)
+	traces = tracer.stageRemoved(traceStageACK, traces, remaining)
+
+	assert.Len(t, traces, 2)
+	var acked []uint64
+	for _, entry := range logp.ObserverLogs().FilterField(zap.String("trace.stage", traceStageACK)).All() {
+		acked = append(acked, entry.ContextMap()["trace.id"].(uint64))
+	}
+	assert.Equal(t, []uint64{1}, acked)
+}
@@ -22,6 +22,7 @@ import (
 	"time"
 
 	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/common/atomic"
 	"github.com/elastic/beats/libbeat/feature"
 	"github.com/elastic/beats/libbeat/logp"
 	"github.com/elastic/beats/libbeat/publisher/queue"
@@ -51,6 +52,9 @@ type Broker struct {
 
 	eventer queue.Eventer
 
+	// events added to and removed from the buffers, updated by the event loop
+	added, removed atomic.Uint64
+
 	// wait group for worker shutdown
 	wg          sync.WaitGroup
 	waitOnClose bool
@@ -189,6 +193,16 @@ func (b *Broker) Consumer() queue.Consumer {
 	return newConsumer(b)
 }
 
+func (b *Broker) Metrics() queue.Metrics {
+	added, removed := b.added.Load(), b.removed.Load()
+	return queue.Metrics{
+		Events:      int(added - removed),
+		EventsLimit: b.bufSize,
+		Added:       added,
+		Removed:     removed,
+	}
+}
+
 var ackChanPool = sync.Pool{
 	New: func() interface{} {
 		return &ackChan{
@@ -132,7 +132,11 @@ func (l *directEventLoop) handleInsert(req *pushRequest) {
 	// log := l.broker.logger
 	// log.Debugf("push event: %v\t%v\t%p\n", req.event, req.seq, req.state)
 
-	if avail, ok := l.insert(req); ok && avail == 0 {
+	avail, ok := l.insert(req)
+	if ok {
+		l.broker.added.Inc()
+	}
+	if ok && avail == 0 {
 		// log.Debugf("buffer: all regions full")
 
 		// no more space to accept new events -> unset events queue for time being
@@ -175,6 +179,7 @@ func (l *directEventLoop) handleCancel(req *producerCancelRequest) {
 	if st := req.state; st != nil {
 		st.cancelled = true
 		removed = l.buf.cancel(st)
+		broker.removed.Add(uint64(removed))
 	}
 
 	// signal cancel request being finished
@@ -215,6 +220,7 @@ func (l *directEventLoop) handleACK(count int) {
 	// After handling ACKs some buffer has been freed up
 	// -> always reenable producers
 	l.buf.ack(count)
+	l.broker.removed.Add(uint64(count))
 	l.events = l.broker.events
 }
 
@@ -345,6 +351,7 @@ func (l *bufferingEventLoop) run() {
 
 func (l *bufferingEventLoop) handleInsert(req *pushRequest) {
 	if l.insert(req) {
+		l.broker.added.Inc()
 		l.eventCount++
 		if l.eventCount == l.maxEvents {
 			l.events = nil // stop inserting events if upper limit is reached
@@ -419,6 +426,7 @@ func (l *bufferingEventLoop) handleCancel(req *producerCancelRequest) {
 		l.get = nil
 	}
 
+	l.broker.removed.Add(uint64(removed))
 	l.eventCount -= removed
 	if l.eventCount < l.maxEvents {
 		l.events = l.broker.events
@@ -463,6 +471,7 @@ func (l *bufferingEventLoop) handleConsumer(req *getRequest) {
 }
 
 func (l *bufferingEventLoop) handleACK(count int) {
+	l.broker.removed.Add(uint64(count))
 	l.eventCount -= count
 	if l.eventCount < l.maxEvents {
 		l.events = l.broker.events
@@ -70,6 +70,15 @@ func TestProducerCancelRemovesEvents(t *testing.T) {
 	queuetest.TestProducerCancelRemovesEvents(t, makeTestQueue(1024, 0, 0))
 }
 
+func TestMetrics(t *testing.T) {
+	t.Run("direct", func(t *testing.T) {
+		queuetest.TestMetrics(t, makeTestQueue(1024, 0, 0))
+	})
+	t.Run("buffered", func(t *testing.T) {
+		queuetest.TestMetrics(t, makeTestQueue(1024, 4, 10*time.Millisecond))
+	})
+}
+
 func makeTestQueue(sz, minEvents int, flushTimeout time.Duration) queuetest.QueueFactory {
 	return func(_ *testing.T) queue.Queue {
 		return NewBroker(Settings{
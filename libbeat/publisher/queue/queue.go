@@ -60,6 +60,21 @@ type BufferConfig struct {
 	Events int // can be <= 0, if queue can not determine limit
 }
 
+// Monitored is implemented by queues reporting their own occupancy.
+type Monitored interface {
+	Metrics() Metrics
+}
+
+// Metrics is a snapshot of the events held by a queue. The fill and drain
+// rates can be derived from the deltas of Added and Removed.
+type Metrics struct {
+	Events      int // number of events in the queue, including events not ACKed yet
+	EventsLimit int // maximum number of events, <= 0 if the queue can not determine it
+
+	Added   uint64 // total number of events added to the queue
+	Removed uint64 // total number of events ACKed or cancelled
+}
+
 // ProducerConfig as used by the Pipeline to configure some custom callbacks
 // between pipeline and queue.
 type ProducerConfig struct {
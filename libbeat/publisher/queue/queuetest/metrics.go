@@ -0,0 +1,82 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package queuetest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/publisher/queue"
+)
+
+// TestMetrics tests the events counters reported by queues implementing
+// queue.Monitored are updated when events are published and ACKed.
+func TestMetrics(t *testing.T, factory QueueFactory) {
+	fn := withLogOutput(func(t *testing.T) {
+		const total, acked = 10, 4
+
+		b := factory(t)
+		defer b.Close()
+
+		monitored, ok := b.(queue.Monitored)
+		if !ok {
+			t.Fatalf("queue %T does not report metrics", b)
+		}
+
+		producer := b.Producer(queue.ProducerConfig{})
+		for i := 0; i < total; i++ {
+			producer.Publish(makeEvent(common.MapStr{"value": i}))
+		}
+		waitMetrics(t, monitored, func(m queue.Metrics) bool {
+			return m.Added == total
+		})
+
+		consumer := b.Consumer()
+		for n := 0; n < acked; {
+			batch, err := consumer.Get(acked - n)
+			if err != nil {
+				t.Fatal(err)
+			}
+			n += len(batch.Events())
+			batch.ACK()
+		}
+		metrics := waitMetrics(t, monitored, func(m queue.Metrics) bool {
+			return m.Removed == acked
+		})
+
+		assert.Equal(t, total-acked, metrics.Events)
+		assert.Equal(t, uint64(total), metrics.Added)
+	})
+
+	fn(t)
+}
+
+func waitMetrics(t *testing.T, q queue.Monitored, cond func(queue.Metrics) bool) queue.Metrics {
+	for deadline := time.Now().Add(5 * time.Second); time.Now().Before(deadline); {
+		if m := q.Metrics(); cond(m) {
+			return m
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	m := q.Metrics()
+	t.Fatalf("unexpected queue metrics: %+v", m)
+	return m
+}
@@ -43,6 +43,9 @@ type Spool struct {
 
 	queue *pq.Queue
 	file  *txfile.File
+
+	// events flushed to and removed from the file
+	added, removed atomic.Uint64
 }
 
 type spoolCtx struct {
@@ -129,6 +132,11 @@ func NewSpool(logger logger, path string, settings Settings) (*Spool, error) {
 	}
 	defer ifNotOK(&ok, ignoreErr(queue.Close))
 
+	// account for the events left in the file by a previous run
+	if pending := queue.Pending(); pending > 0 {
+		spool.added.Store(uint64(pending))
+	}
+
 	inFlushTimeout := settings.WriteFlushTimeout
 	if inFlushTimeout < minInFlushTimeout {
 		inFlushTimeout = minInFlushTimeout
@@ -186,11 +194,24 @@ func (s *Spool) Consumer() queue.Consumer {
 	return s.outBroker.Consumer()
 }
 
+// Metrics returns the number of events stored in the file. Events in the
+// write buffer are not accounted for until they are flushed.
+func (s *Spool) Metrics() queue.Metrics {
+	added, removed := s.added.Load(), s.removed.Load()
+	return queue.Metrics{
+		Events:      int(added - removed),
+		EventsLimit: -1,
+		Added:       added,
+		Removed:     removed,
+	}
+}
+
 // onFlush is run whenever the queue signals it's write buffer being flushed.
 // Flush events are forwarded to all workers.
 // The onFlush callback is directly called by the queue writer (same go-routine)
 // on Write or Flush operations.
 func (s *Spool) onFlush(n uint) {
+	s.added.Add(uint64(n))
 	s.inBroker.onFlush(n)
 	s.outBroker.onFlush(n)
 }
@@ -199,6 +220,7 @@ func (s *Spool) onFlush(n uint) {
 // the queue.
 // ACK events are forwarded to all workers.
 func (s *Spool) onACK(events, pages uint) {
+	s.removed.Add(uint64(events))
 	s.inBroker.onACK(events, pages)
 }
 
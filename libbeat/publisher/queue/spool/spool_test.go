@@ -84,6 +84,13 @@ func TestProduceConsumer(t *testing.T) {
 	))(t)
 }
 
+func TestMetrics(t *testing.T) {
+	queuetest.TestMetrics(t, makeTestQueue(
+		128*humanize.KiByte, 4*humanize.KiByte, 16*humanize.KiByte,
+		10*time.Millisecond,
+	))
+}
+
 func makeTestQueue(
 	maxSize, pageSize, writeBuffer uint,
 	flushTimeout time.Duration,
@@ -32,6 +32,10 @@ type TemplateConfig struct {
 	AppendFields common.Fields    `config:"append_fields"`
 	Overwrite    bool             `config:"overwrite"`
 	Settings     TemplateSettings `config:"settings"`
+
+	// Templates is a list of additional templates. Each entry inherits the
+	// settings above and can override any of them.
+	Templates []*common.Config `config:"templates"`
 }
 
 type TemplateSettings struct {
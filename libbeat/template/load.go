@@ -38,7 +38,7 @@ type ESClient interface {
 }
 
 type Loader struct {
-	config   TemplateConfig
+	configs  []TemplateConfig
 	client   ESClient
 	beatInfo beat.Info
 	fields   []byte
@@ -53,40 +53,85 @@ func NewLoader(cfg *common.Config, client ESClient, beatInfo beat.Info, fields [
 		return nil, err
 	}
 
+	configs, err := templateConfigs(cfg, config)
+	if err != nil {
+		return nil, err
+	}
+
 	return &Loader{
-		config:   config,
+		configs:  configs,
 		client:   client,
 		beatInfo: beatInfo,
 		fields:   fields,
 	}, nil
 }
 
-// Load checks if the index mapping template should be loaded
-// In case the template is not already loaded or overwriting is enabled, the
+// templateConfigs returns the configuration of every template to be loaded.
+// If a list of templates is configured, each entry is merged with the
+// top-level settings. Otherwise the top-level settings define the only template.
+func templateConfigs(cfg *common.Config, config TemplateConfig) ([]TemplateConfig, error) {
+	if len(config.Templates) == 0 {
+		return []TemplateConfig{config}, nil
+	}
+
+	configs := make([]TemplateConfig, 0, len(config.Templates))
+	for i, templateCfg := range config.Templates {
+		merged, err := common.MergeConfigs(cfg, templateCfg)
+		if err != nil {
+			return nil, err
+		}
+
+		c := DefaultConfig
+		if err := merged.Unpack(&c); err != nil {
+			return nil, err
+		}
+		c.Templates = nil
+
+		if !templateCfg.HasField("name") || !templateCfg.HasField("pattern") {
+			return nil, fmt.Errorf("name and pattern have to be set for template %d", i)
+		}
+		configs = append(configs, c)
+	}
+	return configs, nil
+}
+
+// Load checks if the index mapping templates should be loaded
+// In case a template is not already loaded or overwriting is enabled, the
 // template is written to index
 func (l *Loader) Load() error {
+	for _, config := range l.configs {
+		if !config.Enabled {
+			continue
+		}
+		if err := l.load(config); err != nil {
+			return err
+		}
+	}
+	return nil
+}
 
-	tmpl, err := New(l.beatInfo.Version, l.beatInfo.IndexPrefix, l.client.GetVersion(), l.config)
+func (l *Loader) load(config TemplateConfig) error {
+	tmpl, err := New(l.beatInfo.Version, l.beatInfo.IndexPrefix, l.client.GetVersion(), config)
 	if err != nil {
 		return fmt.Errorf("error creating template instance: %v", err)
 	}
 
 	templateName := tmpl.GetName()
-	if l.config.JSON.Enabled {
-		templateName = l.config.JSON.Name
+	if config.JSON.Enabled {
+		templateName = config.JSON.Name
 	}
 	// Check if template already exist or should be overwritten
 	exists := l.CheckTemplate(templateName)
-	if !exists || l.config.Overwrite {
+	if !exists || config.Overwrite {
 
 		logp.Info("Loading template for Elasticsearch version: %s", l.client.GetVersion())
-		if l.config.Overwrite {
+		if config.Overwrite {
 			logp.Info("Existing template will be overwritten, as overwrite is enabled.")
 		}
 
 		var template map[string]interface{}
-		if l.config.JSON.Enabled {
-			jsonPath := paths.Resolve(paths.Config, l.config.JSON.Path)
+		if config.JSON.Enabled {
+			jsonPath := paths.Resolve(paths.Config, config.JSON.Path)
 			if _, err := os.Stat(jsonPath); err != nil {
 				return fmt.Errorf("error checking for json template: %s", err)
 			}
@@ -103,10 +148,10 @@ func (l *Loader) Load() error {
 				return fmt.Errorf("could not unmarshal json template: %s", err)
 			}
 			// Load fields from path
-		} else if l.config.Fields != "" {
-			logp.Debug("template", "Load fields.yml from file: %s", l.config.Fields)
+		} else if config.Fields != "" {
+			logp.Debug("template", "Load fields.yml from file: %s", config.Fields)
 
-			fieldsPath := paths.Resolve(paths.Config, l.config.Fields)
+			fieldsPath := paths.Resolve(paths.Config, config.Fields)
 
 			template, err = tmpl.LoadFile(fieldsPath)
 			if err != nil {
@@ -0,0 +1,109 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package template
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+)
+
+type mockClient struct {
+	loaded map[string]map[string]interface{}
+}
+
+func (c *mockClient) LoadJSON(path string, json map[string]interface{}) ([]byte, error) {
+	c.loaded[path] = json
+	return nil, nil
+}
+
+func (c *mockClient) Request(method, path string, pipeline string, params map[string]string, body interface{}) (int, []byte, error) {
+	return 404, nil, nil
+}
+
+func (c *mockClient) GetVersion() string {
+	return "6.3.0"
+}
+
+var testBeatInfo = beat.Info{Beat: "testbeat", IndexPrefix: "testbeat", Version: "6.3.0"}
+
+func TestLoaderSingleTemplate(t *testing.T) {
+	client := &mockClient{loaded: map[string]map[string]interface{}{}}
+	loader, err := NewLoader(common.NewConfig(), client, testBeatInfo, []byte("- key: test\n  fields:\n"))
+	require.NoError(t, err)
+
+	require.NoError(t, loader.Load())
+	assert.Contains(t, client.loaded, "/_template/testbeat-6.3.0")
+	assert.Len(t, client.loaded, 1)
+}
+
+func TestLoaderMultipleTemplates(t *testing.T) {
+	cfg := common.MustNewConfigFrom(map[string]interface{}{
+		"settings.index.number_of_shards": 1,
+		"templates": []map[string]interface{}{
+			{
+				"name":    "logs",
+				"pattern": "logs-*",
+			},
+			{
+				"name":                            "metrics",
+				"pattern":                         "metrics-*",
+				"settings.index.number_of_shards": 3,
+			},
+			{
+				"name":    "disabled",
+				"pattern": "disabled-*",
+				"enabled": false,
+			},
+		},
+	})
+
+	client := &mockClient{loaded: map[string]map[string]interface{}{}}
+	loader, err := NewLoader(cfg, client, testBeatInfo, []byte("- key: test\n  fields:\n"))
+	require.NoError(t, err)
+
+	require.NoError(t, loader.Load())
+	require.Len(t, client.loaded, 2)
+
+	logs := common.MapStr(client.loaded["/_template/logs"])
+	assert.Equal(t, []string{"logs-*"}, logs["index_patterns"])
+	shards, _ := logs.GetValue("settings.index.number_of_shards")
+	assert.EqualValues(t, 1, shards)
+
+	metrics := common.MapStr(client.loaded["/_template/metrics"])
+	assert.Equal(t, []string{"metrics-*"}, metrics["index_patterns"])
+	shards, _ = metrics.GetValue("settings.index.number_of_shards")
+	assert.EqualValues(t, 3, shards)
+}
+
+func TestLoaderTemplatesRequireNameAndPattern(t *testing.T) {
+	cfg := common.MustNewConfigFrom(map[string]interface{}{
+		"templates": []map[string]interface{}{
+			{"name": "logs"},
+		},
+	})
+
+	_, err := NewLoader(cfg, &mockClient{}, testBeatInfo, nil)
+	assert.Error(t, err)
+}
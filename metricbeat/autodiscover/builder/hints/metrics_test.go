@@ -25,6 +25,7 @@ import (
 
 	"github.com/elastic/beats/libbeat/common"
 	"github.com/elastic/beats/libbeat/common/bus"
+	_ "github.com/elastic/beats/libbeat/processors/add_locale"
 	"github.com/elastic/beats/metricbeat/mb"
 )
 
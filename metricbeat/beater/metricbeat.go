@@ -18,6 +18,7 @@
 package beater
 
 import (
+	"fmt"
 	"sync"
 
 	"github.com/joeshaw/multierror"
@@ -28,7 +29,9 @@ import (
 	"github.com/elastic/beats/libbeat/cfgfile"
 	"github.com/elastic/beats/libbeat/common"
 	"github.com/elastic/beats/libbeat/common/cfgwarn"
+	"github.com/elastic/beats/libbeat/control"
 	"github.com/elastic/beats/libbeat/logp"
+	"github.com/elastic/beats/libbeat/paths"
 	mbautodiscover "github.com/elastic/beats/metricbeat/autodiscover"
 	"github.com/elastic/beats/metricbeat/mb"
 	"github.com/elastic/beats/metricbeat/mb/module"
@@ -94,6 +97,9 @@ func DefaultCreator() beat.Creator {
 
 // newMetricbeat creates and returns a new Metricbeat instance.
 func newMetricbeat(b *beat.Beat, c *common.Config, options ...Option) (*Metricbeat, error) {
+	// Light modules are defined by manifests in the module directory.
+	mb.Registry.SetSecondarySource(mb.NewLightModulesSource(paths.Resolve(paths.Home, "module")))
+
 	// List all registered modules and metricsets.
 	logp.Debug("modules", "%s", mb.Registry.String())
 
@@ -187,18 +193,21 @@ func newMetricbeat(b *beat.Beat, c *common.Config, options ...Option) (*Metricbe
 func (bt *Metricbeat) Run(b *beat.Beat) error {
 	var wg sync.WaitGroup
 
-	for _, m := range bt.modules {
+	for i, m := range bt.modules {
 		client, err := m.connector.Connect()
 		if err != nil {
 			return err
 		}
 
+		id := uint64(i)
 		r := module.NewRunner(client, m.module)
+		control.AddRunner("modules", id, fmt.Sprint(r))
 		r.Start()
 		wg.Add(1)
 		go func() {
 			defer wg.Done()
 			<-bt.done
+			control.RemoveRunner("modules", id)
 			r.Stop()
 		}()
 	}
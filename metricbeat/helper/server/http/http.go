@@ -21,8 +21,11 @@ import (
 	"context"
 	"fmt"
 	"io/ioutil"
+	"net"
 	"net/http"
 
+	"github.com/pkg/errors"
+
 	"github.com/elastic/beats/libbeat/common"
 	"github.com/elastic/beats/libbeat/logp"
 	"github.com/elastic/beats/metricbeat/helper/server"
@@ -51,6 +54,27 @@ func (h *HttpEvent) GetMeta() server.Meta {
 }
 
 func NewHttpServer(mb mb.BaseMetricSet) (server.Server, error) {
+	h, err := newHttpServer(mb)
+	if err != nil {
+		return nil, err
+	}
+	h.server.Handler = http.HandlerFunc(h.handleFunc)
+	return h, nil
+}
+
+// NewHttpServerWithHandler creates a server that passes requests to the given
+// handler instead of queuing their payloads as events. Metricsets using it
+// are responsible for reporting their own events.
+func NewHttpServerWithHandler(mb mb.BaseMetricSet, handlerFunc http.HandlerFunc) (server.Server, error) {
+	h, err := newHttpServer(mb)
+	if err != nil {
+		return nil, err
+	}
+	h.server.Handler = handlerFunc
+	return h, nil
+}
+
+func newHttpServer(mb mb.BaseMetricSet) (*HttpServer, error) {
 	config := defaultHttpConfig()
 	err := mb.Module().UnpackConfig(&config)
 	if err != nil {
@@ -65,22 +89,24 @@ func NewHttpServer(mb mb.BaseMetricSet) (server.Server, error) {
 		stop:       cancel,
 	}
 
-	httpServer := &http.Server{
-		Addr:    fmt.Sprintf("%s:%d", config.Host, config.Port),
-		Handler: http.HandlerFunc(h.handleFunc),
+	h.server = &http.Server{
+		Addr: fmt.Sprintf("%s:%d", config.Host, config.Port),
 	}
-	h.server = httpServer
 
 	return h, nil
 }
 
 func (h *HttpServer) Start() error {
-	go func() {
+	listener, err := net.Listen("tcp", h.server.Addr)
+	if err != nil {
+		return errors.Wrap(err, "failed to start HTTP server")
+	}
+	logp.Info("Started listening for HTTP on: %s", listener.Addr())
 
-		logp.Info("Starting http server on %s", h.server.Addr)
-		err := h.server.ListenAndServe()
+	go func() {
+		err := h.server.Serve(listener)
 		if err != nil && err != http.ErrServerClosed {
-			logp.Critical("Unable to start HTTP server due to error: %v", err)
+			logp.Critical("HTTP server stopped due to error: %v", err)
 		}
 	}()
 
@@ -25,7 +25,6 @@ import (
 	"fmt"
 	"net/http"
 	"testing"
-	"time"
 
 	"github.com/elastic/beats/metricbeat/helper/server"
 
@@ -59,10 +58,11 @@ func TestHttpServer(t *testing.T) {
 		t.FailNow()
 	}
 
-	svc.Start()
+	err = svc.Start()
+	if err != nil {
+		t.Fatal(err)
+	}
 	defer svc.Stop()
-	// make sure server is up before writing data into it.
-	time.Sleep(2 * time.Second)
 	writeToServer(t, "test1", host, port)
 	msg := <-svc.GetEvents()
 
@@ -74,6 +74,27 @@ func TestHttpServer(t *testing.T) {
 
 }
 
+func TestHttpServerPortInUse(t *testing.T) {
+	host := "127.0.0.1"
+	port := 40051
+	svc, err := GetHttpServer(host, port)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = svc.Start()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer svc.Stop()
+
+	other, err := GetHttpServer(host, port)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Error(t, other.Start())
+}
+
 func writeToServer(t *testing.T, message, host string, port int) {
 	url := fmt.Sprintf("http://%s:%d/", host, port)
 	var str = []byte(message)
@@ -18,8 +18,10 @@
 package tcp
 
 import (
+	"bufio"
 	"fmt"
 	"net"
+	"sync"
 
 	"github.com/pkg/errors"
 
@@ -35,10 +37,15 @@ type TcpServer struct {
 	receiveBufferSize int
 	done              chan struct{}
 	eventQueue        chan server.Event
+
+	connsMutex sync.Mutex
+	conns      map[net.Conn]struct{}
+	wg         sync.WaitGroup
 }
 
 type TcpEvent struct {
 	event common.MapStr
+	meta  server.Meta
 }
 
 func (m *TcpEvent) GetEvent() common.MapStr {
@@ -46,7 +53,7 @@ func (m *TcpEvent) GetEvent() common.MapStr {
 }
 
 func (m *TcpEvent) GetMeta() server.Meta {
-	return server.Meta{}
+	return m.meta
 }
 
 func NewTcpServer(base mb.BaseMetricSet) (server.Server, error) {
@@ -56,6 +63,12 @@ func NewTcpServer(base mb.BaseMetricSet) (server.Server, error) {
 		return nil, err
 	}
 
+	return NewTcpServerFromConfig(config)
+}
+
+// NewTcpServerFromConfig creates a TCP server listening on the host and port
+// of the given configuration.
+func NewTcpServerFromConfig(config TcpConfig) (server.Server, error) {
 	addr, err := net.ResolveTCPAddr("tcp", fmt.Sprintf("%s:%d", config.Host, config.Port))
 
 	if err != nil {
@@ -78,49 +91,125 @@ func (g *TcpServer) Start() error {
 	g.listener = listener
 	logp.Info("Started listening for TCP on: %s", g.tcpAddr.String())
 
+	g.wg.Add(1)
 	go g.watchMetrics()
 	return nil
 }
 
+// watchMetrics accepts connections until the server is stopped, every
+// connection is read in its own goroutine.
 func (g *TcpServer) watchMetrics() {
-	buffer := make([]byte, g.receiveBufferSize)
-	for {
-		select {
-		case <-g.done:
-			return
-		default:
-		}
+	defer g.wg.Done()
 
+	for {
 		conn, err := g.listener.Accept()
 		if err != nil {
+			select {
+			case <-g.done:
+				return
+			default:
+			}
 			logp.Err("Unable to accept connection due to error: %v", err)
 			continue
 		}
-		defer func() {
-			if conn != nil {
-				conn.Close()
-			}
-		}()
 
-		length, err := conn.Read(buffer)
-		if err != nil {
-			logp.Err("Error reading from buffer: %v", err.Error())
+		if !g.trackConn(conn) {
+			conn.Close()
+			return
+		}
+
+		g.wg.Add(1)
+		go g.handleConn(conn)
+	}
+}
+
+// handleConn reads newline delimited messages from the connection, each
+// message is sent as a separate event.
+func (g *TcpServer) handleConn(conn net.Conn) {
+	defer g.wg.Done()
+	defer g.untrackConn(conn)
+
+	meta := server.Meta{}
+	if addr, ok := conn.RemoteAddr().(*net.TCPAddr); ok {
+		meta["client_ip"] = addr.IP.String()
+	}
+
+	maxSize := bufio.MaxScanTokenSize
+	if g.receiveBufferSize > maxSize {
+		maxSize = g.receiveBufferSize
+	}
+	scanner := bufio.NewScanner(conn)
+	scanner.Buffer(make([]byte, 0, g.receiveBufferSize), maxSize)
+	for scanner.Scan() {
+		if len(scanner.Bytes()) == 0 {
 			continue
 		}
-		g.eventQueue <- &TcpEvent{
+
+		// The scanner reuses its buffer for the next message.
+		data := make([]byte, len(scanner.Bytes()))
+		copy(data, scanner.Bytes())
+
+		select {
+		case g.eventQueue <- &TcpEvent{
 			event: common.MapStr{
-				server.EventDataKey: buffer[:length],
+				server.EventDataKey: data,
 			},
+			meta: meta,
+		}:
+		case <-g.done:
+			return
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		select {
+		case <-g.done:
+		default:
+			logp.Err("Error reading from connection: %v", err)
 		}
 	}
 }
 
+func (g *TcpServer) trackConn(conn net.Conn) bool {
+	g.connsMutex.Lock()
+	defer g.connsMutex.Unlock()
+
+	select {
+	case <-g.done:
+		return false
+	default:
+	}
+
+	if g.conns == nil {
+		g.conns = map[net.Conn]struct{}{}
+	}
+	g.conns[conn] = struct{}{}
+	return true
+}
+
+func (g *TcpServer) untrackConn(conn net.Conn) {
+	g.connsMutex.Lock()
+	defer g.connsMutex.Unlock()
+
+	delete(g.conns, conn)
+	conn.Close()
+}
+
 func (g *TcpServer) GetEvents() chan server.Event {
 	return g.eventQueue
 }
 
+// Stop closes the listener and all open connections, the events channel is
+// closed once all connections are done.
 func (g *TcpServer) Stop() {
+	g.connsMutex.Lock()
 	close(g.done)
+	for conn := range g.conns {
+		conn.Close()
+	}
+	g.connsMutex.Unlock()
+
 	g.listener.Close()
+	g.wg.Wait()
 	close(g.eventQueue)
 }
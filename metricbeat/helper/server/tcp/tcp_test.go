@@ -73,6 +73,32 @@ func TestTcpServer(t *testing.T) {
 
 }
 
+func TestTcpServerMultipleMessages(t *testing.T) {
+	host := "127.0.0.1"
+	port := 2004
+	svc, err := GetTestTcpServer(host, port)
+	if err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+
+	err = svc.Start()
+	if err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+
+	defer svc.Stop()
+	writeToServer(t, "test1\n\ntest2\n", host, port)
+
+	for _, expected := range []string{"test1", "test2"} {
+		msg := <-svc.GetEvents()
+		bytes, _ := msg.GetEvent()["data"].([]byte)
+		assert.Equal(t, expected, string(bytes))
+		assert.Equal(t, "127.0.0.1", msg.GetMeta()["client_ip"])
+	}
+}
+
 func writeToServer(t *testing.T, message, host string, port int) {
 	servAddr := fmt.Sprintf("%s:%d", host, port)
 	tcpAddr, err := net.ResolveTCPAddr("tcp", servAddr)
@@ -57,6 +57,12 @@ func NewUdpServer(base mb.BaseMetricSet) (server.Server, error) {
 		return nil, err
 	}
 
+	return NewUdpServerFromConfig(config)
+}
+
+// NewUdpServerFromConfig creates a UDP server listening on the host and port
+// of the given configuration.
+func NewUdpServerFromConfig(config UdpConfig) (server.Server, error) {
 	addr, err := net.ResolveUDPAddr("udp", fmt.Sprintf("%s:%d", config.Host, config.Port))
 
 	if err != nil {
@@ -99,9 +105,13 @@ func (g *UdpServer) watchMetrics() {
 			continue
 		}
 
+		// The buffer is reused for the next datagram.
+		data := make([]byte, length)
+		copy(data, buffer[:length])
+
 		g.eventQueue <- &UdpEvent{
 			event: common.MapStr{
-				server.EventDataKey: buffer[:length],
+				server.EventDataKey: data,
 			},
 			meta: server.Meta{
 				"client_ip": addr.IP.String(),
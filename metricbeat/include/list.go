@@ -130,6 +130,7 @@ import (
 	_ "github.com/elastic/beats/metricbeat/module/postgresql/statement"
 	_ "github.com/elastic/beats/metricbeat/module/prometheus"
 	_ "github.com/elastic/beats/metricbeat/module/prometheus/collector"
+	_ "github.com/elastic/beats/metricbeat/module/prometheus/remote_write"
 	_ "github.com/elastic/beats/metricbeat/module/prometheus/stats"
 	_ "github.com/elastic/beats/metricbeat/module/rabbitmq"
 	_ "github.com/elastic/beats/metricbeat/module/rabbitmq/connection"
@@ -139,6 +140,10 @@ import (
 	_ "github.com/elastic/beats/metricbeat/module/redis"
 	_ "github.com/elastic/beats/metricbeat/module/redis/info"
 	_ "github.com/elastic/beats/metricbeat/module/redis/keyspace"
+	_ "github.com/elastic/beats/metricbeat/module/sql"
+	_ "github.com/elastic/beats/metricbeat/module/sql/query"
+	_ "github.com/elastic/beats/metricbeat/module/statsd"
+	_ "github.com/elastic/beats/metricbeat/module/statsd/server"
 	_ "github.com/elastic/beats/metricbeat/module/system"
 	_ "github.com/elastic/beats/metricbeat/module/system/core"
 	_ "github.com/elastic/beats/metricbeat/module/system/cpu"
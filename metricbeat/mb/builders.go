@@ -157,11 +157,6 @@ func initMetricSets(r *Register, m Module) ([]MetricSet, error) {
 // in the module's config. An error is returned if no MetricSets are specified
 // in the module's config and no default MetricSet is defined.
 func newBaseMetricSets(r *Register, m Module) ([]BaseMetricSet, error) {
-	hosts := []string{""}
-	if l := m.Config().Hosts; len(l) > 0 {
-		hosts = l
-	}
-
 	metricSetNames := m.Config().MetricSets
 	if len(metricSetNames) == 0 {
 		var err error
@@ -174,6 +169,18 @@ func newBaseMetricSets(r *Register, m Module) ([]BaseMetricSet, error) {
 	var metricsets []BaseMetricSet
 	for _, name := range metricSetNames {
 		name = strings.ToLower(name)
+
+		// Light metricsets can set defaults, like hosts, on their module.
+		msModule, err := r.metricSetModule(m, name)
+		if err != nil {
+			return nil, err
+		}
+
+		hosts := []string{""}
+		if l := msModule.Config().Hosts; len(l) > 0 {
+			hosts = l
+		}
+
 		for _, host := range hosts {
 			id := uuid.NewV4().String()
 			metrics := monitoring.NewRegistry()
@@ -187,7 +194,7 @@ func newBaseMetricSets(r *Register, m Module) ([]BaseMetricSet, error) {
 			metricsets = append(metricsets, BaseMetricSet{
 				id:      id,
 				name:    name,
-				module:  m,
+				module:  msModule,
 				host:    host,
 				metrics: metrics,
 			})
@@ -0,0 +1,359 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package mb
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/logp"
+	"github.com/elastic/beats/libbeat/processors"
+)
+
+const (
+	lightModuleManifest    = "module.yml"
+	lightMetricSetManifest = "manifest.yml"
+)
+
+// ModulesSource contains modules and metricsets that are not registered by
+// Go code, like light modules. It is used by a Register as secondary source
+// for modules and metricsets not found in the Register.
+type ModulesSource interface {
+	// Modules returns the names of the modules in the source.
+	Modules() ([]string, error)
+
+	// HasModule returns true if the module is defined in the source.
+	HasModule(module string) bool
+
+	// MetricSets returns the names of the metricsets of a module.
+	MetricSets(module string) ([]string, error)
+
+	// DefaultMetricSets returns the names of the default metricsets of a
+	// module.
+	DefaultMetricSets(module string) ([]string, error)
+
+	// HasMetricSet returns true if the metricset is defined in the source.
+	HasMetricSet(module, name string) bool
+
+	// MetricSetRegistration returns the registration used to create the
+	// metricset.
+	MetricSetRegistration(r *Register, module, name string) (MetricSetRegistration, error)
+
+	// MetricSetModule returns the module the metricset is created with, it
+	// contains the given module configuration merged over the defaults of
+	// the metricset.
+	MetricSetModule(module Module, name string) (Module, error)
+
+	// ProcessorsForMetricSet returns the processors to apply to the events
+	// of the metricset.
+	ProcessorsForMetricSet(module, name string) (*processors.Processors, error)
+}
+
+// LightModulesSource loads light modules from directories. A light module
+// is a directory containing a module.yml manifest listing its metricsets:
+//
+//     name: mymodule
+//     metricsets: ["mymetricset"]
+//
+// Every metricset is a subdirectory with a manifest.yml file declaring the
+// registered metricset it is based on, the configuration defaults applied to
+// it and optional processors:
+//
+//     default: true
+//     input:
+//       module: prometheus
+//       metricset: collector
+//       defaults:
+//         hosts: ["localhost:9090"]
+//         metrics_path: /metrics
+//     processors:
+//       - drop_fields:
+//           fields: ["prometheus.labels.instance"]
+//
+// Modules are read each time they are accessed, so modules added to the
+// directories are found without restarting.
+type LightModulesSource struct {
+	paths []string
+	log   *logp.Logger
+}
+
+// LightModule is the manifest of a light module.
+type LightModule struct {
+	Name       string   `config:"name" validate:"required"`
+	MetricSets []string `config:"metricsets" validate:"required"`
+}
+
+// LightMetricSet is the manifest of a light metricset.
+type LightMetricSet struct {
+	Name    string `config:",ignore"`
+	Module  string `config:",ignore"`
+	Default bool   `config:"default"`
+	Input   struct {
+		Module    string         `config:"module"`
+		MetricSet string         `config:"metricset"`
+		Defaults  *common.Config `config:"defaults"`
+	} `config:"input"`
+	Processors processors.PluginConfig `config:"processors"`
+}
+
+// NewLightModulesSource creates a source for the light modules found in the
+// given directories.
+func NewLightModulesSource(paths ...string) *LightModulesSource {
+	return &LightModulesSource{
+		paths: paths,
+		log:   logp.NewLogger("registry.lightmodules"),
+	}
+}
+
+// Modules returns the names of the light modules found in the directories.
+func (s *LightModulesSource) Modules() ([]string, error) {
+	var modules []string
+	for _, dir := range s.paths {
+		entries, err := ioutil.ReadDir(dir)
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "listing modules in '%s'", dir)
+		}
+
+		for _, entry := range entries {
+			if !entry.IsDir() {
+				continue
+			}
+			if _, err := os.Stat(filepath.Join(dir, entry.Name(), lightModuleManifest)); err == nil {
+				modules = append(modules, strings.ToLower(entry.Name()))
+			}
+		}
+	}
+	return modules, nil
+}
+
+// HasModule returns true if a light module with the given name exists.
+func (s *LightModulesSource) HasModule(module string) bool {
+	_, found := s.moduleDir(module)
+	return found
+}
+
+// MetricSets returns the names of the metricsets of a light module.
+func (s *LightModulesSource) MetricSets(module string) ([]string, error) {
+	m, err := s.loadModule(module)
+	if err != nil {
+		return nil, err
+	}
+	return m.MetricSets, nil
+}
+
+// DefaultMetricSets returns the names of the metricsets of a light module
+// flagged as default in their manifest.
+func (s *LightModulesSource) DefaultMetricSets(module string) ([]string, error) {
+	m, err := s.loadModule(module)
+	if err != nil {
+		return nil, err
+	}
+
+	var defaults []string
+	for _, name := range m.MetricSets {
+		ms, err := s.loadMetricSet(module, name)
+		if err != nil {
+			return nil, err
+		}
+		if ms.Default {
+			defaults = append(defaults, ms.Name)
+		}
+	}
+
+	if len(defaults) == 0 {
+		return nil, fmt.Errorf("no default metricset exists for module '%s'", module)
+	}
+	return defaults, nil
+}
+
+// HasMetricSet returns true if the light module contains the metricset.
+func (s *LightModulesSource) HasMetricSet(module, name string) bool {
+	m, err := s.loadModule(module)
+	if err != nil {
+		return false
+	}
+	for _, ms := range m.MetricSets {
+		if ms == strings.ToLower(name) {
+			return true
+		}
+	}
+	return false
+}
+
+// MetricSetRegistration returns the registration of the metricset the light
+// metricset is based on, renamed after the light metricset.
+func (s *LightModulesSource) MetricSetRegistration(r *Register, module, name string) (MetricSetRegistration, error) {
+	ms, err := s.loadMetricSet(module, name)
+	if err != nil {
+		return MetricSetRegistration{}, err
+	}
+
+	registration, err := r.metricSetRegistration(ms.Input.Module, ms.Input.MetricSet)
+	if err != nil {
+		return MetricSetRegistration{}, errors.Wrapf(err, "light metricset '%s/%s' is based on an unavailable metricset", module, name)
+	}
+
+	registration.Name = ms.Name
+	registration.IsDefault = ms.Default
+	return registration, nil
+}
+
+// MetricSetModule returns a module with the configuration of the given
+// module merged over the defaults of the light metricset.
+func (s *LightModulesSource) MetricSetModule(module Module, name string) (Module, error) {
+	ms, err := s.loadMetricSet(module.Name(), name)
+	if err != nil {
+		return nil, err
+	}
+	if ms.Input.Defaults == nil {
+		return module, nil
+	}
+
+	var userConfig *common.Config
+	if base, ok := module.(*BaseModule); ok {
+		userConfig = base.rawConfig
+	} else {
+		userConfig, err = common.NewConfigFrom(module.Config())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Settings are merged as maps, so lists like hosts set by the user
+	// replace the defaults instead of being merged with them.
+	var defaults, settings common.MapStr
+	if err := ms.Input.Defaults.Unpack(&defaults); err != nil {
+		return nil, errors.Wrapf(err, "reading defaults of light metricset '%s/%s'", ms.Module, ms.Name)
+	}
+	if err := userConfig.Unpack(&settings); err != nil {
+		return nil, err
+	}
+	defaults.DeepUpdate(settings)
+
+	raw, err := common.NewConfigFrom(defaults)
+	if err != nil {
+		return nil, err
+	}
+
+	bm, err := newBaseModuleFromConfig(raw)
+	if err != nil {
+		return nil, err
+	}
+	return &bm, nil
+}
+
+// ProcessorsForMetricSet returns the processors declared in the manifest of
+// the light metricset.
+func (s *LightModulesSource) ProcessorsForMetricSet(module, name string) (*processors.Processors, error) {
+	ms, err := s.loadMetricSet(module, name)
+	if err != nil {
+		return nil, err
+	}
+	procs, err := processors.New(ms.Processors)
+	if err != nil {
+		return nil, errors.Wrapf(err, "creating processors of light metricset '%s/%s'", ms.Module, ms.Name)
+	}
+	return procs, nil
+}
+
+// moduleDir returns the directory of a light module, the first directory
+// containing the module takes precedence.
+func (s *LightModulesSource) moduleDir(module string) (string, bool) {
+	module = strings.ToLower(module)
+	if module == "" || strings.ContainsAny(module, `/\.`) {
+		return "", false
+	}
+
+	for _, dir := range s.paths {
+		moduleDir := filepath.Join(dir, module)
+		if _, err := os.Stat(filepath.Join(moduleDir, lightModuleManifest)); err == nil {
+			return moduleDir, true
+		}
+	}
+	return "", false
+}
+
+func (s *LightModulesSource) loadModule(module string) (*LightModule, error) {
+	dir, found := s.moduleDir(module)
+	if !found {
+		return nil, fmt.Errorf("module '%s' not found", module)
+	}
+
+	cfg, err := common.LoadFile(filepath.Join(dir, lightModuleManifest))
+	if err != nil {
+		return nil, errors.Wrapf(err, "loading light module '%s'", module)
+	}
+
+	var m LightModule
+	if err := cfg.Unpack(&m); err != nil {
+		return nil, errors.Wrapf(err, "loading light module '%s'", module)
+	}
+	if !strings.EqualFold(m.Name, module) {
+		s.log.Warnf("Light module in '%s' is named '%s', it is used as '%s'", dir, m.Name, module)
+	}
+	m.Name = strings.ToLower(module)
+	for i, name := range m.MetricSets {
+		m.MetricSets[i] = strings.ToLower(name)
+	}
+	return &m, nil
+}
+
+func (s *LightModulesSource) loadMetricSet(module, name string) (*LightMetricSet, error) {
+	m, err := s.loadModule(module)
+	if err != nil {
+		return nil, err
+	}
+
+	name = strings.ToLower(name)
+	found := false
+	for _, ms := range m.MetricSets {
+		if ms == name {
+			found = true
+			break
+		}
+	}
+	if !found || strings.ContainsAny(name, `/\.`) {
+		return nil, fmt.Errorf("metricset '%s/%s' not found in light module", m.Name, name)
+	}
+
+	dir, _ := s.moduleDir(module)
+	cfg, err := common.LoadFile(filepath.Join(dir, name, lightMetricSetManifest))
+	if err != nil {
+		return nil, errors.Wrapf(err, "loading light metricset '%s/%s'", m.Name, name)
+	}
+
+	var ms LightMetricSet
+	if err := cfg.Unpack(&ms); err != nil {
+		return nil, errors.Wrapf(err, "loading light metricset '%s/%s'", m.Name, name)
+	}
+	if ms.Input.Module == "" || ms.Input.MetricSet == "" {
+		return nil, fmt.Errorf("light metricset '%s/%s' must set input.module and input.metricset", m.Name, name)
+	}
+	ms.Name = name
+	ms.Module = m.Name
+	return &ms, nil
+}
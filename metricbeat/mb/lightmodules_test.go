@@ -0,0 +1,175 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package mb
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/libbeat/common"
+	_ "github.com/elastic/beats/libbeat/processors/actions"
+)
+
+type lightTestMetricSet struct {
+	BaseMetricSet
+	Path string
+}
+
+func (m *lightTestMetricSet) Fetch() (common.MapStr, error) {
+	return nil, nil
+}
+
+func newLightModulesTestRegistry() *Register {
+	r := NewRegister()
+	r.MustAddMetricSet("test", "base",
+		func(base BaseMetricSet) (MetricSet, error) {
+			config := struct {
+				Path string `config:"path"`
+			}{}
+			if err := base.Module().UnpackConfig(&config); err != nil {
+				return nil, err
+			}
+			return &lightTestMetricSet{BaseMetricSet: base, Path: config.Path}, nil
+		},
+		WithHostParser(func(module Module, host string) (HostData, error) {
+			config := struct {
+				Path string `config:"path"`
+			}{}
+			if err := module.UnpackConfig(&config); err != nil {
+				return HostData{}, err
+			}
+			return HostData{URI: "http://" + host + config.Path, Host: host}, nil
+		}),
+	)
+	r.SetSecondarySource(NewLightModulesSource("testdata/lightmodules"))
+	return r
+}
+
+func TestLightModulesRegistry(t *testing.T) {
+	r := newLightModulesTestRegistry()
+
+	modules := r.Modules()
+	sort.Strings(modules)
+	assert.Equal(t, []string{"broken", "service"}, modules)
+
+	metricSets := r.MetricSets("service")
+	assert.Equal(t, []string{"metrics", "status"}, metricSets)
+
+	defaults, err := r.DefaultMetricSets("service")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"metrics"}, defaults)
+
+	registration, err := r.metricSetRegistration("service", "status")
+	require.NoError(t, err)
+	assert.Equal(t, "status", registration.Name)
+	assert.False(t, registration.IsDefault)
+	assert.NotNil(t, registration.HostParser)
+
+	_, err = r.metricSetRegistration("service", "unknown")
+	assert.Error(t, err)
+
+	_, err = r.DefaultMetricSets("unknown")
+	assert.Error(t, err)
+}
+
+func TestNewModuleFromLightModule(t *testing.T) {
+	tests := map[string]struct {
+		config       map[string]interface{}
+		expectedURIs map[string][]string
+	}{
+		"default metricsets and hosts": {
+			config: map[string]interface{}{"module": "service"},
+			expectedURIs: map[string][]string{
+				"metrics": {"http://localhost:8080/metrics"},
+			},
+		},
+		"user settings override defaults": {
+			config: map[string]interface{}{
+				"module":     "service",
+				"metricsets": []string{"metrics", "status"},
+				"hosts":      []string{"a:80", "b:80"},
+				"path":       "/custom",
+			},
+			expectedURIs: map[string][]string{
+				"metrics": {"http://a:80/custom", "http://b:80/custom"},
+				"status":  {"http://a:80/custom", "http://b:80/custom"},
+			},
+		},
+		"defaults per metricset": {
+			config: map[string]interface{}{
+				"module":     "service",
+				"metricsets": []string{"status"},
+				"hosts":      []string{"a:80"},
+			},
+			expectedURIs: map[string][]string{
+				"status": {"http://a:80/status"},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			r := newLightModulesTestRegistry()
+
+			module, metricSets, err := NewModule(common.MustNewConfigFrom(test.config), r)
+			require.NoError(t, err)
+			assert.Equal(t, "service", module.Name())
+
+			uris := map[string][]string{}
+			for _, ms := range metricSets {
+				assert.Equal(t, "service", ms.Module().Name())
+				lms := ms.(*lightTestMetricSet)
+				assert.Equal(t, lms.HostData().URI[len("http://"+lms.Host()):], lms.Path)
+				uris[ms.Name()] = append(uris[ms.Name()], lms.HostData().URI)
+			}
+			for _, list := range uris {
+				sort.Strings(list)
+			}
+			assert.Equal(t, test.expectedURIs, uris)
+		})
+	}
+}
+
+func TestNewModuleFromLightModuleMissingInput(t *testing.T) {
+	r := newLightModulesTestRegistry()
+
+	_, _, err := NewModule(common.MustNewConfigFrom(map[string]interface{}{"module": "broken"}), r)
+	assert.Error(t, err)
+}
+
+func TestProcessorsForMetricSet(t *testing.T) {
+	r := newLightModulesTestRegistry()
+
+	procs, err := r.ProcessorsForMetricSet("service", "metrics")
+	require.NoError(t, err)
+	require.NotNil(t, procs)
+	assert.Len(t, procs.List, 1)
+
+	procs, err = r.ProcessorsForMetricSet("service", "status")
+	require.NoError(t, err)
+	assert.Len(t, procs.List, 0)
+
+	procs, err = r.ProcessorsForMetricSet("test", "base")
+	require.NoError(t, err)
+	assert.Nil(t, procs)
+}
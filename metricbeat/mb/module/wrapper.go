@@ -27,6 +27,7 @@ import (
 	"github.com/elastic/beats/libbeat/common"
 	"github.com/elastic/beats/libbeat/logp"
 	"github.com/elastic/beats/libbeat/monitoring"
+	"github.com/elastic/beats/libbeat/processors"
 	"github.com/elastic/beats/libbeat/testing"
 	"github.com/elastic/beats/metricbeat/mb"
 )
@@ -62,8 +63,9 @@ type Wrapper struct {
 // running the MetricSet. It contains a pointer to the parent Module.
 type metricSetWrapper struct {
 	mb.MetricSet
-	module *Wrapper // Parent Module.
-	stats  *stats   // stats for this MetricSet.
+	module     *Wrapper               // Parent Module.
+	stats      *stats                 // stats for this MetricSet.
+	processors *processors.Processors // processors of the MetricSet, shared by all its hosts.
 }
 
 // stats bundles common metricset stats.
@@ -91,11 +93,26 @@ func NewWrapper(config *common.Config, r *mb.Register, options ...Option) (*Wrap
 		applyOption(wrapper)
 	}
 
+	procs := map[string]*processors.Processors{}
 	for i, ms := range metricsets {
+		p, found := procs[ms.Name()]
+		if !found {
+			p, err = r.ProcessorsForMetricSet(wrapper.Name(), ms.Name())
+			if err != nil {
+				wrapper.closeProcessors()
+				return nil, err
+			}
+			if p != nil && len(p.List) == 0 {
+				p = nil
+			}
+			procs[ms.Name()] = p
+		}
+
 		wrapper.metricSets[i] = &metricSetWrapper{
-			MetricSet: ms,
-			module:    wrapper,
-			stats:     getMetricSetStats(wrapper.Name(), ms.Name()),
+			MetricSet:  ms,
+			module:     wrapper,
+			stats:      getMetricSetStats(wrapper.Name(), ms.Name()),
+			processors: p,
 		}
 	}
 
@@ -142,6 +159,7 @@ func (mw *Wrapper) Start(done <-chan struct{}) <-chan beat.Event {
 	go func() {
 		wg.Wait()
 		close(out)
+		mw.closeProcessors()
 		debugf("Stopped %s", mw)
 	}()
 
@@ -159,6 +177,21 @@ func (mw *Wrapper) MetricSets() []*metricSetWrapper {
 	return mw.metricSets
 }
 
+// closeProcessors closes the processors of the metricsets. Processors are
+// shared by the hosts of a metricset, so each is closed only once.
+func (mw *Wrapper) closeProcessors() {
+	closed := map[*processors.Processors]bool{}
+	for _, msw := range mw.metricSets {
+		if msw == nil || msw.processors == nil || closed[msw.processors] {
+			continue
+		}
+		closed[msw.processors] = true
+		if err := msw.processors.Close(); err != nil {
+			logp.Err("Failed to close processors of '%s/%s': %v", mw.Name(), msw.Name(), err)
+		}
+	}
+}
+
 // metricSetWrapper methods
 
 func (msw *metricSetWrapper) run(done <-chan struct{}, out chan<- beat.Event) {
@@ -356,6 +389,14 @@ func (r reporterV2) Event(event mb.Event) bool {
 		event.Namespace = r.msw.Registration().Namespace
 	}
 	beatEvent := event.BeatEvent(r.msw.module.Name(), r.msw.MetricSet.Name(), r.msw.module.eventModifiers...)
+	if r.msw.processors != nil {
+		processed := r.msw.processors.Run(&beatEvent)
+		if processed == nil {
+			// The event was dropped by the processors of the metricset.
+			return true
+		}
+		beatEvent = *processed
+	}
 	if !writeEvent(r.done, r.out, beatEvent) {
 		return false
 	}
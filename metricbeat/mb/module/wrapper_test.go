@@ -24,6 +24,7 @@ import (
 	"time"
 
 	"github.com/elastic/beats/libbeat/common"
+	_ "github.com/elastic/beats/libbeat/processors/actions"
 	"github.com/elastic/beats/metricbeat/mb"
 	"github.com/elastic/beats/metricbeat/mb/module"
 
@@ -227,3 +228,55 @@ func TestWrapperOfPushMetricSet(t *testing.T) {
 		}
 	}
 }
+
+func TestWrapperOfLightMetricSetProcessors(t *testing.T) {
+	r := mb.NewRegister()
+	r.MustAddMetricSet("test", "base", func(base mb.BaseMetricSet) (mb.MetricSet, error) {
+		return &fakeLightMetricSet{BaseMetricSet: base}, nil
+	})
+	r.SetSecondarySource(mb.NewLightModulesSource("../testdata/lightmodules"))
+
+	c := newConfig(t, map[string]interface{}{
+		"module":     "service",
+		"metricsets": []string{"metrics", "status"},
+		"hosts":      []string{"alpha", "beta"},
+	})
+
+	m, err := module.NewWrapper(c, r, module.WithMetricSetInfo())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	done := make(chan struct{})
+	output := m.Start(done)
+
+	// Processors of the metrics metricset only apply to its own events.
+	for i := 0; i < 4; i++ {
+		event := <-output
+		metricset, err := event.GetValue("metricset.name")
+		if !assert.NoError(t, err) {
+			continue
+		}
+
+		switch metricset {
+		case "metrics":
+			assert.Equal(t, common.MapStr{"value": 1}, event.Fields["service"].(common.MapStr)["metrics"])
+		case "status":
+			assert.Equal(t, common.MapStr{"internal": 1, "value": 1}, event.Fields["service"].(common.MapStr)["status"])
+		default:
+			assert.Fail(t, "unexpected metricset", "metricset: %v", metricset)
+		}
+	}
+	close(done)
+
+	for range output {
+	}
+}
+
+type fakeLightMetricSet struct {
+	mb.BaseMetricSet
+}
+
+func (ms *fakeLightMetricSet) Fetch() (common.MapStr, error) {
+	return common.MapStr{"internal": 1, "value": 1}, nil
+}
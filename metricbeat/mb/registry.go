@@ -24,6 +24,7 @@ import (
 	"sync"
 
 	"github.com/elastic/beats/libbeat/logp"
+	"github.com/elastic/beats/libbeat/processors"
 )
 
 const initialSize = 20 // initialSize specifies the initial size of the Register.
@@ -105,6 +106,8 @@ type Register struct {
 	modules map[string]ModuleFactory
 	// A map of module name to nested map of MetricSet name to MetricSetRegistration.
 	metricSets map[string]map[string]MetricSetRegistration
+	// Source of modules and metricsets not registered in the Register.
+	secondarySource ModulesSource
 }
 
 // NewRegister creates and returns a new Register.
@@ -203,6 +206,22 @@ func (r *Register) addMetricSet(module, name string, factory MetricSetFactory, o
 	return nil
 }
 
+// SetSecondarySource sets the source used to look up modules and metricsets
+// that are not registered, like light modules.
+func (r *Register) SetSecondarySource(source ModulesSource) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.secondarySource = source
+}
+
+func (r *Register) getSecondarySource() ModulesSource {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	return r.secondarySource
+}
+
 // moduleFactory returns the registered ModuleFactory associated with the
 // given name. It returns nil if no ModuleFactory is registered.
 func (r *Register) moduleFactory(name string) ModuleFactory {
@@ -215,12 +234,26 @@ func (r *Register) moduleFactory(name string) ModuleFactory {
 // metricSetRegistration returns the registration data associated with the given
 // metricset name. It returns an error if no metricset is registered.
 func (r *Register) metricSetRegistration(module, name string) (MetricSetRegistration, error) {
-	r.lock.RLock()
-	defer r.lock.RUnlock()
-
 	module = strings.ToLower(module)
 	name = strings.ToLower(name)
 
+	registration, err := r.registeredMetricSet(module, name)
+	if err == nil {
+		return registration, nil
+	}
+
+	if source := r.getSecondarySource(); source != nil && source.HasMetricSet(module, name) {
+		return source.MetricSetRegistration(r, module, name)
+	}
+	return MetricSetRegistration{}, err
+}
+
+// registeredMetricSet returns the registration data of a metricset registered
+// in the Register.
+func (r *Register) registeredMetricSet(module, name string) (MetricSetRegistration, error) {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
 	metricSets, exists := r.metricSets[module]
 	if !exists {
 		return MetricSetRegistration{}, fmt.Errorf("metricset '%s/%s' is not registered, module not found", module, name)
@@ -234,17 +267,51 @@ func (r *Register) metricSetRegistration(module, name string) (MetricSetRegistra
 	return registration, nil
 }
 
+// isSecondaryMetricSet returns true if the metricset is not registered but
+// found in the secondary source.
+func (r *Register) isSecondaryMetricSet(module, name string) (ModulesSource, bool) {
+	if _, err := r.registeredMetricSet(strings.ToLower(module), strings.ToLower(name)); err == nil {
+		return nil, false
+	}
+
+	source := r.getSecondarySource()
+	return source, source != nil && source.HasMetricSet(module, name)
+}
+
+// metricSetModule returns the module used to create the metricset. Metricsets
+// of the secondary source can apply their own configuration defaults to it.
+func (r *Register) metricSetModule(module Module, name string) (Module, error) {
+	if source, ok := r.isSecondaryMetricSet(module.Name(), name); ok {
+		return source.MetricSetModule(module, name)
+	}
+	return module, nil
+}
+
+// ProcessorsForMetricSet returns the processors that must be applied to the
+// events of the metricset. Only metricsets of the secondary source can
+// define processors, nil is returned for registered metricsets.
+func (r *Register) ProcessorsForMetricSet(module, name string) (*processors.Processors, error) {
+	if source, ok := r.isSecondaryMetricSet(module, name); ok {
+		return source.ProcessorsForMetricSet(module, name)
+	}
+	return nil, nil
+}
+
 // DefaultMetricSets returns the names of the default MetricSets for a module.
 // An error is returned if no default MetricSet is declared or the module does
 // not exist.
 func (r *Register) DefaultMetricSets(module string) ([]string, error) {
-	r.lock.RLock()
-	defer r.lock.RUnlock()
-
 	module = strings.ToLower(module)
 
+	r.lock.RLock()
 	metricSets, exists := r.metricSets[module]
+	source := r.secondarySource
+	r.lock.RUnlock()
+
 	if !exists {
+		if source != nil && source.HasModule(module) {
+			return source.DefaultMetricSets(module)
+		}
 		return nil, fmt.Errorf("module '%s' not found", module)
 	}
 
@@ -261,24 +328,40 @@ func (r *Register) DefaultMetricSets(module string) ([]string, error) {
 	return defaults, nil
 }
 
-// Modules returns the list of module names that are registered
+// Modules returns the list of module names that are registered, including
+// the modules of the secondary source.
 func (r *Register) Modules() []string {
 	r.lock.RLock()
-	defer r.lock.RUnlock()
-
 	modules := make([]string, 0, len(r.modules))
+	found := make(map[string]bool, len(r.modules))
 	for module := range r.modules {
 		modules = append(modules, module)
+		found[module] = true
+	}
+	source := r.secondarySource
+	r.lock.RUnlock()
+
+	if source != nil {
+		secondaryModules, err := source.Modules()
+		if err != nil {
+			logp.Err("Failed to list modules of secondary source: %v", err)
+		}
+		for _, module := range secondaryModules {
+			if !found[module] {
+				modules = append(modules, module)
+				found[module] = true
+			}
+		}
 	}
 
 	return modules
 }
 
-// MetricSets returns the list of MetricSets registered for a given module
+// MetricSets returns the list of MetricSets registered for a given module,
+// or the MetricSets of the module in the secondary source if the module is
+// not registered.
 func (r *Register) MetricSets(module string) []string {
 	r.lock.RLock()
-	defer r.lock.RUnlock()
-
 	var metricsets []string
 
 	sets, ok := r.metricSets[strings.ToLower(module)]
@@ -288,6 +371,16 @@ func (r *Register) MetricSets(module string) []string {
 			metricsets = append(metricsets, name)
 		}
 	}
+	source := r.secondarySource
+	r.lock.RUnlock()
+
+	if !ok && source != nil && source.HasModule(module) {
+		var err error
+		metricsets, err = source.MetricSets(module)
+		if err != nil {
+			logp.Err("Failed to list metricsets of module '%s': %v", module, err)
+		}
+	}
 
 	return metricsets
 }
@@ -18,8 +18,11 @@
 package server
 
 import (
+	"github.com/pkg/errors"
+
 	"github.com/elastic/beats/libbeat/common"
 	"github.com/elastic/beats/libbeat/common/cfgwarn"
+	"github.com/elastic/beats/libbeat/logp"
 	serverhelper "github.com/elastic/beats/metricbeat/helper/server"
 	"github.com/elastic/beats/metricbeat/helper/server/http"
 	"github.com/elastic/beats/metricbeat/mb"
@@ -70,7 +73,12 @@ func New(base mb.BaseMetricSet) (mb.MetricSet, error) {
 // Run method provides the Graphite server with a reporter with which events can be reported.
 func (m *MetricSet) Run(reporter mb.PushReporterV2) {
 	// Start event watcher
-	m.server.Start()
+	if err := m.server.Start(); err != nil {
+		err = errors.Wrap(err, "failed to start http server")
+		logp.Err("%v", err)
+		reporter.Error(err)
+		return
+	}
 
 	for {
 		select {
@@ -31,5 +31,5 @@ func init() {
 
 // Asset returns asset data
 func Asset() string {
-	return "eJy0ksGumzAQRfd8xVX2jw9g0U33T5W6rCrkwAWsGA/1DE/K31cuCY9QomzaWQ7jew5jv+HCa4UpyUgbOGsBmLfACqdva/NUAC21SX4yL7HClwIAvpszRSMhsDG26JKM+DxVFoAOkqxuJHa+r9C5oCyAxECnrNC7PEMzH3ut8OOkGk4/C6DzDK1WfzBviG7kTjKXXaeckWSebp0DycesJe+Rf+8uFM0/tXaPIE9BSy1bcWeZDTZwsxAo0wdTuRlfTc40t+nvnbeGUcx3vnEZrg8Tz2xfGOd634QuOyh3M0dKW61fM2fWgbG34a+hu1mQ2B98fCGX6+ucEqMtGCyYveKnTJtkmtj+B4/3eTwzQbo742bED8b90jYvt6EqtZSJse7a42s7kHp1a6tMDkbnA9cjkp7YqElyPctmmONFa5N6YlKv9s+lRo6SrlhAsMEZXGJ+wLjScMOyhQlar5ey+B0AAP//Qe07WA=="
+	return "eJy0ks+Om0AMxu88hZX78gAceum1XVXqsarQAB8wzTCmttmIt69myR9Cku5K24o5jY1/P3+aJ9pjLmgUHmA9Js2IzFtAQbtv58tdRtRAa/GjeY4FfcqIiL6bM6WaQ0BtaKgVHujyV54Rac9iZc2x9V1BrQuKjEgQ4BQFdS71wMzHTgv6sVMNu58ZUesRGi1eMU8U3YCNZCrYPKYZwtN4vLkjeT0rfU8b/ul2oQgGNpQH8YZz8R7rIW85X2Hia6Vx0h4NVfMqGVLIC0Tp4K2/IuarGWfLCnbxvN1nbR9chXBVOalz9Qu1bUrLZbl07DEfWJpNy19WTOdLAipxS9aDzA9Iy3lonm3dND2Xj0S6vDdX8WSvtJtAP5peZPOtr12C690Ut7bvSOh5NXTJYK35SGmt9XvChDIgdtbfNJ3MAsfuTvENuXQ+TyKItmBoweQPZRrhcUTzHzyep6GCpKd0ZByN8IK4De1kMwrXUIXmPCKWbaPZO6XeELrIpMHU+oDzDiwPbNRYXIe87qe419K4HCHq1f651ICBZaYFRNY7IyegyEYzjI5YNGRMjdd9nv0ZAOFOmQE="
 }
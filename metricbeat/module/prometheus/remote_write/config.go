@@ -0,0 +1,66 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package remote_write
+
+import (
+	"github.com/elastic/beats/libbeat/common/match"
+)
+
+type config struct {
+	Labels labelFilter `config:"labels"`
+}
+
+// labelFilter selects the time series to report by their labels. Series are
+// kept if, for every label in Allow, their value matches any of the regular
+// expressions, and dropped if the value of any label in Deny matches any of
+// its expressions. The metric name can be filtered on with the `__name__`
+// label.
+type labelFilter struct {
+	Allow map[string][]match.Matcher `config:"allow"`
+	Deny  map[string][]match.Matcher `config:"deny"`
+}
+
+func defaultConfig() config {
+	return config{}
+}
+
+func (f *labelFilter) accept(labels map[string]string) bool {
+	for name, matchers := range f.Allow {
+		value, found := labels[name]
+		if !found || !matchAny(matchers, value) {
+			return false
+		}
+	}
+
+	for name, matchers := range f.Deny {
+		if value, found := labels[name]; found && matchAny(matchers, value) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func matchAny(matchers []match.Matcher, value string) bool {
+	for _, m := range matchers {
+		if m.MatchString(value) {
+			return true
+		}
+	}
+	return false
+}
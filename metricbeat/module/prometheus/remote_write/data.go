@@ -0,0 +1,92 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package remote_write
+
+import (
+	"math"
+	"time"
+
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/metricbeat/mb"
+)
+
+// nameLabel is the label holding the metric name of a time series.
+const nameLabel = "__name__"
+
+// eventKey groups the samples with the same labels and timestamp.
+type eventKey struct {
+	labelHash string
+	timestamp int64
+}
+
+// samplesToEvents converts the time series in a remote_write request into
+// events. Samples with the same labels and timestamp are grouped as one
+// event, as the collector metricset does with the metrics it scrapes.
+func samplesToEvents(req *WriteRequest, filter *labelFilter) []mb.Event {
+	var keys []eventKey
+	events := map[eventKey]common.MapStr{}
+
+	for _, ts := range req.Timeseries {
+		labels := make(map[string]string, len(ts.Labels))
+		for _, l := range ts.Labels {
+			labels[l.Name] = l.Value
+		}
+
+		name := labels[nameLabel]
+		if name == "" || !filter.accept(labels) {
+			continue
+		}
+
+		labelsMap := common.MapStr{}
+		for k, v := range labels {
+			if k != nameLabel && v != "" {
+				labelsMap[k] = v
+			}
+		}
+		labelHash := labelsMap.String()
+
+		for _, s := range ts.Samples {
+			// NaN is used as staleness marker, neither it nor infinite
+			// values can be indexed.
+			if math.IsNaN(s.Value) || math.IsInf(s.Value, 0) {
+				continue
+			}
+
+			key := eventKey{labelHash: labelHash, timestamp: s.Timestamp}
+			fields, found := events[key]
+			if !found {
+				fields = common.MapStr{}
+				if len(labelsMap) > 0 {
+					fields["label"] = labelsMap
+				}
+				events[key] = fields
+				keys = append(keys, key)
+			}
+			fields[name] = common.MapStr{"value": s.Value}
+		}
+	}
+
+	result := make([]mb.Event, 0, len(keys))
+	for _, key := range keys {
+		result = append(result, mb.Event{
+			Timestamp:       time.Unix(0, key.timestamp*int64(time.Millisecond)).UTC(),
+			MetricSetFields: events[key],
+		})
+	}
+	return result
+}
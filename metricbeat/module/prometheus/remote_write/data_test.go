@@ -0,0 +1,107 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package remote_write
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/libbeat/common"
+)
+
+func series(name string, labels map[string]string, samples ...*Sample) *TimeSeries {
+	ts := &TimeSeries{
+		Labels:  []*Label{{Name: nameLabel, Value: name}},
+		Samples: samples,
+	}
+	for k, v := range labels {
+		ts.Labels = append(ts.Labels, &Label{Name: k, Value: v})
+	}
+	return ts
+}
+
+func TestSamplesToEvents(t *testing.T) {
+	req := &WriteRequest{
+		Timeseries: []*TimeSeries{
+			series("up", map[string]string{"job": "node"},
+				&Sample{Value: 1, Timestamp: 1000},
+				&Sample{Value: 0, Timestamp: 2000},
+			),
+			series("node_load1", map[string]string{"job": "node"},
+				&Sample{Value: 0.5, Timestamp: 1000},
+				&Sample{Value: math.NaN(), Timestamp: 2000},
+			),
+			series("go_goroutines", nil, &Sample{Value: 42, Timestamp: 1000}),
+			{Labels: []*Label{{Name: "job", Value: "unnamed"}}, Samples: []*Sample{{Value: 1}}},
+		},
+	}
+
+	events := samplesToEvents(req, &labelFilter{})
+	require.Len(t, events, 3)
+
+	assert.Equal(t, time.Unix(1, 0).UTC(), events[0].Timestamp)
+	assert.Equal(t, common.MapStr{
+		"label":      common.MapStr{"job": "node"},
+		"up":         common.MapStr{"value": float64(1)},
+		"node_load1": common.MapStr{"value": 0.5},
+	}, events[0].MetricSetFields)
+
+	assert.Equal(t, time.Unix(2, 0).UTC(), events[1].Timestamp)
+	assert.Equal(t, common.MapStr{
+		"label": common.MapStr{"job": "node"},
+		"up":    common.MapStr{"value": float64(0)},
+	}, events[1].MetricSetFields)
+
+	assert.Equal(t, common.MapStr{
+		"go_goroutines": common.MapStr{"value": float64(42)},
+	}, events[2].MetricSetFields)
+}
+
+func TestLabelFilter(t *testing.T) {
+	cfg := common.MustNewConfigFrom(map[string]interface{}{
+		"labels.allow": map[string]interface{}{
+			"job": []string{"^node$", "^api-"},
+		},
+		"labels.deny": map[string]interface{}{
+			"__name__": []string{"^go_"},
+		},
+	})
+	config := defaultConfig()
+	require.NoError(t, cfg.Unpack(&config))
+
+	tests := []struct {
+		labels   map[string]string
+		expected bool
+	}{
+		{map[string]string{nameLabel: "up", "job": "node"}, true},
+		{map[string]string{nameLabel: "up", "job": "api-frontend"}, true},
+		{map[string]string{nameLabel: "up", "job": "db"}, false},
+		{map[string]string{nameLabel: "up"}, false},
+		{map[string]string{nameLabel: "go_goroutines", "job": "node"}, false},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.expected, config.Labels.accept(test.labels), "labels: %v", test.labels)
+	}
+}
@@ -0,0 +1,164 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package remote_write
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/snappy"
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/libbeat/common/cfgwarn"
+	"github.com/elastic/beats/libbeat/logp"
+	serverhelper "github.com/elastic/beats/metricbeat/helper/server"
+	httpserver "github.com/elastic/beats/metricbeat/helper/server/http"
+	"github.com/elastic/beats/metricbeat/mb"
+)
+
+const (
+	// maxRequestBodySize is the maximum size of the compressed body of a
+	// request. Prometheus sends batches of a few hundred samples, that are
+	// much smaller.
+	maxRequestBodySize = 10 * 1024 * 1024
+
+	// maxDecodedBodySize is the maximum size of the decompressed body.
+	maxDecodedBodySize = 64 * 1024 * 1024
+)
+
+func init() {
+	mb.Registry.MustAddMetricSet("prometheus", "remote_write", New)
+}
+
+// MetricSet receives the samples pushed by Prometheus servers with
+// remote_write and reports them as events.
+type MetricSet struct {
+	mb.BaseMetricSet
+	server serverhelper.Server
+	filter labelFilter
+	events chan mb.Event
+	done   chan struct{}
+	log    *logp.Logger
+}
+
+// New creates a new remote_write metricset.
+func New(base mb.BaseMetricSet) (mb.MetricSet, error) {
+	cfgwarn.Beta("The prometheus remote_write metricset is beta")
+
+	config := defaultConfig()
+	if err := base.Module().UnpackConfig(&config); err != nil {
+		return nil, err
+	}
+
+	m := &MetricSet{
+		BaseMetricSet: base,
+		filter:        config.Labels,
+		events:        make(chan mb.Event),
+		done:          make(chan struct{}),
+		log:           logp.NewLogger("prometheus.remote_write"),
+	}
+
+	svc, err := httpserver.NewHttpServerWithHandler(base, m.handleFunc)
+	if err != nil {
+		return nil, err
+	}
+	m.server = svc
+
+	return m, nil
+}
+
+// Run starts the HTTP server and reports the events decoded from the
+// requests it receives until the reporter is done.
+func (m *MetricSet) Run(reporter mb.PushReporterV2) {
+	if err := m.server.Start(); err != nil {
+		err = errors.Wrap(err, "failed to start remote_write server")
+		m.log.Error(err)
+		reporter.Error(err)
+		return
+	}
+	defer m.server.Stop()
+	defer close(m.done)
+
+	for {
+		select {
+		case <-reporter.Done():
+			return
+		case event := <-m.events:
+			reporter.Event(event)
+		}
+	}
+}
+
+func (m *MetricSet) handleFunc(writer http.ResponseWriter, req *http.Request) {
+	if req.Method != "POST" {
+		writer.WriteHeader(http.StatusMethodNotAllowed)
+		writer.Write([]byte("Prometheus remote_write endpoint accepts data via POST"))
+		return
+	}
+
+	writeRequest, err := decodeWriteRequest(writer, req)
+	if err != nil {
+		m.log.Debugf("Invalid remote_write request: %v", err)
+		http.Error(writer, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	for _, event := range samplesToEvents(writeRequest, &m.filter) {
+		select {
+		case <-req.Context().Done():
+			return
+		case <-m.done:
+			http.Error(writer, "Metricset is stopped", http.StatusServiceUnavailable)
+			return
+		case m.events <- event:
+		}
+	}
+
+	writer.WriteHeader(http.StatusAccepted)
+}
+
+// decodeWriteRequest decodes the snappy compressed protobuf body of a
+// remote_write request. Bodies larger than maxRequestBodySize, or
+// decompressing to more than maxDecodedBodySize, are rejected.
+func decodeWriteRequest(writer http.ResponseWriter, req *http.Request) (*WriteRequest, error) {
+	compressed, err := ioutil.ReadAll(http.MaxBytesReader(writer, req.Body, maxRequestBodySize))
+	if err != nil {
+		return nil, fmt.Errorf("error reading request body: %v", err)
+	}
+
+	size, err := snappy.DecodedLen(compressed)
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing request body: %v", err)
+	}
+	if size > maxDecodedBodySize {
+		return nil, fmt.Errorf("decompressed request body of %d bytes exceeds the limit of %d bytes", size, maxDecodedBodySize)
+	}
+
+	buf, err := snappy.Decode(nil, compressed)
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing request body: %v", err)
+	}
+
+	var writeRequest WriteRequest
+	if err := proto.Unmarshal(buf, &writeRequest); err != nil {
+		return nil, fmt.Errorf("error decoding request body: %v", err)
+	}
+	return &writeRequest, nil
+}
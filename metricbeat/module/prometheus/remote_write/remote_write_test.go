@@ -0,0 +1,112 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package remote_write
+
+import (
+	"bytes"
+	"encoding/binary"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/snappy"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/libbeat/common"
+	mbtest "github.com/elastic/beats/metricbeat/mb/testing"
+)
+
+func TestRemoteWrite(t *testing.T) {
+	ms := mbtest.NewPushMetricSetV2(t, map[string]interface{}{
+		"module":     "prometheus",
+		"metricsets": []string{"remote_write"},
+		"host":       "127.0.0.1",
+		"port":       19201,
+	})
+
+	data, err := proto.Marshal(&WriteRequest{
+		Timeseries: []*TimeSeries{
+			series("up", map[string]string{"job": "node"}, &Sample{Value: 1, Timestamp: 1000}),
+		},
+	})
+	require.NoError(t, err)
+	body := snappy.Encode(nil, data)
+
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		// Retry until the server is listening.
+		for {
+			resp, err := http.Post("http://127.0.0.1:19201/write", "application/x-protobuf", bytes.NewReader(body))
+			if err == nil {
+				resp.Body.Close()
+				if resp.StatusCode == http.StatusAccepted {
+					return
+				}
+			}
+
+			select {
+			case <-done:
+				return
+			case <-time.After(20 * time.Millisecond):
+			}
+		}
+	}()
+
+	events := mbtest.RunPushMetricSetV2(5*time.Second, 1, ms)
+	require.Len(t, events, 1)
+	assert.Equal(t, common.MapStr{
+		"label": common.MapStr{"job": "node"},
+		"up":    common.MapStr{"value": float64(1)},
+	}, events[0].MetricSetFields)
+}
+
+func TestDecodeWriteRequestInvalid(t *testing.T) {
+	req, err := http.NewRequest("POST", "/write", bytes.NewReader([]byte("not snappy")))
+	require.NoError(t, err)
+
+	_, err = decodeWriteRequest(httptest.NewRecorder(), req)
+	assert.Error(t, err)
+}
+
+func TestDecodeWriteRequestTooLarge(t *testing.T) {
+	body := bytes.Repeat([]byte{0xff}, maxRequestBodySize+1)
+	req, err := http.NewRequest("POST", "/write", bytes.NewReader(body))
+	require.NoError(t, err)
+
+	_, err = decodeWriteRequest(httptest.NewRecorder(), req)
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "too large")
+	}
+
+	// Snappy blocks start with their decoded length.
+	header := make([]byte, binary.MaxVarintLen64)
+	n := binary.PutUvarint(header, maxDecodedBodySize+1)
+	req, err = http.NewRequest("POST", "/write", bytes.NewReader(header[:n]))
+	require.NoError(t, err)
+
+	_, err = decodeWriteRequest(httptest.NewRecorder(), req)
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "exceeds the limit")
+	}
+}
@@ -0,0 +1,66 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package query
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+)
+
+const (
+	// tableFormat reports one event per row, with the columns as fields.
+	tableFormat = "table"
+	// variablesFormat reports one event for all rows, each row being a
+	// name/value pair.
+	variablesFormat = "variables"
+)
+
+type config struct {
+	Driver         string `config:"driver" validate:"required"`
+	Query          string `config:"sql_query" validate:"required"`
+	ResponseFormat string `config:"sql_response_format"`
+}
+
+func defaultConfig() config {
+	return config{
+		ResponseFormat: tableFormat,
+	}
+}
+
+func (c *config) Validate() error {
+	if !isDriverRegistered(c.Driver) {
+		return fmt.Errorf("unknown driver '%s' (available drivers: %s)", c.Driver, strings.Join(sql.Drivers(), ", "))
+	}
+
+	switch c.ResponseFormat {
+	case tableFormat, variablesFormat:
+	default:
+		return fmt.Errorf("invalid sql_response_format '%s' (must be %s or %s)", c.ResponseFormat, tableFormat, variablesFormat)
+	}
+	return nil
+}
+
+func isDriverRegistered(name string) bool {
+	for _, driver := range sql.Drivers() {
+		if driver == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,156 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package query
+
+import (
+	"database/sql"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/elastic/beats/libbeat/common"
+)
+
+// fetchRows returns the column names and the values of each row.
+func fetchRows(rows *sql.Rows) ([]string, [][]interface{}, error) {
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, nil, fmt.Errorf("error getting columns: %v", err)
+	}
+
+	var result [][]interface{}
+	for rows.Next() {
+		values := make([]interface{}, len(columns))
+		pointers := make([]interface{}, len(columns))
+		for i := range values {
+			pointers[i] = &values[i]
+		}
+
+		if err := rows.Scan(pointers...); err != nil {
+			return nil, nil, fmt.Errorf("error scanning row: %v", err)
+		}
+		result = append(result, values)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, nil, fmt.Errorf("error reading rows: %v", err)
+	}
+	return columns, result, nil
+}
+
+// metrics groups values by type, so each field has a consistent mapping.
+type metrics struct {
+	numeric common.MapStr
+	str     common.MapStr
+	boolean common.MapStr
+}
+
+func newMetrics() *metrics {
+	return &metrics{
+		numeric: common.MapStr{},
+		str:     common.MapStr{},
+		boolean: common.MapStr{},
+	}
+}
+
+// add stores the value with the given name. Strings holding numbers, as
+// returned by some drivers for any column, are stored as numbers. Null
+// values are ignored.
+func (m *metrics) add(name string, value interface{}) {
+	switch v := value.(type) {
+	case nil:
+	case int64, float64:
+		m.numeric[name] = v
+	case bool:
+		m.boolean[name] = v
+	case time.Time:
+		m.str[name] = v.Format(time.RFC3339Nano)
+	case []byte:
+		m.addString(name, string(v))
+	case string:
+		m.addString(name, v)
+	default:
+		m.str[name] = fmt.Sprint(v)
+	}
+}
+
+func (m *metrics) addString(name, value string) {
+	if n, err := strconv.ParseFloat(value, 64); err == nil {
+		m.numeric[name] = n
+		return
+	}
+	m.str[name] = value
+}
+
+func (m *metrics) mapStr() common.MapStr {
+	result := common.MapStr{}
+	for key, values := range map[string]common.MapStr{
+		"numeric": m.numeric,
+		"string":  m.str,
+		"boolean": m.boolean,
+	} {
+		if len(values) > 0 {
+			result[key] = values
+		}
+	}
+	return result
+}
+
+// tableMetrics returns the metrics of each row, with the columns as names.
+func tableMetrics(columns []string, rows [][]interface{}) []common.MapStr {
+	result := make([]common.MapStr, 0, len(rows))
+	for _, row := range rows {
+		m := newMetrics()
+		for i, column := range columns {
+			m.add(column, row[i])
+		}
+		result = append(result, m.mapStr())
+	}
+	return result
+}
+
+// variablesMetrics returns the metrics of all rows, each row holding the
+// name of a variable in its first column and its value in the second.
+func variablesMetrics(columns []string, rows [][]interface{}) (common.MapStr, error) {
+	if len(columns) != 2 {
+		return nil, fmt.Errorf("queries with sql_response_format '%s' must return two columns, got %d", variablesFormat, len(columns))
+	}
+
+	m := newMetrics()
+	for _, row := range rows {
+		name, ok := variableName(row[0])
+		if !ok {
+			continue
+		}
+		m.add(name, row[1])
+	}
+	return m.mapStr(), nil
+}
+
+func variableName(value interface{}) (string, bool) {
+	switch v := value.(type) {
+	case []byte:
+		return string(v), len(v) > 0
+	case string:
+		return v, v != ""
+	case nil:
+		return "", false
+	default:
+		return fmt.Sprint(v), true
+	}
+}
@@ -0,0 +1,71 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package query
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/libbeat/common"
+)
+
+func TestTableMetrics(t *testing.T) {
+	columns := []string{"name", "size", "ratio", "active", "created", "comment"}
+	rows := [][]interface{}{
+		{[]byte("users"), int64(42), []byte("0.5"), true, time.Date(2018, 7, 1, 10, 0, 0, 0, time.UTC), nil},
+		{"orders", []byte("7"), 1.5, false, nil, []byte("archived")},
+	}
+
+	assert.Equal(t, []common.MapStr{
+		{
+			"numeric": common.MapStr{"size": int64(42), "ratio": 0.5},
+			"string":  common.MapStr{"name": "users", "created": "2018-07-01T10:00:00Z"},
+			"boolean": common.MapStr{"active": true},
+		},
+		{
+			"numeric": common.MapStr{"size": float64(7), "ratio": 1.5},
+			"string":  common.MapStr{"name": "orders", "comment": "archived"},
+			"boolean": common.MapStr{"active": false},
+		},
+	}, tableMetrics(columns, rows))
+}
+
+func TestVariablesMetrics(t *testing.T) {
+	columns := []string{"Variable_name", "Value"}
+	rows := [][]interface{}{
+		{[]byte("Threads_connected"), []byte("3")},
+		{[]byte("version"), []byte("5.7.22")},
+		{[]byte("read_only"), []byte("OFF")},
+		{nil, []byte("ignored")},
+	}
+
+	metrics, err := variablesMetrics(columns, rows)
+	require.NoError(t, err)
+	assert.Equal(t, common.MapStr{
+		"numeric": common.MapStr{"Threads_connected": float64(3)},
+		"string":  common.MapStr{"version": "5.7.22", "read_only": "OFF"},
+	}, metrics)
+
+	_, err = variablesMetrics([]string{"name"}, nil)
+	assert.Error(t, err)
+}
@@ -0,0 +1,146 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package query
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/common/cfgwarn"
+	"github.com/elastic/beats/metricbeat/mb"
+	"github.com/elastic/beats/metricbeat/mb/parse"
+	"github.com/elastic/beats/metricbeat/module/mysql"
+	"github.com/elastic/beats/metricbeat/module/postgresql"
+)
+
+func init() {
+	mb.Registry.MustAddMetricSet("sql", "query", New,
+		mb.WithHostParser(hostParser),
+		mb.WithNamespace("sql"),
+		mb.DefaultMetricSet(),
+	)
+}
+
+// hostParser parses the host with the parser of the module of the driver,
+// so the credentials are removed from the reported host. The hosts of other
+// drivers are used as is as data source names.
+func hostParser(mod mb.Module, host string) (mb.HostData, error) {
+	config := struct {
+		Driver string `config:"driver"`
+	}{}
+	if err := mod.UnpackConfig(&config); err != nil {
+		return mb.HostData{}, err
+	}
+
+	switch config.Driver {
+	case "mysql":
+		return mysql.ParseDSN(mod, host)
+	case "postgres":
+		return postgresql.ParseURL(mod, host)
+	default:
+		return parse.PassThruHostParser(mod, host)
+	}
+}
+
+// MetricSet runs a user-defined query at every period and reports its
+// results.
+type MetricSet struct {
+	mb.BaseMetricSet
+	config config
+	db     *sql.DB
+}
+
+// New creates a new instance of the query metricset.
+func New(base mb.BaseMetricSet) (mb.MetricSet, error) {
+	cfgwarn.Beta("The sql query metricset is beta")
+
+	config := defaultConfig()
+	if err := base.Module().UnpackConfig(&config); err != nil {
+		return nil, err
+	}
+
+	return &MetricSet{
+		BaseMetricSet: base,
+		config:        config,
+	}, nil
+}
+
+// Fetch runs the query and reports one event per row, or a single event
+// with all rows when using the variables response format.
+func (m *MetricSet) Fetch(reporter mb.ReporterV2) {
+	if m.db == nil {
+		db, err := sql.Open(m.config.Driver, m.HostData().URI)
+		if err != nil {
+			reporter.Error(fmt.Errorf("error opening connection: %v", err))
+			return
+		}
+		m.db = db
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), m.Module().Config().Timeout)
+	defer cancel()
+
+	rows, err := m.db.QueryContext(ctx, m.config.Query)
+	if err != nil {
+		reporter.Error(fmt.Errorf("error running query: %v", err))
+		return
+	}
+	defer rows.Close()
+
+	columns, values, err := fetchRows(rows)
+	if err != nil {
+		reporter.Error(err)
+		return
+	}
+
+	if m.config.ResponseFormat == variablesFormat {
+		metrics, err := variablesMetrics(columns, values)
+		if err != nil {
+			reporter.Error(err)
+			return
+		}
+		reporter.Event(m.event(metrics))
+		return
+	}
+
+	for _, metrics := range tableMetrics(columns, values) {
+		if !reporter.Event(m.event(metrics)) {
+			return
+		}
+	}
+}
+
+func (m *MetricSet) event(metrics common.MapStr) mb.Event {
+	return mb.Event{
+		MetricSetFields: common.MapStr{
+			"driver":  m.config.Driver,
+			"query":   m.config.Query,
+			"metrics": metrics,
+		},
+	}
+}
+
+// Close closes the connection to the database.
+func (m *MetricSet) Close() error {
+	if m.db == nil {
+		return nil
+	}
+	return m.db.Close()
+}
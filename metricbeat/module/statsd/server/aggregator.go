@@ -0,0 +1,279 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package server
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/metricbeat/mb"
+)
+
+// aggregator aggregates the received metrics over the reporting period.
+// Metrics sharing the same tags are reported in the same event.
+type aggregator struct {
+	ttl    time.Duration
+	groups map[string]*metricGroup
+}
+
+type metricGroup struct {
+	tags    map[string]string
+	metrics map[string]*metricEntry
+}
+
+type metricEntry struct {
+	metricType string
+	metric     aggregatedMetric
+	updated    time.Time
+}
+
+// aggregatedMetric is a metric aggregating the values received during a
+// period.
+type aggregatedMetric interface {
+	update(value string, sampleRate float64) error
+
+	// report returns the fields of the metric for the period, false if there
+	// is nothing to report. The metric is reset for the next period.
+	report() (common.MapStr, bool)
+}
+
+func newAggregator(ttl time.Duration) *aggregator {
+	return &aggregator{
+		ttl:    ttl,
+		groups: map[string]*metricGroup{},
+	}
+}
+
+// update adds the value of the metric to the current period.
+func (a *aggregator) update(m statsdMetric, now time.Time) error {
+	key := tagsKey(m.tags)
+	group, found := a.groups[key]
+	if !found {
+		group = &metricGroup{tags: m.tags, metrics: map[string]*metricEntry{}}
+		a.groups[key] = group
+	}
+
+	metricType := m.metricType
+	if metricType == typeHistogram {
+		metricType = typeTimer
+	}
+
+	entry, found := group.metrics[m.name]
+	if !found || entry.metricType != metricType {
+		entry = &metricEntry{metricType: metricType, metric: newAggregatedMetric(metricType)}
+		group.metrics[m.name] = entry
+	}
+	entry.updated = now
+
+	if err := entry.metric.update(m.value, m.sampleRate); err != nil {
+		return fmt.Errorf("metric '%s': %v", m.name, err)
+	}
+	return nil
+}
+
+// flush returns the events for the period and resets the metrics. Metrics
+// not updated for longer than the ttl are removed.
+func (a *aggregator) flush(now time.Time) []mb.Event {
+	var events []mb.Event
+	for key, group := range a.groups {
+		metrics := common.MapStr{}
+		for name, entry := range group.metrics {
+			if a.ttl > 0 && now.Sub(entry.updated) > a.ttl {
+				delete(group.metrics, name)
+				continue
+			}
+			if fields, ok := entry.metric.report(); ok {
+				metrics[name] = fields
+			}
+		}
+
+		if len(group.metrics) == 0 {
+			delete(a.groups, key)
+		}
+		if len(metrics) == 0 {
+			continue
+		}
+
+		fields := common.MapStr{"metrics": metrics}
+		if len(group.tags) > 0 {
+			labels := common.MapStr{}
+			for k, v := range group.tags {
+				labels[k] = v
+			}
+			fields["labels"] = labels
+		}
+		events = append(events, mb.Event{MetricSetFields: fields})
+	}
+	return events
+}
+
+func tagsKey(tags map[string]string) string {
+	keys := make([]string, 0, len(tags))
+	for k, v := range tags {
+		keys = append(keys, k+"="+v)
+	}
+	sort.Strings(keys)
+	return strings.Join(keys, "\x00")
+}
+
+func newAggregatedMetric(metricType string) aggregatedMetric {
+	switch metricType {
+	case typeCounter:
+		return &counter{}
+	case typeGauge:
+		return &gauge{}
+	case typeSet:
+		return &set{values: map[string]struct{}{}}
+	default:
+		return &timer{}
+	}
+}
+
+// counter sums the values received during the period, scaled by their
+// sample rate.
+type counter struct {
+	count   float64
+	updated bool
+}
+
+func (c *counter) update(value string, sampleRate float64) error {
+	v, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return err
+	}
+	c.count += v / sampleRate
+	c.updated = true
+	return nil
+}
+
+func (c *counter) report() (common.MapStr, bool) {
+	if !c.updated {
+		return nil, false
+	}
+	fields := common.MapStr{"count": c.count}
+	c.count, c.updated = 0, false
+	return fields, true
+}
+
+// gauge keeps the last value set, values prefixed with a sign modify the
+// current value. It is reported on every period.
+type gauge struct {
+	value float64
+}
+
+func (g *gauge) update(value string, _ float64) error {
+	v, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return err
+	}
+	if strings.HasPrefix(value, "+") || strings.HasPrefix(value, "-") {
+		g.value += v
+	} else {
+		g.value = v
+	}
+	return nil
+}
+
+func (g *gauge) report() (common.MapStr, bool) {
+	return common.MapStr{"value": g.value}, true
+}
+
+// timer reports statistics of the values received during the period. It is
+// also used for histograms.
+type timer struct {
+	values []float64
+	count  float64
+}
+
+func (t *timer) update(value string, sampleRate float64) error {
+	v, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return err
+	}
+	t.values = append(t.values, v)
+	t.count += 1 / sampleRate
+	return nil
+}
+
+func (t *timer) report() (common.MapStr, bool) {
+	if len(t.values) == 0 {
+		return nil, false
+	}
+
+	values := t.values
+	sort.Float64s(values)
+
+	var sum float64
+	for _, v := range values {
+		sum += v
+	}
+	mean := sum / float64(len(values))
+
+	var variance float64
+	for _, v := range values {
+		variance += (v - mean) * (v - mean)
+	}
+	variance /= float64(len(values))
+
+	fields := common.MapStr{
+		"count":  t.count,
+		"min":    values[0],
+		"max":    values[len(values)-1],
+		"mean":   mean,
+		"stddev": math.Sqrt(variance),
+		"median": percentile(values, 50),
+		"p75":    percentile(values, 75),
+		"p95":    percentile(values, 95),
+		"p99":    percentile(values, 99),
+	}
+	t.values, t.count = nil, 0
+	return fields, true
+}
+
+// percentile returns the nearest rank percentile of the sorted values.
+func percentile(sorted []float64, p float64) float64 {
+	rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
+
+// set counts the unique values received during the period.
+type set struct {
+	values map[string]struct{}
+}
+
+func (s *set) update(value string, _ float64) error {
+	s.values[value] = struct{}{}
+	return nil
+}
+
+func (s *set) report() (common.MapStr, bool) {
+	if len(s.values) == 0 {
+		return nil, false
+	}
+	fields := common.MapStr{"count": len(s.values)}
+	s.values = map[string]struct{}{}
+	return fields, true
+}
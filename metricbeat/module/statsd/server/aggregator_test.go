@@ -0,0 +1,152 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/metricbeat/mb"
+)
+
+func update(t *testing.T, a *aggregator, now time.Time, lines ...string) {
+	for _, line := range lines {
+		metric, err := parseLine(line)
+		require.NoError(t, err)
+		require.NoError(t, a.update(metric, now))
+	}
+}
+
+func eventsByLabels(events []mb.Event) map[string]common.MapStr {
+	byLabels := map[string]common.MapStr{}
+	for _, event := range events {
+		var key string
+		if labels, ok := event.MetricSetFields["labels"]; ok {
+			key = labels.(common.MapStr).String()
+		}
+		byLabels[key] = event.MetricSetFields["metrics"].(common.MapStr)
+	}
+	return byLabels
+}
+
+func TestAggregatorCounters(t *testing.T) {
+	a := newAggregator(time.Minute)
+	now := time.Now()
+
+	update(t, a, now, "requests:1|c", "requests:2|c", "requests:1|c|@0.5")
+	assert.Equal(t, []mb.Event{
+		{MetricSetFields: common.MapStr{
+			"metrics": common.MapStr{"requests": common.MapStr{"count": float64(5)}},
+		}},
+	}, a.flush(now))
+
+	// Counters are reset and not reported when not updated.
+	assert.Empty(t, a.flush(now))
+}
+
+func TestAggregatorGauges(t *testing.T) {
+	a := newAggregator(time.Minute)
+	now := time.Now()
+
+	update(t, a, now, "queue:10|g", "queue:+5|g", "queue:-3|g")
+	expected := []mb.Event{
+		{MetricSetFields: common.MapStr{
+			"metrics": common.MapStr{"queue": common.MapStr{"value": float64(12)}},
+		}},
+	}
+	assert.Equal(t, expected, a.flush(now))
+
+	// Gauges are reported until they expire.
+	assert.Equal(t, expected, a.flush(now.Add(30*time.Second)))
+	assert.Empty(t, a.flush(now.Add(2*time.Minute)))
+	assert.Empty(t, a.groups)
+}
+
+func TestAggregatorTimers(t *testing.T) {
+	a := newAggregator(time.Minute)
+	now := time.Now()
+
+	update(t, a, now, "latency:4|ms", "latency:1|ms", "latency:3|h", "latency:2|ms|@0.5")
+	events := a.flush(now)
+	require.Len(t, events, 1)
+
+	fields := events[0].MetricSetFields["metrics"].(common.MapStr)["latency"].(common.MapStr)
+	assert.Equal(t, float64(5), fields["count"])
+	assert.Equal(t, float64(1), fields["min"])
+	assert.Equal(t, float64(4), fields["max"])
+	assert.Equal(t, 2.5, fields["mean"])
+	assert.Equal(t, float64(2), fields["median"])
+	assert.Equal(t, float64(3), fields["p75"])
+	assert.Equal(t, float64(4), fields["p99"])
+	assert.InDelta(t, 1.118, fields["stddev"], 0.001)
+
+	assert.Empty(t, a.flush(now))
+}
+
+func TestAggregatorSets(t *testing.T) {
+	a := newAggregator(time.Minute)
+	now := time.Now()
+
+	update(t, a, now, "users:alice|s", "users:bob|s", "users:alice|s")
+	assert.Equal(t, []mb.Event{
+		{MetricSetFields: common.MapStr{
+			"metrics": common.MapStr{"users": common.MapStr{"count": 2}},
+		}},
+	}, a.flush(now))
+}
+
+func TestAggregatorGroupsByTags(t *testing.T) {
+	a := newAggregator(time.Minute)
+	now := time.Now()
+
+	update(t, a, now,
+		"requests:1|c|#region:eu,host:a",
+		"errors:1|c|#host:a,region:eu",
+		"requests,region=us:1|c",
+		"requests:1|c",
+	)
+
+	events := a.flush(now)
+	require.Len(t, events, 3)
+
+	byLabels := eventsByLabels(events)
+	assert.Equal(t, common.MapStr{
+		"requests": common.MapStr{"count": float64(1)},
+		"errors":   common.MapStr{"count": float64(1)},
+	}, byLabels[common.MapStr{"host": "a", "region": "eu"}.String()])
+	assert.Equal(t, common.MapStr{
+		"requests": common.MapStr{"count": float64(1)},
+	}, byLabels[common.MapStr{"region": "us"}.String()])
+	assert.Equal(t, common.MapStr{
+		"requests": common.MapStr{"count": float64(1)},
+	}, byLabels[""])
+}
+
+func TestAggregatorInvalidValue(t *testing.T) {
+	a := newAggregator(time.Minute)
+
+	metric, err := parseLine("requests:abc|c")
+	require.NoError(t, err)
+	assert.Error(t, a.update(metric, time.Now()))
+}
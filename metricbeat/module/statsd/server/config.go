@@ -0,0 +1,48 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package server
+
+import (
+	"errors"
+	"time"
+)
+
+type config struct {
+	Protocol          string        `config:"protocol"`
+	Host              string        `config:"host"`
+	Port              int           `config:"port"`
+	ReceiveBufferSize int           `config:"receive_buffer_size" validate:"positive"`
+	TTL               time.Duration `config:"ttl" validate:"min=0"`
+}
+
+func defaultConfig() config {
+	return config{
+		Protocol:          "udp",
+		Host:              "localhost",
+		Port:              8125,
+		ReceiveBufferSize: 65535,
+		TTL:               30 * time.Second,
+	}
+}
+
+func (c config) Validate() error {
+	if c.Protocol != "tcp" && c.Protocol != "udp" {
+		return errors.New("`protocol` can only be tcp or udp")
+	}
+	return nil
+}
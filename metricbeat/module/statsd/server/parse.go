@@ -0,0 +1,161 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package server
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Metric types of the statsd protocol.
+const (
+	typeCounter   = "c"
+	typeGauge     = "g"
+	typeTimer     = "ms"
+	typeHistogram = "h"
+	typeSet       = "s"
+)
+
+// statsdMetric is a single metric read from a statsd message.
+type statsdMetric struct {
+	name       string
+	metricType string
+	value      string
+	sampleRate float64
+	tags       map[string]string
+}
+
+// parse reads the newline separated metrics of a statsd message. Lines that
+// cannot be parsed are skipped and reported in the returned error.
+func parse(b []byte) ([]statsdMetric, error) {
+	var (
+		metrics []statsdMetric
+		errs    []string
+	)
+	for _, line := range bytes.Split(b, []byte("\n")) {
+		line = bytes.TrimSpace(line)
+		if len(line) == 0 {
+			continue
+		}
+
+		metric, err := parseLine(string(line))
+		if err != nil {
+			errs = append(errs, err.Error())
+			continue
+		}
+		metrics = append(metrics, metric)
+	}
+
+	if len(errs) > 0 {
+		return metrics, fmt.Errorf("invalid statsd metrics: %s", strings.Join(errs, "; "))
+	}
+	return metrics, nil
+}
+
+// parseLine parses a metric in the format
+//
+//     <name>:<value>|<type>[|@<sample rate>][|#<tag>:<value>,...]
+//
+// Tags can also be set using the InfluxDB extension, as part of the name:
+//
+//     <name>,<tag>=<value>,...:<value>|<type>
+func parseLine(line string) (statsdMetric, error) {
+	metric := statsdMetric{sampleRate: 1}
+
+	parts := strings.Split(line, "|")
+	if len(parts) < 2 {
+		return metric, fmt.Errorf("'%s': missing metric type", line)
+	}
+
+	sep := strings.LastIndex(parts[0], ":")
+	if sep <= 0 {
+		return metric, fmt.Errorf("'%s': missing metric value", line)
+	}
+	metric.name, metric.value = parts[0][:sep], parts[0][sep+1:]
+	if metric.value == "" {
+		return metric, fmt.Errorf("'%s': missing metric value", line)
+	}
+
+	if i := strings.Index(metric.name, ","); i >= 0 {
+		tags, err := parseInfluxTags(metric.name[i+1:])
+		if err != nil {
+			return metric, fmt.Errorf("'%s': %v", line, err)
+		}
+		metric.name, metric.tags = metric.name[:i], tags
+	}
+	if metric.name == "" {
+		return metric, fmt.Errorf("'%s': missing metric name", line)
+	}
+
+	metric.metricType = parts[1]
+	switch metric.metricType {
+	case typeCounter, typeGauge, typeTimer, typeHistogram, typeSet:
+	default:
+		return metric, fmt.Errorf("'%s': unknown metric type '%s'", line, metric.metricType)
+	}
+
+	for _, part := range parts[2:] {
+		switch {
+		case strings.HasPrefix(part, "@"):
+			rate, err := strconv.ParseFloat(part[1:], 64)
+			if err != nil || rate <= 0 || rate > 1 {
+				return metric, fmt.Errorf("'%s': invalid sample rate '%s'", line, part[1:])
+			}
+			metric.sampleRate = rate
+		case strings.HasPrefix(part, "#"):
+			metric.tags = parseDogStatsDTags(metric.tags, part[1:])
+		}
+	}
+
+	return metric, nil
+}
+
+// parseInfluxTags parses tags in the format <tag>=<value>,...
+func parseInfluxTags(s string) (map[string]string, error) {
+	tags := map[string]string{}
+	for _, tag := range strings.Split(s, ",") {
+		kv := strings.SplitN(tag, "=", 2)
+		if len(kv) != 2 || kv[0] == "" {
+			return nil, fmt.Errorf("invalid tag '%s'", tag)
+		}
+		tags[kv[0]] = kv[1]
+	}
+	return tags, nil
+}
+
+// parseDogStatsDTags parses tags in the format <tag>:<value>,... Tags
+// without value are set to an empty string.
+func parseDogStatsDTags(tags map[string]string, s string) map[string]string {
+	if tags == nil {
+		tags = map[string]string{}
+	}
+	for _, tag := range strings.Split(s, ",") {
+		if tag == "" {
+			continue
+		}
+		kv := strings.SplitN(tag, ":", 2)
+		if len(kv) == 2 {
+			tags[kv[0]] = kv[1]
+		} else {
+			tags[kv[0]] = ""
+		}
+	}
+	return tags
+}
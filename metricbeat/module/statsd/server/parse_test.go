@@ -0,0 +1,84 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package server
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseLine(t *testing.T) {
+	tests := map[string]statsdMetric{
+		"requests:1|c": {
+			name: "requests", metricType: "c", value: "1", sampleRate: 1,
+		},
+		"requests:3|c|@0.1": {
+			name: "requests", metricType: "c", value: "3", sampleRate: 0.1,
+		},
+		"queue.size:-2|g": {
+			name: "queue.size", metricType: "g", value: "-2", sampleRate: 1,
+		},
+		"latency:320|ms|@0.5|#region:eu,canary": {
+			name: "latency", metricType: "ms", value: "320", sampleRate: 0.5,
+			tags: map[string]string{"region": "eu", "canary": ""},
+		},
+		"latency,region=eu,host=a:12.5|h": {
+			name: "latency", metricType: "h", value: "12.5", sampleRate: 1,
+			tags: map[string]string{"region": "eu", "host": "a"},
+		},
+		"users:alice|s": {
+			name: "users", metricType: "s", value: "alice", sampleRate: 1,
+		},
+	}
+
+	for line, expected := range tests {
+		t.Run(line, func(t *testing.T) {
+			metric, err := parseLine(line)
+			require.NoError(t, err)
+			assert.Equal(t, expected, metric)
+		})
+	}
+}
+
+func TestParseLineInvalid(t *testing.T) {
+	for _, line := range []string{
+		"requests",
+		"requests:1",
+		"requests:|c",
+		":1|c",
+		"requests:1|x",
+		"requests:1|c|@2",
+		"requests,region:1|c",
+	} {
+		_, err := parseLine(line)
+		assert.Error(t, err, line)
+	}
+}
+
+func TestParse(t *testing.T) {
+	metrics, err := parse([]byte("a:1|c\n\nb:2|g\ninvalid\nc:3|ms\n"))
+	assert.Error(t, err)
+	require.Len(t, metrics, 3)
+	assert.Equal(t, "a", metrics[0].name)
+	assert.Equal(t, "b", metrics[1].name)
+	assert.Equal(t, "c", metrics[2].name)
+}
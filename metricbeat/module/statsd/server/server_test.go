@@ -0,0 +1,68 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package server
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/libbeat/common"
+	mbtest "github.com/elastic/beats/metricbeat/mb/testing"
+)
+
+func TestServerUDP(t *testing.T) {
+	const addr = "127.0.0.1:18125"
+	ms := mbtest.NewPushMetricSetV2(t, map[string]interface{}{
+		"module": "statsd",
+		"host":   "127.0.0.1",
+		"port":   18125,
+		"period": "100ms",
+	})
+
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		// Send until the server is listening and the events are reported.
+		for {
+			conn, err := net.Dial("udp", addr)
+			if err == nil {
+				conn.Write([]byte("queue:7|g|#queue:jobs\n"))
+				conn.Close()
+			}
+
+			select {
+			case <-done:
+				return
+			case <-time.After(20 * time.Millisecond):
+			}
+		}
+	}()
+
+	events := mbtest.RunPushMetricSetV2(5*time.Second, 1, ms)
+	require.NotEmpty(t, events)
+	assert.Equal(t, common.MapStr{
+		"metrics": common.MapStr{"queue": common.MapStr{"value": float64(7)}},
+		"labels":  common.MapStr{"queue": "jobs"},
+	}, events[0].MetricSetFields)
+}
@@ -31,5 +31,5 @@ func init() {
 
 // Asset returns asset data
 func Asset() string {
-	return "eJzsvW1zGzmSIPy9fwVCz0S0HA9J2W6399Zxc3caSe7Rji1rJHlnem42aLAqSWJVBVQDKNHsi/vvF0i8FOqVpEi73bHyh26RrMpMJBKZicxE4rsxuYf1GzIDqr8jRDOdwRvyJ/spBZVIVmgm+BvyP74jhJAzwTVlXJFE5Lng+B6ZM8hSRegDZRmdZUAYJzTLCDwA10SvC1CT74h77M13CGhMOM3BIp6YP/HbTpzm390S8AUi5kQvASkkCnjK+AK/yMSC5KAUXYCakMvoKXyNqQBKgTYEmt8TwedsUUpq0JE5y2Bkvjc/Uk0eaFaaN0mpIEWYTJuPXOgYGL5ClkJph8k9fycQVY2OkfkNv/pkPn4KcASOuJ+uSZtpHuNmxgXaqCISdCk5pGS2RlSiAIOGL4haKw05EZyslixZVoRHvJMl54wvOqjRLIdfBd+CGv/kl6TmAaRigm8mxj3oxQrFGSd/AdyQAinRS6asKE/qonv0v8xQlKZ5ceSAGll/Q1KqPR8k/FIyCekbomXpv5wLmVNdew4+07wwS++0XJRKk5ev9ZK8fP7i9Yi8ePnmhx/f/PjD5IcfXm7HXSSJrKwgg1uGZoFISIRMyYqqanyNQWm6UMNYTuWMaUnlGp+13EqoUQUo7wVIO1GUp/hBS8oVTXQ1H5ZPDcRWO9T4KGb/CYlfa/bD1P5yD+uVkOkwoUFXlQpktaaMgrLIGhSAlELWCFhIURbDSC7MS14DJhajkV+apsw8SzPC+FyYlZ1QhfoL8aiJFwanFT1AT41TZuF7T5OGzzr6soesijQHZ9JCkIi0DT0TfLELdAOkDdrAaoGuz9lW0K2YOBOVZKJMKxt1Zj6SQooHloIZpqYp1bTbbL13v5K5FLmFFF5VZq4qFUTTdIoPTD1I82QCSgnZa8XMoxN8a+LBNhc2JBtW71Vk3uoUTsi1UIoZwUWbpAiVYACOyCKBERGSpGzBNM1EApRPemljXGnKE5iyDUvn0j1ILs89ScaIkJwmS8abS7cLw2bLFHDEdn07LO6BaSRngc/65SSHlJX5MPb3FgSK2G7InZvDMqbX08jkBQpKNQaq9PhFskGRRoAIWkRWWTumLDlMVWZuQORQN4ZZDaS4X8aftxc994qh5SchFhnYldaPXcJio6m9wWc2jc8t9FQk97h+3Eo/9587gNvfiNJUG/WbZZAYm43L3P5m1qxaCqmn1gK8IXOaKTNplCdLIT2+cVjl39WVsh9yIIt02oc+Pe5sAsgJS/fTiR85+6WECiBhaZdWD+jyLvOxE8ZYLhCc904dAcaRmJUs00TwIVIiZfBISs4CTgNrCFdGZ5CpFraaL0GG/YkNtFwiJyyeILRGmCuR/bP91AHk0jgDkaAaK9dSPZVsmu83SqbDvZtc7j8nf3bbivZsHEjSrYLoEHIqkyXTkOhSHmAMNXDkGCaLCfn8315PX78aESrzESmKZERyVqhnbVKEmhQZ1cal34+SD7fEA3I0JMC1UCNSzkquyxFZMZ6KVQ8R9R3P42lwcDpxzGnOsvXeKCwYN0gJ6ZLqEUlhxigfkbkEmKl0aLSsaJFQ+2oA+zumtFFol9djmqYSlALVRpDTZL9BejRLKtMVlVAhG5FSlTTL1uT96VlMg9cj9+UMJAcNqtImf4m/60Bb/R7c4LpPWwElsS4ZNovVSxsVUI1ospMaKkR6APMQcaAQqdVtnajKfVVThOlapOTj5XkbkfmvKmhyuEFVENvIzA7soBw0EHtYuK1x3Q6RhUZyWrQxUc6FxvjXwdBFILtxHtJhifAmNd9lCO0BXLZOvBau30dj5DbaSPvPnVDvlqCgEd8gVsPMRGnDm8AfmBQ8B65jF9+PJAoAma3qPBMr9BwTWhiLm056tIoC+RAc7i3jwvYdG5TCv1P8OiKhsZFJMgZcT/fFxTjTzIULh9CZd1iyY7A7EwuW0My//LjRHQbrtuNkG8JmBtnlNXEGcJfZs++HEKrQhhi/sLuHvz8xA8PehR4JNNtIzWUNvcPs0xzxWmKKYGzq83pkN9S4iAKcxIc/MbAu2YJxmjmWRMOt3J+3QpI/391dj3BX4mIINrsRcQc+a0mrTTatBVYDLAOHLIGmIEfGC0lhTstMk09/H78VckVlCqn569Ok0ocfeWYQVEMxI0yZMoDTEWGa0GxF14osqRk5hsJGGGZmZu+kkyVUtgJzLGH+P+GQuOCWX44Lqjl759tI0wJE9xQaMfoJxOU1RnwNxCizYF+e7OwfZSKhusuxX4CYFoLx2A6GuM//ycxwfnwxIpmh7F//73bGoxqBR+vJj1npBYfc1WYqpP9qIAXP1oTNyVqURggYB0JrDyy1LtSbk5PVajWBjCrNkkkiThYlS+EE+In7ToHZpZ0UWblgXJ3kVGmQJ6VifDFmfAFKj3FiJkudZ//bDuLau63/QVBiClZAxmycrTJPByCjTcAlfuOYGdxnYt/7D2MGkXTyTiyUpmrZLWqFkHqz6sroGiR5RczTURQvsYvpcNoLX9yOpPCoIUSLRGSYFA3hjpgGo/C40EQVkLA5M0tdLyHKFSUFipdSZQ4hGFBFW9OiQWYVFB6iMIr7xkr1uKb7rDp8v77967sRuYGUKYy233x8/8z8/8j4Mkdxbsd8EdRKI/lXo/JAU3sIK2kA7uYabEVCp0Dvh2pb70NCBlRtIQVKzLXZoPs3YttvfB78f9v0TiIgTPnsp42N5jnThKVGPChRkFOuWVKFVbwXjoIyxdRs5YofvTW+8YX58qjbId/GHUcHm2kF2bzPtT5Smko91SyHx6Wuf/7555/H79+Pz8/v/vznN+/fv7m9neQsy9g/muvz5fMXP46fvxi/fHX34tWb56/fPP9x8vxfXvxjiyXKcud+zJlUmhQ0uQcddAgO07gCMwBOFEBTCo6Myv7djDEXShMJifHOnNBDuvOY58bJ2+Bf8pQl1GwQ2dzVBjCzF1faf+KIBxUzwjO/Y3RoVNUTBHASjHIyu2vCuAaZQ2qWqCVVafOn8QGadGZitUUWUoM0+K1Ep2RGDU8EN5LPwSrsHDR1K4CnlVNbw/aQ0Q0JqkvOQeIU/Pu706vg7KLRYpxw0Csh7910NMGLUoOcbkZyC4kw3uquuOo7SFHKsJXbukzhWooCpGZQbW8QTpw76K1EqIVDB9zHWwvy/elZGBRVhDl5w2hkbSUb+Q2inZRSGulD0WsHSbaN8FYTeXn98MqPcmdyajA3kjZ9nJd+9Or55F9e/Dgi4395NXn+4sXR3l46K6Z2xJ/iHR6+UfnpRGnJamUeJK5cwb0/1UyXKeCaygRf2E8KCio97ygaO9rBELsetp2x1qp41MTVQG4pU57Ob2b6AkGbJ7EG0k7oYSeRFQ+vH7HkXn+lJffw+rGz9jrM2utDLbqH19/Sstt23roW3u7Tt8/C+4YmMSLpG1h80e5w0yTa6cINIi/zGcjD2VysqmlPTORtbCDuAyZqyIrppZcrLcwLmnFX22w8uxyoKiXkcUiOdDgkMW0c9NR5SFMtdHB667Q2yhk3kIsiYmB5Toq598K+6yVittbwZUlADN813EDDxP2dwHgqDukJnkdwvyV3MB7vfyGf0Az7mzBN+3iEu85dXUnvIFvfrlu4aR6/XafwKy68b8ipQGK+mcW3n1/41ZffNzSPEUm/+RLc3jWMjfC37x/G8qWFdxef/MNt/cMYL0vyYnN09ez9NWFpiDviZxthjeY7KlJyEdeNgO1ZRpqRu7PrOFLL0pD9wFxKK/txF+Xh9k2C1AolWrmQuivNJMTH0zqTAhuD6asl6CW00ptGKTA+EyVPyTHkTLtFZ8s7nlVMk0bxtZ+rageeTci/29M/Lt/EuHtrQq6Er7AIC6RwR4am9shQ7MwzHn0QpW4EmDXV5YYzgHiqlC2WJIMHyNwrHelUqx1XdG2WdCLyotSABR4Bkj1km0IBPFVEcJ/0w6TxiMzcdEpQZaZd5UcOlMcWk3H7vlFVVdoQIfSkYjey6MNfog8X0cFA8/nWluY0vz6zpTX26xpLc9BLsWHV3LnsIeXpyQPI2Yl9qZOpVaUOFsswVfOS3IuYRT3+6eJuRK4/3Jr/fryz5TJKEMGf2TKf27++i4EQg5oc3168uzi7GwWQH6/PT+8uRuT84t2F+X8FpZV5reUnhlLbrrzMv2EzvEhKvHwkzEEqokXHqAM8Q/jHm3ekoHpJysIImzW0ShOVUbUkxyfPLICQ2mfz8BpT5NNJqUCqkxefRjWogbrqmU8WkNE3RluqUetBvS7M0LJ1bVo0njzFAxN1n4ELTeYsy1x9BM2yGgfc+fkam81AH6GtDFrkUVNJDXHZs6leKGbkpsaC6tl4oObRe1iP7TJXWkj/dLV67Vv30MwR/lKCXNdiHFsd+zWDxFexDQFZljk1A6SpPfmLyd14mMw4IIbnYdZm1aQpYVaT8dwydg/k008Xd8SJytTWAv1PQ+wftXELLVRXLcJqR9WbcOwCM+YXq+gQojEh0jLOwWtOuqR5vcI+Ogw8wA0jIoAunqQ5aJCqPs3GmlJpCxiMqjBmxQw0er4293dLyeZ6fHN91ny7esOOS1fYG4Phojot0HdE07VzsKCu0dHCA/rOnsf1Z/5URSh6hPjwszLTHpkLDbKQEIoqJV2hLDuIcfWeM7VLyIp5mVnHWIpyloFaCqHtsUzn1Ei6qpyZG/zQrA9suy0ef7wakZaeyg3HzR2lwMyaeSrYxcaS9RJCld0AODu8YtH5qmNaFBlzOyNbmCR4tnZ6dcY4lesKfgAvyorzEgoJCriuba+6BUSCKgRXcPCRWrC/9VBrjnC8wYn84ffR1+Q48o7Vs1084xg6kZDZAirRVdTULXGWY5pt02tkZcxXkonkHmtbjBrUQtx7/y8DDUPVVMZzg4Sp4DkTrLhRGJJQ9fJZ3DnVNylFOe0j08A+u/64M1V9uOy+jm3RY6SxU2vKArkSOvZ+FPsVms5NWx6dZiMZ8IVejnAP7fc+9juP5/KaRMrP7MlsXXYXN+0XvgDKJR7aozZ7hscP24rT72vcKVeRYLXe3FDhhfJG78F4WM430b7O0dX5o+dHFuwBeKUlKjhOgQUXJVTT3nx8T44l0GxsfIhxLjjTQjK+eIZ7p4RWez2aKUGW9CF0kTDvW/RjLcaOELMHKblnOjaROb+6jb21gNtXSaZMJeIB5HrTSk6kCCu5K7pwEBb74FUj+qCFMeSgjHfK1NIOoSZslvk7KKbe4WSCpgcdi1Hl2EsLB2HAY4epplgESNuKB7Ote3J6bwTRmEXGidBLqDiTGAffWMsVZNmjOZKK/JFMueQDg7B7LwyudXMugDn/8L7BvUtONMg8KKa//UCu6ANbWMG/Y7lxD0+vL7u3mymbz0ECT4DMQK+MJ/EpFfmZnah3iOOCp5/MVjm82HriVlOJfr5zB2j+SxE5AKfv/3rdsvTmS18sn7iSTd/PqNuCO6hkp4MnEopsPd6zSRDSipCwU5CZAcqtbz4iiuUso9J8udS66MYYovqvnr9qR6DtK42WSI84NXlnPEb4XGRRmB6p7Ih6JxlVatxxjnh7trylLDNoXKQGIXZgsj8fFNXleQce+JwsKT9ksxAPcQDZ+ABNohwo1yeq+iEIzZzyEN8kta20UuyhjX4mRAaUb4f+cm7b/6UCQziJBKqroZ/8UkLZxYC0cVRuL9xh4+bBbsYPn5OsPNzoAwW8gkz6cNNSi3EKxt8+DPYIoEVqPZaSYw/GrtPy4xVlbW3xKOTRMU2MIBkpsO5t2F/ZZdelRQRXZW48L7rlSr5MgWs2Z85ZdG4BAhkZj46l6Az7FpIudWeEgUPWJYeQMeO0NSjYVcHcBSaMzaJacEgxPOwQj4Ol8viIpotOZYeO/TgRJW/Pz270VFuPEABybEEZGbkj6jhpMyC/ghQ1b9D847DK1uMUkoxKSO2LXUo6TORhCfdg0T2hvQtKilIzvhjfw559WVywzQOMwrGkvnxocn/w1ZMKsGFw+FxAoglN7rlYZZAuXNRiHsXyusnKRFJLsB54WSvgaSVMbnHHu4slrVc8FKXfZugl5F2FKvOx1VL7EX1udZ8/Ztur+Nh8DHmh21KyDzaE2IEMpXVPj8wvVrtLZl75qWoZx+ruYSlCBJHUHESndvblc5XodRELCKGIcB6rkPDARKmyNQlYrawwVQNm9voct1nhXHhbH5aZZsW+fsJptZICxKGVROWi9GHIOtpdmq588BUAURfUABmdL8sYJXJvItWEnNlYu5jXYD1QaXhaS4PV+ER5SrWQe0p2Nb8BoNeFXaspd8fc9kN643ynAC4YyW5DYzaOB/Cb31++v6hCCn2+s9lVneCOqJ8W4IlI68Vr+9LjQXZwwMXvNovm43v7eTNoUbnkEiY0hzyovGuXvNvmSfBxAVIxhUw4foHny+NvXj7rKgaTTEjWodW3dzv8iD2oEXlulua/dkqgxPQBE7xrU7rTgE+jyG4Et1L0XVtvt90Xe6J21YtauNCEFp3bpILJ7urDR0lUBS8Eb+LD+KTDFT4kj72xGuRvaB5+mCEHcF2o9tdiHsu6cNUGbSwYL9yXjWdmY298Yuwjzrq8K1oUh0MTJz0Qm2/cRZWiPJU0ihCe+e9aYcLwC3l4dfLDbgHDGBPZfMLkMkqYVxV4FQFViCCt0j8OUH/4Mc5zdxPRIqSFcttq0bZl6ce4GWsz0TREQUxFu4dnnZKOUtEWMR2XGjRr4dqI51nV9b+Nti3FnZjfGiAovGsMoQrXyUnWWsQ2USstgeb74ianFo8rELRAzeLBXR31Xa8UFhlW8+SVIhYg47bPv0j+7ip4yKKkknINuJNznn/1WCOpaUdNY8g2xPD3fg6IpnDtPPpT7i/OcOViloaUKaNPSrMNtdsmmuiSZu0rAZok2UTqXnJ4imU2C5BVJUSof6+laWciXfu/7RweU/cHUyRjOXPlCi9/fP3+T4Rx9/6zSedKjovGtmFmu0bgr+9citYGiSLRwXIBv9g73ZNayQrZXWnVdSP5WlrLCa+DN/IyXtqOyNh8iahwgQyune+Ve/xJyT0puScl9+WUXPfJElsP/7iVfw6askxFrlq4hsmC3XVJN3z5R01vTR2VWTsu0Ri/WPWv5S4ObMOFqHXbNsOP6eFlPu2hiWwSqRZpN01hqtICBgdxv9qcBlM9s1YnMAdNB4nrY1qLujORFwL7MM39XPmu490kDHMwJvIe1s2+2d3E9gtVJ8kfeLYOXKNzDRKvqvopEzOaTTG8o6ZmhzTypehIhttVbqJat5pefn2So5r7jfT2GcK96L22dxXVq6ddba39AqU5mJLcVVpEj2+mPBHZtJlm66Z+YKm1SB9YbonIypwrosDd1+FOZPkaPop1xmmZ+HvihpZiPJLiHtZTB/3LDub6L2EUjKfw2SZnDRM7lV2DTLpgfDHFNm6Hlhh7GKWCbw9D21pRe1zEtpdcijJLjXvhDyr+9ePFzc8nF3+/OPt4d2FLeM1wSw/OxRm0ZPAAkbildk6j42Q2j86Unc9+azOgl3Yyci7JEOQsqpgJOgcHHbX46xCm4FYmS8jptFW8U6dtK2t4VzFFC+NcxqD7fantjGMvgdswsEVqW8R9L1eLx8jIg8geIB22iBuMzc50YTWm/WaG50HN7jFMq5lRR98wWUPW5DA0WVuxNUGt7MohKYqXgaIsJXQ+t5rWoiXHwKpjtYbwkQ3DGsQjMi855t+xKy9dLCQsjCYxEJ9tYrNcwOFGhdCMWrWq6ujtx6uzu8sPV0fYJvj0p59uLn46vbs4GlVZ2JAQHSaU16+i2JP5EFh2UmfXMBFU9noMOxPxgYNvjIC9lmmyDLywS/mYKgzDmA8d01glv6Cg9cR+nahHab7rm4vr05uLfXWeJ27K+piyM+Naes/jcO7I5fnwJEr4ZXq4bUDHQq4iDk/bgd+W5KftQJ36p+3A03Zg/+0AacT6v6w2DVe5+WpfR2XnlsD+e1KsT4r1SbGSJ8X6jSvWzpSGKotCSN3y53tq/MjmOr8WK2rtmsxWGK/BKAvXssreqx7o8EJoa8HdcUuf80pEbo9F0lpejHLy4dps/G6rDUTnaGmpl0Z6Wg3uyPaJnK6iZFe47rrCqAYe27nHjr3+C8khWVLOVG6GUaqWfuu2LbVDcS2JHJbXrtCYs33zErsIUKVqQbLL04pmIY2Mlgp6MmQrKo3i686Ob1kLgI0kHG4Pb2Sr30WSlNIeNvqb/QX1PV5siBa6k6j61Rk7TTY2RCNFiWcKGpJ56nO/mIlF+iQkwB7cZR3VQWKsoibMRhhvLn66vL27uMHU42+R9GspUVucNpz62xDu3BL1XVTAj/WneGzL0GH+hESzB8DS0I4II5mLLBOrah4aNwdyWJ1IyMUD3rmUDowl6rl8OCbi/a2sGAic1FtI1rFuk/fuRmnAfrVgtZPr1GVxo7YmFhHxB1DbsJ5C1k8h66eQ9VPI+guGrHusf61hZEzLRutfuUe+f4K/JAwd8aqZ0F2j2qhZo0U5ce9jQ4bYktF60zSExUeuNyduKrk/3A+FFeFcyKo5SU7XDt6uvkSj50OdN9sWBEajcmPvqqzspSFXXVh29ilqLHwUIYdwrCpKdHTP5U5kOMu6v6X2Jtq3hrBtNdovbmOWJdB0mghuT0UlzULfbXnVorNtoCMkrsmtoz8KSWjJFgt7yDNeFh1MJY2TDaw7bUV2LhUbCqoYp0w1N/c0M7sCPPqEbm57oBvIRwBfnHYJeAbGkb8CCeSei5Vv42RHgbuvOPG0pKk/iesvUDxWjCeo9koebiiu5qqqtAiT+Wzj/OHO6mvN35LiDaTRkfi0o2tiH7GzTCT3zdYGX3TCVkuhoHmCnzAV5B7DJMkSg0a7Ct9KMg3THg1JHrXyz51jV3PLccNvcLmFznLj3pWbuJ1STaeORYMEtk8J9xN4qbHhtU+xIpvdsqCKUHXvWn1hrsCsALeXrbUB6KL20LsJ1PZh9+CrnSnDxsbOhdtA0kF3EgegR+lcHzCD315AJbdqrdaXqYsSXuZTQ3sp4WBu7ZbGAz4XIBm2OqPE0WD2ZahHISmrPrpbqSTP+oNOcxwl3G2KqVygQjkcV3fcLfST7Q9+pjzuCnt+dds67Hl+dTve6YRnyuuNpLfpCLdXHzIzSedXt/6akeoYMgldg1wwrZBiIWluZW8BHKS/8bsG0KYSEG4EjCmSiIJVDW47PN3qTMq0sfHYgv7qDIptaIfelbu53jUyZxxr40MP8mgbNmnAZD4FwzBq7FkgJFswjjFu3/1Hrl1CCQfHuB1eDVzX8bHQzQGTOW0eYI5yQku9FJJpqvduC3aKXMJQuGWLPSfl0xNRnNVlKhhuaT0F61YfJmy2KHLKuF3kPjvlfHHVc4beDkxCUuIxsWnw/b7E8PzdHw7dg98luFQSjjH4npb2GlReNYLdYigpKLZ3P5sN8+TyCfZqFBVnlrQgRSlVCY27juyIAwey9YTc9LPD3xHTO9yQnZoav+YLy6QnM7TA5YqleCFCpEFqIAN5vQNIlpDcM76YpkyZef9K84W44gmrQTGaluKhTmwYV+tN3szN1oejZcnxxqhp7ynXQ40H81c6XPv/44uXzQ7SRbbGPWFD/dlDbAMHcwf1vdfw7jKbpJG4CZr06sPFzc2Hm86uV1YbNeKfG6xKrNxmYLhgZoJhi9p5FW22rWjC/QZc8HEhGW97zMmSSprgHQzHM8jEivzwEmPNM/EA5MXL17ZrrtFCZqcWPU4lVAcZGxtcqgiohBZgb+EnL577s4+KHP/z/Pz82YT8iSb39r4VjFOm5JdSYMhSgn+5YQDpTI1IQqVk2HsOZ1DZJHXGOJA5QGrfTwR/AOlSPP/UI/JPOar11jX//slr2bvO6VutVpOFEIsMJonoaswWprGxzWxnh91mUUIiZKoak9eF+/T09HQAYTOJ3sJoM+JivhvWy6sBnKCzdFpkpZoKPjhawCyHDR4VYxsSc6J7DHfvzp8RA4UIDjYqnNEZNE5j1+7fwZDZ3bvz//8FeslHcyEmMyonC5FRvpgIuZgcGUtxFH/R9J+AhAq5FDTIPGrfe/fu3FVp4P0hlBPIZ5CmgF6UD5DXAFpg5uml1sWbkxPs4peocj5nn5GCLv7SnP5qZk9Myvuu3jBcrbbqWjWkLTmhUtJ1fJUgJSnDnQI1viGeYrVbDcRHFPirfdzZ3tm65Vf1uhyO5lYR2GO8/jhG5O5I8rLrRlM5dJ9SriYO+Ser8uJkSoO8QUXbVK1a+Fyiqx+LSSEFSNSrnRPs/ujRF56YbdUFCllj5G2KOgl5//d+9NsrD2Pk9iCiS50EHuhse8GwDdu5BvlAM0OCax0f3QnRnKacrskMSEKTZcM+zWButA6LY90pUwmV2Oz9HyCFv/YgB8orzwk5Yd+p++RCV6gm3Wuglw8Nn3WTB2BIcAnmKpZiRz5xlSp4453VWUz5N/DSvBo4l6JDWcP9op/0GGaY3Tb9bhvG4AvrqyowEjZ+XmH5i6Pq7gcydpji30hbVQQEjdUE2vMgirOQ+D8nbownWWlMVDPrWnfxBJ+zRSmD/15djjXMom9EY0YEfQWteXU7TMJvqzlDh9SvtuKqnqyPXHIVyb/RkqsI2LDkWg9+rSVXIf5GllxE0G+15CISvpUl9+SwRLz4vTototCTdlexlkhdGFFyz3XKytHzo27gabvh7IZYV9xKPr7ZsVSgjEjfXpz1DAQ+66kcClNdfNbAU6g6abpCrqYarIb1p9Pzf7+4ue0ZXJkWU8V+3e2WH9e4WsjvFfl4fk0Kus4ETe0dbseM24Dds6p3qdlPRzmsP9/dtS82Ml/ulsVyUMljW5QajAfqTtoaydYdTIc6/NWufO1E1XE9fChSim5jFXhpSO40yqT7ISqhlafwUz3+eHPZQmVY5g+eeWWFR2hA+9eRFViQGKp14kbmPvGlBfn0ebxarcYG1riUGfYBh/RTd5vHodaHBzkq1ObrKclpEbtXOBZa6FJCWmsY3nX5bRPa39DMu2HYxpKF77YYfI1ZBhgE9o9VLfy6KmwdCehImFmotQkLKUhUSutQD6rACIBux4cIOj15TlX3DJg57WR/4zauFmebFarxYtmuqWbXWtuy8+bQYuuoQm0Rjth6MgSk97qyGEuxlFTthMe+0YvpSmgyFyVvlvv8/pZKO31t/+21VlrQsJHpfmulBXO2/qprJZQ0OOvKkjy2rpdn79vW1c6S+Wm3XuAONtmpVGQLd6zRvxUJ62riWgil2CyDqbUvzaX7qvH5dZcGsbrFTWpLR2zo0n7adYV8993VXVh1V6P7Id8qvrHdnoTrhb3z7YwxbKu+evXtF2JXbzVO+OmRDPNncPs45qA/kmWRq10tuxxy3G3Vruy2X7WWn/8h7fZwexZfhIHstAD9SmrWym41he3NnqcjwCXMGJ/c329uHUpbyuSuzD+y16Qf1WDNw/Xp4xlVkI7IkVGBR/Z8KnzW/mshyZGrjbQ/YgE3fq4BbBO2YclkjB+AH5KurMIPiWohQy2n+0GRD1fvfh5cvfjcAWfHk2Rzwg5PFTwLz9UuyO/N0ZIjBdqeBluA7si9Ni7CJ6JIsGrUGFTc9dozmVis1o27EWKzfBtevgfg2UV0Ps9QgzJXDSOs9jy6YaQesJCk2b/Rl+xFjCds3mKRuz57V3uxv0hgTKW6dCauPfQL9uPVX64+/O3qaESO3gmaHo1qUI9utZBgfrSXwJm/zkTJNUjzp9lgm//fZnR2pmWGUG4+nkm6yswTTVhUK3y8TBJQ+OdbysxbePiw1MujnW3Ef00mNQfVJdHowEJeZGJNKPfepMKLMEECHrOpS7iT2y44ObMHy2quhNN4WLp1rKAO7JNn9CRMoN3afIoMQsDSdc9VeA9Pj03rZ/UeOfu+BLFxZK+pK4M2OO7irBlwN8F2NVuVuD+xTT0SDhgifHcctp9tvzUZMTOsI7+TD7YzIYhiM0N+W1I8U+gvB6fBAvW7XKvBsHB6jkdk3XVrlamqAcSdc9MIDlrl3/UY7DQ8zMrkHvZNZToorgNUHFFwo2s3BWox06rG/deqUVclzaqKzlq1b+BNdWy6BuG4NR29mq5Gd7PE9jFcjOJrbt5DERvjdep3INPO8z2s27zFzPn29GVMYVsgA6s2ycpYf2OcMRmyjT97cHICp3zHn5gUcszmPtQ1xCTM6ruAy55zWSX3XX4AQ5UdVrZ2WU3drXFl0H4Q4bZovJPJ+DMp1XTkbuLBLD9TJGfYG2F4M/H1h1nXSF94nE61dQ/wkVJmHKDXr4hLz/jhKtt9yqUn/GxuFDc3Eb8FhV6BbLciMJ+5SUZsivENaT68yfCtNdhOgV45O5LtNh530nOQcvBow7dMoWVhClnHQZ7dllli91KE8URi9OkkBfcXQfgb3S3GmWYdN8Ufjg6HwVmukF7d3VQ9gJwJtd/tvlVYLvSDcaroKIA/8hpngBZJV9NGR5093JIo1kJX0c3g3mYdGQ9AkclkcoQ55qNMliQxu2T73aBltQTbopFps9DoUe6IrT+xVSkMlfr3KqMzYnbOii3491swMAWlD0KNAYSBJsH3JImWWuSi48DnTnNqqfKwSI7N56zZsyT5nwJJBD4bjWtceX+RHRqwJtRW7EVpytPZ+uj4j8+fjciRysTq6PiPL8zf2BlKKfYAR8d/fPls5EN0Rr7cCdt5A0FQYxiUs7HbAW41u/k+Zu5aAScEWnMhdzWdByXLb127yNrNXsLnQrN8340AVozhxdlrX3wXau7q9rzF2TqdMeDLeX3q//sPz0lK1+7ayxo211fQdcw54mJlY2+QKSCsvuN055hnSmSlBvKRs88tmo9/eDmesUHGqQygmHbs/3bUWQYMUWC7QTNOcpZI4enwevb7TJbTxEYf7SvDeiN21w5kQRv7O2xD5n8LZ+0H+MUFHgdtUbPTYdRbW4GkJeoJ4mD6Xq6NpdlRa9yKb/pqY185sNlJ/6VkHdGHfUahB2JTTLlGSloC5mhQESMNgyEKtz2kalpytn/I5+z0lhwnIi+ohDHl6VitaPGs1s8hrOLBjdzXI8iyDeNQqHzOTm9tzpKURUp1o43Itloc/Z1D7X8MMKY0S7yXHk5GkwuaLAlwLde2C3h0PqCzXsbV6BwZcp0rhjAHkzPt4o/HZlm9Vgja3ReNeJchZOIFX4h0FifizTfns54yGPvrn3qqTQ1yBX7wLt+RZEKBUzT2cLotX3Kq1EEkKyajy4hxGEu2WIJ0XeRCup+Q43l8HvYTlmN+QiZ/8kXPn57Zu4aN2HkMrvMtVWQFWdZXtlNxhOxUONDsUTkwRc6QerqqJmy+Pb+N1/mGlbXARTjH3pS4ejImtgvxgdUm1fMyy85EltnzLFc7nb+3vcbDyy6Jsc1TqEZtQWtCNfBagNX4LnhSHp8MjkoDRD3lV7punqnQ5HjyLAhXDcHAmWr/fMA9FyIU6UaYZ1Rab6dvVP4wdovRNs51J27vWbGHrr0F11SkipulInG7QC2IyJkmY9ueH/so+SJB2xLCPxt5p2WGD5qRG5M9rqHD0mMrS9FRhTLTjd4XfaO9wVf3tC1VOUZOP7O8zPsGP4Ool0U3STfu94NFLSsCAkuLrIMjfkbeSpFvh+dvS5BhR5iUUvm7IKyPxVSA2cbWvMx/sJ/Iv91+uKokA4/LhNSH6pplUiuWR1fNqSXsYmDUkJBAwNY5qRGhGXYBtSe08lJpklOdLG19UkBdg2+nMxwxq8lr+yafa1frGHD6N8kfkMgR+YOQKciZ+WvJuB6RP8DnIqPM3ezwB8VpoZZCt3lpReotqv1bvGZlWz3fyVq8s1zFljCMzansIFJtjnfRUpmEbubj2cXAfVbvw02dWWk0O/W01LWsV4j+7na7BXnRIew7sulPbTbVW4NZSTPiYkF7ZVS9I5wbSbCjRQYa2mTZJw5GlENoJbUAORcybzZqeYu3bdQuHIq69jjPd0QU2DaaHy3ID37/pgIBtHbeNPgO7ykvadYeqtUXlzv4jE7DRB57M3f44Xp6c3H97md/oYtZx679Z3QdzorGuTRPrzeszv0Na6tIeh2tGr0feHJzfdZfgE0GPLPPrJcNBmYoVouOm1Vc6PDXE5pljzj5dX2Gb9qjXujW+Pht556gyNaPQ2LNw1ZYWhnzXuY4oPh8B6CdQ1Yx7Kgfu4HTA36qdK8+GYBmhLejrrrncP10ntGHfr1l8DRu/7EvdEmJhHRS7tj60QsJyO+VvfuApSMzhMQ4pUZfl3o5Ljn73IdxsQ9GXH6PQTkoQwG8jaKZPbNHpHbDpDTNd/OeT+WMaUnxktdwQhwcSSSnyZJxwMPGvmFmH2737KZD6XFpaxi4ezfadq/VL1m86V7f/vVd35bb/Lbb8U4PnuzWplQ197C7x9L81tbQHDobYnOy7u1sdYpRy870o78jZCrFap/Ybo0wH+s22G2J6LzMerfZgYYawKj5gliFQ9cZVRrVJPSoXMYVyEZr5M1kX17dXtzc+c6o21HN0q5OXRxWZvOAVEBqaO8gEnsmV/GWbam8vXh3cbaZymjOw1aqBk7MvWs80JrQ0NiQia9KIc76AH07bMHQsxcru2x9z1qFBqvyoCL35Hs1cHzKFvoeoJgsuhYkjibhCurF2z77tBUW4yJ3xq48Nqc3+Txu6Hz1tt3Q+ertLXl4dfLDbmf1LFyy51G9zVw21IWcgo/JWvnqYGnOuJDTvfEgmM3YNB0E9/CKnH14f/3h49V51MtZ067cTKtqekAIDOwKHob2bJ8ZxlvfR76Cp6UGyxjcLvd02M+tU9DwdL3kFYu6xb4WSi8k9Jvt6oHdbLdHtJswPkLbIKJ9tc0hfAavnK8Xh3IaOnVgy1WrZijSddv2f9lK3XVi6U8TWpgKHkDWi5c2A/Uv7XAA2DbjrX/39vTu9F3ju+vTq8uzL+QjzL95H2EvCps+gtMlElIW27Eb87lHjeBvu2kQD57spEEsma2DHVtlGuuVckhy5WNzQrt24J3tv3ZPotWRfckUmsUUOr+6udRLyeY6msw7/GJ8c33WM6PVA7v5KAHTbvPa6oSzYUZtLEUvRWrDVVGbm4GpDBuV87r6mLMMGv1xbAItAlu6XJ/CdJNRZEF11YOpH/QS5IopB+LyPGqC4WfNoOo5KGqmjiU7CHe8l49nzcIxRjOFedxk7/L8nR1xZ0TvMeurXeXTIMbMkQvIMhVKt6uZqkHcYgH6+71205koKfHCq+D0b1CrZ7an16+6LNafd+/a+wC31t7teL2LznbXnEvKU7Wk9zBNRF5koPe9suBv7lYMnOp3t4TDQmhm3dNw7VpllkJeRoFS7pkaPHTmsFud7QIPPJHrAhOqva0synzfUTjRMAOICLPEOwSuwTgpJDwwUSr/YB9JiGdqtVOLuJ2qZBxxfYRZBVPyFGSGmRqnEVGz4D1Fol4Ve8RS224hHu7lOZ431iy5B139bD8T+KyB94zW3koxTUBqe6oapiELfjjZcrd2WKPpc+y6uhRDizjaDYRpBVl93L7wwr0REdw/qiVkWbsx4C4Np9pb4iEx2MAR4lVtva+OmamwhZ6t4+CxvzIer2vQogXNeCMltzxLS2mTlaxLuuNBuUteIJ0mrFj2NZ5qlrZtMbh3rrzNgY3HwBSm75FCgYZ47qsMArFNcLcA2D9QvTk5Wa1WE0Y5nQi5OLEF9Jh1PtGZGlcmvvFx8nmp8+z/q3857mn7FbFF5Fj+XumAg7EorgKM0LhlX2OZo6fjJuetGGOgjw3YMUsbnyxburkQlEX3kDdeg4zldGbdRZCMeD+wtHIr7Ah3vYPYapGp+TBl9rbl7kvqhtZni2AvtEuhNDqH7cvoQoqgwKsd0TZkdA1yGpr4RJZzX4LaQkOitRXRMEYagu4YXm8D9wi7BTi15uILkQ98oZde5Xm7ZTGObA2xFRF76TonkBd67apIOyEam5E+GDOgINxbhUoFgXZG56wA/Q7NAhY8uM40W2jOS+3Lb7CyVemqLNcruXq9Jh5iNW4ypF381hF5rZ5FTuKQq30WB2tpgsE5LN8sTKJKpiFgagwv7N5Q93pZa4GbrR81qJa5OPAAW3Ziq2G2YAUtvP0Yv4o1aJYM23+brMGeyrjLdrfIv3KAII3Rkbre/W0V60fl7mvmXJQ8cbVRtKFiwzGXXtEnVvyj+SCn2YquVVMZb7WJ2KhdayM7q17scRVsAWetIGbSoat3VtYd92rXKPv7j8//1cUEqsbkPdpAMppNO8KzO6x/XO0VM7CSxYBt59Ji1Fzoqe1V34m3UYrYQnpu2O563Ud7j2hOmG1ygBcSDtBA57pn6FuRgK/3UIBn/mrRrhi5vQNseg/rKc0WQjK9zA+rggPYhgWuT5alw2Bpm2S7lSc3t6cjcn57aryci7Pz29PNQ2rU5pGthfeW/RqiijFp3fLr7538qixsibunoodKmmmQHM97Tq2z3kXjxn3ZbYkNlMlpBY5cYWC4a2Z7aJF0tc86xwaV8SLj5PrifTtgWpuksqsb9Ja22A86uj/SKtnmaOswNtlhPAoqu0zpTtbtzIJp9rxtYhNyQTn79SAbrQ8RLHemaCu8NJuWnO1tzz9yZo9HM14DP0AFGkeedN2/vxPqawfHaCEJCzN+R4ibzQEaEpHngk95V3/7Hcm4wrSHxK23O9jky6Er+79xHTKlyh67s3FNXHDN9Npvr1RpHD2eEnfd+dPSeFoav5ul0Rft+CJeud9vPnnlT175k1deG82TV/7klZMnr/zRKJ9cj9+d69FF0JNX/rQ0npbGFk75NFlS1j50MdhZ6GyJhxbmRMtS6WC1nVe+VW3Ml6Fgq+ocmoG0l5ft2ayy60ZlnzpFJAjU3qv8gKcP8EsJCbCHzsrNOeMLkIVkvKPZ0+Bu6W30ZuWvREVaW++M/pP+8Di1+W+nPyBCnzKpKOrRkL1LZknVct+10p2uMj6WoTMiDrE1JUixQa1aP6L9BeizuS7rFON1dllSZtiEYQlI8OS7/xcAAP//idOd/g=="
+	return "eJzsfWtzIzeS4Hf+CoS+jDqOpKR2u2dXcbd3GoltK9xSy5LaY8/NBgVWgSROVUAZQJGiL+6/XyRehXqSFKnu9qzGjrFIVuULiUQiM5HoDdAjWZ2iCcGqh5CiKiGn6G/mU0xkJGimKGen6D96CCF0zpnClEkU8TTlTL+HppQksUR4gWmCJwlBlCGcJIgsCFNIrTIihz1kHzvtaUADxHBKDOIh/Km/bcQJ/97PiX4B8SlSc6IpRJKwmLKZ/iLhM5QSKfGMyCG6DJ7Sr1HpQUmigED4PeJsSme5wMAimtKE9OE9+BErtMBJThCVKJck1jCpgo+MqxCYfgXNuVQWk33+nmtUJTr68Jt+/gEefvBwuOa4na5hXWgO43rBedqwRIKoXDASo8lK08EzAuyzGZIrqUiKOEPLOY3mBeGB7ETOGGWzBmoUTckfnG1AjXvyJalZECEpZ+uJsQ86tYKXzeDPCAPBkBipOZVGlYdl1T34X8CKVDjNDixQ0PVTFGPl5CDI7zkVJD5FSuTuyykXKVal58gTTjOYemf5LJcKvX2v5ujt8cn7Pjp5e/rd96fffzf87ru36xnyJKGlUWRipyFMEEEiLmK0xLLgr8KUwjPZjeVMTKgSWKz0s0ZaEQZToPU9I8IMFGax/qAEZhJHqhgPpG1CBbGxDvYJ+P0U8cn/IZGba+bD2PzySFZLLuJuQr2tyiURxZwCA2WQVSggQnBh3zZoZoLnWTeSEbxk4QEOsI5gk3AcU3gWJ4iyKYeZHWFJQNE0Hm0RESqsogPoqLHGzH/vaFLkqTA/rWQVpFk4wxqCiMd16Alns22gA5A6aIAVPNw0ZhtBhxeHbomKEp7HxRp1Dh9RJviCxgTYVDjGCjcvW1f2VzQVPEVR6VWJcBwXJgjH8Vg/MHYgAUlEpOSidRWDR4f6raEDW53YJFoze6+D5a1M4RDdcCkpKK5ekyTCgiASve2jWUT6iAsU0xlVOOERwWzYShtlUmEWkTFdM3Uu7YPo8sKRBIsISnE0p4xsgGH9yuRxhOv6ZljsA+NAz7yc1dthSmKap93YrwwIPam2Q27dHJpQtRoHS56nIJcDgqUanETdJJwFgBAAQrRY7ajULgW4E36Za6MoE1zbRhpXSbG/DJ66KQlVz74CtPzA+SwhZqa1YxdktnapvdXPrOPPTvSYR49EFDP9wn1uAG5+Q1JhBT5pkpBIkdhMc/MbzFk550KNzQpwiqY4kaA2mEVzLhy+gZ/lwSQPWfZkNa8P4Svha3ZNIGJI491s4mdGf89JARDReNiFLsWzHa1wqBcanPNOLQHgSExymijEWRcpgTF4JiV2LSdC618XrgRPSCJr2Eq+xBp/Yg0tl1oSBo9XWpishcr+aD41ALkEZyBQVC4aTE+hmwB2rWZa3Nvp5e5j8qPdVtRHY0+aDnw1KjkW0ZwqEqlc7IGHEjh0SIazIXr6t/fj9+/6CIu0j7Is6qOUZvJNnRQuh1mCFbj0u1Hy6Q45QJaGiDDFZR/lk5ypvI+WlMV82UJEecfzfBosnEYcU5zSZLUzCgPGMilIPMeqj2IyoZj10VQQMpFxF7c0q5FAs82wf6RSgUG7vBngOBZESiLrCFIc1TBsxaRDM8ciXmJBCmQQAMhxkqzQ1dl5SIOzI4/5hAhGFJGFNfkp/K4BbfG7d4PLPm0BtPBl1y6LxUtrDVDx6NZmKOPxHpaHQAIZjzXoXiOqnMZ7w3TDY/T58qKOCP5fZjjaH1MFxDoy2IHtVYKMx6RFhJsurpshMtBQirM6JswYVzr+tTd0AchmnPt0WAK8HmyLUAu0e3DZGvEauNbCmMhtYV3O3edGqPdzIl28xEGEwBVGeMJzE94kbEEFZylEewMX33ESBIBgqzpN+FKHoCKcwYobh7vqUC6SiIV3uDeMC5t3TFBK/w3xOxKSUNnIRAklTI13xUUZVRSrtejgHRqR7RAlfEYjnLiXn8fdfrBuyifN1iO7vEF2AWySaBt/lRAqV0CMm9jN7O9OTAfb29AjCE7WUnNZQm8xuzRHgBpCAxjW86cVpCCoNJPIw7GTVWpoXNAZZTixIgnYdRwg9IEL9OP9/U0fTblwMQQAXZIOeVICF5tsXAqselgAB80JjonogxcSkynOE4Uefh184GKJRUxi+OvBSgj+/cwSImXACnAYUwkppbiPqEI4WeKVRHMMnOtQWF+HmSnsnVQ09/4H0lQ/+PF/0Cwxzoy8rBRkdfQuNtGmGeHNQwhq9APhlzc64gv6EGQWDMbh1v5RwiNcCp37lwkfZ5yycB30cZ//m4Ayfn/SRwlQ9u//L3ioRe3cRDAcOLSO/FCUTnHQfWmkfPqvBJKzZIXoFK14jmIypZD3KT0wVyqTp0dHy+VySBIsFY2GET+a5TQmR4Qd2e8kgV3aUZbkM8rkUYqlIuIol5TNBpTNiFQDPTDDuUqT/22YuHFu639CrgmjjGYkAQpMmm1/ZNQJuNTfWGF69xkZ8v8TlkFNOvrIZ1JhOW9WtYwL1escNRixBK+IQO8QPO3Gy6Lcq/XSL25Gkn8U5pviEU8g8VmEO0IaILHKuEIyIxGdUhJrk1MovIoyMARYyjwlPhjgVT2PswqZRVC4i8Ig7htQgw5Ltg/MWB9dre5+/thHtySmUkfbbz9fvYH/HoAvcxDmduALOWxO/pWo3NPQ7mOVBIDrSQldg41IaFTo3VB1KHIJmyAJwXIDLZB8qmCD7t5wWJ3Po//LZm3yBSBUuuwnvAjONVQJxKAeGEmSYqZoVIRVnBeuFWWsU7OFK37wAXzjEXx50OyQb+KOA2hElSTJtM21PpAKCzWGZPHzUte//fbbb4Orq8HFxf2PP55eXZ3e3Q1TmiT0H9X5+fb45PvB8cng7bv7k3enx+9Pj78fHv/15B/rB0fR1LofUyqkQhmOHonyNkSzCa7AhBCGJCFVLTgAk/2n4TGFSKQgEPRzSk/irXmegpPXjfaSxTTCsP+mU1sbQGEvLpX7xDQeXfGi4YH51UmTflFP4MEJAsZJIswQZYoISL5hZUmFvAzRPkCVzoQvN8hCKiIAv4ZFYzTBsIhwBprPiJ75OuJlZwCLvW9SxrZIMFuHihGhh+CXj2fXDoxZtChDjKglF492OKrgea6IGK9HckciDt7qtrhKyCTPRURa/cgW1DcCCn0UJcX2RsMJcwdtLmg5HNqCAP69MyCvzs49U1giavVNZ9hLMxn016t2lAsBug9jPezViKDZZjQUA3l5s3jnuNyanBLMtaSNn+elH7w7Hv715Ps+Gvz13fD45ORgMxY7vHSajQ3HD+EOT1uawk9HUglaKvPwm0VfTYcVVXlM9O4KakLMJ0kyLJzsYOeXprhBIGY+bDpitVnxrIErgdxQpxyd38zweYLWD2IJpBnQ/Q4izRbvN2OoNOXef6Ept3j/3FF770ft/b4m3eL9tzTtFu+fP/G2H75dJt43NIgBSd/A5At2h+sGURNr9v4sTydEbD7j1g0TeG/17Evobawh7pPOC6ElVXOnV4rDACnKbG0zeHYpwTIXJA1Dck0OSUgbI2psPaSx4so7vZ3ljGvIhX/vAZaTJJ9ascleKxGTlSIvS4LG0Ku4gSDEXtuwbOwEhkOxT0/wIoD7LbmDIb9b01QCvJa+b8apoNkY2P4mlqbNmGn2CLcduxLIbXTLEfvNjKAnaN04vvi69Gyn8AtOvG/NM/xmJt9ufuEXn37fpnP41afg5q5huAh/+/5hqF+KO3fx1T/c1D8M8dIozdZHV8+vbiBR4eKO+rOJsAbj7UAWEde1gM1ZRpyg+/ObMFJLY5/9UAKzevbjvsiw7JwECbI1DbmQEmsxFSQ8ntaYFFgbTF/OiZoTUUcOu7EJz1mMDklKlZ10kFki4o0HxAUYvvpzRe3AmyH6BUoefL6JMkgy8VwN0TV3FRZ+gmT2yNBY10mUnHlaGNQBYC2PNOz6ctnNNti8OZ3NUUIWJLGvOHMZcG+s4xKvkIKq9zTLFeTJgjOcmjoUk4ywWMLhU5v000njPprY4RREQvkIpHsw2AMWrpiUmffBVPFpCcKwa0w7RPTpp+DDKDgYCHK602NX+/pc5zjt1yWRpkTN+ZpZc2+zh5jFRwsiJkfmpUahFpU6IEtQsdBLsi/CaKLDH0b3fXTz6Q7+//O9KZeRHHH2pq/94bufP4ZAIFE5QYd3o4+j8/u+B/n55uLsftRHF6OPo/tRCKViJgQp5Sc6eHXlZe4Nk+HVpAS8IkGmcERP8QauPTwQ0OfbjyjDao7yDJQNvtI5LZlgOUeHR28MAOsl9KEmxb1GJXo4gkOi8ujkoWDa6p3mJ3jmwQACewPWUvZrD6pVBpVzyao0LArqmLSYKj4DFD9MaZLY+gg4OR9KwJ6fL4kZGO3S7A65w6tVjeqUshOTm0qmUAz0piSC4tmQUXj0kawGZppLxYV72kOzbz2Sao7w95yIVa+9EraDSf0qLGoYzfMUMyQIjjVZJoEdskkVWtIkCUZtUgya5DCbwIlL6CNBDz+M7pFVlbGpBfqfQOz/UOAWGqi2WoSWjqpX4ZgJBsuvPqqmIaLlnAiCAnhleYB7mMpey2HgDmmA8YNqJA2AKCJkeZihpACKIGDwwFTAsgKMBs97ePDe/VzQqRrc3pxX3y7eMNWBqsBeGVzGi9MCLaRf2XYOBtSNdrT0AX27nof1Z+5UhfUGJCLh4WcJE8vD1WnqTBDlHHKBlzqDbCvawuo9u9TOSZJN8wTAICV4PkmInHMOEIqSDoGXhTNzqz+UOGt0Wxz+cDZqWloqN6w0t9QCGDXQFb8uVqashQqhYx0psevwkgbnqw5xliXU7oxMYRIk9q1dnVAGLQE8fA+e54XkBckEkYSp0vaqWUEEkRlnkuydUwP2a7NacoTDDU7gD18FX6PDwDuWb7bxjEPoUOik932KVxeBtlohJzEopOmWPaxqS1i+ooRHj7q2Bap2FeePzv9LiCJNiAsAmSARld5zRrqqSOqIoDdDwc6pRGqU5eM2MgH2+c3nralqw6V3XWPKmnCVRVLZqVV1AV1zFXo/kv5R6lcDz9b10Vo2lBA2U3M4Lqfmbu9jvnN4Lm9QYPxgT2bqspukWS6AsomHOtewZ3g+20ad/lx8x0wGilV7s0MMXt/wIwEPy/omytU52jp/WFkwmtEFYYWVKOBQWXYsfTXt7ecrdAjnCgbgQwxSzqjikGd+o/dOka9JQggnkqM5XvguEoDToB8oPrCEwB4kZ07ouonMxfWdB2LbG/l3oUoypjLiCyJW62ZyJLifyU3Rhb2I2AWvKtEHxaFGk0jwTqmcG/F5MPCCEf4WhqmVnYTjeK+8gCmHvaVhAsBDR6qaWnhIm6oH1RqCUvwI8UcmdWk8hziGBwWVzTrSuyRJ8myJxDx9plAuWQcT4MVAThkCYo2S82AuPl1VpHfJEJQresP09+/QNV7QmVH8e5qCe3h2c+n9Bw8LcMZ0OiWCQI+UCVFLcJoeYp5CnwLC1EeNY8TiB9hw+xdrT9xBGe5D4Q7g9PcscADOrn6+qa308KUrlo9syabrZ9S8gluozSHa8IXwJUGyZDXYsUmQplVDQgAJRgAz45v3kaQpTbCAL+EQSDNGH9V/d/yuHoE2rwReYdtecQ2d9+AxkqcsCcL0msphHWeUYCkHNK5h3FwsHzBNQLtspEZDbMBkft4rqsuLBjzkKZpjts9mIQ5iB7JBcE7jmRhHFpRtJ9agNFPMfHwzJCLDUtJFHf2E84Rgthn6yyk0POujmMPJChQJglXB+tHvOcmbBBBXjsrthNu6Cgg7sOvxk6coyffHvaeAFZBRG26cKz6ICewC9oM9AGiQGo8lZ7ACNhDA+GCJqdoP8uCYpo4ggRYY99bvr8y0ayAk4gzOM4mBwhvO5MuYMAUHpEToFmggffDoaKydYcpKqTtQRkaSBgpiklBw2ioUbGtg7r0QBjCpZtDNEcLDFvHAr1QOH7QNbCDHOvaDiOdM7UhPsfWwULXDBsOldaRvj6jrQZsQ9AcRvOQNwr+MLJPVICZRggWJjXLJBrr9QO6XcAdWuye4dUIJnkMQavBIVrvZUhtscwCDcGyIjvEBjh73PntirttO6CUYCgZx9Mj4MiHxzEYtpkEsr5ksyNkneyfMT2tJWFwok53c4e5ijsOxRyjL3TZDzUnaQDOdDoyV2o3oC2P73DHbVsNHpwOSZmq1V2waYgMyra276aON4ud2l0yd8ZPFNA7N3QKqFxsoEcSanV3lXJyashEL4tShOI+VCbKgPJfJCnmsZiEINg822YuZ3mbZ3G4D5WmeKJrt6iecFTPJQ/R63IAVi1nuwpDPb7ryyVUABF1QPWTtfJkIJGRFrGcqh+jcxNr5tARrgQXItJQGK8kJsxgrLlY1ip85vh6gs4UNSGlqj7nthvTW+k4enNObJttrYwB78JuvLq9GDly77wy7qiO9I2qnhbCIx+XitV3pcSAbJGDjd+tV8/m9/dwyaFDZ5BKEjJoWXzdYg7Rpl7wV3mvOBhmcEZZ6UA5P9Pny8Ju3bxooyATlgqrVDm6H49iB6qNjmJr/3oAt4kKnDyhnTZvSrRg+CyK7AdzC0Ith63af74jaVi8qbgAixRtwkaeMiubqw2dpVAHPB2/Cw/ghamuf9ypjC7Nbvr55+G54HcseXBOq3a2YwwKdHID+Biw6Jr6rGM9hYw8+MUDT/ShreHCW7Q9NmPSgcREcjLCUmMUCBxHCc/ddLUzof0GLd0ffbRcwDDE1Rw1LqC6DhHlRgVcQUIQI4iL9szb8GOa5m4lAqJVn+3q4sFUxda8s7RjXY3XgQuxtFIRU2CYRtd9bjHojMQ2XGrhE9bAV8TQpuv4jtF6LGzF/ACA6Y7cCLbZ+L5qKUovYKmqpBMFh69Rn4UZnBo8tEDRAYarq7Sa2XjakOGKJinFyRhE2ElpU/kX0q63gQbMcC8wUIXHh+RePVZKasHLq/UEB2YQYfm2XAM925f6MuYszbLmYoTSmUFE9y2EbCtsWgnCkcpw4tttJMgnknfTwTHeDmBFRVEK4wHo5TTvh8cr9bcbwENs/oC0FTaktV3j7/furv0Ecx7wfNIZtKxrbRJglomHynP/80aZoTZAoUB0YXW8aG1YBpwW9dSak1XyUbeMXs1pWeS08GwFRIo+gPyNMAiiW8RfI6LnzF2nRvxq5VyP3auRezsj1ek3Em3r45838C6IwTWTgqvlrmAzYbad0xZd/1vCWzFGe1OMSFf75sn0uN0lgEykErds2YT+kh+XpuIWmtSpVI+22qkxFWgBwIPsrrIXa+jSPWplAaJPUgrtbaDXqznmacagv4VM3Vq7reDMJ3RIMiXwkq2rf7G2VqpHkTxAdd1LDUwU3hhCFfkj4BCdjHd6RY9gh9V0puibD7iodyDaqVSWd+zVIDmru19LbthDuRO+NufaoXD1ta2vNF9o0WhsoSGorLYLH10s64sm4mmbbeqptM90inuQpg0phe1/HZOXyD5DIBC87EzzOIxKvn4ohJ9kjWY0t9Jdl5uYnzwWck3qCgiSkhSg3IBPPKJuN4XzX3jUcnLgQvvYzba2oLlC07SXnPE9i2EO5g4o/fx7d/nY0+nV0/vl+BIsmhI4pyx04G2dQgpIFCdQNrgnz+gfDZPPoVBqHf9hrE0OHXVrHeollm2TwehZUzHibo5kOWvypdrJkNCcpHteKdzYz7LXBsEKBGq0y6HZfarPFsZXATQRYI7Wu4q6Xq8EDTfQWPFkUvWKbqeoY1GfRpasxzTcTfR4Udo9+WGFELX3D3nNXk/3QpDFsTlAtu7JPisJpIDGNEZ5OjaU1aNEhocWxWiAcblCBz6uM9NE0Z7pSV3flxbOZIDPIogHESnygypXCYkZU4yPP4UpDA7NqTNXBh8/X5/eXn64PgLCDsx9+uB39cHY/OugXWVifEO0mtHIVxW5kzokX2VFZXN1EYDGT+yLiEyOuMQLYX4KjuZeFhoYOsdRhGPjQMIyOqExAX7RSYn8Plu/mdnRzdjva1eY54orT8jsLrmb3HA7rjkBtp3uxiSRBfh/vbxvQMJGLiMPrduB1O/C6HXjdDvxrbQdCUUAw9GWtqbOilixPZeOW4NWwvhrWV8P6alj/HIa11yQDmWfQpazmz7fU+G1Q51cTRVDlabbC+hqMPLMtq8y96p4Op4SmSN0et7TbAjg2TnReFJfyYpihTzew8bsrNhCN3OIcLrlRts6nt+ni0cZOkbXTxLquMLKCx3TuMbyXf0EpgfAElSmwkZeT0O1ri2NHH2Gr/IZQ18BUeAlZgU2q7iKApSwFyS7PCpq5AB3NJWnJkC2xAMMne5uTVCIIwpNQAutwO3h9fZ0F4lGUC3PY6O/mF51g1hcb6hW6kajy1RlbDbZuiIayXM7rmnnmcr+63ETTBzeJ0IW9rMMfh9UjIiHpC+Gf29EPl3f3o1swqnyz8d5v0q9mRMmiqSR5w2jiVqhheIu5LOyxLTDm8Cec6lgQXRraEGFEU54kfFmMg+0F6VSFkeWRICmHa5HgMtV2XoKey8/mpCZEQIlo1o610kJyo0VwA5QA9osFq61exzaLG7Q1MYjsUNXpadfsjZRsk+GpEfwasn4NWb+GrP8LhaybXRJSahi5zuy1uEeuf4K7JAwsii/2Aie1XG1UrdHCDNn3dUOGcCXD9gf7iobF+rY3J6Cx20zyFBFNVh+lXBTNSVK8sivjsLeZxXWCqfR82H5Bunf9GkybCct7vcRx2GulIZWz3vaq0kKFk/pzCNmHY1VQ4haarcmwK+vuK7Vbovk0bKvhHl+vJCFR0MZxDEej9amoqFrou6msNligAyS2yS2fVkMSStDZjAgSl6fFsLeGB9OKtoWuTqXfgPAiqAJOmaxu7jEcWQO31rq5dUbXkK8BvDjtcDCLRtiSvySCIDjI6to4aSLM7itMPM1x7E7iugsUDyWFHj2YoZz5G4qLsSoO7/rBDM/ZNQnA7qy+1PjN8QJ+Co7ExyHPa4idQCe+amuDlyDWD9hyziUJyU3tDZNO72EIcTTXxTnbKt9SUEXGLRbyeTP/wjp2Jbcc/ta47ESnKbh3eXVbXyUPwvVjK6IW5G2nhNsJvISEKmYuxarFbKcFhJ/ko231Bcj1DLB72VIbgCZq972b0Ouf3z1YKU4x1Y2NrQs37H25ncQe6JEqVXvM4NcnUM5gWrNyX6YmSqDAGGSZCyJfjpyq8dFqBk05BNWtzjCyNMC+TDcbIlHu397MJDnR742L6pXR2w0xFjNtUPYn1S13C+1ku4Of8TzKFu+CU58XP57fLN7Vjnyar0snPFsOeHqIzf5c1RVzrwW9Ecuzok1IHcddw8seLi/6cGAFs5inTgcjWEeYi7CZ+Kau/XJRtxI0G/+E6LaNgMMqIyWP4L5wtwuSNmUB9hG6GrrOfbgNFnYh1qYGCQfHT8fHx8ffxSfxQa8mK3vjefnKtOfIyXP/F1m6WvfQNERVS/6mhbzj0+PJ6b+9PT0+OZ1Gp+/etlMJccjKrv2ZpDpIcAxJlUcPURaaCXRosi7opLG1gX0KKqjCbYYha9ObxMA0FHf1OMwkrqqVb1ZuO3KQGF3elCCdWRCWZi5CxgIUeuq4KW3xNXDnWmXtkTnffct1J0poMAROj2uUmB/2SIflHYyRnT2BfO3VGUEvM17dMznC9IX8up3zWJKot8Zp3Zw+Ddd2C4U71HW7UjTT59/iteKyQ9pbtx3uoOcDqIiXkx0mC9dZqnBGV61xSI59vMklbpvAa6hzEtPjZ8HbVcvq/vff2btDrKlBF5d3559+Gd32exU46NOHD6PbProd/fx5BFeQXIzOP15ej/ro7PynPro++wl++zg6uxtB0PHy+sOn26thr5HTOc9kb8PdywbsFZ4OpA5WCM90PqEUMoPpo+aC57N5C1FWe/ZE17WnyWklSXAGu1iziQ1nNZlhFuaeasBw9HtOJXXWShBGljhxO+MWfppKVnbQo5EJhB9MBMdxhKU6QLSk9Wb3oqMRelMNh8UTaPJlQo01gB6QY1tH0Q90v2qpDlq4mmMRL7Fo3DjuokMOriPGRvh1/hWKiCyv8K2752UEAmGt+WG7HNOskUqabU+j6wNZN9C1zmPr16fdSAlI8OUKwSoBIjJo/cnqgkgHx/0vuPoDXoPO5mYjhSU6WPFcHAToWnhsWvn2xqEVMoMKgsIjzU2H7QnnSiqBsxbKtEnaJ2EaYEhe4EdbGbsCHxyFhSQ1aId0SIYIm4EzYO1VZt1SbsgJ7bhAhVs+K2FpExWBFR/6ZnQt9MFYjKc0IXsmMWyHDDgQ4GiQ+1oCzapbgd/sdGxAlh43V+5UnCIu5FV5o8n5CKmT+QRuOUyxDNuCrtHYDeh0o2zgI4BvY3GFxtqoNkwqXup62wiNEbXk4nHYa+UF+v0RsXc2PlKp76Urpp/tT2/w+Vvv/C7P8NxBaMzk2Ki8fDFqLzjU8hk1vltJRVI7yyQq4ugVK91KcMP+cpMptiHJVWuwlpxY8zZ+WYoMEo2xS3N1EgNuj0sgzt8IzUlPogXF6OL6roM1u4/QK/fYqtvedaSy1nmcFcvWTqVbev02cO8kBuRZraAspgsaQ2+Q0OswxZBzzOLEFhc3wvPmsZWnQt7j1q3rWq9ziwEopM6F99fWbnQ7VUdvEV6abmiZIhY4CXffOj7g6DZOoI6W5kzRpHEnEATqdFRSb3R8Z2a92wFPQFc8d/I8Ab1gs38Nri0za9lO8dMYYsBjq9ZjuHTqhThP8RNN89R1xCnHFSp2kUrdTKjNEEJMN4IsexdrBnTD+7Ur5LZlhjzp9mnlEg/fO8faPhfyKu5qCq7bDf/BEP/oYESXVG9iIndaqX4hLIZiIEAWNAdt2SDYB1ZwpXIjuIUBB4TZAxBsSme5advUySyM/4tzWwTTQ15dIPr9yRuo6J+TJ5u/6SDY33Q5tnZ4nFCpXoZq55JZMmEJbV907XZChxsaoZl98mQV3qgJ5x4EsccesItyj/87VFP9RyAFx71LofTW7Ug2DoPa1dgBbtqOvMZBX+Ogr3HQ1zjoaxz0NQ76Ggd9jYO+xkFf46CvcdDXOOhrHPQ1DvoaB32Ng77GQV/joK9x0Nc46MvFQV09PpNhMf71Xb0S//pusNWNS7FvT1Tf0VS3Hu6dhgr8ioXqkCm4LhfXdyjTQeBQC/wSauWZCT4TODU2akYYERikUzXLugelPjMcAqMSRTyjRVWyC/AOezV+eDauHATegP7i4ht41552NJK3Z/UfKdO96jWBnbs8HUwxracpbAp98TQXdEaZ7jnjbuMVKzuvNXOUmaWnBK5gtaE6XzexrstAF2AOoTUP3OuH1c7XdJ9pKQFYKxZzb4lbb4K+J9YPhOgTQ46CVe1eZFVx6V23KHs2VlYMXJkxQaJcX9sy9nunl2BvOSc64GrRLdypXdvaCZZXj9/SXoJqDzC0lIBXWYmJrJzn3f84WWMdU0EiG56346U4ynIhc1I5xWLU3UsgWQ3Rbbs4eD32UmbXd4sawznDF+XV02yHAJKIksKFaoHKlnJPqGhm1cpANCfRIzi4MZWwZ/9C46VxhQNWggKWFutLlvQF7rabX3DQvJUdJXIG/bLicYM89suPjuMDRVMqpELfn7y13ePshhJyFSt9RrsE0TmEDSw4kjvtvbPwsHXJYRmJmy3p9afR7e2n2zoWb40q8Y0OKVTjFRMCmgkjQUk8hGuWfPcXvSqDaipMtZfBBpmgrH6CNZpjgSMd4DqckIQv0Xdv9VZ1whcEnbx9/0a3lgQrBCeng8etN2KOaJYUFoF/RmSEM1inYXt7cuzuIpLo8J8XFxdvhuhvOHpEMsH6aiRYrX7POXRYA7j25VCiCN3jieyjCAtBC5demqZxCWUETQmJzfsRZwsibMulf6o++qfQz5Xg/ZOVumk1Dt9yuRzOOJ8lZBjxdNgxjJWsVU1Z3OFtQSIuYlkZvCbcZ2dnZx0Iq03tahj1A4ByK6yX1x04iUricZbkcsxZJ7dEN8oHK6l4NtCH553qHpL7jxdvEEBBnBHTpSXBE1K5He1DkbvWKojgvf92Aks+OphyPpxgMZzxBLPZkIvZ8ABWioPwizI8PXtcx9oYNgWpueTFwbZdE03dE0MknZA4JrA7ylzDmhJAWGpMdGCuVHZ6dKRv1Y9kPp3SJ01Bk3xxiv+A0ePD/HFYFzVmcrnRLdIdduKMISwEXrn5D0xiFFN9nBWDb6hvlTK97TU+CHbAj+E+uQSyWCHaaa41ZX2O1x9m/CXPRUS87lpuCofuIWZyaJE/mBzIsNdKXqehrYWCuQ05un6uISkoIwLkKhsH2P7RYi8cMZuaC61kFc7rFDUScvVrO/rNjQcscjsQcXndToRSSRsJdcWwEdJ6fM56NXV6dMunCUERjuaV9WlCpmB1qI/xTwh4QxEWMayk/yCCm+6zUne3KDwnLQnzTgkgVA14VMPmOdAqh4rPukYQ8LQV1sSZL8e52eECyb7NMnSHM29Ao6xwzJFLaRVxIDfoIUw/unX67TaMkhe2V0WjAr/xcwbLnd0tATQK1k3xV7JWBQHeYlWBtjyo1Rm6d+GVUzfKoiSHJaraBa1EaCm6B6NbhJS6RfSNWMyAoC9gNa/vukn4upYzjnWtGE6+2IzzGJ875QqSv9KUKwhYM+VqD36pKVcg/kamXEDQ15pyAQnfypR7dVgCWfxZnRaeqWH9lu8S+UDOCFTJPteoKwfHDQ1rAHjMt411XU4hnG+v8AgaD+XQBeji+u5udN7CCHlSY9EVpho9KcLAXLmglo5U1c1gwdbfzi5+Gd3etTCXx1k1C73eiNu2NVz8RaLPFzcow6uEY+gb8QdBhxTK/RWRb4a+pRTsp4Mc1o/39ze1JBZ8uV0Wy0JtTmOVYF8W15EENzADRpfQK7VqGq5Jhdl3/PcNnDQ8U6exCUeIR5/6KE+WzonpJicEoVZFrlTCkgcCsBZl2PwQxNmqeQo31IPPt5c1VCAydxGMM1YABFJZ9nUtYt0g2HfPtP17B64+G55WHD08DZbL5QBgDXKREAZ6HT8MGwUzJziuV+Ht8eqOulzPUIoztww5ixfhDMLpsSXIDqZ3qJwSlJmAf/6uYxGWDVj3LSQQiPc1JgnRQWD3WHGlftmnsOVq+ik9tXXI1Pbaq6QgtVFa+f7MEq70w6oeH4J/Ip6mWDaPAIxpb8NCj5Jkqx2jw8nSYBTdlOy1gLOvt+QktplsFavbSLjG1pIhKFndd8fveo1YsrnAcis85o1WTNdQ48xzFg+bEVrl+RNMlXr6eg9zpQZNwvzYba7UYE5WX3SuOEG51ZVGabi6Xp5f1VdXM0rwE9pqjbWwm6dUdTq5lzZwx+wjjjNNWCa44hEvpQ0yLiWdJGRs1pfq1H1X+fy+VyPG2RY7qMELbSpeIvYMzfMUM90LHLQMdtKpI7vLbplfKrut9b5VUGOqtaQDdsVibQcbXm6EbbXrZcTlVbcV7zMFZp3hVolZ6M8UWeBqF9MuJane2AVT78p+VZt+7oe42cNtmXwBhu0moJtJ1aN3Gw1h2aCDzjg6PFxEIeoAVbM6BmkcSj1DUYQZbHkPJhTiTgclWBD2Nd8PJliSuI8OoC70AOaUNobua8gI2l7F5kfdUF1/LgGsE7ZmykBaeXd5CLw0Bt8nqrlw9LkfJPp0/fG3DlLsc7tT44VgIdqcsMXjIibBcyDpRmNbytGiA0mUuVB8RlRD7tWMZCF6nsHsMlsAXSZt7kjSxWrNuEsgLfWyUWR++u5BZvZ4rHLUaJ0r2PCz3VrA+g0ZthtrePbFlewFgodzqFURUXMEsEMr9jxhrcBMTIVOaeTnK5WVCfv5+qfrT3+/Puijg48cxwflY+UHd4oLAj9ekIQo/dc5RH+JgD9hgw3/vUvw5FyJBP7+ePv5XOBlQkQdFlYSHrnLIzgWDX9+wBTeAnWDa/cOGmVk1eBVSF5IVaaaNFpv9kiaJRyqSJ03KUkfrrQQRF97EcoTOb1tgpNS3Y8k8H4Ki6dLtw4lKQN7cIIe+gE0W6iHYEHwWN50Dbw+AzAu353zzNF3JYiVcwVVW+lQo8MmyQLDzQRryQyNSdyd2IqMPLUGvr2eqoEKK7avTUYoDOPIb+WDbU2IRrFeIF+XFCcU/PveaTBA3S5Xk6DXHT3f9PWTSWmpKgHUUSYnKcdO56r8p+ZBvzxcTHLI5+3Ig4Vib2QOIwqWO0tJlzCNadx9rip7bMpXdPrUZbgTs9avYh4QOqwNR6ulK9FdLbF9jhSD+Jodd1/ERlmZ+i3INOP8SFZ12epalc3pS+y5IIBVOssvYfUHh1knQ9yq2CW0vZPjJTW1a3RICjqkUxfq6hKSzurbgMuOY1kk9xc4yf8/e9fW3LitpN/1K1DaqZqZKluTuZxTu6nNgyJ7dpzy2F7Ts9l9oikRspBQpEKQlrW//lQDDRAgAV4kzSVVTl7GFNn9oQF0N4BGNwRjwlalw8p609ETFQatGhFnFOKfIc8xlMxIdyIcCvbqzNwkayZqFbYJ/3s0E8ftt2knqjZ3A/ccZeAS/vMDweMZ1VwYUdXxhOrNzuGGHfE9ECoF0m9GiPPMrjECO11R8TOpv9yBG16Huqiprv2NkOUyXqyklzTPW682/MgIpQhjmhRRF8AOIAIDXBpLF7nYfXoTU/wXEfQ73S2WsoJFyVfEgRzQcunj1eGm6pHm84yzYncgWAkkW9qqaKzJj5XGacGSR9uwVuH2ALekkgFspKlaVVXV+DF4AJxMJpOxOJYfJ3lJFrCVIJ+1WlYpPBmGE9YDjfaRH8afqKvaYLte8iSawxa3uLv6socAY0jZeAw0QEhsNGXpgZCissigaPxhfSpRKVpkDU4bmj0pJfWThkToExgFcOWhoKC+812n2th74UWUxvPd+NUvP70+IWOeZNvxq1/ewr9FpWYOdw3Hr3559/pEbdHB+MIbtssabK3GwIri3m2LtOop+vbpOz35lCQEUcuFHGo6jwoLvRInrGH2kj5tIFruQGDg78BoYfmunk+lZs8bkrVxOrws3fX/+f4nEkc7jleSTG5Y5x8r2I7TbCs3KGkC+eTsFSfeY57zLCkLSr6k7KmB+dX7d6dz1io4nlC6CUt+oOQEGYhBE6t8lpI1W+SZwqH07MskL0OhV6GYBXzSrjdwzB1j10RZ0Nr6TpQFV7/pu/Yt8kozcR10aICeDSiQEUhFLvQEQZpkG3EzlEmxtD6FtjT3N1W0sYoc6HbS/yoZLY7aCmPXQZtbNcEZx8LGRU4jzD0REYGhWoJ5sS4iHpYpO3zLZzYNyKtFtt5EOT2N0viUb6PNayufg57FbQPyGwKSYhN7aUL5zKaBPLMk5SaObK+a9Nbiwt851voHiDFesIXy0tXsmpBzuABA0yLfgXKz7gcoVWqRxRidMcBFV0zQbD2caQZ/7Okqaq2gtbsKGtFpYtRJfJY+ZPHcPIiHJ2dzTxiM/PVXT7QpMOd445qr845FknGKiqZY6V+VKkWKZMvy6jAajtkoWbEHSA4hr8zq435CXhm5nE/IvQjHvBdCvldBz/evSbQBVVRkmoOACnmryJYmiS9sp5LIsMABsR3Yr4sullbLUTuICuc55WUCeoWlYBUFUWvjAmPDmgdPfZI8TRqol2WSzLIkkfdZrlpuXDRHGXxLFvpjPMTo8xbMIQxohdQI6JTjQgt8F1AMgl7lqNRI2Ed+JcdrO1lBXk1eazttMfCe15/o9zXvZZbpIF2D8zwSveFvlbqM3RC03M67y4I/2eYAXRtQTCpS7ZvF2QJXgUVGsjUryCkEQObCgdFBgjIlhHrX8E7LRLwILQeTfWqxw1yPMJZUNKL8pJb7wtfaW6GIDmivEXdFY52ZzdP4eTXufZBu8fcDIbkAaJFukl2TveqRj3m27sfndzhhVlQh0YoYo+KqDCg2xjXNJjfRLf3YTMlvwfWVboe8LqOPPrirl5UUxGN5to1qSWQxgDNh2M6nMs4J0lIkCchqK0K31yUvyDoqFisx7SLN2qJfZNYVMy1cMfg4rTmTNxjrqHmqL8kLAfKEvMjymObz3Ql5sWJQSfgFfdokEUtFMgzygqfRhq+yoilLOaRkLdiAwoTP8gNEm7A1K7hpCXXbUGWr9/nEY9htLEr23CN8cVtYS59xKwInQrMioFX3qRSWk5Hr1gLmL8ElyNumyPhAMf3aFJOdGkx0ohgukrRSRtU3GbqRsMqMRZxNE5Z842igkKEcqRuawx6xfYFMWhlmXKHJUiQisvag53tCOJVFY7+IB+Rard+4BhBZ90217/A5SssoaTZV6ouLAT4jahjDY9cDEgfo9U14e35z+X8Y3iPm8ZwaewJCRrD2019qvMqwovurYOabhdfRsvBep4vbmxlxu50dntkT84oBaCqXyaiZb6xbHP46JMnc4+bXzUx8Ka96CbdG7d86eIh19H5MxKf9uDROzL3CQaLifQehwVtWJm2s8AETAuh4yIe8yPegBgbUEVftYAI328NlEj369Rag1gnMcEaKDxzkFnCoUw5M/agGCYX7gSWnOWHxCTRhAQ4x6OuyWJ2WKXvycXw4hKOYfvuw5L3Iy100iDpQjPgwTryI1ptBzZvmc1bkwPLiDC0g6CspY7KOFisIQoZL7Sphpo83vtt1KV0Z1orLS674GMvuHf8rMRfdu+C/L31LbvjNs+D2rWGRvFux+vQk4/U17PC9NLW0BcxotaUx4O7lbHWLEfYQHbKPRPYsGod5tuWDut695hbA1F43cJdxtMsy8S6zNQaLYLUEAGSqyxMoeQ5qlXpULks5zYuQxYNgX1wF57d3KNCeqFmMoCyCKd3C4kGggLvQ2dYBMi3XobHf0hdlcH55PutGafS5XkpZ5LIljlHtSHgw1sbEN0UIvNvwDViCgd7Is62ctghOeFGR4UEZ7slLnUzZwVfM4WMEk1XxbbqZsJskZpCXL1rhoVzARdYW3MUN9Wa6NBM6X31sJnS++hiQxw9v3pNBClPSHaYufbu1bVIGdPpMQe3JSk3iEOmapVkeHsxHkOnmVkSt5B4/kNn155vrL1dn1fkSKSLX2UwjarplEADUih58L04KRf65+nPDV1BYLFpgcPlgP9dGUPN01cjbPNgW+ybjxUNO/Wa7emGY7VaMhg3GPbTN5uEI2uYYPoNSzjcPx3IanDrQAgCqp+ohQ9dN3PPMw6JD3Tm54Dde2Jw+0twOXuomqj4acAFYJuNVf8P/p+Tj9G56WXvvZnp1MftKPsLyh/cRlsf0EVCX5DRmph27hb89akT8NkyDKPLDNIiE2bjY0eukEfd/0AcWkHU8CRxkuVbgzvRfdWXS3Vs2s695hCY5qYM0ZReKVc6WhdGZd+LB6e3NzNOj1QvDfBTNaVi/NjLhtMgU9BVsQaxpscpisXA309y0dKXqjoszW30sWUJr+XGgL4zwTrHPgSVC4LgJFJlWXaY6I+Qabp9uGUcSF2dGEgzVa3CF1nNRFLqOLQYMbnMtb/aapAMHyTFdVpnVANGlqJrojq/cZ341o3xqYKCPcEOWcR26XfWURbHHBHyCqu1QjqUfRux2QGFNvIqOd+oZvIyR1YFXzbrE1J93l4Fvrl0OLO9SJMPLu0ApNr6K/qQhBMAk1N4p2MMf+h2rYoCQ7i4DktKHrGDSPQUNN6c0NcySPpfhsIsq37HoVZW3ZBZ4mi7y3QZ6au1NZVGuD20FDg1ogAFMgkcGWFAMIn0eWVZy9aIPkpBWKLXTYVEyCM4HDAYtJWUa0zwRJzWoEYVmIddwyGlHxY5ZLNMtmM29OIOVzLhgcEGu+ln+TSjko/O0FqtLLWgOZzEQI6GqNh1zbGHVjlWkPXwa1+pnGbvdELjJabJ0Bl7gFwZgf6tWNEmaiQHNmeaabSah5pK4bRh0SESrWlwgK4V/aSyh5ztz81g0g2yZKNdQmE3BZWi2XosK3wUlcQkBV1IH4riYjJyNwiIvNA4XbLPyJZ6qh7b1aJyqxoVk3eXrVLHnZZbXwCoy6r+AUpE/kP/85g3UhGBRGk2y/OFNVRCQvykSflqZ+Nqfk6dVsU7+zX54+qFTLNkaohJ4pQOOJiIzCtBgg44QtyqhSjx8T8EA9VMge8ri2l9SLG4paGXhbnJ98jSaDA0U886gBMP7kcWNgmw1Oq6J6KmXHUI1R5koo/F6+/xsAFaDVpeSHXkBQKAfcg2TaEfzUM3b0LCchwJqr3RnYDgVGLTuaJ9vE3+zcAKG0lx8JfiyxCWqPOSIBuoEIrrVEBF7crCaWm8g47yIInVSBJsRP4IZ4FTNFWH5JFHn7pwwQ39HsyACHjAzTQ/NeVGowEcR2coLTRwFVU1FNM1wWRfcZBq75F0Y8CoDzlJzxAmpTkZOeclYGm1wjis3aW0IL1lBMSCo0Ty9ehO6V421Brn5bq9GNczFkRvYsBO9mtmgpZo9oI3fxBrUQ4b7WYMDlbHLdjfgXyEhGpvsiK13R16I30KxfgGNALowTbMyXWBsVFRTsfqai5K9k5bdH2SabKMdrytjY6TU3G3DJx91jRSrZbPqw8bgwEEKOs8OiJmMmmNl5OoFVFwjl+hrR1sNZP/7j5/+A/cElAL0zBROcxYloWN7tr2bLXYwXQwpvgQVD2Rx19fDOs2KUBbXqdGWfGuhiA2mZxAIiLnujbWH0SdMJnMQBQlbMETLgub7QxCfexCIO3+Ue5jLGmDhn3QXRskDFA1ZrZ049lbBmmzNAtudJXHAfK4rM4JLeXIbTE/IWTAFL+d8dhZMu5tUi83rP3gDiMxDb8KE5mSo605+UxFavfySVyg8KKOkoDnc4nikYh3AR311uy2ZUmRVJtOKHIErKdzZsx4sebTdV0I6QWXFBIzyzfnnagPSxZKXrmzQPW2xarQOuVdKtt7aYXZYXAW1joT7iKOBTuTgy3cqf6t6p84tyx+ilP3/URZa1watemK0Nr5RAncrD7bnX1Imr0ez1CLfgkIYx9Q6WdiL9Q3SAS2U0wdoPwLB3mzBsMjW6ywNa+GJe8GAKQcQYOmNF5tUOHRl/82ROXIBYpyXNN9vTpynBSt2annFS3D0UiiSIcqdP0+N56nxt5kaozoa3C4z3hp1zY/eXrlabz575c9e+bNX/uyVP3vlz175s1f+7JU/e+XPXvmzV2545XUwTac8XKwilo66rKWFYwafwHZikcPdaGW10SvvFRvzdRDgbn07giiBi2nAhI+6O6LFiXFVVFZHp4KJGGTCe4Bgzx0+zOmCskdn5OaSpQ803+QsdSR7qustC9lH40sUB+NmkNZk1FRRxqMKwx/Re+u5j30Dwm/T94KhOjKpEHk0ZO1xBWEV8VXjR38XOdE0/U3lcwJOAxxEEa7qI4izVq1qX9H+Cvhk+irpFItydsmihFo5YooD4Bo4BeyP6D0fuVD16b0Ax4uYxT9y9wX+/kP037n/gkEdqFBRXkTzhPHVMWMfe8TVTsj5LCD0r5I9RgmEHdRiPu8dgb/3DvzNPRWfRDEf1c9k/HbybtyvUf+DASBq7YrH37pxxvJRqzwfRH1WPwRrFTLdgfSmG9oJuec8uQd/4r5IuEuajViVToB3l0F4Pjv7dB7eBtPw94u7T+H0PAjfvvv38L9mn8Pg0/TdP/7Zrwmz7qAWB+QUalPvI9rVu36wpt4oCFPW4COS6eXNlbhImWRbmi+qlCyWjIWpGnUpSwsRzBVerteQayFb1o2GeqSHYIfe7LK6TaF1iKjVymXLvpE36Cg6vNYDcAUY7AVubBXHNd/Z0caYBy+4uqiidiadMbMtocR7YT0wmNgD179TsxfIM8jimT6UwmZYKTmQlfqz1THuuW4+MkLJaTDAY+xrizg1Y0+5wRu6+FtvbDtBpJnY0KV5KxyY2xO+it4er9+CT9PTty6lYQAU6rXcbKR6JSv6FMV0wdaQp2tUxygVysGq1vDvDlC1/Oi61uGS2ohblW3LDu6RZ11NLyA4o1N/ML3QG+BX0AtN3t9BL7hB/EB6oQmwTS/8awApXlFT"
 }
@@ -0,0 +1,420 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// Package dhcpv4 provides support for parsing DHCPv4 messages (RFC 2131)
+// and reporting the client/server exchanges as transactions.
+//
+// DHCP clients usually send their requests from the unspecified address to
+// the broadcast address, so transactions are correlated by the transaction
+// ID and client hardware address instead of the IP/port tuple.
+package dhcpv4
+
+import (
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/logp"
+	"github.com/elastic/beats/libbeat/monitoring"
+
+	"github.com/elastic/beats/packetbeat/procs"
+	"github.com/elastic/beats/packetbeat/protos"
+)
+
+var (
+	debugf = logp.MakeDebug("dhcpv4")
+)
+
+var (
+	unmatchedRequests  = monitoring.NewInt(nil, "dhcpv4.unmatched_requests")
+	unmatchedResponses = monitoring.NewInt(nil, "dhcpv4.unmatched_responses")
+)
+
+// Notes added to the published events.
+const (
+	duplicateRequestNote = "Another request with the same transaction ID was seen."
+	orphanedResponseNote = "Response: received without an associated request."
+	noResponseNote       = "Response: no response was received for the request."
+)
+
+type dhcpv4Plugin struct {
+	// Configuration data.
+	ports []int
+
+	// Cache of active DHCP transactions. The map key is the transactionKey
+	// of the request.
+	transactions       *common.Cache
+	transactionTimeout time.Duration
+
+	results protos.Reporter // Channel where results are pushed.
+}
+
+// transactionKey identifies the request and reply messages of a DHCP
+// exchange.
+type transactionKey struct {
+	xid    uint32
+	chaddr string
+}
+
+func keyOf(m *message) transactionKey {
+	return transactionKey{xid: m.xid, chaddr: string(m.chaddr)}
+}
+
+type dhcpMessage struct {
+	ts           time.Time
+	tuple        common.IPPortTuple
+	cmdlineTuple *common.CmdlineTuple
+	data         *message
+	length       int
+}
+
+type dhcpTransaction struct {
+	ts    time.Time // Time when the request was received.
+	key   transactionKey
+	src   common.Endpoint
+	dst   common.Endpoint
+	notes []string
+
+	request  *dhcpMessage
+	response *dhcpMessage
+}
+
+func init() {
+	protos.Register("dhcpv4", New)
+}
+
+func New(
+	testMode bool,
+	results protos.Reporter,
+	cfg *common.Config,
+) (protos.Plugin, error) {
+	p := &dhcpv4Plugin{}
+	config := defaultConfig
+	if !testMode {
+		if err := cfg.Unpack(&config); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.init(results, &config); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+func (dhcp *dhcpv4Plugin) init(results protos.Reporter, config *dhcpv4Config) error {
+	dhcp.setFromConfig(config)
+	dhcp.transactions = common.NewCacheWithRemovalListener(
+		dhcp.transactionTimeout,
+		protos.DefaultTransactionHashSize,
+		func(k common.Key, v common.Value) {
+			trans, ok := v.(*dhcpTransaction)
+			if !ok {
+				logp.Err("Expired value is not a *dhcpTransaction.")
+				return
+			}
+			dhcp.expireTransaction(trans)
+		})
+	dhcp.transactions.StartJanitor(dhcp.transactionTimeout)
+
+	dhcp.results = results
+
+	return nil
+}
+
+func (dhcp *dhcpv4Plugin) setFromConfig(config *dhcpv4Config) {
+	dhcp.ports = config.Ports
+	dhcp.transactionTimeout = config.TransactionTimeout
+}
+
+func (dhcp *dhcpv4Plugin) GetPorts() []int {
+	return dhcp.ports
+}
+
+func (dhcp *dhcpv4Plugin) ConnectionTimeout() time.Duration {
+	return dhcp.transactionTimeout
+}
+
+func (dhcp *dhcpv4Plugin) ParseUDP(pkt *protos.Packet) {
+	defer logp.Recover("DHCPv4 ParseUDP")
+
+	debugf("Parsing packet addressed with %s of length %d.",
+		pkt.Tuple.String(), len(pkt.Payload))
+
+	m, err := decodeMessage(pkt.Payload)
+	if err != nil {
+		debugf("Failed to decode DHCPv4 message: %v", err)
+		return
+	}
+
+	msg := &dhcpMessage{
+		ts:           pkt.Ts,
+		tuple:        pkt.Tuple,
+		cmdlineTuple: procs.ProcWatcher.FindProcessesTupleUDP(&pkt.Tuple),
+		data:         m,
+		length:       len(pkt.Payload),
+	}
+
+	if m.op == opBootRequest {
+		dhcp.receivedRequest(msg)
+	} else {
+		dhcp.receivedResponse(msg)
+	}
+}
+
+func (dhcp *dhcpv4Plugin) getTransaction(k transactionKey) *dhcpTransaction {
+	v := dhcp.transactions.Get(k)
+	if v != nil {
+		return v.(*dhcpTransaction)
+	}
+	return nil
+}
+
+// deleteTransaction deletes an entry from the transaction map and returns
+// the deleted element. If the key does not exist then nil is returned.
+func (dhcp *dhcpv4Plugin) deleteTransaction(k transactionKey) *dhcpTransaction {
+	v := dhcp.transactions.Delete(k)
+	if v != nil {
+		return v.(*dhcpTransaction)
+	}
+	return nil
+}
+
+func (dhcp *dhcpv4Plugin) receivedRequest(msg *dhcpMessage) {
+	key := keyOf(msg.data)
+	debugf("Processing %v request, xid=0x%08x.", msg.data.msgType, key.xid)
+
+	if trans := dhcp.deleteTransaction(key); trans != nil {
+		// Clients retransmit requests that were not answered, and reuse
+		// the transaction ID of a DHCPDISCOVER in the following
+		// DHCPREQUEST.
+		trans.notes = append(trans.notes, duplicateRequestNote)
+		dhcp.publishTransaction(trans)
+	}
+
+	trans := &dhcpTransaction{ts: msg.ts, key: key, request: msg}
+	trans.src, trans.dst = common.MakeEndpointPair(msg.tuple.BaseTuple, msg.cmdlineTuple)
+
+	if !msg.data.msgType.expectsReply() {
+		dhcp.publishTransaction(trans)
+		return
+	}
+	dhcp.transactions.Put(key, trans)
+}
+
+func (dhcp *dhcpv4Plugin) receivedResponse(msg *dhcpMessage) {
+	key := keyOf(msg.data)
+	debugf("Processing %v response, xid=0x%08x.", msg.data.msgType, key.xid)
+
+	trans := dhcp.deleteTransaction(key)
+	if trans == nil {
+		trans = &dhcpTransaction{ts: msg.ts, key: key}
+		// Report the client as source of the transaction.
+		trans.dst, trans.src = common.MakeEndpointPair(msg.tuple.BaseTuple, msg.cmdlineTuple)
+		trans.notes = append(trans.notes, orphanedResponseNote)
+		unmatchedResponses.Add(1)
+	}
+
+	trans.response = msg
+	dhcp.publishTransaction(trans)
+}
+
+func (dhcp *dhcpv4Plugin) expireTransaction(t *dhcpTransaction) {
+	t.notes = append(t.notes, noResponseNote)
+	debugf("%s xid=0x%08x", noResponseNote, t.key.xid)
+	dhcp.publishTransaction(t)
+	unmatchedRequests.Add(1)
+}
+
+func (dhcp *dhcpv4Plugin) publishTransaction(t *dhcpTransaction) {
+	if dhcp.results == nil {
+		return
+	}
+
+	fields := common.MapStr{}
+	fields["type"] = "dhcpv4"
+	fields["transport"] = "udp"
+	fields["src"] = &t.src
+	fields["dst"] = &t.dst
+	fields["status"] = transactionStatus(t)
+	if len(t.notes) == 1 {
+		fields["notes"] = t.notes[0]
+	} else if len(t.notes) > 1 {
+		fields["notes"] = strings.Join(t.notes, " ")
+	}
+
+	dhcpEvent := common.MapStr{
+		"transaction_id": fmt.Sprintf("0x%08x", t.key.xid),
+		"client_mac":     net.HardwareAddr(t.key.chaddr).String(),
+	}
+	fields["dhcpv4"] = dhcpEvent
+
+	if t.request != nil {
+		req := t.request.data
+		fields["bytes_in"] = t.request.length
+		fields["method"] = req.msgType.String()
+		dhcpEvent["request"] = messageFields(req)
+
+		if req.options.hostname != "" {
+			dhcpEvent["client_hostname"] = req.options.hostname
+		}
+		if isSet(req.options.requestedIP) {
+			dhcpEvent["requested_ip"] = req.options.requestedIP.String()
+		} else if isSet(req.ciaddr) {
+			dhcpEvent["requested_ip"] = req.ciaddr.String()
+		}
+		if isSet(req.options.serverIdentifier) {
+			dhcpEvent["server_ip"] = req.options.serverIdentifier.String()
+		}
+	}
+
+	if t.response != nil {
+		resp := t.response.data
+		fields["bytes_out"] = t.response.length
+		if t.request != nil {
+			fields["responsetime"] = int32(t.response.ts.Sub(t.ts).Nanoseconds() / 1e6)
+		}
+		dhcpEvent["response"] = messageFields(resp)
+
+		if isSet(resp.yiaddr) {
+			dhcpEvent["assigned_ip"] = resp.yiaddr.String()
+		}
+		if isSet(resp.options.serverIdentifier) {
+			dhcpEvent["server_ip"] = resp.options.serverIdentifier.String()
+		}
+		if resp.options.leaseTime != nil {
+			dhcpEvent["lease_time_sec"] = *resp.options.leaseTime
+		}
+	}
+
+	dhcp.results(beat.Event{
+		Timestamp: t.ts,
+		Fields:    fields,
+	})
+}
+
+// transactionStatus returns OK for exchanges that completed without a
+// DHCPNAK, including client messages that are not answered.
+func transactionStatus(t *dhcpTransaction) string {
+	if t.response != nil {
+		if t.response.data.msgType == msgNak {
+			return common.ERROR_STATUS
+		}
+		return common.OK_STATUS
+	}
+	if t.request != nil && !t.request.data.msgType.expectsReply() {
+		return common.OK_STATUS
+	}
+	return common.ERROR_STATUS
+}
+
+// messageFields returns the fields reported for a single DHCP message.
+func messageFields(m *message) common.MapStr {
+	fields := common.MapStr{
+		"message_type": m.msgType.String(),
+		"hops":         m.hops,
+		"seconds":      m.secs,
+	}
+	if m.flags&flagBroadcast != 0 {
+		fields["flags"] = "broadcast"
+	} else {
+		fields["flags"] = "unicast"
+	}
+	if m.htype != htypeEthernet {
+		fields["hardware_type"] = m.htype
+	}
+
+	addIP(fields, "client_ip", m.ciaddr)
+	addIP(fields, "assigned_ip", m.yiaddr)
+	addIP(fields, "server_ip", m.siaddr)
+	addIP(fields, "relay_ip", m.giaddr)
+	if m.sname != "" {
+		fields["server_name"] = m.sname
+	}
+	if m.file != "" {
+		fields["boot_file_name"] = m.file
+	}
+
+	if opts := optionFields(&m.options); len(opts) > 0 {
+		fields["option"] = opts
+	}
+	return fields
+}
+
+func optionFields(o *options) common.MapStr {
+	fields := common.MapStr{}
+	addIP(fields, "subnet_mask", o.subnetMask)
+	addIPs(fields, "router", o.routers)
+	addIPs(fields, "dns_servers", o.dnsServers)
+	addIP(fields, "requested_ip_address", o.requestedIP)
+	addIP(fields, "server_identifier", o.serverIdentifier)
+	if o.hostname != "" {
+		fields["hostname"] = o.hostname
+	}
+	if o.domainName != "" {
+		fields["domain_name"] = o.domainName
+	}
+	if o.leaseTime != nil {
+		fields["ip_address_lease_time_sec"] = *o.leaseTime
+	}
+	if o.renewalTime != nil {
+		fields["renewal_time_sec"] = *o.renewalTime
+	}
+	if o.rebindingTime != nil {
+		fields["rebinding_time_sec"] = *o.rebindingTime
+	}
+	if o.maxMessageSize != nil {
+		fields["max_dhcp_message_size"] = *o.maxMessageSize
+	}
+	if o.message != "" {
+		fields["message"] = o.message
+	}
+	if o.vendorClass != "" {
+		fields["class_identifier"] = o.vendorClass
+	}
+	if len(o.clientIdentifier) > 0 {
+		fields["client_identifier"] = fmt.Sprintf("%x", o.clientIdentifier)
+	}
+	if len(o.parameterRequestList) > 0 {
+		names := make([]string, 0, len(o.parameterRequestList))
+		for _, code := range o.parameterRequestList {
+			names = append(names, optionName(code))
+		}
+		fields["parameter_request_list"] = names
+	}
+	return fields
+}
+
+func addIP(m common.MapStr, key string, ip net.IP) {
+	if isSet(ip) {
+		m[key] = ip.String()
+	}
+}
+
+func addIPs(m common.MapStr, key string, ips []net.IP) {
+	if len(ips) == 0 {
+		return
+	}
+	values := make([]string, 0, len(ips))
+	for _, ip := range ips {
+		values = append(values, ip.String())
+	}
+	m[key] = values
+}
@@ -0,0 +1,226 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package dhcpv4
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/packetbeat/protos"
+)
+
+// Verify that the interface for UDP has been satisfied.
+var _ protos.UDPPlugin = &dhcpv4Plugin{}
+
+var (
+	clientTuple = common.NewIPPortTuple(4,
+		net.IPv4zero, 68, net.IPv4bcast, 67)
+	serverTuple = common.NewIPPortTuple(4,
+		net.ParseIP("192.168.0.1"), 67, net.IPv4bcast, 68)
+)
+
+type eventStore struct {
+	events []beat.Event
+}
+
+func (e *eventStore) publish(event beat.Event) {
+	e.events = append(e.events, event)
+}
+
+func newDHCPv4(store *eventStore) *dhcpv4Plugin {
+	cfg, _ := common.NewConfigFrom(map[string]interface{}{
+		"ports": []int{67, 68},
+	})
+	p, err := New(false, store.publish, cfg)
+	if err != nil {
+		panic(err)
+	}
+	return p.(*dhcpv4Plugin)
+}
+
+func newPacket(t common.IPPortTuple, ts time.Time, payload []byte) *protos.Packet {
+	return &protos.Packet{
+		Ts:      ts,
+		Tuple:   t,
+		Payload: payload,
+	}
+}
+
+func TestParseUDP_discoverOffer(t *testing.T) {
+	store := &eventStore{}
+	dhcp := newDHCPv4(store)
+	ts := time.Now()
+
+	dhcp.ParseUDP(newPacket(clientTuple, ts, discover))
+	assert.Empty(t, store.events)
+	assert.Equal(t, 1, dhcp.transactions.Size())
+
+	dhcp.ParseUDP(newPacket(serverTuple, ts.Add(5*time.Millisecond), offer))
+	if !assert.Len(t, store.events, 1) {
+		return
+	}
+	assert.Equal(t, 0, dhcp.transactions.Size())
+
+	fields := store.events[0].Fields
+	assert.Equal(t, "dhcpv4", fields["type"])
+	assert.Equal(t, "udp", fields["transport"])
+	assert.Equal(t, common.OK_STATUS, fields["status"])
+	assert.Equal(t, "DISCOVER", fields["method"])
+	assert.Equal(t, int32(5), fields["responsetime"])
+	assert.Equal(t, len(discover), fields["bytes_in"])
+	assert.Equal(t, len(offer), fields["bytes_out"])
+	assert.Equal(t, "0.0.0.0", fields["src"].(*common.Endpoint).IP)
+	assert.Equal(t, "255.255.255.255", fields["dst"].(*common.Endpoint).IP)
+
+	event := fields["dhcpv4"].(common.MapStr)
+	assert.Equal(t, "0x00003d1d", event["transaction_id"])
+	assert.Equal(t, "00:0b:82:01:fc:42", event["client_mac"])
+	assert.Equal(t, "192.168.0.10", event["assigned_ip"])
+	assert.Equal(t, "192.168.0.1", event["server_ip"])
+	assert.Equal(t, uint32(3600), event["lease_time_sec"])
+	assert.NotContains(t, event, "requested_ip")
+
+	req := event["request"].(common.MapStr)
+	assert.Equal(t, "DISCOVER", req["message_type"])
+	assert.Equal(t, "unicast", req["flags"])
+	assert.Equal(t,
+		[]string{"subnet_mask", "router", "dns_servers", "option_42"},
+		req["option"].(common.MapStr)["parameter_request_list"])
+
+	resp := event["response"].(common.MapStr)
+	assert.Equal(t, "OFFER", resp["message_type"])
+	assert.Equal(t, "192.168.0.1", resp["server_ip"])
+	assert.Equal(t, uint32(1800), resp["option"].(common.MapStr)["renewal_time_sec"])
+}
+
+func TestParseUDP_requestAck(t *testing.T) {
+	store := &eventStore{}
+	dhcp := newDHCPv4(store)
+
+	dhcp.ParseUDP(newPacket(clientTuple, time.Now(), request))
+	dhcp.ParseUDP(newPacket(serverTuple, time.Now(), ack))
+	if !assert.Len(t, store.events, 1) {
+		return
+	}
+
+	fields := store.events[0].Fields
+	assert.Equal(t, common.OK_STATUS, fields["status"])
+	assert.Equal(t, "REQUEST", fields["method"])
+
+	event := fields["dhcpv4"].(common.MapStr)
+	assert.Equal(t, "dhcp-client", event["client_hostname"])
+	assert.Equal(t, "192.168.0.10", event["requested_ip"])
+	assert.Equal(t, "192.168.0.10", event["assigned_ip"])
+
+	opts := event["response"].(common.MapStr)["option"].(common.MapStr)
+	assert.Equal(t, []string{"192.168.0.1"}, opts["router"])
+	assert.Equal(t, []string{"192.168.0.1", "8.8.8.8"}, opts["dns_servers"])
+	assert.Equal(t, "example.com", opts["domain_name"])
+}
+
+func TestParseUDP_nak(t *testing.T) {
+	store := &eventStore{}
+	dhcp := newDHCPv4(store)
+
+	nak := append([]byte(nil), ack...)
+	nak[headerSize+6] = byte(msgNak)
+
+	dhcp.ParseUDP(newPacket(clientTuple, time.Now(), request))
+	dhcp.ParseUDP(newPacket(serverTuple, time.Now(), nak))
+	if assert.Len(t, store.events, 1) {
+		assert.Equal(t, common.ERROR_STATUS, store.events[0].Fields["status"])
+	}
+}
+
+func TestParseUDP_orphanedResponse(t *testing.T) {
+	store := &eventStore{}
+	dhcp := newDHCPv4(store)
+
+	dhcp.ParseUDP(newPacket(serverTuple, time.Now(), offer))
+	if !assert.Len(t, store.events, 1) {
+		return
+	}
+
+	fields := store.events[0].Fields
+	assert.Equal(t, orphanedResponseNote, fields["notes"])
+	assert.NotContains(t, fields, "method")
+	assert.NotContains(t, fields, "responsetime")
+	assert.Equal(t, "255.255.255.255", fields["src"].(*common.Endpoint).IP)
+	assert.Equal(t, "192.168.0.1", fields["dst"].(*common.Endpoint).IP)
+}
+
+func TestParseUDP_release(t *testing.T) {
+	store := &eventStore{}
+	dhcp := newDHCPv4(store)
+
+	release := append([]byte(nil), request...)
+	release[headerSize+6] = byte(msgRelease)
+
+	dhcp.ParseUDP(newPacket(clientTuple, time.Now(), release))
+	assert.Equal(t, 0, dhcp.transactions.Size())
+	if assert.Len(t, store.events, 1) {
+		assert.Equal(t, common.OK_STATUS, store.events[0].Fields["status"])
+		assert.Equal(t, "RELEASE", store.events[0].Fields["method"])
+	}
+}
+
+func TestParseUDP_duplicateRequest(t *testing.T) {
+	store := &eventStore{}
+	dhcp := newDHCPv4(store)
+
+	dhcp.ParseUDP(newPacket(clientTuple, time.Now(), discover))
+	dhcp.ParseUDP(newPacket(clientTuple, time.Now(), discover))
+	assert.Equal(t, 1, dhcp.transactions.Size())
+	if assert.Len(t, store.events, 1) {
+		assert.Equal(t, duplicateRequestNote, store.events[0].Fields["notes"])
+		assert.Equal(t, common.ERROR_STATUS, store.events[0].Fields["status"])
+	}
+}
+
+func TestParseUDP_malformedPacket(t *testing.T) {
+	store := &eventStore{}
+	dhcp := newDHCPv4(store)
+
+	dhcp.ParseUDP(newPacket(clientTuple, time.Now(), discover[:100]))
+	assert.Empty(t, store.events)
+	assert.Equal(t, 0, dhcp.transactions.Size())
+}
+
+func TestExpireTransaction(t *testing.T) {
+	store := &eventStore{}
+	dhcp := newDHCPv4(store)
+
+	dhcp.ParseUDP(newPacket(clientTuple, time.Now(), discover))
+	trans := dhcp.getTransaction(transactionKey{xid: 0x3d1d, chaddr: string([]byte{0, 0x0b, 0x82, 1, 0xfc, 0x42})})
+	if !assert.NotNil(t, trans) {
+		return
+	}
+
+	dhcp.expireTransaction(trans)
+	if assert.Len(t, store.events, 1) {
+		assert.Equal(t, noResponseNote, store.events[0].Fields["notes"])
+		assert.Equal(t, common.ERROR_STATUS, store.events[0].Fields["status"])
+	}
+}
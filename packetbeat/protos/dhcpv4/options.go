@@ -0,0 +1,119 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package dhcpv4
+
+import "strconv"
+
+// BOOTP operation codes (RFC 951).
+const (
+	opBootRequest = 1
+	opBootReply   = 2
+)
+
+// DHCP option codes used by the analyzer (RFC 2132).
+const (
+	optPad                   = 0
+	optSubnetMask            = 1
+	optRouter                = 3
+	optDomainNameServer      = 6
+	optHostname              = 12
+	optDomainName            = 15
+	optRequestedIPAddress    = 50
+	optIPAddressLeaseTime    = 51
+	optMessageType           = 53
+	optServerIdentifier      = 54
+	optParameterRequestList  = 55
+	optMessage               = 56
+	optMaxDHCPMessageSize    = 57
+	optRenewalTimeValue      = 58
+	optRebindingTimeValue    = 59
+	optVendorClassIdentifier = 60
+	optClientIdentifier      = 61
+	optEnd                   = 255
+)
+
+// messageType is the value of the DHCP Message Type option (53).
+type messageType uint8
+
+// DHCP message types (RFC 2132, section 9.6).
+const (
+	msgDiscover messageType = 1 + iota
+	msgOffer
+	msgRequest
+	msgDecline
+	msgAck
+	msgNak
+	msgRelease
+	msgInform
+)
+
+var messageTypeNames = map[messageType]string{
+	msgDiscover: "DISCOVER",
+	msgOffer:    "OFFER",
+	msgRequest:  "REQUEST",
+	msgDecline:  "DECLINE",
+	msgAck:      "ACK",
+	msgNak:      "NAK",
+	msgRelease:  "RELEASE",
+	msgInform:   "INFORM",
+}
+
+func (t messageType) String() string {
+	if name, found := messageTypeNames[t]; found {
+		return name
+	}
+	return "UNKNOWN(" + strconv.Itoa(int(t)) + ")"
+}
+
+// expectsReply returns true for the client messages a server answers. The
+// DHCPRELEASE and DHCPDECLINE messages are never answered.
+func (t messageType) expectsReply() bool {
+	switch t {
+	case msgDiscover, msgRequest, msgInform:
+		return true
+	}
+	return false
+}
+
+var optionNames = map[uint8]string{
+	optSubnetMask:            "subnet_mask",
+	optRouter:                "router",
+	optDomainNameServer:      "dns_servers",
+	optHostname:              "hostname",
+	optDomainName:            "domain_name",
+	optRequestedIPAddress:    "requested_ip_address",
+	optIPAddressLeaseTime:    "ip_address_lease_time_sec",
+	optMessageType:           "message_type",
+	optServerIdentifier:      "server_identifier",
+	optParameterRequestList:  "parameter_request_list",
+	optMessage:               "message",
+	optMaxDHCPMessageSize:    "max_dhcp_message_size",
+	optRenewalTimeValue:      "renewal_time_sec",
+	optRebindingTimeValue:    "rebinding_time_sec",
+	optVendorClassIdentifier: "class_identifier",
+	optClientIdentifier:      "client_identifier",
+}
+
+// optionName returns the name of the option code used in the
+// parameter_request_list field.
+func optionName(code uint8) string {
+	if name, found := optionNames[code]; found {
+		return name
+	}
+	return "option_" + strconv.Itoa(int(code))
+}
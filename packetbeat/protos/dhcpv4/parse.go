@@ -0,0 +1,249 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package dhcpv4
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net"
+)
+
+const (
+	// Size of the fixed BOOTP header, without the magic cookie.
+	headerSize = 236
+
+	// Size of the chaddr, sname and file fields.
+	chaddrSize = 16
+	snameSize  = 64
+	fileSize   = 128
+
+	// Ethernet hardware type.
+	htypeEthernet = 1
+
+	// Flag set by clients that can not receive unicast replies.
+	flagBroadcast = 0x8000
+)
+
+var magicCookie = []byte{99, 130, 83, 99}
+
+var (
+	errMessageTooShort = errors.New("message too short")
+	errInvalidOp       = errors.New("invalid op code")
+	errNoMagicCookie   = errors.New("missing DHCP magic cookie")
+	errInvalidLength   = errors.New("invalid length")
+)
+
+// message is a decoded DHCPv4 message as defined in RFC 2131.
+type message struct {
+	op     uint8
+	htype  uint8
+	hops   uint8
+	xid    uint32
+	secs   uint16
+	flags  uint16
+	ciaddr net.IP
+	yiaddr net.IP
+	siaddr net.IP
+	giaddr net.IP
+	chaddr net.HardwareAddr
+	sname  string
+	file   string
+
+	msgType messageType
+	options options
+}
+
+// options holds the decoded DHCP options of a message. Options that are
+// not understood by the analyzer are ignored.
+type options struct {
+	subnetMask           net.IP
+	routers              []net.IP
+	dnsServers           []net.IP
+	hostname             string
+	domainName           string
+	requestedIP          net.IP
+	leaseTime            *uint32
+	serverIdentifier     net.IP
+	parameterRequestList []uint8
+	message              string
+	maxMessageSize       *uint16
+	renewalTime          *uint32
+	rebindingTime        *uint32
+	vendorClass          string
+	clientIdentifier     []byte
+}
+
+// decodeMessage decodes a DHCPv4 message from a UDP payload.
+func decodeMessage(data []byte) (*message, error) {
+	if len(data) < headerSize+len(magicCookie) {
+		return nil, errMessageTooShort
+	}
+
+	// Decoded addresses and options reference the message data, which
+	// must outlive the packet buffer while the transaction is pending.
+	data = append([]byte(nil), data...)
+
+	m := &message{
+		op:     data[0],
+		htype:  data[1],
+		hops:   data[3],
+		xid:    binary.BigEndian.Uint32(data[4:8]),
+		secs:   binary.BigEndian.Uint16(data[8:10]),
+		flags:  binary.BigEndian.Uint16(data[10:12]),
+		ciaddr: net.IP(data[12:16]),
+		yiaddr: net.IP(data[16:20]),
+		siaddr: net.IP(data[20:24]),
+		giaddr: net.IP(data[24:28]),
+	}
+	if m.op != opBootRequest && m.op != opBootReply {
+		return nil, errInvalidOp
+	}
+
+	hlen := int(data[2])
+	if hlen > chaddrSize {
+		hlen = chaddrSize
+	}
+	m.chaddr = net.HardwareAddr(data[28 : 28+hlen])
+
+	off := 28 + chaddrSize
+	m.sname = cString(data[off : off+snameSize])
+	off += snameSize
+	m.file = cString(data[off : off+fileSize])
+	off += fileSize
+
+	if !bytes.Equal(data[off:off+len(magicCookie)], magicCookie) {
+		return nil, errNoMagicCookie
+	}
+	off += len(magicCookie)
+
+	if err := m.decodeOptions(data[off:]); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (m *message) decodeOptions(data []byte) error {
+	for len(data) > 0 {
+		code := data[0]
+		if code == optPad {
+			data = data[1:]
+			continue
+		}
+		if code == optEnd {
+			return nil
+		}
+		if len(data) < 2 || len(data) < 2+int(data[1]) {
+			return fmt.Errorf("option %d exceeds message length", code)
+		}
+		value := data[2 : 2+int(data[1])]
+		data = data[2+len(value):]
+
+		if err := m.decodeOption(code, value); err != nil {
+			return fmt.Errorf("invalid option %d: %v", code, err)
+		}
+	}
+	return nil
+}
+
+func (m *message) decodeOption(code uint8, v []byte) error {
+	var err error
+	o := &m.options
+	switch code {
+	case optMessageType:
+		if len(v) != 1 {
+			return errInvalidLength
+		}
+		m.msgType = messageType(v[0])
+	case optSubnetMask:
+		o.subnetMask, err = ipv4(v)
+	case optRouter:
+		o.routers, err = ipv4List(v)
+	case optDomainNameServer:
+		o.dnsServers, err = ipv4List(v)
+	case optHostname:
+		o.hostname = string(v)
+	case optDomainName:
+		o.domainName = cString(v)
+	case optRequestedIPAddress:
+		o.requestedIP, err = ipv4(v)
+	case optIPAddressLeaseTime:
+		o.leaseTime, err = uint32Value(v)
+	case optServerIdentifier:
+		o.serverIdentifier, err = ipv4(v)
+	case optParameterRequestList:
+		o.parameterRequestList = v
+	case optMessage:
+		o.message = string(v)
+	case optMaxDHCPMessageSize:
+		if len(v) != 2 {
+			return errInvalidLength
+		}
+		size := binary.BigEndian.Uint16(v)
+		o.maxMessageSize = &size
+	case optRenewalTimeValue:
+		o.renewalTime, err = uint32Value(v)
+	case optRebindingTimeValue:
+		o.rebindingTime, err = uint32Value(v)
+	case optVendorClassIdentifier:
+		o.vendorClass = string(v)
+	case optClientIdentifier:
+		o.clientIdentifier = v
+	}
+	return err
+}
+
+func ipv4(v []byte) (net.IP, error) {
+	if len(v) != net.IPv4len {
+		return nil, errInvalidLength
+	}
+	return net.IP(v), nil
+}
+
+func ipv4List(v []byte) ([]net.IP, error) {
+	if len(v) == 0 || len(v)%net.IPv4len != 0 {
+		return nil, errInvalidLength
+	}
+	ips := make([]net.IP, 0, len(v)/net.IPv4len)
+	for i := 0; i < len(v); i += net.IPv4len {
+		ips = append(ips, net.IP(v[i:i+net.IPv4len]))
+	}
+	return ips, nil
+}
+
+func uint32Value(v []byte) (*uint32, error) {
+	if len(v) != 4 {
+		return nil, errInvalidLength
+	}
+	value := binary.BigEndian.Uint32(v)
+	return &value, nil
+}
+
+// cString returns the content of a NUL terminated string field.
+func cString(v []byte) string {
+	if i := bytes.IndexByte(v, 0); i >= 0 {
+		v = v[:i]
+	}
+	return string(v)
+}
+
+// isSet returns false for nil and unspecified (0.0.0.0) addresses.
+func isSet(ip net.IP) bool {
+	return ip != nil && !ip.IsUnspecified()
+}
@@ -0,0 +1,130 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package dhcpv4
+
+import (
+	"encoding/hex"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// The test messages are a DHCPDISCOVER/DHCPOFFER and a DHCPREQUEST/DHCPACK
+// exchange for client 00:0b:82:01:fc:42. Each message is given as its BOOTP
+// header up to chaddr followed by the options after the magic cookie; the
+// empty sname and file fields are filled in by newMessage.
+var (
+	discover = newMessage(
+		"0101060000003d1d0000000000000000000000000000000000000000000b8201fc4200000000000000000000",
+		"3501013d0701000b8201fc4232040000000037040103062aff")
+	offer = newMessage(
+		"0201060000003d1d0000000000000000c0a8000ac0a8000100000000000b8201fc4200000000000000000000",
+		"3501020104ffffff003a04000007083b0400000c4e330400000e103604c0a80001ff")
+	request = newMessage(
+		"0101060000003d1e0000000000000000000000000000000000000000000b8201fc4200000000000000000000",
+		"3501033d0701000b8201fc423204c0a8000a3604c0a800010c0b646863702d636c69656e7437040103062aff")
+	ack = newMessage(
+		"0201060000003d1e0000000000000000c0a8000a0000000000000000000b8201fc4200000000000000000000",
+		"3501053a04000007083b0400000c4e330400000e103604c0a800010104ffffff000304c0a800010608c0a80001080808080f0b6578616d706c652e636f6dff")
+)
+
+func newMessage(header, options string) []byte {
+	data, err := hex.DecodeString(header + strings.Repeat("00", snameSize+fileSize) + "63825363" + options)
+	if err != nil {
+		panic(err)
+	}
+	return data
+}
+
+func TestDecodeDiscover(t *testing.T) {
+	m, err := decodeMessage(discover)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, uint8(opBootRequest), m.op)
+	assert.Equal(t, uint32(0x3d1d), m.xid)
+	assert.Equal(t, "00:0b:82:01:fc:42", m.chaddr.String())
+	assert.False(t, isSet(m.ciaddr))
+	assert.Equal(t, "", m.sname)
+	assert.Equal(t, msgDiscover, m.msgType)
+	assert.Equal(t, []byte{1, 0, 0x0b, 0x82, 1, 0xfc, 0x42}, m.options.clientIdentifier)
+	assert.False(t, isSet(m.options.requestedIP))
+	assert.Equal(t, []uint8{1, 3, 6, 42}, m.options.parameterRequestList)
+}
+
+func TestDecodeAck(t *testing.T) {
+	m, err := decodeMessage(ack)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, uint8(opBootReply), m.op)
+	assert.Equal(t, msgAck, m.msgType)
+	assert.Equal(t, "192.168.0.10", m.yiaddr.String())
+	assert.Equal(t, "255.255.255.0", m.options.subnetMask.String())
+	assert.Equal(t, []net.IP{net.IPv4(192, 168, 0, 1).To4()}, m.options.routers)
+	assert.Equal(t, []net.IP{net.IPv4(192, 168, 0, 1).To4(), net.IPv4(8, 8, 8, 8).To4()}, m.options.dnsServers)
+	assert.Equal(t, "example.com", m.options.domainName)
+	assert.Equal(t, "192.168.0.1", m.options.serverIdentifier.String())
+	if assert.NotNil(t, m.options.leaseTime) {
+		assert.Equal(t, uint32(3600), *m.options.leaseTime)
+	}
+	if assert.NotNil(t, m.options.renewalTime) {
+		assert.Equal(t, uint32(1800), *m.options.renewalTime)
+	}
+	if assert.NotNil(t, m.options.rebindingTime) {
+		assert.Equal(t, uint32(3150), *m.options.rebindingTime)
+	}
+}
+
+func TestDecodeInvalid(t *testing.T) {
+	tests := map[string][]byte{
+		"empty":          {},
+		"truncated":      discover[:headerSize],
+		"invalid op":     append([]byte{3}, discover[1:]...),
+		"no cookie":      newMessage(strings.Repeat("01", 44), "")[:headerSize+4],
+		"option overrun": discover[:len(discover)-3],
+		"bad lease time": newMessage(strings.Repeat("01", 44), "3302ffffff"),
+	}
+	// Clear the magic cookie.
+	tests["no cookie"][headerSize] = 0
+
+	for name, data := range tests {
+		_, err := decodeMessage(data)
+		assert.Error(t, err, name)
+	}
+}
+
+func TestDecodeDoesNotReferencePayload(t *testing.T) {
+	data := append([]byte(nil), offer...)
+	m, err := decodeMessage(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := range data {
+		data[i] = 0
+	}
+	assert.Equal(t, "192.168.0.10", m.yiaddr.String())
+	assert.Equal(t, "00:0b:82:01:fc:42", m.chaddr.String())
+}
@@ -0,0 +1,116 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tls
+
+import (
+	"crypto/sha1"
+	"crypto/x509"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/elastic/beats/libbeat/common"
+)
+
+// ecsFields returns the fields of the session defined by the Elastic Common
+// Schema for the tls field set. They summarize the detailed fields of the
+// event so TLS sessions can be correlated with other sources using ECS.
+func ecsFields(established bool, clientHello, serverHello *helloMessage,
+	clientCerts, serverCerts []*x509.Certificate) common.MapStr {
+
+	fields := common.MapStr{
+		"established": established,
+	}
+
+	client := common.MapStr{}
+	if clientHello != nil {
+		hash, _ := getJa3Fingerprint(clientHello)
+		client["ja3"] = hash
+		if names := sniNames(clientHello); len(names) > 0 {
+			client["server_name"] = names[0]
+		}
+		if len(clientHello.supported.cipherSuites) > 0 {
+			ciphers := make([]string, len(clientHello.supported.cipherSuites))
+			for idx, code := range clientHello.supported.cipherSuites {
+				ciphers[idx] = code.String()
+			}
+			client["supported_ciphers"] = ciphers
+		}
+	}
+	addCertificateFields(client, clientCerts)
+
+	server := common.MapStr{}
+	if serverHello != nil {
+		protocol, version := serverHello.version.protocolAndVersion()
+		fields["version_protocol"] = protocol
+		fields["version"] = version
+		fields["cipher"] = serverHello.selected.cipherSuite.String()
+		if protocols, ok := serverHello.extensions.Parsed["application_layer_protocol_negotiation"].([]string); ok && len(protocols) > 0 {
+			fields["next_protocol"] = strings.ToLower(protocols[0])
+		}
+
+		hash, _ := getJa3sFingerprint(serverHello)
+		server["ja3s"] = hash
+	}
+	addCertificateFields(server, serverCerts)
+
+	if len(client) > 0 {
+		fields["client"] = client
+	}
+	if len(server) > 0 {
+		fields["server"] = server
+	}
+	return fields
+}
+
+// addCertificateFields adds the summary of the leaf certificate of a chain.
+func addCertificateFields(m common.MapStr, certs []*x509.Certificate) {
+	if len(certs) == 0 {
+		return
+	}
+	cert := certs[0]
+	sum := sha1.Sum(cert.Raw)
+	m["subject"] = cert.Subject.String()
+	m["issuer"] = cert.Issuer.String()
+	m["not_before"] = cert.NotBefore
+	m["not_after"] = cert.NotAfter
+	m["hash"] = common.MapStr{
+		"sha1": strings.ToUpper(hex.EncodeToString(sum[:])),
+	}
+}
+
+func sniNames(hello *helloMessage) []string {
+	if value, ok := hello.extensions.Parsed["server_name_indication"]; ok {
+		if list, ok := value.([]string); ok {
+			return list
+		}
+	}
+	return nil
+}
+
+// protocolAndVersion returns the name of the protocol and its version, as
+// "ssl" and "3.0" or "tls" and "1.2".
+func (v tlsVersion) protocolAndVersion() (protocol, version string) {
+	if v.major == 3 && v.minor == 0 {
+		return "ssl", "3.0"
+	}
+	if v.major == 3 && v.minor > 0 {
+		return "tls", fmt.Sprintf("1.%d", v.minor-1)
+	}
+	return "unknown", fmt.Sprintf("%d.%d", v.major, v.minor)
+}
@@ -0,0 +1,69 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package tls
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProtocolAndVersion(t *testing.T) {
+	tests := []struct {
+		version           tlsVersion
+		protocol, release string
+	}{
+		{tlsVersion{3, 0}, "ssl", "3.0"},
+		{tlsVersion{3, 1}, "tls", "1.0"},
+		{tlsVersion{3, 3}, "tls", "1.2"},
+		{tlsVersion{3, 4}, "tls", "1.3"},
+		{tlsVersion{2, 0}, "unknown", "2.0"},
+	}
+
+	for _, test := range tests {
+		protocol, version := test.version.protocolAndVersion()
+		assert.Equal(t, test.protocol, protocol)
+		assert.Equal(t, test.release, version)
+	}
+}
+
+func TestECSCertificateFields(t *testing.T) {
+	parser := &parser{}
+	assert.Equal(t, resultOK, parser.parse(sBuf(t, certsMsg)))
+
+	fields := ecsFields(true, nil, nil, nil, parser.certificates)
+	assert.Equal(t, true, fields["established"])
+	assert.NotContains(t, fields, "client")
+
+	sum := sha1.Sum(parser.certificates[0].Raw)
+	expected := map[string]string{
+		"server.subject":   "CN=www.example.org,OU=Technology,O=Internet Corporation for Assigned Names and Numbers,L=Los Angeles,ST=California,C=US",
+		"server.issuer":    "CN=DigiCert SHA2 High Assurance Server CA,OU=www.digicert.com,O=DigiCert Inc,C=US",
+		"server.hash.sha1": strings.ToUpper(hex.EncodeToString(sum[:])),
+	}
+	for key, expectedValue := range expected {
+		value, err := fields.GetValue(key)
+		assert.NoError(t, err, key)
+		assert.Equal(t, expectedValue, value, key)
+	}
+}
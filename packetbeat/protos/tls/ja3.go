@@ -66,6 +66,24 @@ func getJa3Fingerprint(hello *helloMessage) (hash string, ja3str string) {
 	return hex.EncodeToString(sum[:]), ja3str
 }
 
+// getJa3sFingerprint returns the JA3S fingerprint of a server hello, built
+// from the version, the selected cipher suite and the extensions.
+func getJa3sFingerprint(hello *helloMessage) (hash string, ja3str string) {
+	extensions := make([]string, len(hello.extensions.InOrder))
+	for idx, extid := range hello.extensions.InOrder {
+		extensions[idx] = strconv.Itoa(int(extid))
+	}
+
+	ja3str = strings.Join([]string{
+		strconv.Itoa(int(hello.version.major)*256 + int(hello.version.minor)),
+		strconv.Itoa(int(hello.selected.cipherSuite)),
+		strings.Join(extensions, "-"),
+	}, ",")
+	sum := md5.Sum([]byte(ja3str))
+
+	return hex.EncodeToString(sum[:]), ja3str
+}
+
 func extractJa3Array(raw []byte, size int) []uint16 {
 	if size < 1 || size > 2 {
 		return nil
@@ -277,6 +277,11 @@ func (plugin *tlsPlugin) createEvent(conn *tlsConnectionData) beat.Event {
 	if server.parser.hello != nil {
 		serverHello = server.parser.hello
 		tls["server_hello"] = serverHello.toMap()
+		hash, str := getJa3sFingerprint(serverHello)
+		fingerprints["ja3s"] = common.MapStr{
+			"hash": hash,
+			"str":  str,
+		}
 	} else {
 		serverHello = emptyHello
 	}
@@ -347,6 +352,15 @@ func (plugin *tlsPlugin) createEvent(conn *tlsConnectionData) beat.Event {
 	if len(fingerprints) > 0 {
 		tls["fingerprints"] = fingerprints
 	}
+
+	serverCerts := server.parser.certificates
+	if !plugin.sendCertificates {
+		serverCerts = nil
+	}
+	tls.DeepUpdate(ecsFields(conn.handshakeCompleted > 1,
+		client.parser.hello, server.parser.hello,
+		client.parser.certificates, serverCerts))
+
 	fields := common.MapStr{
 		"type":   "tls",
 		"status": status,
@@ -355,10 +369,8 @@ func (plugin *tlsPlugin) createEvent(conn *tlsConnectionData) beat.Event {
 		"dst":    dst,
 	}
 	// set "server" to SNI, if provided
-	if value, ok := clientHello.extensions.Parsed["server_name_indication"]; ok {
-		if list, ok := value.([]string); ok && len(list) > 0 {
-			fields["server"] = list[0]
-		}
+	if names := sniNames(clientHello); len(names) > 0 {
+		fields["server"] = names[0]
 	}
 
 	// set "responsetime" if handshake completed
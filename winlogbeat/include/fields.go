@@ -31,5 +31,5 @@ func init() {
 
 // Asset returns asset data
 func Asset() string {
-	return "eJzMWl+TG7eRf99P0aWXk6pI1pIr6ex9uDqd5Iu3YsWqrB3lSSSIaZKIZoAx/pDLfPpUAxgMhjNLcrV0Yj2olgCm+4dG/weuxvAV97ewRGavAKywJd7C/4VfBRquRW2FkrfwP1cAAO+VtExIA1xVlZL+O1gJLAsDbMtEyZYlgpDAyhJwi9KC3ddoJlcQl91eeUJjkKzCwHhCf/rRQZ7075cN+g9ArcBu0CMEg7IQcu0HSrWGCo1hazQTuMtW+c+ESaQMWgJI81zJlVg7zYgdrESJIxqnSWZhy0pHX4IzWHiawtJPqWxOzH8CG2Vs5BTX/6I8qw6OEc35oQX9XCQ6yu/4cVyTvtAajqcFl7AxAxqt0xILWO49K1UjsZFrMHtjsQIlYbcRfNMCz2SnnZRCrgfQWFHhP5U8A02z8vdEs0VthJKnwcSFjVp5dfaHv0ZJULAAuxEmqPKkq7ov/pe2Yiyr6heRKOn6LRTMNnLQ+JsTGotbsNo1gyulK2Y76/CBVTWZ3ju3dsbC7K3dwOx6+nYE09ntzZvbNzeTm5vZedL1kGAXFBmjGZKBaORKF7Bjpt3fwaYsW5vjXN7ppbCa6b1fG6TFGbkCr+816nBQTBb+h9VMGsZtex5BTgeMg3foyFEt/4G8sbXwYx5mvuJ+p3RxHGjyVc6gbm2KHFRgdoAAtVa6A2CtlauPM/mBPmo8IA8cSX9ZUQhay0oQcqXIsjkz3n95PmbSKEP0ig3BBk10Zmm8wWTxwWaDj8BqoUU6kx4Droo+9VLJ9VOoE5E+aaLVI909s7OoBzWJIYqXyhVtjHpPP6HWaisKpG1aVjDLhsPWxzgLK62qQCl9auisWhfEimLuF8wbkrSSozFKPxrFaOnEfzVpyB4aNvIT1vuXLLx1EU7gkzJGkOL6mGSAaSSCI1hzHIHSUIi1sKxUHJmcPIpNSGOZ5DgXJ0znLi6Euw8NJAoiUDG+EfLQdIc4nI5MiUce18/jEhfMMz1LcrazSYWFcNVx7h8DCa9iT2Me0xxRCrufZyEvIXBmjMzY8ZSfcKQZIfARUbTRTpgAR5g2zB1ROe8b06kmKHFm/HC+6sVPCMuflFqXGCztce4a1ydD7V/9mlP7i4ZeKP7V20+09A/N7wHiYQ6MZZbcb1kip5jtzTzMkc2ajdJ2HiLALaxYaejQmOQbpRt+42TlV12n3Gw5wYLB+PCYH48xAfVEFM/zib9K8ZvDliCIYsirJ3bVUPh4EsdcLzy5JjuNACiRWDpRWlDyGJTMGXwjkveJJ9E6xqtkSyxNj1snl4Dj+cQJLHdeEoFPUlpS5lZlfwy/BojcUTKQKSpFuZ7raXWTxk9qZuT9NL18/pn8GMuK/mlcSNODgxhQcqb5Rljk1ukL7KFDDl7iZD2Bh+/ezt++HgHT1Qjqmo+gErV51YeizKQumaWU/nlIfr6HhlDEwFFaZUbglk5aN4KdkIXaPQKiW/F8O4ZIZ5DHilWi3D+bRSATN6mx2DA7ggKXgskRrDTi0hTHdivqHoTO0BHuPwljyaHdfRqzotBoDJo+g4rx522yYbNhutgxjS2zETjjWFnu4eO79zmGxo98dUvUEi2a1pv8OR8bYNvOpzS4m9O2RCH3JcfDYvvRSQfUAQ1PckO1Ki4QHjIJ1KoIvm2QlXuua8o4fVIF/Hr3oc+I/jc145fbVEuxz4wqsItKkCg+IsJzg+t5jAI1qFjd58SkVNb3vy7GLiM5zPOSCUvGl3dyl2NsL5CyDfINdJs62nduW+/y4n1o5X4WslTrJTL74rze7xPbvjDcIBjskB1pcLXdrHef7kL55gwWYBVoZIX3d6HPNfHr637pnOi92Anp6ZVq/SLlYp9D5IEfPKefIiel4UWzdi1kuz5RS+tpmr6ZXHWQ9+Z9/lygEWsZU8GG9T3qLWqYXV/fJBLZ9Oz6+rrXKzUTuJNpyd+EsWzU7f8R30ROyJVmxmoX0p6d78Y2YCbw8wEpYl8yi7rlm0hF/qMj0ktd8VbLgDmrKmYFp3h41eo2ZWImFor+jJXvmpN86IRpx2lLFDkbxW5Op1XtHw46nyf0uu0QsbSNw+bpYxrNuBVbYfdtET5kvK2yN9H2iLa/g3Wplj5ZcCEhFgVKK1YCdWi7pt8mFGZOa0LbYPEGkMj5ncR+LdMItVuWwmywgJ2wsSTPGPglTNum/jO5S0wcul0BrqraWdR59+e4GM6z+bxD1PDot+lbu/+8QQnOkKbEc+xKgXRpxzSp0ihs3HPgTEIhVivUQQv8PU3nrmVx2DImavPYd4QnN66fpA7J+Y1NjVysBPcKS4csTNBJMrPK2ZBg4gMvnRFb9OebyCycQe0hL/yd1l45f9ac1dbp1ri8MSjZFASUOYrUHgcyygFXUzPNKrSoDQWDRSuehedBQitg4VdNF6MWkx+ZLUb+0sAoUHIES+SM7L61wIw6UXMy0BNt1YNMl6S8CbRaNTAHT+7AWLPu97eFpdZ+QvBJV4jpyKwK1qSc5kmnh26XoqaYS3kTgtPQDOKLUZOXzBix2gOTg0BKtf497bk9Xm9zWX8wu60ivx8VvRFpGxdlouXT5Mx2wyFTmFg0PZfHblkPN41bLC8pek+we940rtGfxYrM1C/xKpu76nC1lmhRGCe2945TSTbyDSVd+T2M4DPT0js1f38ygneuELZdS9blhxK5/2eidLrf7c7vnnr3Tk/efKTXnkw/qg7xn/dv5J7rP8O1EslVcR8uC9K4ElNmQSIKArXN04Icfev/wpuDA+BhN3PpqiWeB/w8g4mmEOj2LSfPd1dCG5tsRwtrUeZeu7Fy/2FrZZH0NCiJshvUcSp4izCPbRVi8DdHzo5iTXpw0SWmkfFNzE0q9iAqV0XzfTn7cjP7kmg1mXc/QyYwsy9vX385np2/GnUORuKDPcCyE2UJS4Tr3olRVlvM/wj5W4OBjsn7wUSKSjmtSu8L/X36Cr3uWjWJ+uF30TiOnXJlEV6ybNgWvTttE/8sx/N8hYZFtv1FHsW6wlJ1dml8EYMMFKHAlZA+lHf8IzNfgzqGVaSHdl+HgqGJX4f72kfT5Cz39ayuSxGHYpwh39ua0Y6ZAx97sPfmMna+dv9BFUn33X4yAO2o/lAEj+27s7KdM8+tJTmAkGaG0vMhbCERunSCMZBe5Q9gXh6qhNJgUG8FR4/7QBMy1/aq91zGfL2kPRC9P6Y1eHicWVwrHdnF7nLfa79UEmqN3QqhTdgOGxevKA7FUBUS5KagOZT2hsL0JfU4UTxU4zBxrhanmurfWAbC88rArDYb2Myk9UNPVu/0+uB+PB2/Gc+m45s3r6evb66/n303nl2/mf73dDqbXo+nN99Pb757ffP2+/H0+np6etuNOhnkTlOczJzly/u7D68ag2ecKyctMGMUF/7kOpvvPOlLo3ervDvFfbsYNBpVboNt3N998BlUfMppmwd2vqT2PeO8pvWDhaqYkLGwDUMkyEXTPG3SElWR9hcHGfIkx0aFeiEMV1vUOdAWJZnU/d0HMwKNW4G79DB2lRXuPHRBTUgymG2c9rLECiq2h+VhNZB2d0lHl5eAjxzX4y8vM9FeElOgGGz+PFxkaQwqjGn5EMys1X2ZCBEfLD1ZaN0b4m9xnfmD2YFS5L9MiFxioJ5+qI5V08+vMDXbwd8//gQaa40GpY1xPU8A1NJfh0Qja9pC3m+2lUjjP5Us99nVBtE6DFtgXF0rnerEw1ZjtwP+8qPgWhm1sgeNdfIMEneoXx368PyhuZC8dPFKt8AVc2V4YVs5Y8mBoCScftpgqF0X8Zv5Q1WGxKl9b85Ib7vPzOMTdKqzGGUKhdiKwrGyTZ9yZ0lCPyXw0PRfuTIUb1q5ZYlmo1Tn9qB2ulYGTcgwfNsxpinRR2okKYe9dR00bZyrqmZ5YRo7bDkhAmoVFIKtpTLJ3ZnJ1b8CAAD//zPw/iA="
+	return "eJzMfF1z27iS9r1/RVdu3qRK1mvJiXfGF1vrjTMnrjOZpOLMyV6kRobIloQ1CTAAaFn767caXwRF6itS9pya1FQkgk8/3ehuNBpQzs7hEVfXMEVmzgAMNwVew3+6TznqTPHKcCmu4d/PAADeSmEYFxoyWZZS2PdgxrHINbAnxgs2LRC4AFYUgE8oDJhVhXp4Bn7Y9ZkFOgfBSnSCh/RX+22vTPrzZYH2BZAzMAu0DEGjyLmY2y8KOYcStWZz1EO4S0bZ17iOUBoNEaTnmRQzPq8VIxVhxgsc0Hv0kBl4YkWNwDXUGnOLyQ19FNKkYPYVWEhtvCQ//ou0olo8BvTMjn+gwQ8RR1qNN/Mado0WJO42XOTGNCg0tRKYw3RlecgKSX0xB73SBkuQApYLni0a4ontVC0EF/MeNoaX+D9S7MEmjPyZbJ5QaS7FbjJ+YHAretlN/hwFGQZzMAuunSsP26774j9IFW1YWb3woOTr15AzE+yg8HvNFebXYFQdvpxJVTLTGofPrKwo9G7qea0NjK/MAsYXo6sBjMbXl2+u31wOLy/HuxWKlGDpHBl9GFKAKMykymHJdKPfmlKGzfV2KTdqyo1iamXHOmtljFKB9fcKlZsoJnL7wSgmNMtMMx9gc8KaYJcd/Ah6fg1y+t+YhVhzHybuySOullLl24nGXFVrVE1MUYJywtYYoFJS+bedmLmSdbVdyDt6yeORDMqOlJNYnnMaywrgYiYpsjOmkRzNyrEZEaDJigEwsPHJLH4fOBl8btLPRloNNY8z7AjIZN5FL6SYH4JOIF1owkoG983ZXuj04jAsUVkh67xZo97SR6iUfOI5kpqG5cyw/mXrg38KMyVLyFqvamB53qQglucTO2ASIElIhlpLtXEVo6FD+9YwwK4HNmY7ovePZHlrMxzCJ6k1J8e1a5IGphAwGw9gnuEApIKcz7lhhcyQieFGblxow0SGE74jdO78QLi7DZRoEYGSZQsucA8Ju1emKCNd1/eT4gdMEj+LdjbjYYk5r8vt0j84CBtUhwn3ZQ4vuFlNkiUvMqj1OTJtzkfZdgo3CRAQEPBmtePalhRUTsRlbhOjSkmbG3m+TsU/OX/eziR1Pf8KcfmblPMCXaRtlq5wvnOp/WzH7NLPB3ous0dUTaTfhs894O4ZaMMM1aRFgZnB3IW5e0YxqxdSmYlbAa5hxgpNbsNEtpAqyDuPUZ4EeapypNW/PqSvpK/5NQHVkOfH5cQ/Bf9eYwMIPB9uE1ey+ZFZOPULCxeqU0+AColpzQsDUmyjkiSDH2Ti13JU1v+2ySrYFAvdkdaqJXbUEzu43FlLODnRaSlYG5d97z71gNxRMZA4qlQ9qafxTYLd6Zle9mF+efycvPfbiu5snMjTSa9eJ2cqW3CDmanVCXRowcFLHM6H8PzL1eTq9QCYKgdQVdkASl7pV10qUg+rghkq6Y9j8vEeApDnkKEwUg+gntbC1ANYcpHL5QYS7R3Pj3PwOL0yZqzkxepoEQ7GK6kwXzAzgBynnIkBzBTiVOfbtOVVhwKv9pP+O9eGEtrdp3OW5wq1Rt0VULKsI+EgJYOYBVP5kilshFEDoGZFsYIPN29TDiGPPNZTVAIN6iab/D39rkds8zyWwe2atgFtatmdy2Lz0s4E1Aw9OA1VMj/B8pBYoJK5hT7rFVXz/GSSPskc/ry77Qqi/+uKZadTqkHsCqMd2EktKGSOG0y47+K6nyCHBiWrupKYENLY/tfJxCWQ/TJPWbAkciPsBqM2Yk9QsvXKdbg+w7jObZNdXry1X8BXLgo5pw7Yi/40s977PbDtu6FB0Nsh26Dgl1Y36+bTnZ0Z6qnmYCQoZNSuQ9/nGtp2Y9XdOke8F0suLF4h5y9iLfbVrbPwjp7A716SVPAijJ1z0YyPaHE8PaZ3hmct5p3nthGXo+Zz4UvBIPoe1RMqGF9cXEaI5PH44uKi0yultreIQ/7BtWGDdv+PeEU4LmaKaaNqW0VZKgoDmSF8XIOiJkPBDKpGboTy8gdbrBe74o2XAauNLJnhGa2HESxHKuy03yjSu0bSu9bcNMNkvKiSHp7FpTPMTuPa79Y6nzv8uukQsagGrkFs8mhqcD5RRyAuMH3B2zh7WG23ePsNzAs5JeNA7bZ+PEdh+IxTV5XarvGztqbPaqWIbeAyJLeLcFYT36+l/lFVTwuuF5jDkhu/JU8E2CFMmbD/02lKjBLaXYFMllVtUE2S/LndDPvFfNohCjK6bfom7r9S67vW5Cl+HiOctQL50pIpOjwip6UzHZKQMQE5n81QOS8gyIfWWcvDWhvEok1833HDArVlo3mQO8Tkd64rzPiMZ3SAwGiSuXZZlrpFZW1cgYnPWVFr/oR2fiPMA3XDLeUHe6a1kjXlRMhYZWrVBBeNoMMgvyHQUCke2+NA6bYn1VRMsRINNVW5gIfGPA9WBs1jDg921Ohh0HCy34wfBvbQQEuQYgBTzBjFfROBCTqh1cLh8WbXg0wVFB2RtJwFmr0ztxasSff7UBd1LJv4oWnxaw7NSpwyI62htKxVFn2673TJe4reK4z2dJ+AaScjrJpZwbTmsxUw0XvMVcj5z4znZnptzCX9weS0ivK+d/Rg0ggoRcQiXJ3ErsWe0DLxEHoum05Z15XGJyz20nhP01vAls6kDyq0czGjMLVDNI1JUzUlpWkQAHYZJ0Pf1xltyQa2oUTHiZxi5itT1EoduPOTAdzUOTfNWIou+1WE+43xolbdbnd69tQ5dzpYeY/XzEx3Ve2TP+meyB05CxbQ2VVmdrnMqdAoaOlwlQWZyBnUhKsFKfuI5u8crBF32kxEXU5xP+L7BYwPBYfb8qLUiFbFGVfahBeWihuDTX40Mka5fZHrNeiRcxJpFqj8I3fa5J5jUIBuPnyvKdnRWhMvXLTBFLJsgVRyIJTsmZd16TPiy/Ffl+O/IlaovLsVMpEZ/3X1+q/t1fmrZi0hHIHPZo3LkhcFHVFfdGaMqtp88q9QvwUOlJVtHoxQtEVUsrC50J6nz9D6rpFD7x9Wi5A4lrIubEUkYMGe0JY4ESshQWgL5AoeEvUfEpprxpJVcmh8tH2IuEOEHGec9kBctPIj04/OHd0o8kOzqtyGIez61vVa+etAGUtzPauqgvuv/DpDubcJoyVrVhSXY9d0D4exk3n9T3SRwMLlMEc0ojWm61Cn/L9XtbPnvDWQPQxJWF953sfNFUKnLjB6yqskUcLLdZeQCjSqJ56RSzCz5glJanu1Rt8w/bgX7z3tSnj/mtFg6WXM4FwqL2662pC1X0oBlWpytd0hRMBO4+IVVch+qaLcZ+KGZt3aC1qmT+nHEXHdjd2Dfb047qn+D7eBcNw2MNmb9SgzjOY4vP6Ktw/uz0fnb87Ho/PLN69Hry8vfh3/cj6+eDP6t9FoPLo4H13+Orr85fXl1a/no4uL0W61gztpzGpFlyYakvDy/u72VQh4lmWypkaI1jLjdmVsKd+60he/vZslPVDIbLsYFGpZ2CUU4f7ultyT+auc/qYoui21PcVI97T2y1yWjAu/sXXjyJAPvocUyxJZchMvLiReFtD8Rj3nOpPUGUyINiwppO7vbvUAFD5xXPrYn1PtFHllrguqSZMlebdP2tMCSyjZCqbru4Go3cGesOcWcMN0bb55mZj2lJzcZLmY348XRRqDEn1Z3kczaXWfhGS4sHSw0XxP5IjUmV6Y9WW1n0E7Sf9Pu5WL9+ynn8ttu+njd5iKLeG/PvwOCiuFGoXx63paAMipPQ7xQeaTicubESvmTymKVXK0IUV32QJdV5VUcZ/oESNWa4cCLz/wTEktZ6a9LNrMIHCJ6tV6Dk8vmnORFbU/0s1xxurC3bAt6bbwFAEF8bSPNRrL6cG/M3kuC1c4NffNGfltqwESrqDTPotR3ZzzJ57XLJznuM1uBCCj7zK4a/rP6oISEBgl62mBeiFl6/SgqlUl6RzeujctSKFM8TlSIVnZqd5O0LSho34wSzemvsOWAhFRIyHnbC6kjumuub2jV7p9JnZvv9hwEPab4xVzdZzZcz+z5+71///RuYwUqRUHdPhYWXXOQtXuxkMp87pAcAKHvrCyiticRH1qacLrCilb6dD+bNbzTUcUdsTGA/oNweU4+B5hrBn99ekkZrysVJ7TJn7dH/oH3EDz1mn8i3bD0ZbNWplUIH5eO9QeuciPI0a+Sigtjx92JfXesj5YkvcPKwTubnt1HXWFr926/2HxDscfETSFv9e5h8snvwl8q5B+C9EhFvYRx1OLO5J0HgY24Xg2YUc68D/wEWiWUj1CUhPRH7rGqo1aDbtskyX8CKbpTWPvv9wsuABudFTDSw+SVV20Lnf0id4gNo0c7z7tfE/YrtYpmckWW3Y33oCHpo6kTxDabCE5tzxnU/6g2j5tyG0ywB629wYIbP72593tIHRcGCXveDGKbrwrrHUoglt26KGSVFA7eISYoPDtQCdz/INqBvi4N7Hu3j5qecasNlQn9AZtVuZDfO6J1ua94yjeTLUsakPnynTgLFv9opRch0Emy5KJfFJwcSSH3+qiCHBAcC4EtGEqbPA9ox4aTM31ceLDLDE1r0s65BmArgpummKixW0mi0IufYHZAgo15PfaVlM2mvsoU6rjYj7JucLMnCTlekiIkMG/NhvOdVOGR8XPF4vRHz4VU01t05+k9lDMoXS0CUO6mWpXttpm5B18tmSuSNDRjTx7aVUbCK3ZfQ82wXUPJLCW2Y40Sbqq7SW+N3cdSaI3jzWCDuK3IbMdybCb5Q7htJbmjrVWSHVbOQTZC6YXZ5tieIOk90wvMMLbZa+v3hhQ1yvUQE17/T7s/Rqwm2IuFTcL2uO7zfTwrD8DBNpl/uZst7Va/D/cvrHaDs86aHrBRofC3b+/GW3BG7+5+gHE8ZurTZi8rJK52hu07R93JW3trYgwfU0c+fmy173snsPNlOcSeNB0H+owX7a5ya6ppuLlOK1tdKa5g7j8lKIwTZckxP5W1H+ueIV2n2T/YlXXPSROVDjcxmIhcKEOlz2YsqHoJQepYTf2IzMb3qVJIX3dlYMfmeoFfzpyAm6mU+rFWydLS3FC7i3C3//99w9nP9XnPIPUOAP6AaVrZPoeMgJLmG9l++3+429fvt58fvct9sG++er021u6YiPMP1zr+dvnWnz7s6J/9kB1dXzEI13sU1s7+gmRWcjaJAbvCLW+cZzYNMYsXI8Y25bThq5XHrmBoMvKSZFqBbotDHXDNK7v3d1J+KFhdB/Pz1nsl2RSCLSNoF1hc9APtO4+gf8ZVNDJUe6xIq0VZzsK2Rb2J6nMTtTWOdLesxJ+/NgLH6FRGy5Y0oTbewZum1f/SdOQNwxOPRfboU8zIV0ZAd9b8tAZ+aMzAZCjYbzY2Qg3ignda7AdOjXC6b8vAYZKZyMzWfTmZJP1/LQpvHEcg5vk3khAHDSti8Tk1j/WfqudcFwYU+membdFwtEt63fcXl+UtZnKWuTAfcHjN7D2hDJe52imc7DeEubCvv9TOsKeI6+eXlONwqunqzU3zYU+1EVv/7iH7zWq1b6e+b2mKZMi7TH/oELxUH+KVEcQDR4v0aUyFepKCo2T0xyNkM4Bcu2fo0kc7o+P7z5//vi5y4YJvUTVXZRbd3f2oOFxQvYh9X0FYhWzZ7VUBdAAuhbr75P124dunOSTg/J3m1GTzVFHwHBs871GtRqe/e8Aqd8Ouw=="
 }
@@ -0,0 +1,555 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// This script maps the event data of Microsoft-Windows-Sysmon/Operational
+// events to ECS fields. It is meant to be used with the script processor in
+// the configuration of the Sysmon event log.
+
+var sysmonModule = "sysmon";
+
+// Abbreviations used for the registry hives in ECS registry.hive.
+var registryHives = {
+    "HKEY_CLASSES_ROOT": "HKCR",
+    "HKCR": "HKCR",
+    "HKEY_CURRENT_CONFIG": "HKCC",
+    "HKCC": "HKCC",
+    "HKEY_CURRENT_USER": "HKCU",
+    "HKCU": "HKCU",
+    "HKEY_LOCAL_MACHINE": "HKLM",
+    "HKLM": "HKLM",
+    "HKEY_USERS": "HKU",
+    "HKU": "HKU",
+    "\\REGISTRY\\MACHINE": "HKLM",
+    "\\REGISTRY\\USER": "HKU",
+};
+
+// DNS response codes of the Sysmon DNS query status.
+var dnsResponseCodes = {
+    "0": "NOERROR",
+    "1": "FORMERR",
+    "2": "SERVFAIL",
+    "3": "NXDOMAIN",
+    "4": "NOTIMP",
+    "5": "REFUSED",
+};
+
+var isEmpty = function(v) {
+    return v === null || v === undefined || v === "" || v === "-";
+};
+
+var basename = function(path) {
+    var idx = Math.max(path.lastIndexOf("\\"), path.lastIndexOf("/"));
+    return idx < 0 ? path : path.substring(idx + 1);
+};
+
+var dirname = function(path) {
+    var idx = Math.max(path.lastIndexOf("\\"), path.lastIndexOf("/"));
+    return idx < 0 ? "" : path.substring(0, idx);
+};
+
+// move renames an event_data field to an ECS field. Empty values are
+// removed.
+var move = function(evt, from, to) {
+    var key = "event_data." + from;
+    var value = evt.Get(key);
+    if (isEmpty(value)) {
+        evt.Delete(key);
+        return null;
+    }
+    evt.Put(to, value);
+    evt.Delete(key);
+    return value;
+};
+
+// moveInt is like move, but converts the value to a number.
+var moveInt = function(evt, from, to) {
+    var key = "event_data." + from;
+    var value = evt.Get(key);
+    var n = parseInt(value, 10);
+    if (isNaN(n)) {
+        return move(evt, from, to);
+    }
+    evt.Put(to, n);
+    evt.Delete(key);
+    return n;
+};
+
+// moveBool is like move, but converts the value to a boolean.
+var moveBool = function(evt, from, to) {
+    var key = "event_data." + from;
+    var value = evt.Get(key);
+    if (value === "true" || value === "false") {
+        evt.Put(to, value === "true");
+        evt.Delete(key);
+        return value === "true";
+    }
+    return move(evt, from, to);
+};
+
+// splitCommandLine splits a command line into arguments following the
+// rules of CommandLineToArgvW.
+var splitCommandLine = function(cmd) {
+    var args = [];
+    var arg = "";
+    var inArg = false;
+    var quoted = false;
+    var backslashes = 0;
+
+    for (var i = 0; i < cmd.length; i++) {
+        var c = cmd.charAt(i);
+        if (c === "\\") {
+            backslashes++;
+            inArg = true;
+            continue;
+        }
+        if (c === "\"") {
+            arg += new Array(Math.floor(backslashes / 2) + 1).join("\\");
+            if (backslashes % 2 === 1) {
+                arg += "\"";
+            } else {
+                quoted = !quoted;
+            }
+            backslashes = 0;
+            inArg = true;
+            continue;
+        }
+        arg += new Array(backslashes + 1).join("\\");
+        backslashes = 0;
+
+        if ((c === " " || c === "\t") && !quoted) {
+            if (inArg) {
+                args.push(arg);
+                arg = "";
+                inArg = false;
+            }
+            continue;
+        }
+        arg += c;
+        inArg = true;
+    }
+    arg += new Array(backslashes + 1).join("\\");
+    if (inArg) {
+        args.push(arg);
+    }
+    return args;
+};
+
+// setProcess maps the process fields of an event to the given ECS process
+// prefix.
+var setProcess = function(evt, prefix, guid, pid, image, commandLine) {
+    move(evt, guid, prefix + ".entity_id");
+    moveInt(evt, pid, prefix + ".pid");
+
+    var exe = move(evt, image, prefix + ".executable");
+    if (exe !== null) {
+        evt.Put(prefix + ".name", basename(exe));
+    }
+
+    if (commandLine) {
+        var cmd = move(evt, commandLine, prefix + ".command_line");
+        if (cmd !== null) {
+            evt.Put(prefix + ".args", splitCommandLine(cmd));
+        }
+    }
+};
+
+var setDefaultProcess = function(evt) {
+    setProcess(evt, "process", "ProcessGuid", "ProcessId", "Image");
+};
+
+// setHashes splits a list of hashes of the form "SHA1=...,MD5=..." into
+// hash.* fields.
+var setHashes = function(field) {
+    return function(evt) {
+        var key = "event_data." + field;
+        var hashes = evt.Get(key);
+        if (isEmpty(hashes)) {
+            return;
+        }
+
+        var parts = hashes.split(",");
+        for (var i = 0; i < parts.length; i++) {
+            var kv = parts[i].split("=");
+            if (kv.length !== 2 || isEmpty(kv[1])) {
+                continue;
+            }
+            evt.Put("hash." + kv[0].trim().toLowerCase(), kv[1].trim().toLowerCase());
+        }
+        evt.Delete(key);
+    };
+};
+
+// setUser splits a DOMAIN\user account into user.domain and user.name. The
+// user fields added by Winlogbeat describe the Sysmon service account and
+// are replaced.
+var setUser = function(evt) {
+    var key = "event_data.User";
+    var user = evt.Get(key);
+    if (isEmpty(user)) {
+        return;
+    }
+
+    evt.Delete("user");
+    var idx = user.indexOf("\\");
+    if (idx < 0) {
+        evt.Put("user.name", user);
+    } else {
+        evt.Put("user.domain", user.substring(0, idx));
+        evt.Put("user.name", user.substring(idx + 1));
+    }
+    evt.Delete(key);
+};
+
+var setFile = function(field) {
+    return function(evt) {
+        var path = move(evt, field, "file.path");
+        if (path !== null) {
+            evt.Put("file.name", basename(path));
+            evt.Put("file.directory", dirname(path));
+        }
+    };
+};
+
+// setRegistry normalizes the hive of the registry path and splits it into
+// the ECS registry fields. For values, the last path element is the value
+// name.
+var setRegistry = function(evt) {
+    var path = move(evt, "TargetObject", "registry.path");
+    if (path === null) {
+        return;
+    }
+
+    var hive = null;
+    var rest = path;
+    for (var prefix in registryHives) {
+        if (path.toUpperCase().indexOf(prefix + "\\") === 0) {
+            hive = registryHives[prefix];
+            rest = path.substring(prefix.length + 1);
+            break;
+        }
+    }
+    if (hive === null) {
+        return;
+    }
+    evt.Put("registry.hive", hive);
+    evt.Put("registry.path", hive + "\\" + rest);
+
+    var eventType = evt.Get("event_data.EventType");
+    if (eventType === "SetValue" || eventType === "DeleteValue") {
+        evt.Put("registry.key", dirname(rest));
+        evt.Put("registry.value", basename(rest));
+    } else {
+        evt.Put("registry.key", rest);
+    }
+
+    var details = move(evt, "Details", "registry.data.strings");
+    if (details !== null) {
+        evt.Put("registry.data.strings", [details]);
+    }
+};
+
+var setNetwork = function(evt) {
+    moveInt(evt, "SourcePort", "source.port");
+    move(evt, "SourceIp", "source.ip");
+    move(evt, "SourceHostname", "source.domain");
+    moveInt(evt, "DestinationPort", "destination.port");
+    move(evt, "DestinationIp", "destination.ip");
+    move(evt, "DestinationHostname", "destination.domain");
+    move(evt, "Protocol", "network.transport");
+    move(evt, "DestinationPortName", "network.protocol");
+    evt.Delete("event_data.SourcePortName");
+
+    var initiated = evt.Get("event_data.Initiated");
+    if (initiated === "true") {
+        evt.Put("network.direction", "outbound");
+    } else if (initiated === "false") {
+        evt.Put("network.direction", "inbound");
+    }
+    evt.Delete("event_data.Initiated");
+
+    var ipv6 = evt.Get("event_data.DestinationIsIpv6");
+    if (ipv6 === "true") {
+        evt.Put("network.type", "ipv6");
+    } else if (ipv6 === "false") {
+        evt.Put("network.type", "ipv4");
+    }
+    evt.Delete("event_data.SourceIsIpv6");
+    evt.Delete("event_data.DestinationIsIpv6");
+};
+
+// setDNS maps the query of a DNSEvent. The query results are a list of
+// addresses and records separated by semicolons, where CNAME and other
+// records are prefixed with "type:  <n> ".
+var setDNS = function(evt) {
+    move(evt, "QueryName", "dns.question.name");
+
+    var status = move(evt, "QueryStatus", "dns.response_code");
+    if (status !== null && dnsResponseCodes[status]) {
+        evt.Put("dns.response_code", dnsResponseCodes[status]);
+    }
+
+    var key = "event_data.QueryResults";
+    var results = evt.Get(key);
+    evt.Delete(key);
+    if (isEmpty(results)) {
+        return;
+    }
+
+    var answers = [];
+    var ips = [];
+    var parts = results.split(";");
+    for (var i = 0; i < parts.length; i++) {
+        var answer = parts[i].trim();
+        if (answer === "") {
+            continue;
+        }
+
+        var m = answer.match(/^type:\s+(\d+)\s+(.*)$/);
+        if (m) {
+            answers.push({type: dnsType(m[1]), data: m[2]});
+            continue;
+        }
+
+        var ip = answer.replace(/^::ffff:/, "");
+        ips.push(ip);
+        answers.push({type: ip.indexOf(":") < 0 ? "A" : "AAAA", data: ip});
+    }
+    if (answers.length > 0) {
+        evt.Put("dns.answers", answers);
+    }
+    if (ips.length > 0) {
+        evt.Put("dns.resolved_ip", ips);
+    }
+};
+
+var dnsType = function(code) {
+    switch (code) {
+    case "1":
+        return "A";
+    case "2":
+        return "NS";
+    case "5":
+        return "CNAME";
+    case "6":
+        return "SOA";
+    case "12":
+        return "PTR";
+    case "15":
+        return "MX";
+    case "16":
+        return "TXT";
+    case "28":
+        return "AAAA";
+    case "33":
+        return "SRV";
+    }
+    return code;
+};
+
+var setRuleName = function(evt) {
+    move(evt, "RuleName", "rule.name");
+};
+
+// Event metadata and mapping functions for each Sysmon event ID.
+var events = {
+    1: {
+        action: "Process Create",
+        category: "process",
+        type: "process_start",
+        steps: [
+            function(evt) {
+                setProcess(evt, "process", "ProcessGuid", "ProcessId", "Image", "CommandLine");
+                move(evt, "CurrentDirectory", "process.working_directory");
+                setProcess(evt, "process.parent", "ParentProcessGuid", "ParentProcessId", "ParentImage", "ParentCommandLine");
+            },
+            setHashes("Hashes"),
+            setUser,
+        ],
+    },
+    2: {
+        action: "File creation time changed",
+        category: "file",
+        type: "change",
+        steps: [setDefaultProcess, setFile("TargetFilename")],
+    },
+    3: {
+        action: "Network connection detected",
+        category: "network",
+        type: "connection",
+        steps: [setDefaultProcess, setNetwork, setUser],
+    },
+    4: {
+        action: "Sysmon service state changed",
+        category: "process",
+        type: "change",
+        steps: [],
+    },
+    5: {
+        action: "Process terminated",
+        category: "process",
+        type: "process_end",
+        steps: [setDefaultProcess],
+    },
+    6: {
+        action: "Driver loaded",
+        category: "driver",
+        type: "start",
+        steps: [setFile("ImageLoaded"), setHashes("Hashes")],
+    },
+    7: {
+        action: "Image loaded",
+        category: "process",
+        type: "change",
+        steps: [setDefaultProcess, setFile("ImageLoaded"), setHashes("Hashes")],
+    },
+    8: {
+        action: "CreateRemoteThread detected",
+        category: "process",
+        type: "change",
+        steps: [
+            function(evt) {
+                setProcess(evt, "process", "SourceProcessGuid", "SourceProcessId", "SourceImage");
+            },
+        ],
+    },
+    9: {
+        action: "RawAccessRead detected",
+        category: "process",
+        type: "access",
+        steps: [setDefaultProcess, setFile("Device")],
+    },
+    10: {
+        action: "Process accessed",
+        category: "process",
+        type: "access",
+        steps: [
+            function(evt) {
+                setProcess(evt, "process", "SourceProcessGUID", "SourceProcessId", "SourceImage");
+                moveInt(evt, "SourceThreadId", "process.thread.id");
+            },
+        ],
+    },
+    11: {
+        action: "File created",
+        category: "file",
+        type: "creation",
+        steps: [setDefaultProcess, setFile("TargetFilename")],
+    },
+    12: {
+        action: "Registry object added or deleted",
+        category: "registry",
+        type: "change",
+        steps: [setDefaultProcess, setRegistry],
+    },
+    13: {
+        action: "Registry value set",
+        category: "registry",
+        type: "change",
+        steps: [setDefaultProcess, setRegistry],
+    },
+    14: {
+        action: "Registry object renamed",
+        category: "registry",
+        type: "change",
+        steps: [setDefaultProcess, setRegistry],
+    },
+    15: {
+        action: "File stream created",
+        category: "file",
+        type: "creation",
+        steps: [setDefaultProcess, setFile("TargetFilename"), setHashes("Hash")],
+    },
+    16: {
+        action: "Sysmon config state changed",
+        category: "configuration",
+        type: "change",
+        steps: [],
+    },
+    17: {
+        action: "Pipe Created",
+        category: "file",
+        type: "creation",
+        steps: [
+            setDefaultProcess,
+            function(evt) {
+                move(evt, "PipeName", "file.name");
+            },
+        ],
+    },
+    18: {
+        action: "Pipe Connected",
+        category: "file",
+        type: "access",
+        steps: [
+            setDefaultProcess,
+            function(evt) {
+                move(evt, "PipeName", "file.name");
+            },
+        ],
+    },
+    19: {
+        action: "WmiEventFilter activity detected",
+        category: "process",
+        type: "change",
+        steps: [setUser],
+    },
+    20: {
+        action: "WmiEventConsumer activity detected",
+        category: "process",
+        type: "change",
+        steps: [setUser],
+    },
+    21: {
+        action: "WmiEventConsumerToFilter activity detected",
+        category: "process",
+        type: "change",
+        steps: [setUser],
+    },
+    22: {
+        action: "Dns query",
+        category: "network",
+        type: "protocol",
+        steps: [setDefaultProcess, setDNS],
+    },
+    255: {
+        action: "Error report",
+        category: "error",
+        type: "error",
+        steps: [],
+    },
+};
+
+function process(evt) {
+    var code = evt.Get("event_id");
+    var e = events[code];
+    if (!e) {
+        return;
+    }
+
+    evt.Put("event.module", sysmonModule);
+    evt.Put("event.kind", "event");
+    evt.Put("event.code", String(code));
+    evt.Put("event.action", e.action);
+    evt.Put("event.category", e.category);
+    evt.Put("event.type", e.type);
+
+    evt.Delete("event_data.UtcTime");
+    setRuleName(evt);
+    for (var i = 0; i < e.steps.length; i++) {
+        e.steps[i](evt);
+    }
+}
@@ -0,0 +1,252 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package test
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/processors"
+	"github.com/elastic/beats/libbeat/processors/script/javascript"
+)
+
+func newSysmonProcessor(t testing.TB) processors.Processor {
+	file, err := filepath.Abs("../config/winlogbeat-sysmon.js")
+	require.NoError(t, err)
+
+	c, err := common.NewConfigFrom(map[string]interface{}{
+		"file": file,
+	})
+	require.NoError(t, err)
+
+	p, err := javascript.New(c)
+	require.NoError(t, err)
+	return p
+}
+
+func run(t testing.TB, eventID uint32, eventData common.MapStr) common.MapStr {
+	evt, err := newSysmonProcessor(t).Run(&beat.Event{
+		Timestamp: time.Now(),
+		Fields: common.MapStr{
+			"event_id":    eventID,
+			"log_name":    "Microsoft-Windows-Sysmon/Operational",
+			"source_name": "Microsoft-Windows-Sysmon",
+			"event_data":  eventData,
+			"user": common.MapStr{
+				"identifier": "S-1-5-18",
+				"name":       "SYSTEM",
+				"domain":     "NT AUTHORITY",
+				"type":       "User",
+			},
+		},
+	})
+	require.NoError(t, err)
+	require.NotNil(t, evt)
+	return evt.Fields
+}
+
+func TestProcessCreate(t *testing.T) {
+	fields := run(t, 1, common.MapStr{
+		"RuleName":          "",
+		"UtcTime":           "2019-03-18 16:57:38.636",
+		"ProcessGuid":       "{42f11c3b-ce01-5c8f-0000-0010c73e2a00}",
+		"ProcessId":         "2848",
+		"Image":             `C:\Windows\System32\cmd.exe`,
+		"CommandLine":       `"C:\Windows\system32\cmd.exe" /c "echo hello" C:\a\\\"b`,
+		"CurrentDirectory":  `C:\Users\vagrant\`,
+		"User":              `VAGRANT-2012-R2\vagrant`,
+		"IntegrityLevel":    "High",
+		"Hashes":            "SHA1=EE8CBF12D87C4D388F09B4F69BED2E91682920B5,MD5=AB2A7A5B0D01A9C6A8E26F5E7C2BDB3C,IMPHASH=D0C2BE5B1E3D5D3F1F1F3AA5C3F3B1C0",
+		"ParentProcessGuid": "{42f11c3b-cd3f-5c8f-0000-0010f2e02800}",
+		"ParentProcessId":   "1408",
+		"ParentImage":       `C:\Windows\explorer.exe`,
+		"ParentCommandLine": `C:\Windows\Explorer.EXE`,
+	})
+
+	expected := common.MapStr{
+		"event.module":              "sysmon",
+		"event.kind":                "event",
+		"event.code":                "1",
+		"event.action":              "Process Create",
+		"event.category":            "process",
+		"event.type":                "process_start",
+		"process.entity_id":         "{42f11c3b-ce01-5c8f-0000-0010c73e2a00}",
+		"process.pid":               int64(2848),
+		"process.executable":        `C:\Windows\System32\cmd.exe`,
+		"process.name":              "cmd.exe",
+		"process.args":              []interface{}{`C:\Windows\system32\cmd.exe`, "/c", "echo hello", `C:\a\"b`},
+		"process.working_directory": `C:\Users\vagrant\`,
+		"process.parent.entity_id":  "{42f11c3b-cd3f-5c8f-0000-0010f2e02800}",
+		"process.parent.pid":        int64(1408),
+		"process.parent.name":       "explorer.exe",
+		"process.parent.args":       []interface{}{`C:\Windows\Explorer.EXE`},
+		"hash.sha1":                 "ee8cbf12d87c4d388f09b4f69bed2e91682920b5",
+		"hash.md5":                  "ab2a7a5b0d01a9c6a8e26f5e7c2bdb3c",
+		"hash.imphash":              "d0c2be5b1e3d5d3f1f1f3aa5c3f3b1c0",
+		"user.name":                 "vagrant",
+		"user.domain":               "VAGRANT-2012-R2",
+		"event_data.IntegrityLevel": "High",
+	}
+	for k, v := range expected {
+		actual, err := fields.GetValue(k)
+		if assert.NoError(t, err, k) {
+			assert.Equal(t, v, actual, k)
+		}
+	}
+
+	for _, k := range []string{
+		"event_data.Hashes", "event_data.User", "event_data.Image",
+		"event_data.UtcTime", "event_data.RuleName", "rule.name", "user.identifier",
+	} {
+		has, _ := fields.HasKey(k)
+		assert.False(t, has, k)
+	}
+}
+
+func TestNetworkConnect(t *testing.T) {
+	fields := run(t, 3, common.MapStr{
+		"ProcessGuid":         "{42f11c3b-ce01-5c8f-0000-0010c73e2a00}",
+		"ProcessId":           "2848",
+		"Image":               `C:\Program Files\Mozilla Firefox\firefox.exe`,
+		"User":                `VAGRANT-2012-R2\vagrant`,
+		"Protocol":            "tcp",
+		"Initiated":           "true",
+		"SourceIsIpv6":        "false",
+		"SourceIp":            "10.0.2.15",
+		"SourceHostname":      "vagrant-2012-r2",
+		"SourcePort":          "49796",
+		"SourcePortName":      "",
+		"DestinationIsIpv6":   "false",
+		"DestinationIp":       "151.101.1.140",
+		"DestinationHostname": "",
+		"DestinationPort":     "443",
+		"DestinationPortName": "https",
+	})
+
+	expected := common.MapStr{
+		"event.category":    "network",
+		"process.name":      "firefox.exe",
+		"source.ip":         "10.0.2.15",
+		"source.port":       int64(49796),
+		"source.domain":     "vagrant-2012-r2",
+		"destination.ip":    "151.101.1.140",
+		"destination.port":  int64(443),
+		"network.transport": "tcp",
+		"network.protocol":  "https",
+		"network.direction": "outbound",
+		"network.type":      "ipv4",
+	}
+	for k, v := range expected {
+		actual, err := fields.GetValue(k)
+		if assert.NoError(t, err, k) {
+			assert.Equal(t, v, actual, k)
+		}
+	}
+
+	has, _ := fields.HasKey("destination.domain")
+	assert.False(t, has)
+	eventData, _ := fields.GetValue("event_data")
+	assert.Empty(t, eventData)
+}
+
+func TestRegistryValueSet(t *testing.T) {
+	fields := run(t, 13, common.MapStr{
+		"EventType":    "SetValue",
+		"ProcessGuid":  "{42f11c3b-ce01-5c8f-0000-0010c73e2a00}",
+		"ProcessId":    "1256",
+		"Image":        `C:\Windows\system32\svchost.exe`,
+		"TargetObject": `\REGISTRY\MACHINE\SOFTWARE\Microsoft\Windows\CurrentVersion\Run\Updater`,
+		"Details":      `C:\updater.exe`,
+	})
+
+	expected := common.MapStr{
+		"event.category":        "registry",
+		"registry.hive":         "HKLM",
+		"registry.path":         `HKLM\SOFTWARE\Microsoft\Windows\CurrentVersion\Run\Updater`,
+		"registry.key":          `SOFTWARE\Microsoft\Windows\CurrentVersion\Run`,
+		"registry.value":        "Updater",
+		"registry.data.strings": []interface{}{`C:\updater.exe`},
+		"event_data.EventType":  "SetValue",
+	}
+	for k, v := range expected {
+		actual, err := fields.GetValue(k)
+		if assert.NoError(t, err, k) {
+			assert.Equal(t, v, actual, k)
+		}
+	}
+}
+
+func TestRegistryKeyCreate(t *testing.T) {
+	fields := run(t, 12, common.MapStr{
+		"EventType":    "CreateKey",
+		"TargetObject": `HKU\S-1-5-21-1\Software\Test`,
+	})
+
+	v, _ := fields.GetValue("registry.key")
+	assert.Equal(t, `S-1-5-21-1\Software\Test`, v)
+	v, _ = fields.GetValue("registry.hive")
+	assert.Equal(t, "HKU", v)
+	has, _ := fields.HasKey("registry.value")
+	assert.False(t, has)
+}
+
+func TestDNSQuery(t *testing.T) {
+	fields := run(t, 22, common.MapStr{
+		"ProcessGuid":  "{42f11c3b-ce01-5c8f-0000-0010c73e2a00}",
+		"ProcessId":    "4332",
+		"QueryName":    "www.elastic.co",
+		"QueryStatus":  "0",
+		"QueryResults": "type:  5 www.elastic.co.cdn.cloudflare.net;::ffff:151.101.1.140;::ffff:151.101.65.140;",
+		"Image":        `C:\Windows\System32\nslookup.exe`,
+	})
+
+	expected := common.MapStr{
+		"event.category":    "network",
+		"dns.question.name": "www.elastic.co",
+		"dns.response_code": "NOERROR",
+		"dns.resolved_ip":   []interface{}{"151.101.1.140", "151.101.65.140"},
+		"dns.answers": []interface{}{
+			common.MapStr{"type": "CNAME", "data": "www.elastic.co.cdn.cloudflare.net"},
+			common.MapStr{"type": "A", "data": "151.101.1.140"},
+			common.MapStr{"type": "A", "data": "151.101.65.140"},
+		},
+	}
+	for k, v := range expected {
+		actual, err := fields.GetValue(k)
+		if assert.NoError(t, err, k) {
+			assert.Equal(t, v, actual, k)
+		}
+	}
+}
+
+func TestUnknownEvent(t *testing.T) {
+	fields := run(t, 100, common.MapStr{"Image": `C:\a.exe`})
+
+	has, _ := fields.HasKey("event.module")
+	assert.False(t, has)
+	v, _ := fields.GetValue("event_data.Image")
+	assert.Equal(t, `C:\a.exe`, v)
+}